@@ -1,30 +1,48 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gorilla/websocket"
 	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
 	"github.com/Saracomethstein/ozon-test-task/internal/cfg"
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
 	"github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
 	"github.com/Saracomethstein/ozon-test-task/internal/graphql/middleware"
+	"github.com/Saracomethstein/ozon-test-task/internal/graphql/sse"
 	"github.com/Saracomethstein/ozon-test-task/internal/handler/graphql/resolvers"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/pkg/db"
+	"github.com/Saracomethstein/ozon-test-task/internal/pubsub"
+	memBroker "github.com/Saracomethstein/ozon-test-task/internal/pubsub/inmemory"
+	pgBroker "github.com/Saracomethstein/ozon-test-task/internal/pubsub/postgres"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
 	"github.com/Saracomethstein/ozon-test-task/internal/service"
 	"github.com/Saracomethstein/ozon-test-task/internal/service/comment"
 	"github.com/Saracomethstein/ozon-test-task/internal/service/post"
+	"github.com/Saracomethstein/ozon-test-task/internal/service/reaction"
+	"github.com/Saracomethstein/ozon-test-task/internal/service/report"
+	"github.com/Saracomethstein/ozon-test-task/internal/service/search"
+	"github.com/Saracomethstein/ozon-test-task/internal/spam"
 )
 
-const defaultPort = "8080"
+const (
+	defaultPort           = "8080"
+	websocketPingInterval = 10 * time.Second
+	ssePingInterval       = 10 * time.Second
+)
 
 var (
 	production = flag.Bool("production", false, "use PostgreSQL storage")
@@ -33,18 +51,60 @@ var (
 func main() {
 	flag.Parse()
 
-	rContainer := GetRepositoryContainer()
+	config := cfg.New()
+
+	rContainer, broker := GetStorage(context.Background(), config)
 
-	postSvc := post.New(rContainer.Post)
-	commentSvc := comment.New(rContainer.Comment)
+	contentFilter, err := filter.Load(config.ContentFilterFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	spamClassifier := spam.New(rContainer.Spam, config.SpamHoldThreshold)
+	if err := spamClassifier.Load(context.Background()); err != nil {
+		log.Fatalf("Unable to load the spam model: %v", err)
+	}
+	go spamClassifier.Reload(context.Background(), time.Duration(config.SpamReloadInterval)*time.Second)
+
+	postSvc := post.New(rContainer.Post, broker, contentFilter, rContainer.Ban)
+	commentSvc := comment.New(rContainer.Comment, broker, comment.ReplyDepth{
+		Max:    int32(config.MaxReplyDepth),
+		Policy: models.ReplyDepthPolicy(config.ReplyDepthPolicy),
+	}, contentFilter, spamClassifier, rContainer.Ban)
+	searchSvc := search.New(rContainer.Post, rContainer.Comment)
+	reactionSvc := reaction.New(rContainer.Reaction, config.ReactionKinds)
+	reportSvc := report.New(rContainer.Report, rContainer.Ban, rContainer.Post, rContainer.Comment, rContainer.Tx, broker, report.Limits{
+		HideThreshold: config.ReportHideThreshold,
+		HourlyReports: config.ReportHourlyLimit,
+	})
 	commentLoader := dataloader.NewCommentLoader(rContainer.Comment)
+	reactionLoader := dataloader.NewReactionLoader(rContainer.Reaction)
+	postLoader := dataloader.NewPostLoader(rContainer.Post)
 
-	allSvc := service.New(postSvc, commentSvc)
+	allSvc := service.New(postSvc, commentSvc, searchSvc, reactionSvc, reportSvc)
 
 	srv := handler.New(graphql.NewExecutableSchema(graphql.Config{Resolvers: resolvers.New(allSvc)}))
 
-	handlerWithDataloader := middleware.DataloaderMiddleware(*commentLoader)(srv)
-
+	handlerWithDataloader := middleware.AuthMiddleware(config.ModeratorToken)(
+		middleware.DataloaderMiddleware(*commentLoader, *reactionLoader, *postLoader)(srv),
+	)
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: websocketPingInterval,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+		// Browsers cannot set headers on a websocket, so the token may also
+		// arrive in the connection_init payload.
+		InitFunc: func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			return auth.WithAuthorization(ctx, payload.Authorization(), config.ModeratorToken), &payload, nil
+		},
+	})
+	srv.AddTransport(sse.Transport{
+		SSE: transport.SSE{KeepAlivePingInterval: ssePingInterval},
+	})
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
@@ -52,6 +112,7 @@ func main() {
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
 	srv.Use(extension.Introspection{})
+	srv.Use(sse.EventIDs{})
 	srv.Use(extension.AutomaticPersistedQuery{
 		Cache: lru.New[string](100),
 	})
@@ -64,15 +125,13 @@ func main() {
 	log.Fatal(http.ListenAndServe(":"+defaultPort, nil))
 }
 
-func GetRepositoryContainer() *repository.Container {
-	cfg := cfg.New()
-
+func GetStorage(ctx context.Context, cfg *cfg.Config) (*repository.Container, pubsub.Broker) {
 	if *production {
 		log.Println("Starting with PostgreSQL storage")
 		pgpool := db.SetupDB(*cfg)
-		return db.NewPostgresContainer(pgpool)
+		return db.NewPostgresContainer(pgpool), pgBroker.New(ctx, pgpool)
 	}
 
 	log.Println("Starting with inmemory storage")
-	return db.NewInmemoryContainer()
+	return db.NewInmemoryContainer(cfg.SpamModelFile), memBroker.New()
 }
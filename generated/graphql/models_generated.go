@@ -2,6 +2,26 @@
 
 package graphql
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type CommentEvent interface {
+	IsCommentEvent()
+}
+
+type Node interface {
+	IsNode()
+	GetID() string
+}
+
+type SearchResult interface {
+	IsSearchResult()
+}
+
 type AddCommentInput struct {
 	PostID   string  `json:"postId"`
 	ParentID *string `json:"parentId,omitempty"`
@@ -9,51 +29,147 @@ type AddCommentInput struct {
 	Text     string  `json:"text"`
 }
 
+// One entry of the moderation audit log. actor is the reporter for REPORT,
+// "system" for AUTO_HIDE and "moderator" for the actions resolving a report.
+type AuditEntry struct {
+	ID         string       `json:"id"`
+	Actor      string       `json:"actor"`
+	Action     AuditAction  `json:"action"`
+	TargetID   string       `json:"targetId"`
+	TargetType ReportTarget `json:"targetType"`
+	ReportID   *string      `json:"reportId,omitempty"`
+	Details    *string      `json:"details,omitempty"`
+	CreatedAt  string       `json:"createdAt"`
+}
+
+type AuditEntryConnection struct {
+	Edges      []*AuditEntryEdge `json:"edges"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	TotalCount int32             `json:"totalCount"`
+}
+
+type AuditEntryEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *AuditEntry `json:"node"`
+}
+
 type Comment struct {
-	ID        string             `json:"id"`
-	PostID    string             `json:"postId"`
-	ParentID  *string            `json:"parentId,omitempty"`
-	Author    string             `json:"author"`
-	Text      string             `json:"text"`
-	CreatedAt string             `json:"createdAt"`
-	Children  *CommentConnection `json:"children"`
+	ID              string        `json:"id"`
+	PostID          string        `json:"postId"`
+	ParentID        *string       `json:"parentId,omitempty"`
+	Author          string        `json:"author"`
+	Text            string        `json:"text"`
+	CreatedAt       string        `json:"createdAt"`
+	EditedAt        *string       `json:"editedAt,omitempty"`
+	IsDeleted       bool          `json:"isDeleted"`
+	Status          CommentStatus `json:"status"`
+	RejectionReason *string       `json:"rejectionReason,omitempty"`
+	// The probability, from 0 to 1, that the comment is spam according to the
+	// spam model when the comment was added. Null when the model had not learned
+	// from enough moderation decisions to score it then. Moderators only.
+	SpamScore  *float64                   `json:"spamScore,omitempty"`
+	Reactions  *ReactionSummary           `json:"reactions"`
+	Children   *CommentConnection         `json:"children"`
+	ReplyCount int32                      `json:"replyCount"`
+	Revisions  *CommentRevisionConnection `json:"revisions"`
+	Ancestors  []*Comment                 `json:"ancestors"`
+	Post       *Post                      `json:"post,omitempty"`
+}
+
+func (Comment) IsNode()            {}
+func (this Comment) GetID() string { return this.ID }
+
+func (Comment) IsSearchResult() {}
+
+type CommentAddedEvent struct {
+	Comment *Comment `json:"comment"`
 }
 
+func (CommentAddedEvent) IsCommentEvent() {}
+
 type CommentConnection struct {
 	Edges      []*CommentEdge `json:"edges"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
 	TotalCount int32          `json:"totalCount"`
 }
 
+type CommentDeletedEvent struct {
+	CommentID string `json:"commentId"`
+	PostID    string `json:"postId"`
+}
+
+func (CommentDeletedEvent) IsCommentEvent() {}
+
 type CommentEdge struct {
 	Cursor string   `json:"cursor"`
 	Node   *Comment `json:"node"`
 }
 
+type CommentRevision struct {
+	ID        string `json:"id"`
+	Text      string `json:"text"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type CommentRevisionConnection struct {
+	Edges      []*CommentRevisionEdge `json:"edges"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	TotalCount int32                  `json:"totalCount"`
+}
+
+type CommentRevisionEdge struct {
+	Cursor string           `json:"cursor"`
+	Node   *CommentRevision `json:"node"`
+}
+
+type CommentUpdatedEvent struct {
+	Comment *Comment `json:"comment"`
+}
+
+func (CommentUpdatedEvent) IsCommentEvent() {}
+
 type CreatePostInput struct {
-	Title         string `json:"title"`
-	Body          string `json:"body"`
-	Author        string `json:"author"`
-	AllowComments *bool  `json:"allowComments,omitempty"`
+	Title           string         `json:"title"`
+	Body            string         `json:"body"`
+	Author          string         `json:"author"`
+	AllowComments   *bool          `json:"allowComments,omitempty"`
+	CommentPolicy   *CommentPolicy `json:"commentPolicy,omitempty"`
+	CommentsCloseAt *string        `json:"commentsCloseAt,omitempty"`
+	Tags            []string       `json:"tags"`
+	MaxReplyDepth   *int32         `json:"maxReplyDepth,omitempty"`
 }
 
 type Mutation struct {
 }
 
 type PageInfo struct {
-	EndCursor   *string `json:"endCursor,omitempty"`
-	HasNextPage bool    `json:"hasNextPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	HasNextPage     bool    `json:"hasNextPage"`
 }
 
 type Post struct {
-	ID            string `json:"id"`
-	Title         string `json:"title"`
-	Body          string `json:"body"`
-	Author        string `json:"author"`
-	AllowComments bool   `json:"allowComments"`
-	CreatedAt     string `json:"createdAt"`
+	ID              string             `json:"id"`
+	Title           string             `json:"title"`
+	Body            string             `json:"body"`
+	Author          string             `json:"author"`
+	AllowComments   bool               `json:"allowComments"`
+	CommentPolicy   CommentPolicy      `json:"commentPolicy"`
+	CommentsCloseAt *string            `json:"commentsCloseAt,omitempty"`
+	CreatedAt       string             `json:"createdAt"`
+	DeletedAt       *string            `json:"deletedAt,omitempty"`
+	Tags            []string           `json:"tags"`
+	Reactions       *ReactionSummary   `json:"reactions"`
+	Comments        *CommentConnection `json:"comments"`
+	CommentCount    int32              `json:"commentCount"`
 }
 
+func (Post) IsNode()            {}
+func (this Post) GetID() string { return this.ID }
+
+func (Post) IsSearchResult() {}
+
 type PostConnection struct {
 	Edges      []*PostEdge `json:"edges"`
 	PageInfo   *PageInfo   `json:"pageInfo"`
@@ -68,5 +184,724 @@ type PostEdge struct {
 type Query struct {
 }
 
+type ReactionCount struct {
+	Kind  string `json:"kind"`
+	Count int32  `json:"count"`
+}
+
+type ReactionSummary struct {
+	Upvotes   int32            `json:"upvotes"`
+	Downvotes int32            `json:"downvotes"`
+	Score     int32            `json:"score"`
+	Counts    []*ReactionCount `json:"counts"`
+}
+
+// A user's complaint about a post or a comment. A reporter has at most one open
+// report per target: reporting it again returns that report, and once it is
+// resolved the target can be reported again.
+type Report struct {
+	ID           string        `json:"id"`
+	TargetID     string        `json:"targetId"`
+	TargetType   ReportTarget  `json:"targetType"`
+	TargetAuthor string        `json:"targetAuthor"`
+	Reporter     string        `json:"reporter"`
+	Reason       ReportReason  `json:"reason"`
+	Details      *string       `json:"details,omitempty"`
+	Status       ReportStatus  `json:"status"`
+	Action       *ReportAction `json:"action,omitempty"`
+	// Whether the target was hidden automatically because too many users reported
+	// it. It stays hidden until a moderator resolves the reports.
+	TargetHidden bool    `json:"targetHidden"`
+	CreatedAt    string  `json:"createdAt"`
+	ResolvedAt   *string `json:"resolvedAt,omitempty"`
+}
+
+type ReportConnection struct {
+	Edges      []*ReportEdge `json:"edges"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	TotalCount int32         `json:"totalCount"`
+}
+
+type ReportEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Report `json:"node"`
+}
+
+type SearchConnection struct {
+	Edges      []*SearchEdge `json:"edges"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	TotalCount int32         `json:"totalCount"`
+}
+
+type SearchEdge struct {
+	Cursor string  `json:"cursor"`
+	Rank   float64 `json:"rank"`
+	// An excerpt of the text around the matches. It is HTML-safe: the text is
+	// escaped and the only markup is <b></b> around each match.
+	Snippet string       `json:"snippet"`
+	Node    SearchResult `json:"node"`
+}
+
 type Subscription struct {
 }
+
+type ThreadComment struct {
+	Depth   int32    `json:"depth"`
+	Path    []string `json:"path"`
+	Comment *Comment `json:"comment"`
+}
+
+type UpdatePostInput struct {
+	Title *string `json:"title,omitempty"`
+	Body  *string `json:"body,omitempty"`
+}
+
+type AuditAction string
+
+const (
+	AuditActionReport    AuditAction = "REPORT"
+	AuditActionAutoHide  AuditAction = "AUTO_HIDE"
+	AuditActionDismiss   AuditAction = "DISMISS"
+	AuditActionHide      AuditAction = "HIDE"
+	AuditActionDelete    AuditAction = "DELETE"
+	AuditActionBanAuthor AuditAction = "BAN_AUTHOR"
+)
+
+var AllAuditAction = []AuditAction{
+	AuditActionReport,
+	AuditActionAutoHide,
+	AuditActionDismiss,
+	AuditActionHide,
+	AuditActionDelete,
+	AuditActionBanAuthor,
+}
+
+func (e AuditAction) IsValid() bool {
+	switch e {
+	case AuditActionReport, AuditActionAutoHide, AuditActionDismiss, AuditActionHide, AuditActionDelete, AuditActionBanAuthor:
+		return true
+	}
+	return false
+}
+
+func (e AuditAction) String() string {
+	return string(e)
+}
+
+func (e *AuditAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditAction", str)
+	}
+	return nil
+}
+
+func (e AuditAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuditAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuditAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// The reason a post's comment policy turned a new comment away. addComment
+// reports it in the COMMENT_NOT_ALLOWED error's extensions.reason.
+type CommentDenialReason string
+
+const (
+	CommentDenialReasonClosed      CommentDenialReason = "CLOSED"
+	CommentDenialReasonAutoClosed  CommentDenialReason = "AUTO_CLOSED"
+	CommentDenialReasonAuthorOnly  CommentDenialReason = "AUTHOR_ONLY"
+	CommentDenialReasonRepliesOnly CommentDenialReason = "REPLIES_ONLY"
+)
+
+var AllCommentDenialReason = []CommentDenialReason{
+	CommentDenialReasonClosed,
+	CommentDenialReasonAutoClosed,
+	CommentDenialReasonAuthorOnly,
+	CommentDenialReasonRepliesOnly,
+}
+
+func (e CommentDenialReason) IsValid() bool {
+	switch e {
+	case CommentDenialReasonClosed, CommentDenialReasonAutoClosed, CommentDenialReasonAuthorOnly, CommentDenialReasonRepliesOnly:
+		return true
+	}
+	return false
+}
+
+func (e CommentDenialReason) String() string {
+	return string(e)
+}
+
+func (e *CommentDenialReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentDenialReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentDenialReason", str)
+	}
+	return nil
+}
+
+func (e CommentDenialReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CommentDenialReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CommentDenialReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CommentOrder string
+
+const (
+	CommentOrderNewest        CommentOrder = "NEWEST"
+	CommentOrderOldest        CommentOrder = "OLDEST"
+	CommentOrderTop           CommentOrder = "TOP"
+	CommentOrderControversial CommentOrder = "CONTROVERSIAL"
+)
+
+var AllCommentOrder = []CommentOrder{
+	CommentOrderNewest,
+	CommentOrderOldest,
+	CommentOrderTop,
+	CommentOrderControversial,
+}
+
+func (e CommentOrder) IsValid() bool {
+	switch e {
+	case CommentOrderNewest, CommentOrderOldest, CommentOrderTop, CommentOrderControversial:
+		return true
+	}
+	return false
+}
+
+func (e CommentOrder) String() string {
+	return string(e)
+}
+
+func (e *CommentOrder) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentOrder", str)
+	}
+	return nil
+}
+
+func (e CommentOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CommentOrder) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CommentOrder) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CommentPolicy string
+
+const (
+	CommentPolicyOpen        CommentPolicy = "OPEN"
+	CommentPolicyClosed      CommentPolicy = "CLOSED"
+	CommentPolicyAuthorOnly  CommentPolicy = "AUTHOR_ONLY"
+	CommentPolicyRepliesOnly CommentPolicy = "REPLIES_ONLY"
+	CommentPolicyModerated   CommentPolicy = "MODERATED"
+)
+
+var AllCommentPolicy = []CommentPolicy{
+	CommentPolicyOpen,
+	CommentPolicyClosed,
+	CommentPolicyAuthorOnly,
+	CommentPolicyRepliesOnly,
+	CommentPolicyModerated,
+}
+
+func (e CommentPolicy) IsValid() bool {
+	switch e {
+	case CommentPolicyOpen, CommentPolicyClosed, CommentPolicyAuthorOnly, CommentPolicyRepliesOnly, CommentPolicyModerated:
+		return true
+	}
+	return false
+}
+
+func (e CommentPolicy) String() string {
+	return string(e)
+}
+
+func (e *CommentPolicy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentPolicy", str)
+	}
+	return nil
+}
+
+func (e CommentPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CommentPolicy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CommentPolicy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Where a comment is in pre-moderation. Comments on MODERATED posts, and comments
+// the content filter or the spam classifier holds, start as PENDING and are
+// hidden from every listing and subscription until a moderator approves or
+// rejects them.
+type CommentStatus string
+
+const (
+	CommentStatusPublished CommentStatus = "PUBLISHED"
+	CommentStatusPending   CommentStatus = "PENDING"
+	CommentStatusRejected  CommentStatus = "REJECTED"
+)
+
+var AllCommentStatus = []CommentStatus{
+	CommentStatusPublished,
+	CommentStatusPending,
+	CommentStatusRejected,
+}
+
+func (e CommentStatus) IsValid() bool {
+	switch e {
+	case CommentStatusPublished, CommentStatusPending, CommentStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e CommentStatus) String() string {
+	return string(e)
+}
+
+func (e *CommentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentStatus", str)
+	}
+	return nil
+}
+
+func (e CommentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CommentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CommentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReactionTarget string
+
+const (
+	ReactionTargetPost    ReactionTarget = "POST"
+	ReactionTargetComment ReactionTarget = "COMMENT"
+)
+
+var AllReactionTarget = []ReactionTarget{
+	ReactionTargetPost,
+	ReactionTargetComment,
+}
+
+func (e ReactionTarget) IsValid() bool {
+	switch e {
+	case ReactionTargetPost, ReactionTargetComment:
+		return true
+	}
+	return false
+}
+
+func (e ReactionTarget) String() string {
+	return string(e)
+}
+
+func (e *ReactionTarget) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReactionTarget(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReactionTarget", str)
+	}
+	return nil
+}
+
+func (e ReactionTarget) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReactionTarget) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReactionTarget) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How a moderator resolves a report. The action applies to the reported post or
+// comment, so it resolves every open report on it.
+// DISMISS keeps the target and brings it back if reports hid it.
+// HIDE hides the target for good: a comment is rejected, a post deleted.
+// DELETE deletes the target: a comment becomes a tombstone, a post is deleted.
+// BAN_AUTHOR bans the author of the target from posting and commenting and hides
+// the target.
+type ReportAction string
+
+const (
+	ReportActionDismiss   ReportAction = "DISMISS"
+	ReportActionHide      ReportAction = "HIDE"
+	ReportActionDelete    ReportAction = "DELETE"
+	ReportActionBanAuthor ReportAction = "BAN_AUTHOR"
+)
+
+var AllReportAction = []ReportAction{
+	ReportActionDismiss,
+	ReportActionHide,
+	ReportActionDelete,
+	ReportActionBanAuthor,
+}
+
+func (e ReportAction) IsValid() bool {
+	switch e {
+	case ReportActionDismiss, ReportActionHide, ReportActionDelete, ReportActionBanAuthor:
+		return true
+	}
+	return false
+}
+
+func (e ReportAction) String() string {
+	return string(e)
+}
+
+func (e *ReportAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportAction", str)
+	}
+	return nil
+}
+
+func (e ReportAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportReason string
+
+const (
+	ReportReasonSpam     ReportReason = "SPAM"
+	ReportReasonAbuse    ReportReason = "ABUSE"
+	ReportReasonOffTopic ReportReason = "OFF_TOPIC"
+	ReportReasonOther    ReportReason = "OTHER"
+)
+
+var AllReportReason = []ReportReason{
+	ReportReasonSpam,
+	ReportReasonAbuse,
+	ReportReasonOffTopic,
+	ReportReasonOther,
+}
+
+func (e ReportReason) IsValid() bool {
+	switch e {
+	case ReportReasonSpam, ReportReasonAbuse, ReportReasonOffTopic, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+func (e ReportReason) String() string {
+	return string(e)
+}
+
+func (e *ReportReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportReason", str)
+	}
+	return nil
+}
+
+func (e ReportReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportStatus string
+
+const (
+	ReportStatusOpen     ReportStatus = "OPEN"
+	ReportStatusResolved ReportStatus = "RESOLVED"
+)
+
+var AllReportStatus = []ReportStatus{
+	ReportStatusOpen,
+	ReportStatusResolved,
+}
+
+func (e ReportStatus) IsValid() bool {
+	switch e {
+	case ReportStatusOpen, ReportStatusResolved:
+		return true
+	}
+	return false
+}
+
+func (e ReportStatus) String() string {
+	return string(e)
+}
+
+func (e *ReportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportStatus", str)
+	}
+	return nil
+}
+
+func (e ReportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportTarget string
+
+const (
+	ReportTargetPost    ReportTarget = "POST"
+	ReportTargetComment ReportTarget = "COMMENT"
+)
+
+var AllReportTarget = []ReportTarget{
+	ReportTargetPost,
+	ReportTargetComment,
+}
+
+func (e ReportTarget) IsValid() bool {
+	switch e {
+	case ReportTargetPost, ReportTargetComment:
+		return true
+	}
+	return false
+}
+
+func (e ReportTarget) String() string {
+	return string(e)
+}
+
+func (e *ReportTarget) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportTarget(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportTarget", str)
+	}
+	return nil
+}
+
+func (e ReportTarget) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportTarget) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportTarget) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "ANY"
+	TagMatchAll TagMatch = "ALL"
+)
+
+var AllTagMatch = []TagMatch{
+	TagMatchAny,
+	TagMatchAll,
+}
+
+func (e TagMatch) IsValid() bool {
+	switch e {
+	case TagMatchAny, TagMatchAll:
+		return true
+	}
+	return false
+}
+
+func (e TagMatch) String() string {
+	return string(e)
+}
+
+func (e *TagMatch) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TagMatch(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TagMatch", str)
+	}
+	return nil
+}
+
+func (e TagMatch) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TagMatch) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TagMatch) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -34,6 +34,7 @@ type Config struct {
 type ResolverRoot interface {
 	Comment() CommentResolver
 	Mutation() MutationResolver
+	Post() PostResolver
 	Query() QueryResolver
 	Subscription() SubscriptionResolver
 }
@@ -42,351 +43,1348 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AuditEntry struct {
+		Action     func(childComplexity int) int
+		Actor      func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		Details    func(childComplexity int) int
+		ID         func(childComplexity int) int
+		ReportID   func(childComplexity int) int
+		TargetID   func(childComplexity int) int
+		TargetType func(childComplexity int) int
+	}
+
+	AuditEntryConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	AuditEntryEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Comment struct {
-		Author    func(childComplexity int) int
-		Children  func(childComplexity int, first *int32, after *string) int
+		Ancestors       func(childComplexity int) int
+		Author          func(childComplexity int) int
+		Children        func(childComplexity int, first *int32, after *string, last *int32, before *string, orderBy *CommentOrder) int
+		CreatedAt       func(childComplexity int) int
+		EditedAt        func(childComplexity int) int
+		ID              func(childComplexity int) int
+		IsDeleted       func(childComplexity int) int
+		ParentID        func(childComplexity int) int
+		Post            func(childComplexity int) int
+		PostID          func(childComplexity int) int
+		Reactions       func(childComplexity int) int
+		RejectionReason func(childComplexity int) int
+		ReplyCount      func(childComplexity int) int
+		Revisions       func(childComplexity int, first *int32, after *string) int
+		SpamScore       func(childComplexity int) int
+		Status          func(childComplexity int) int
+		Text            func(childComplexity int) int
+	}
+
+	CommentAddedEvent struct {
+		Comment func(childComplexity int) int
+	}
+
+	CommentConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CommentDeletedEvent struct {
+		CommentID func(childComplexity int) int
+		PostID    func(childComplexity int) int
+	}
+
+	CommentEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	CommentRevision struct {
 		CreatedAt func(childComplexity int) int
 		ID        func(childComplexity int) int
-		ParentID  func(childComplexity int) int
-		PostID    func(childComplexity int) int
 		Text      func(childComplexity int) int
 	}
 
-	CommentConnection struct {
+	CommentRevisionConnection struct {
 		Edges      func(childComplexity int) int
 		PageInfo   func(childComplexity int) int
 		TotalCount func(childComplexity int) int
 	}
 
-	CommentEdge struct {
+	CommentRevisionEdge struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
 
+	CommentUpdatedEvent struct {
+		Comment func(childComplexity int) int
+	}
+
 	Mutation struct {
 		AddComment             func(childComplexity int, input AddCommentInput) int
+		ApproveComment         func(childComplexity int, id string) int
 		CreatePost             func(childComplexity int, input CreatePostInput) int
+		DeleteComment          func(childComplexity int, id string) int
+		DeletePost             func(childComplexity int, id string) int
+		EditComment            func(childComplexity int, id string, text string) int
+		PurgeComment           func(childComplexity int, id string) int
+		React                  func(childComplexity int, target ReactionTarget, targetID string, author string, kind string) int
+		RejectComment          func(childComplexity int, id string, reason *string) int
+		Report                 func(childComplexity int, targetID string, reporter string, reason ReportReason, details *string) int
+		ResolveReport          func(childComplexity int, id string, action ReportAction) int
+		RestorePost            func(childComplexity int, id string) int
+		SetPostCommentPolicy   func(childComplexity int, postID string, policy CommentPolicy, closeAt *string) int
 		SetPostCommentsAllowed func(childComplexity int, postID string, allow bool) int
+		Unreact                func(childComplexity int, target ReactionTarget, targetID string, author string) int
+		UpdatePost             func(childComplexity int, id string, input UpdatePostInput) int
 	}
 
 	PageInfo struct {
-		EndCursor   func(childComplexity int) int
-		HasNextPage func(childComplexity int) int
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
 	}
 
 	Post struct {
-		AllowComments func(childComplexity int) int
-		Author        func(childComplexity int) int
-		Body          func(childComplexity int) int
-		CreatedAt     func(childComplexity int) int
-		ID            func(childComplexity int) int
-		Title         func(childComplexity int) int
+		AllowComments   func(childComplexity int) int
+		Author          func(childComplexity int) int
+		Body            func(childComplexity int) int
+		CommentCount    func(childComplexity int) int
+		CommentPolicy   func(childComplexity int) int
+		Comments        func(childComplexity int, first *int32, after *string, last *int32, before *string, orderBy *CommentOrder) int
+		CommentsCloseAt func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		DeletedAt       func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Reactions       func(childComplexity int) int
+		Tags            func(childComplexity int) int
+		Title           func(childComplexity int) int
+	}
+
+	PostConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PostEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	Query struct {
+		AuditLog        func(childComplexity int, first *int32, after *string) int
+		Comment         func(childComplexity int, id string) int
+		CommentThread   func(childComplexity int, postID string, rootID *string, maxDepth *int32, maxPerLevel *int32) int
+		CommentsByPost  func(childComplexity int, postID string, first *int32, after *string, last *int32, before *string, orderBy *CommentOrder) int
+		ModerationQueue func(childComplexity int, first *int32, after *string, postID *string) int
+		Node            func(childComplexity int, id string) int
+		Nodes           func(childComplexity int, ids []string) int
+		Post            func(childComplexity int, id string) int
+		Posts           func(childComplexity int, first *int32, after *string, last *int32, before *string, tags []string, match *TagMatch) int
+		ReactionKinds   func(childComplexity int) int
+		Reports         func(childComplexity int, status *ReportStatus, first *int32, after *string) int
+		Search          func(childComplexity int, query string, first *int32, after *string) int
+		Tags            func(childComplexity int, prefix *string, first *int32) int
+	}
+
+	ReactionCount struct {
+		Count func(childComplexity int) int
+		Kind  func(childComplexity int) int
+	}
+
+	ReactionSummary struct {
+		Counts    func(childComplexity int) int
+		Downvotes func(childComplexity int) int
+		Score     func(childComplexity int) int
+		Upvotes   func(childComplexity int) int
+	}
+
+	Report struct {
+		Action       func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		Details      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Reason       func(childComplexity int) int
+		Reporter     func(childComplexity int) int
+		ResolvedAt   func(childComplexity int) int
+		Status       func(childComplexity int) int
+		TargetAuthor func(childComplexity int) int
+		TargetHidden func(childComplexity int) int
+		TargetID     func(childComplexity int) int
+		TargetType   func(childComplexity int) int
+	}
+
+	ReportConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	ReportEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	SearchConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	SearchEdge struct {
+		Cursor  func(childComplexity int) int
+		Node    func(childComplexity int) int
+		Rank    func(childComplexity int) int
+		Snippet func(childComplexity int) int
+	}
+
+	Subscription struct {
+		CommentAdded  func(childComplexity int, postID string, after *string) int
+		CommentEvents func(childComplexity int, postID string) int
+		PostUpdated   func(childComplexity int, postID string) int
+	}
+
+	ThreadComment struct {
+		Comment func(childComplexity int) int
+		Depth   func(childComplexity int) int
+		Path    func(childComplexity int) int
+	}
+}
+
+type executableSchema struct {
+	schema     *ast.Schema
+	resolvers  ResolverRoot
+	directives DirectiveRoot
+	complexity ComplexityRoot
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	if e.schema != nil {
+		return e.schema
 	}
+	return parsedSchema
+}
+
+func (e *executableSchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, rawArgs map[string]any) (int, bool) {
+	ec := executionContext{nil, e, 0, 0, nil}
+	_ = ec
+	switch typeName + "." + field {
+
+	case "AuditEntry.action":
+		if e.complexity.AuditEntry.Action == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.Action(childComplexity), true
+
+	case "AuditEntry.actor":
+		if e.complexity.AuditEntry.Actor == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.Actor(childComplexity), true
+
+	case "AuditEntry.createdAt":
+		if e.complexity.AuditEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.CreatedAt(childComplexity), true
+
+	case "AuditEntry.details":
+		if e.complexity.AuditEntry.Details == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.Details(childComplexity), true
+
+	case "AuditEntry.id":
+		if e.complexity.AuditEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.ID(childComplexity), true
+
+	case "AuditEntry.reportId":
+		if e.complexity.AuditEntry.ReportID == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.ReportID(childComplexity), true
+
+	case "AuditEntry.targetId":
+		if e.complexity.AuditEntry.TargetID == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.TargetID(childComplexity), true
+
+	case "AuditEntry.targetType":
+		if e.complexity.AuditEntry.TargetType == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.TargetType(childComplexity), true
+
+	case "AuditEntryConnection.edges":
+		if e.complexity.AuditEntryConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryConnection.Edges(childComplexity), true
+
+	case "AuditEntryConnection.pageInfo":
+		if e.complexity.AuditEntryConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryConnection.PageInfo(childComplexity), true
+
+	case "AuditEntryConnection.totalCount":
+		if e.complexity.AuditEntryConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryConnection.TotalCount(childComplexity), true
+
+	case "AuditEntryEdge.cursor":
+		if e.complexity.AuditEntryEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryEdge.Cursor(childComplexity), true
+
+	case "AuditEntryEdge.node":
+		if e.complexity.AuditEntryEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryEdge.Node(childComplexity), true
+
+	case "Comment.ancestors":
+		if e.complexity.Comment.Ancestors == nil {
+			break
+		}
+
+		return e.complexity.Comment.Ancestors(childComplexity), true
+
+	case "Comment.author":
+		if e.complexity.Comment.Author == nil {
+			break
+		}
+
+		return e.complexity.Comment.Author(childComplexity), true
+
+	case "Comment.children":
+		if e.complexity.Comment.Children == nil {
+			break
+		}
+
+		args, err := ec.field_Comment_children_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Comment.Children(childComplexity, args["first"].(*int32), args["after"].(*string), args["last"].(*int32), args["before"].(*string), args["orderBy"].(*CommentOrder)), true
+
+	case "Comment.createdAt":
+		if e.complexity.Comment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.CreatedAt(childComplexity), true
+
+	case "Comment.editedAt":
+		if e.complexity.Comment.EditedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.EditedAt(childComplexity), true
+
+	case "Comment.id":
+		if e.complexity.Comment.ID == nil {
+			break
+		}
+
+		return e.complexity.Comment.ID(childComplexity), true
+
+	case "Comment.isDeleted":
+		if e.complexity.Comment.IsDeleted == nil {
+			break
+		}
+
+		return e.complexity.Comment.IsDeleted(childComplexity), true
+
+	case "Comment.parentId":
+		if e.complexity.Comment.ParentID == nil {
+			break
+		}
+
+		return e.complexity.Comment.ParentID(childComplexity), true
+
+	case "Comment.post":
+		if e.complexity.Comment.Post == nil {
+			break
+		}
+
+		return e.complexity.Comment.Post(childComplexity), true
+
+	case "Comment.postId":
+		if e.complexity.Comment.PostID == nil {
+			break
+		}
+
+		return e.complexity.Comment.PostID(childComplexity), true
+
+	case "Comment.reactions":
+		if e.complexity.Comment.Reactions == nil {
+			break
+		}
+
+		return e.complexity.Comment.Reactions(childComplexity), true
+
+	case "Comment.rejectionReason":
+		if e.complexity.Comment.RejectionReason == nil {
+			break
+		}
+
+		return e.complexity.Comment.RejectionReason(childComplexity), true
+
+	case "Comment.replyCount":
+		if e.complexity.Comment.ReplyCount == nil {
+			break
+		}
+
+		return e.complexity.Comment.ReplyCount(childComplexity), true
+
+	case "Comment.revisions":
+		if e.complexity.Comment.Revisions == nil {
+			break
+		}
+
+		args, err := ec.field_Comment_revisions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Comment.Revisions(childComplexity, args["first"].(*int32), args["after"].(*string)), true
+
+	case "Comment.spamScore":
+		if e.complexity.Comment.SpamScore == nil {
+			break
+		}
+
+		return e.complexity.Comment.SpamScore(childComplexity), true
+
+	case "Comment.status":
+		if e.complexity.Comment.Status == nil {
+			break
+		}
+
+		return e.complexity.Comment.Status(childComplexity), true
+
+	case "Comment.text":
+		if e.complexity.Comment.Text == nil {
+			break
+		}
+
+		return e.complexity.Comment.Text(childComplexity), true
+
+	case "CommentAddedEvent.comment":
+		if e.complexity.CommentAddedEvent.Comment == nil {
+			break
+		}
+
+		return e.complexity.CommentAddedEvent.Comment(childComplexity), true
+
+	case "CommentConnection.edges":
+		if e.complexity.CommentConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.Edges(childComplexity), true
+
+	case "CommentConnection.pageInfo":
+		if e.complexity.CommentConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.PageInfo(childComplexity), true
+
+	case "CommentConnection.totalCount":
+		if e.complexity.CommentConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.TotalCount(childComplexity), true
+
+	case "CommentDeletedEvent.commentId":
+		if e.complexity.CommentDeletedEvent.CommentID == nil {
+			break
+		}
+
+		return e.complexity.CommentDeletedEvent.CommentID(childComplexity), true
+
+	case "CommentDeletedEvent.postId":
+		if e.complexity.CommentDeletedEvent.PostID == nil {
+			break
+		}
+
+		return e.complexity.CommentDeletedEvent.PostID(childComplexity), true
+
+	case "CommentEdge.cursor":
+		if e.complexity.CommentEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CommentEdge.Cursor(childComplexity), true
+
+	case "CommentEdge.node":
+		if e.complexity.CommentEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CommentEdge.Node(childComplexity), true
+
+	case "CommentRevision.createdAt":
+		if e.complexity.CommentRevision.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CommentRevision.CreatedAt(childComplexity), true
+
+	case "CommentRevision.id":
+		if e.complexity.CommentRevision.ID == nil {
+			break
+		}
+
+		return e.complexity.CommentRevision.ID(childComplexity), true
+
+	case "CommentRevision.text":
+		if e.complexity.CommentRevision.Text == nil {
+			break
+		}
+
+		return e.complexity.CommentRevision.Text(childComplexity), true
+
+	case "CommentRevisionConnection.edges":
+		if e.complexity.CommentRevisionConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CommentRevisionConnection.Edges(childComplexity), true
+
+	case "CommentRevisionConnection.pageInfo":
+		if e.complexity.CommentRevisionConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CommentRevisionConnection.PageInfo(childComplexity), true
+
+	case "CommentRevisionConnection.totalCount":
+		if e.complexity.CommentRevisionConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CommentRevisionConnection.TotalCount(childComplexity), true
+
+	case "CommentRevisionEdge.cursor":
+		if e.complexity.CommentRevisionEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CommentRevisionEdge.Cursor(childComplexity), true
+
+	case "CommentRevisionEdge.node":
+		if e.complexity.CommentRevisionEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CommentRevisionEdge.Node(childComplexity), true
+
+	case "CommentUpdatedEvent.comment":
+		if e.complexity.CommentUpdatedEvent.Comment == nil {
+			break
+		}
+
+		return e.complexity.CommentUpdatedEvent.Comment(childComplexity), true
+
+	case "Mutation.addComment":
+		if e.complexity.Mutation.AddComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddComment(childComplexity, args["input"].(AddCommentInput)), true
+
+	case "Mutation.approveComment":
+		if e.complexity.Mutation.ApproveComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveComment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.createPost":
+		if e.complexity.Mutation.CreatePost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createPost_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreatePost(childComplexity, args["input"].(CreatePostInput)), true
+
+	case "Mutation.deleteComment":
+		if e.complexity.Mutation.DeleteComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteComment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deletePost":
+		if e.complexity.Mutation.DeletePost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deletePost_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeletePost(childComplexity, args["id"].(string)), true
+
+	case "Mutation.editComment":
+		if e.complexity.Mutation.EditComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_editComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.EditComment(childComplexity, args["id"].(string), args["text"].(string)), true
+
+	case "Mutation.purgeComment":
+		if e.complexity.Mutation.PurgeComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_purgeComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PurgeComment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.react":
+		if e.complexity.Mutation.React == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_react_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.React(childComplexity, args["target"].(ReactionTarget), args["targetId"].(string), args["author"].(string), args["kind"].(string)), true
+
+	case "Mutation.rejectComment":
+		if e.complexity.Mutation.RejectComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rejectComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RejectComment(childComplexity, args["id"].(string), args["reason"].(*string)), true
+
+	case "Mutation.report":
+		if e.complexity.Mutation.Report == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_report_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Report(childComplexity, args["targetId"].(string), args["reporter"].(string), args["reason"].(ReportReason), args["details"].(*string)), true
+
+	case "Mutation.resolveReport":
+		if e.complexity.Mutation.ResolveReport == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resolveReport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResolveReport(childComplexity, args["id"].(string), args["action"].(ReportAction)), true
+
+	case "Mutation.restorePost":
+		if e.complexity.Mutation.RestorePost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restorePost_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestorePost(childComplexity, args["id"].(string)), true
+
+	case "Mutation.setPostCommentPolicy":
+		if e.complexity.Mutation.SetPostCommentPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setPostCommentPolicy_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetPostCommentPolicy(childComplexity, args["postId"].(string), args["policy"].(CommentPolicy), args["closeAt"].(*string)), true
+
+	case "Mutation.setPostCommentsAllowed":
+		if e.complexity.Mutation.SetPostCommentsAllowed == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setPostCommentsAllowed_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetPostCommentsAllowed(childComplexity, args["postId"].(string), args["allow"].(bool)), true
+
+	case "Mutation.unreact":
+		if e.complexity.Mutation.Unreact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unreact_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Unreact(childComplexity, args["target"].(ReactionTarget), args["targetId"].(string), args["author"].(string)), true
+
+	case "Mutation.updatePost":
+		if e.complexity.Mutation.UpdatePost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updatePost_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdatePost(childComplexity, args["id"].(string), args["input"].(UpdatePostInput)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Post.allowComments":
+		if e.complexity.Post.AllowComments == nil {
+			break
+		}
+
+		return e.complexity.Post.AllowComments(childComplexity), true
+
+	case "Post.author":
+		if e.complexity.Post.Author == nil {
+			break
+		}
+
+		return e.complexity.Post.Author(childComplexity), true
+
+	case "Post.body":
+		if e.complexity.Post.Body == nil {
+			break
+		}
+
+		return e.complexity.Post.Body(childComplexity), true
+
+	case "Post.commentCount":
+		if e.complexity.Post.CommentCount == nil {
+			break
+		}
+
+		return e.complexity.Post.CommentCount(childComplexity), true
+
+	case "Post.commentPolicy":
+		if e.complexity.Post.CommentPolicy == nil {
+			break
+		}
+
+		return e.complexity.Post.CommentPolicy(childComplexity), true
+
+	case "Post.comments":
+		if e.complexity.Post.Comments == nil {
+			break
+		}
+
+		args, err := ec.field_Post_comments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Post.Comments(childComplexity, args["first"].(*int32), args["after"].(*string), args["last"].(*int32), args["before"].(*string), args["orderBy"].(*CommentOrder)), true
+
+	case "Post.commentsCloseAt":
+		if e.complexity.Post.CommentsCloseAt == nil {
+			break
+		}
+
+		return e.complexity.Post.CommentsCloseAt(childComplexity), true
+
+	case "Post.createdAt":
+		if e.complexity.Post.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Post.CreatedAt(childComplexity), true
+
+	case "Post.deletedAt":
+		if e.complexity.Post.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Post.DeletedAt(childComplexity), true
+
+	case "Post.id":
+		if e.complexity.Post.ID == nil {
+			break
+		}
+
+		return e.complexity.Post.ID(childComplexity), true
+
+	case "Post.reactions":
+		if e.complexity.Post.Reactions == nil {
+			break
+		}
+
+		return e.complexity.Post.Reactions(childComplexity), true
+
+	case "Post.tags":
+		if e.complexity.Post.Tags == nil {
+			break
+		}
+
+		return e.complexity.Post.Tags(childComplexity), true
+
+	case "Post.title":
+		if e.complexity.Post.Title == nil {
+			break
+		}
+
+		return e.complexity.Post.Title(childComplexity), true
+
+	case "PostConnection.edges":
+		if e.complexity.PostConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PostConnection.Edges(childComplexity), true
+
+	case "PostConnection.pageInfo":
+		if e.complexity.PostConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PostConnection.PageInfo(childComplexity), true
+
+	case "PostConnection.totalCount":
+		if e.complexity.PostConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PostConnection.TotalCount(childComplexity), true
+
+	case "PostEdge.cursor":
+		if e.complexity.PostEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PostEdge.Cursor(childComplexity), true
+
+	case "PostEdge.node":
+		if e.complexity.PostEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PostEdge.Node(childComplexity), true
+
+	case "Query.auditLog":
+		if e.complexity.Query.AuditLog == nil {
+			break
+		}
+
+		args, err := ec.field_Query_auditLog_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AuditLog(childComplexity, args["first"].(*int32), args["after"].(*string)), true
+
+	case "Query.comment":
+		if e.complexity.Query.Comment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_comment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Comment(childComplexity, args["id"].(string)), true
+
+	case "Query.commentThread":
+		if e.complexity.Query.CommentThread == nil {
+			break
+		}
+
+		args, err := ec.field_Query_commentThread_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CommentThread(childComplexity, args["postId"].(string), args["rootId"].(*string), args["maxDepth"].(*int32), args["maxPerLevel"].(*int32)), true
+
+	case "Query.commentsByPost":
+		if e.complexity.Query.CommentsByPost == nil {
+			break
+		}
+
+		args, err := ec.field_Query_commentsByPost_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CommentsByPost(childComplexity, args["postId"].(string), args["first"].(*int32), args["after"].(*string), args["last"].(*int32), args["before"].(*string), args["orderBy"].(*CommentOrder)), true
+
+	case "Query.moderationQueue":
+		if e.complexity.Query.ModerationQueue == nil {
+			break
+		}
+
+		args, err := ec.field_Query_moderationQueue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ModerationQueue(childComplexity, args["first"].(*int32), args["after"].(*string), args["postId"].(*string)), true
+
+	case "Query.node":
+		if e.complexity.Query.Node == nil {
+			break
+		}
+
+		args, err := ec.field_Query_node_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Node(childComplexity, args["id"].(string)), true
+
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_nodes_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Nodes(childComplexity, args["ids"].([]string)), true
+
+	case "Query.post":
+		if e.complexity.Query.Post == nil {
+			break
+		}
+
+		args, err := ec.field_Query_post_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Post(childComplexity, args["id"].(string)), true
+
+	case "Query.posts":
+		if e.complexity.Query.Posts == nil {
+			break
+		}
 
-	PostConnection struct {
-		Edges      func(childComplexity int) int
-		PageInfo   func(childComplexity int) int
-		TotalCount func(childComplexity int) int
-	}
+		args, err := ec.field_Query_posts_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	PostEdge struct {
-		Cursor func(childComplexity int) int
-		Node   func(childComplexity int) int
-	}
+		return e.complexity.Query.Posts(childComplexity, args["first"].(*int32), args["after"].(*string), args["last"].(*int32), args["before"].(*string), args["tags"].([]string), args["match"].(*TagMatch)), true
 
-	Query struct {
-		CommentsByPost func(childComplexity int, postID string, first *int32, after *string) int
-		Post           func(childComplexity int, id string) int
-		Posts          func(childComplexity int, first *int32, after *string) int
-	}
+	case "Query.reactionKinds":
+		if e.complexity.Query.ReactionKinds == nil {
+			break
+		}
 
-	Subscription struct {
-		CommentAdded func(childComplexity int, postID string) int
-	}
-}
+		return e.complexity.Query.ReactionKinds(childComplexity), true
 
-type executableSchema struct {
-	schema     *ast.Schema
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
-}
+	case "Query.reports":
+		if e.complexity.Query.Reports == nil {
+			break
+		}
 
-func (e *executableSchema) Schema() *ast.Schema {
-	if e.schema != nil {
-		return e.schema
-	}
-	return parsedSchema
-}
+		args, err := ec.field_Query_reports_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (e *executableSchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, rawArgs map[string]any) (int, bool) {
-	ec := executionContext{nil, e, 0, 0, nil}
-	_ = ec
-	switch typeName + "." + field {
+		return e.complexity.Query.Reports(childComplexity, args["status"].(*ReportStatus), args["first"].(*int32), args["after"].(*string)), true
 
-	case "Comment.author":
-		if e.complexity.Comment.Author == nil {
+	case "Query.search":
+		if e.complexity.Query.Search == nil {
 			break
 		}
 
-		return e.complexity.Comment.Author(childComplexity), true
+		args, err := ec.field_Query_search_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Comment.children":
-		if e.complexity.Comment.Children == nil {
+		return e.complexity.Query.Search(childComplexity, args["query"].(string), args["first"].(*int32), args["after"].(*string)), true
+
+	case "Query.tags":
+		if e.complexity.Query.Tags == nil {
 			break
 		}
 
-		args, err := ec.field_Comment_children_args(ctx, rawArgs)
+		args, err := ec.field_Query_tags_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Comment.Children(childComplexity, args["first"].(*int32), args["after"].(*string)), true
+		return e.complexity.Query.Tags(childComplexity, args["prefix"].(*string), args["first"].(*int32)), true
 
-	case "Comment.createdAt":
-		if e.complexity.Comment.CreatedAt == nil {
+	case "ReactionCount.count":
+		if e.complexity.ReactionCount.Count == nil {
 			break
 		}
 
-		return e.complexity.Comment.CreatedAt(childComplexity), true
+		return e.complexity.ReactionCount.Count(childComplexity), true
 
-	case "Comment.id":
-		if e.complexity.Comment.ID == nil {
+	case "ReactionCount.kind":
+		if e.complexity.ReactionCount.Kind == nil {
 			break
 		}
 
-		return e.complexity.Comment.ID(childComplexity), true
+		return e.complexity.ReactionCount.Kind(childComplexity), true
 
-	case "Comment.parentId":
-		if e.complexity.Comment.ParentID == nil {
+	case "ReactionSummary.counts":
+		if e.complexity.ReactionSummary.Counts == nil {
 			break
 		}
 
-		return e.complexity.Comment.ParentID(childComplexity), true
+		return e.complexity.ReactionSummary.Counts(childComplexity), true
 
-	case "Comment.postId":
-		if e.complexity.Comment.PostID == nil {
+	case "ReactionSummary.downvotes":
+		if e.complexity.ReactionSummary.Downvotes == nil {
 			break
 		}
 
-		return e.complexity.Comment.PostID(childComplexity), true
+		return e.complexity.ReactionSummary.Downvotes(childComplexity), true
 
-	case "Comment.text":
-		if e.complexity.Comment.Text == nil {
+	case "ReactionSummary.score":
+		if e.complexity.ReactionSummary.Score == nil {
 			break
 		}
 
-		return e.complexity.Comment.Text(childComplexity), true
+		return e.complexity.ReactionSummary.Score(childComplexity), true
 
-	case "CommentConnection.edges":
-		if e.complexity.CommentConnection.Edges == nil {
+	case "ReactionSummary.upvotes":
+		if e.complexity.ReactionSummary.Upvotes == nil {
 			break
 		}
 
-		return e.complexity.CommentConnection.Edges(childComplexity), true
+		return e.complexity.ReactionSummary.Upvotes(childComplexity), true
 
-	case "CommentConnection.pageInfo":
-		if e.complexity.CommentConnection.PageInfo == nil {
+	case "Report.action":
+		if e.complexity.Report.Action == nil {
 			break
 		}
 
-		return e.complexity.CommentConnection.PageInfo(childComplexity), true
+		return e.complexity.Report.Action(childComplexity), true
 
-	case "CommentConnection.totalCount":
-		if e.complexity.CommentConnection.TotalCount == nil {
+	case "Report.createdAt":
+		if e.complexity.Report.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.CommentConnection.TotalCount(childComplexity), true
+		return e.complexity.Report.CreatedAt(childComplexity), true
 
-	case "CommentEdge.cursor":
-		if e.complexity.CommentEdge.Cursor == nil {
+	case "Report.details":
+		if e.complexity.Report.Details == nil {
 			break
 		}
 
-		return e.complexity.CommentEdge.Cursor(childComplexity), true
+		return e.complexity.Report.Details(childComplexity), true
 
-	case "CommentEdge.node":
-		if e.complexity.CommentEdge.Node == nil {
+	case "Report.id":
+		if e.complexity.Report.ID == nil {
 			break
 		}
 
-		return e.complexity.CommentEdge.Node(childComplexity), true
+		return e.complexity.Report.ID(childComplexity), true
 
-	case "Mutation.addComment":
-		if e.complexity.Mutation.AddComment == nil {
+	case "Report.reason":
+		if e.complexity.Report.Reason == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addComment_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Report.Reason(childComplexity), true
+
+	case "Report.reporter":
+		if e.complexity.Report.Reporter == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddComment(childComplexity, args["input"].(AddCommentInput)), true
+		return e.complexity.Report.Reporter(childComplexity), true
 
-	case "Mutation.createPost":
-		if e.complexity.Mutation.CreatePost == nil {
+	case "Report.resolvedAt":
+		if e.complexity.Report.ResolvedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createPost_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Report.ResolvedAt(childComplexity), true
+
+	case "Report.status":
+		if e.complexity.Report.Status == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreatePost(childComplexity, args["input"].(CreatePostInput)), true
+		return e.complexity.Report.Status(childComplexity), true
 
-	case "Mutation.setPostCommentsAllowed":
-		if e.complexity.Mutation.SetPostCommentsAllowed == nil {
+	case "Report.targetAuthor":
+		if e.complexity.Report.TargetAuthor == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_setPostCommentsAllowed_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Report.TargetAuthor(childComplexity), true
+
+	case "Report.targetHidden":
+		if e.complexity.Report.TargetHidden == nil {
+			break
 		}
 
-		return e.complexity.Mutation.SetPostCommentsAllowed(childComplexity, args["postId"].(string), args["allow"].(bool)), true
+		return e.complexity.Report.TargetHidden(childComplexity), true
 
-	case "PageInfo.endCursor":
-		if e.complexity.PageInfo.EndCursor == nil {
+	case "Report.targetId":
+		if e.complexity.Report.TargetID == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.EndCursor(childComplexity), true
+		return e.complexity.Report.TargetID(childComplexity), true
 
-	case "PageInfo.hasNextPage":
-		if e.complexity.PageInfo.HasNextPage == nil {
+	case "Report.targetType":
+		if e.complexity.Report.TargetType == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+		return e.complexity.Report.TargetType(childComplexity), true
 
-	case "Post.allowComments":
-		if e.complexity.Post.AllowComments == nil {
+	case "ReportConnection.edges":
+		if e.complexity.ReportConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.Post.AllowComments(childComplexity), true
+		return e.complexity.ReportConnection.Edges(childComplexity), true
 
-	case "Post.author":
-		if e.complexity.Post.Author == nil {
+	case "ReportConnection.pageInfo":
+		if e.complexity.ReportConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.Post.Author(childComplexity), true
+		return e.complexity.ReportConnection.PageInfo(childComplexity), true
 
-	case "Post.body":
-		if e.complexity.Post.Body == nil {
+	case "ReportConnection.totalCount":
+		if e.complexity.ReportConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.Post.Body(childComplexity), true
+		return e.complexity.ReportConnection.TotalCount(childComplexity), true
 
-	case "Post.createdAt":
-		if e.complexity.Post.CreatedAt == nil {
+	case "ReportEdge.cursor":
+		if e.complexity.ReportEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.Post.CreatedAt(childComplexity), true
+		return e.complexity.ReportEdge.Cursor(childComplexity), true
 
-	case "Post.id":
-		if e.complexity.Post.ID == nil {
+	case "ReportEdge.node":
+		if e.complexity.ReportEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.Post.ID(childComplexity), true
+		return e.complexity.ReportEdge.Node(childComplexity), true
 
-	case "Post.title":
-		if e.complexity.Post.Title == nil {
+	case "SearchConnection.edges":
+		if e.complexity.SearchConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.Post.Title(childComplexity), true
+		return e.complexity.SearchConnection.Edges(childComplexity), true
 
-	case "PostConnection.edges":
-		if e.complexity.PostConnection.Edges == nil {
+	case "SearchConnection.pageInfo":
+		if e.complexity.SearchConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.PostConnection.Edges(childComplexity), true
+		return e.complexity.SearchConnection.PageInfo(childComplexity), true
 
-	case "PostConnection.pageInfo":
-		if e.complexity.PostConnection.PageInfo == nil {
+	case "SearchConnection.totalCount":
+		if e.complexity.SearchConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.PostConnection.PageInfo(childComplexity), true
+		return e.complexity.SearchConnection.TotalCount(childComplexity), true
 
-	case "PostConnection.totalCount":
-		if e.complexity.PostConnection.TotalCount == nil {
+	case "SearchEdge.cursor":
+		if e.complexity.SearchEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.PostConnection.TotalCount(childComplexity), true
+		return e.complexity.SearchEdge.Cursor(childComplexity), true
 
-	case "PostEdge.cursor":
-		if e.complexity.PostEdge.Cursor == nil {
+	case "SearchEdge.node":
+		if e.complexity.SearchEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.PostEdge.Cursor(childComplexity), true
+		return e.complexity.SearchEdge.Node(childComplexity), true
 
-	case "PostEdge.node":
-		if e.complexity.PostEdge.Node == nil {
+	case "SearchEdge.rank":
+		if e.complexity.SearchEdge.Rank == nil {
 			break
 		}
 
-		return e.complexity.PostEdge.Node(childComplexity), true
+		return e.complexity.SearchEdge.Rank(childComplexity), true
 
-	case "Query.commentsByPost":
-		if e.complexity.Query.CommentsByPost == nil {
+	case "SearchEdge.snippet":
+		if e.complexity.SearchEdge.Snippet == nil {
 			break
 		}
 
-		args, err := ec.field_Query_commentsByPost_args(ctx, rawArgs)
+		return e.complexity.SearchEdge.Snippet(childComplexity), true
+
+	case "Subscription.commentAdded":
+		if e.complexity.Subscription.CommentAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_commentAdded_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.CommentsByPost(childComplexity, args["postId"].(string), args["first"].(*int32), args["after"].(*string)), true
+		return e.complexity.Subscription.CommentAdded(childComplexity, args["postId"].(string), args["after"].(*string)), true
 
-	case "Query.post":
-		if e.complexity.Query.Post == nil {
+	case "Subscription.commentEvents":
+		if e.complexity.Subscription.CommentEvents == nil {
 			break
 		}
 
-		args, err := ec.field_Query_post_args(ctx, rawArgs)
+		args, err := ec.field_Subscription_commentEvents_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Post(childComplexity, args["id"].(string)), true
+		return e.complexity.Subscription.CommentEvents(childComplexity, args["postId"].(string)), true
 
-	case "Query.posts":
-		if e.complexity.Query.Posts == nil {
+	case "Subscription.postUpdated":
+		if e.complexity.Subscription.PostUpdated == nil {
 			break
 		}
 
-		args, err := ec.field_Query_posts_args(ctx, rawArgs)
+		args, err := ec.field_Subscription_postUpdated_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Posts(childComplexity, args["first"].(*int32), args["after"].(*string)), true
+		return e.complexity.Subscription.PostUpdated(childComplexity, args["postId"].(string)), true
 
-	case "Subscription.commentAdded":
-		if e.complexity.Subscription.CommentAdded == nil {
+	case "ThreadComment.comment":
+		if e.complexity.ThreadComment.Comment == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_commentAdded_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ThreadComment.Comment(childComplexity), true
+
+	case "ThreadComment.depth":
+		if e.complexity.ThreadComment.Depth == nil {
+			break
+		}
+
+		return e.complexity.ThreadComment.Depth(childComplexity), true
+
+	case "ThreadComment.path":
+		if e.complexity.ThreadComment.Path == nil {
+			break
 		}
 
-		return e.complexity.Subscription.CommentAdded(childComplexity, args["postId"].(string)), true
+		return e.complexity.ThreadComment.Path(childComplexity), true
 
 	}
 	return 0, false
@@ -398,6 +1396,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputAddCommentInput,
 		ec.unmarshalInputCreatePostInput,
+		ec.unmarshalInputUpdatePostInput,
 	)
 	first := true
 
@@ -513,17 +1512,30 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 
 var sources = []*ast.Source{
 	{Name: "../../schema/schema.graphqls", Input: `type PageInfo {
+  startCursor: String
   endCursor: String
+  hasPreviousPage: Boolean!
   hasNextPage: Boolean!
 }
 
-type Post {
+interface Node {
+  id: ID!
+}
+
+type Post implements Node {
   id: ID!
   title: String!
   body: String!
   author: String!
-  allowComments: Boolean!
+  allowComments: Boolean! @deprecated(reason: "Use commentPolicy and commentsCloseAt.")
+  commentPolicy: CommentPolicy!
+  commentsCloseAt: String
   createdAt: String!
+  deletedAt: String
+  tags: [String!]!
+  reactions: ReactionSummary!
+  comments(first: Int, after: String, last: Int, before: String, orderBy: CommentOrder = NEWEST): CommentConnection!
+  commentCount: Int!
 }
 
 type PostEdge {
@@ -537,14 +1549,52 @@ type PostConnection {
   totalCount: Int!
 }
 
-type Comment {
+type Comment implements Node {
   id: ID!
   postId: ID!
   parentId: ID
   author: String!
   text: String!
   createdAt: String!
-  children(first: Int, after: String): CommentConnection!
+  editedAt: String
+  isDeleted: Boolean!
+  status: CommentStatus!
+  rejectionReason: String
+  """
+  The probability, from 0 to 1, that the comment is spam according to the
+  spam model when the comment was added. Null when the model had not learned
+  from enough moderation decisions to score it then. Moderators only.
+  """
+  spamScore: Float
+  reactions: ReactionSummary!
+  children(first: Int, after: String, last: Int, before: String, orderBy: CommentOrder = NEWEST): CommentConnection!
+  replyCount: Int!
+  revisions(first: Int = 20, after: String): CommentRevisionConnection!
+  ancestors: [Comment!]!
+  post: Post
+}
+
+type ThreadComment {
+  depth: Int!
+  path: [ID!]!
+  comment: Comment!
+}
+
+type CommentRevision {
+  id: ID!
+  text: String!
+  createdAt: String!
+}
+
+type CommentRevisionEdge {
+  cursor: String!
+  node: CommentRevision!
+}
+
+type CommentRevisionConnection {
+  edges: [CommentRevisionEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
 }
 
 type CommentEdge {
@@ -558,17 +1608,220 @@ type CommentConnection {
   totalCount: Int!
 }
 
+enum ReactionTarget {
+  POST
+  COMMENT
+}
+
+type ReactionCount {
+  kind: String!
+  count: Int!
+}
+
+type ReactionSummary {
+  upvotes: Int!
+  downvotes: Int!
+  score: Int!
+  counts: [ReactionCount!]!
+}
+
+union SearchResult = Post | Comment
+
+type SearchEdge {
+  cursor: String!
+  rank: Float!
+  """
+  An excerpt of the text around the matches. It is HTML-safe: the text is
+  escaped and the only markup is <b></b> around each match.
+  """
+  snippet: String!
+  node: SearchResult!
+}
+
+type SearchConnection {
+  edges: [SearchEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+enum CommentOrder {
+  NEWEST
+  OLDEST
+  TOP
+  CONTROVERSIAL
+}
+
+enum CommentPolicy {
+  OPEN
+  CLOSED
+  AUTHOR_ONLY
+  REPLIES_ONLY
+  MODERATED
+}
+
+"""
+The reason a post's comment policy turned a new comment away. addComment
+reports it in the COMMENT_NOT_ALLOWED error's extensions.reason.
+"""
+enum CommentDenialReason {
+  CLOSED
+  AUTO_CLOSED
+  AUTHOR_ONLY
+  REPLIES_ONLY
+}
+
+"""
+Where a comment is in pre-moderation. Comments on MODERATED posts, and comments
+the content filter or the spam classifier holds, start as PENDING and are
+hidden from every listing and subscription until a moderator approves or
+rejects them.
+"""
+enum CommentStatus {
+  PUBLISHED
+  PENDING
+  REJECTED
+}
+
+enum ReportTarget {
+  POST
+  COMMENT
+}
+
+enum ReportReason {
+  SPAM
+  ABUSE
+  OFF_TOPIC
+  OTHER
+}
+
+enum ReportStatus {
+  OPEN
+  RESOLVED
+}
+
+"""
+How a moderator resolves a report. The action applies to the reported post or
+comment, so it resolves every open report on it.
+DISMISS keeps the target and brings it back if reports hid it.
+HIDE hides the target for good: a comment is rejected, a post deleted.
+DELETE deletes the target: a comment becomes a tombstone, a post is deleted.
+BAN_AUTHOR bans the author of the target from posting and commenting and hides
+the target.
+"""
+enum ReportAction {
+  DISMISS
+  HIDE
+  DELETE
+  BAN_AUTHOR
+}
+
+"""
+A user's complaint about a post or a comment. A reporter has at most one open
+report per target: reporting it again returns that report, and once it is
+resolved the target can be reported again.
+"""
+type Report {
+  id: ID!
+  targetId: ID!
+  targetType: ReportTarget!
+  targetAuthor: String!
+  reporter: String!
+  reason: ReportReason!
+  details: String
+  status: ReportStatus!
+  action: ReportAction
+  """
+  Whether the target was hidden automatically because too many users reported
+  it. It stays hidden until a moderator resolves the reports.
+  """
+  targetHidden: Boolean!
+  createdAt: String!
+  resolvedAt: String
+}
+
+type ReportEdge {
+  cursor: String!
+  node: Report!
+}
+
+type ReportConnection {
+  edges: [ReportEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+enum AuditAction {
+  REPORT
+  AUTO_HIDE
+  DISMISS
+  HIDE
+  DELETE
+  BAN_AUTHOR
+}
+
+"""
+One entry of the moderation audit log. actor is the reporter for REPORT,
+"system" for AUTO_HIDE and "moderator" for the actions resolving a report.
+"""
+type AuditEntry {
+  id: ID!
+  actor: String!
+  action: AuditAction!
+  targetId: ID!
+  targetType: ReportTarget!
+  reportId: ID
+  details: String
+  createdAt: String!
+}
+
+type AuditEntryEdge {
+  cursor: String!
+  node: AuditEntry!
+}
+
+type AuditEntryConnection {
+  edges: [AuditEntryEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+enum TagMatch {
+  ANY
+  ALL
+}
+
 type Query {
-  posts(first: Int = 20, after: String): PostConnection!
+  node(id: ID!): Node
+  nodes(ids: [ID!]!): [Node]!
+  posts(first: Int, after: String, last: Int, before: String, tags: [String!], match: TagMatch = ANY): PostConnection!
   post(id: ID!): Post
-  commentsByPost(postId: ID!, first: Int = 20, after: String): CommentConnection!
+  comment(id: ID!): Comment
+  commentsByPost(postId: ID!, first: Int, after: String, last: Int, before: String, orderBy: CommentOrder = NEWEST): CommentConnection!
+  commentThread(postId: ID!, rootId: ID, maxDepth: Int = 5, maxPerLevel: Int = 20): [ThreadComment!]!
+  tags(prefix: String, first: Int = 10): [String!]!
+  search(query: String!, first: Int = 20, after: String): SearchConnection!
+  reactionKinds: [String!]!
+  moderationQueue(first: Int = 20, after: String, postId: ID): CommentConnection!
+  "Moderators only, oldest first."
+  reports(status: ReportStatus = OPEN, first: Int = 20, after: String): ReportConnection!
+  "Moderators only, newest first."
+  auditLog(first: Int = 20, after: String): AuditEntryConnection!
 }
 
 input CreatePostInput {
   title: String!
   body: String!
   author: String!
-  allowComments: Boolean = true
+  allowComments: Boolean @deprecated(reason: "Use commentPolicy.")
+  commentPolicy: CommentPolicy
+  commentsCloseAt: String
+  tags: [String!]! = []
+  maxReplyDepth: Int
+}
+
+input UpdatePostInput {
+  title: String
+  body: String
 }
 
 input AddCommentInput {
@@ -581,11 +1834,51 @@ input AddCommentInput {
 type Mutation {
   createPost(input: CreatePostInput!): Post!
   addComment(input: AddCommentInput!): Comment!
-  setPostCommentsAllowed(postId: ID!, allow: Boolean!): Post!
+  editComment(id: ID!, text: String!): Comment!
+  deleteComment(id: ID!): Comment!
+  purgeComment(id: ID!): [ID!]!
+  approveComment(id: ID!): Comment!
+  rejectComment(id: ID!, reason: String): Comment!
+  setPostCommentsAllowed(postId: ID!, allow: Boolean!): Post! @deprecated(reason: "Use setPostCommentPolicy.")
+  setPostCommentPolicy(postId: ID!, policy: CommentPolicy!, closeAt: String): Post!
+  updatePost(id: ID!, input: UpdatePostInput!): Post!
+  deletePost(id: ID!): Post!
+  restorePost(id: ID!): Post!
+  react(target: ReactionTarget!, targetId: ID!, author: String!, kind: String!): ReactionSummary!
+  unreact(target: ReactionTarget!, targetId: ID!, author: String!): ReactionSummary!
+  """
+  Files a report. A target is hidden once enough distinct hosts report it;
+  the reporter name is only shown to moderators and carries no weight.
+  """
+  report(targetId: ID!, reporter: String!, reason: ReportReason!, details: String): Report!
+  "Moderators only."
+  resolveReport(id: ID!, action: ReportAction!): Report!
 }
 
+type CommentAddedEvent {
+  comment: Comment!
+}
+
+type CommentUpdatedEvent {
+  comment: Comment!
+}
+
+type CommentDeletedEvent {
+  commentId: ID!
+  postId: ID!
+}
+
+union CommentEvent = CommentAddedEvent | CommentUpdatedEvent | CommentDeletedEvent
+
 type Subscription {
-  commentAdded(postId: ID!): Comment!
+  """
+  New and newly approved comments on a post. after is the cursor of the last
+  comment received; every comment published since then is replayed first,
+  including comments approved later than they were written.
+  """
+  commentAdded(postId: ID!, after: String): Comment!
+  postUpdated(postId: ID!): Post!
+  commentEvents(postId: ID!): CommentEvent!
 }
 
 `, BuiltIn: false},
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type ctxKey string
+
+const (
+	moderatorKey = ctxKey("auth.moderator")
+	callerKey    = ctxKey("auth.caller")
+
+	bearerPrefix = "Bearer "
+)
+
+var (
+	ErrModeratorRequired = errors.New("moderator access required")
+)
+
+// WithAuthorization marks ctx as a moderator context when authorization holds
+// the configured moderator token. An empty token disables moderator access.
+func WithAuthorization(ctx context.Context, authorization, moderatorToken string) context.Context {
+	if moderatorToken == "" {
+		return ctx
+	}
+
+	token := strings.TrimPrefix(authorization, bearerPrefix)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(moderatorToken)) != 1 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, moderatorKey, true)
+}
+
+func IsModerator(ctx context.Context) bool {
+	ok, _ := ctx.Value(moderatorKey).(bool)
+	return ok
+}
+
+func RequireModerator(ctx context.Context) error {
+	if !IsModerator(ctx) {
+		return ErrModeratorRequired
+	}
+
+	return nil
+}
+
+// WithCaller records the host a request came from. The API has no user
+// accounts, so this is the only caller identity the server can vouch for;
+// names a client sends, such as an author or a reporter, are not.
+func WithCaller(ctx context.Context, remoteAddr string) context.Context {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	if host == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, callerKey, host)
+}
+
+// Caller returns the host WithCaller recorded, or "" for an unknown caller.
+func Caller(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey).(string)
+	return caller
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAuthorization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		authorization  string
+		moderatorToken string
+		want           bool
+	}{
+		{name: "bearer_token", authorization: "Bearer secret", moderatorToken: "secret", want: true},
+		{name: "raw_token", authorization: "secret", moderatorToken: "secret", want: true},
+		{name: "wrong_token", authorization: "Bearer nope", moderatorToken: "secret", want: false},
+		{name: "missing_header", authorization: "", moderatorToken: "secret", want: false},
+		{name: "moderation_disabled", authorization: "", moderatorToken: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := WithAuthorization(context.Background(), tt.authorization, tt.moderatorToken)
+
+			assert.Equal(t, tt.want, IsModerator(ctx))
+			if tt.want {
+				assert.NoError(t, RequireModerator(ctx))
+			} else {
+				assert.ErrorIs(t, RequireModerator(ctx), ErrModeratorRequired)
+			}
+		})
+	}
+}
+
+func TestWithCaller(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		want       string
+	}{
+		{name: "ipv4", remoteAddr: "203.0.113.7:51234", want: "203.0.113.7"},
+		{name: "ipv6", remoteAddr: "[2001:db8::1]:51234", want: "2001:db8::1"},
+		{name: "no_port", remoteAddr: "203.0.113.7", want: "203.0.113.7"},
+		{name: "unknown", remoteAddr: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := WithCaller(context.Background(), tt.remoteAddr)
+			assert.Equal(t, tt.want, Caller(ctx))
+		})
+	}
+}
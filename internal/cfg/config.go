@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -16,6 +17,16 @@ type Config struct {
 	DBName              string
 	DBConnectionRetries int
 	DBConnectionDelay   int
+	ModeratorToken      string
+	ReactionKinds       []string
+	MaxReplyDepth       int
+	ReplyDepthPolicy    string
+	ContentFilterFile   string
+	SpamHoldThreshold   float64
+	SpamModelFile       string
+	SpamReloadInterval  int
+	ReportHideThreshold int
+	ReportHourlyLimit   int
 }
 
 func init() {
@@ -33,6 +44,16 @@ func New() *Config {
 		DBName:              getEnvStr("DB_NAME", ""),
 		DBConnectionRetries: getEnvInt("DB_CONNECTION_RETRIES", 0),
 		DBConnectionDelay:   getEnvInt("DB_CONNECTION_DELAY", 0),
+		ModeratorToken:      getEnvStr("MODERATOR_TOKEN", ""),
+		ReactionKinds:       getEnvList("REACTION_KINDS", []string{"like", "love", "laugh", "wow", "sad", "angry"}),
+		MaxReplyDepth:       getEnvInt("MAX_REPLY_DEPTH", 8),
+		ReplyDepthPolicy:    getEnvStr("REPLY_DEPTH_POLICY", "reject"),
+		ContentFilterFile:   getEnvStr("CONTENT_FILTER_FILE", ""),
+		SpamHoldThreshold:   getEnvFloat("SPAM_HOLD_THRESHOLD", 0.9),
+		SpamModelFile:       getEnvStr("SPAM_MODEL_FILE", ""),
+		SpamReloadInterval:  getEnvInt("SPAM_RELOAD_INTERVAL", 60),
+		ReportHideThreshold: getEnvInt("REPORT_HIDE_THRESHOLD", 5),
+		ReportHourlyLimit:   getEnvInt("REPORT_HOURLY_LIMIT", 10),
 	}
 }
 
@@ -52,3 +73,28 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return defaultVal
 }
+
+func getEnvFloat(key string, defaultVal float64) float64 {
+	if value, exists := os.LookupEnv(key); exists {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+		log.Printf("Warning: Cannot convert %s to float, using default: %g\n", key, defaultVal)
+	}
+	return defaultVal
+}
+
+func getEnvList(key string, defaultVal []string) []string {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultVal
+	}
+
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,41 @@
+package filter
+
+import (
+	"context"
+	"unicode"
+)
+
+// AllCaps fires when at least ratio of the letters are upper case. Texts with
+// fewer than minLetters letters pass, so "OK" or an acronym are fine.
+type AllCaps struct {
+	action     Action
+	minLetters int
+	ratio      float64
+}
+
+func NewAllCaps(action Action, minLetters int, ratio float64) *AllCaps {
+	return &AllCaps{action: action, minLetters: minLetters, ratio: ratio}
+}
+
+func (r *AllCaps) Name() string {
+	return "all_caps"
+}
+
+func (r *AllCaps) Check(ctx context.Context, c Content) Decision {
+	letters, upper := 0, 0
+	for _, ch := range c.Text {
+		if !unicode.IsLetter(ch) {
+			continue
+		}
+		letters++
+		if unicode.IsUpper(ch) {
+			upper++
+		}
+	}
+
+	if letters >= r.minLetters && letters > 0 && float64(upper)/float64(letters) >= r.ratio {
+		return decide(r.action, r.Name(), "text is written in capital letters")
+	}
+
+	return allowed
+}
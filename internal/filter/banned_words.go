@@ -0,0 +1,46 @@
+package filter
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// BannedWords fires when the text contains one of the words, compared whole
+// and case-insensitively.
+type BannedWords struct {
+	action Action
+	words  map[string]struct{}
+}
+
+func NewBannedWords(action Action, words []string) *BannedWords {
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			set[w] = struct{}{}
+		}
+	}
+
+	return &BannedWords{action: action, words: set}
+}
+
+func (r *BannedWords) Name() string {
+	return "banned_words"
+}
+
+func (r *BannedWords) Check(ctx context.Context, c Content) Decision {
+	for _, w := range words(c.Text) {
+		if _, ok := r.words[w]; ok {
+			return decide(r.action, r.Name(), "text contains a banned word")
+		}
+	}
+
+	return allowed
+}
+
+// words splits text into lowercase words of letters and digits.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,124 @@
+package filter
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Config is the content filter file. Rules run in the order they are listed.
+//
+//	{"rules": [
+//	  {"type": "banned_words", "action": "reject", "words": ["casino"]},
+//	  {"type": "links", "action": "hold", "max": 2},
+//	  {"type": "repeated_chars", "action": "reject", "max": 8},
+//	  {"type": "all_caps", "action": "hold", "minLetters": 12, "ratio": 0.8},
+//	  {"type": "duplicate_text", "action": "reject", "window": "10m"}
+//	]}
+type Config struct {
+	Rules []RuleConfig `json:"rules"`
+}
+
+// RuleConfig configures one rule. Action is "reject" or "hold", reject when
+// empty; the other fields apply to the rule types that use them.
+type RuleConfig struct {
+	Type       string   `json:"type"`
+	Action     string   `json:"action"`
+	Words      []string `json:"words"`
+	Max        int      `json:"max"`
+	MinLetters int      `json:"minLetters"`
+	Ratio      float64  `json:"ratio"`
+	Window     string   `json:"window"`
+}
+
+const (
+	defaultMinLetters = 10
+	defaultCapsRatio  = 0.8
+)
+
+// Load builds the pipeline described by the file at path. An empty path
+// gives a pipeline without rules.
+func Load(path string) (*Pipeline, error) {
+	if path == "" {
+		return New(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read content filter config")
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, errors.Wrap(err, "parse content filter config")
+	}
+
+	return FromConfig(cfg)
+}
+
+func FromConfig(cfg Config) (*Pipeline, error) {
+	rules := make([]Rule, 0, len(cfg.Rules))
+	for i, rc := range cfg.Rules {
+		rule, err := rc.build()
+		if err != nil {
+			return nil, errors.Wrapf(err, "content filter rule %d", i+1)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return New(rules...), nil
+}
+
+func (rc RuleConfig) build() (Rule, error) {
+	action, err := parseAction(rc.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rc.Type {
+	case "banned_words":
+		return NewBannedWords(action, rc.Words), nil
+	case "links":
+		return NewLinkLimit(action, rc.Max), nil
+	case "repeated_chars":
+		if rc.Max < 1 {
+			return nil, errors.New("repeated_chars needs max of at least 1")
+		}
+		return NewRepeatedChars(action, rc.Max), nil
+	case "all_caps":
+		minLetters, ratio := rc.MinLetters, rc.Ratio
+		if minLetters == 0 {
+			minLetters = defaultMinLetters
+		}
+		if ratio == 0 {
+			ratio = defaultCapsRatio
+		}
+		if ratio < 0 || ratio > 1 {
+			return nil, errors.New("all_caps ratio must be between 0 and 1")
+		}
+		return NewAllCaps(action, minLetters, ratio), nil
+	case "duplicate_text":
+		window, err := time.ParseDuration(rc.Window)
+		if err != nil || window <= 0 {
+			return nil, errors.New("duplicate_text needs a positive window such as \"10m\"")
+		}
+		return NewDuplicateText(action, window), nil
+	default:
+		return nil, errors.Errorf("unknown rule type %q", rc.Type)
+	}
+}
+
+func parseAction(s string) (Action, error) {
+	switch strings.ToLower(s) {
+	case "", "reject":
+		return Reject, nil
+	case "hold":
+		return Hold, nil
+	default:
+		return "", errors.Errorf("unknown action %q", s)
+	}
+}
@@ -0,0 +1,108 @@
+package filter
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DuplicateText fires when an author sends the same text twice within the
+// window, ignoring case and whitespace. Only texts passed to Record, i.e.
+// stored ones, count; texts without an author are not tracked. The history
+// lives in process memory, so each instance keeps its own.
+type DuplicateText struct {
+	action Action
+	window time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	seen      map[string][]sentText
+	lastSweep time.Time
+}
+
+type sentText struct {
+	text string
+	at   time.Time
+}
+
+func NewDuplicateText(action Action, window time.Duration) *DuplicateText {
+	return &DuplicateText{
+		action: action,
+		window: window,
+		now:    time.Now,
+		seen:   make(map[string][]sentText),
+	}
+}
+
+func (r *DuplicateText) Name() string {
+	return "duplicate_text"
+}
+
+var _ Recorder = (*DuplicateText)(nil)
+
+func (r *DuplicateText) Check(ctx context.Context, c Content) Decision {
+	if c.Author == "" {
+		return allowed
+	}
+
+	text := normalizeText(c.Text)
+	now := r.now()
+	since := now.Add(-r.window)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sweep(now, since)
+
+	for _, s := range r.seen[c.Author] {
+		if s.text == text && s.at.After(since) {
+			return decide(r.action, r.Name(), "text duplicates a recent submission by the same author")
+		}
+	}
+
+	return allowed
+}
+
+// Record remembers a stored text, so the next identical one fires.
+func (r *DuplicateText) Record(ctx context.Context, c Content) {
+	if c.Author == "" {
+		return
+	}
+
+	text := normalizeText(c.Text)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seen[c.Author] = append(r.seen[c.Author], sentText{text: text, at: r.now()})
+}
+
+// normalizeText folds case and whitespace, which do not make a text new.
+func normalizeText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// sweep drops texts older than the window, at most once per window so a check
+// stays cheap. The caller holds the lock.
+func (r *DuplicateText) sweep(now, since time.Time) {
+	if now.Sub(r.lastSweep) < r.window {
+		return
+	}
+	r.lastSweep = now
+
+	for author, texts := range r.seen {
+		kept := texts[:0]
+		for _, s := range texts {
+			if s.at.After(since) {
+				kept = append(kept, s)
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(r.seen, author)
+		} else {
+			r.seen[author] = kept
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package filter
+
+import (
+	"context"
+)
+
+// Action is what the pipeline decided to do with a piece of content.
+type Action string
+
+const (
+	Allow  Action = "ALLOW"
+	Reject Action = "REJECT"
+	Hold   Action = "HOLD"
+)
+
+// Decision is the outcome of a check. Rule and Reason are empty when the
+// content is allowed.
+type Decision struct {
+	Action Action
+	Rule   string
+	Reason string
+}
+
+var allowed = Decision{Action: Allow}
+
+// Content is a post or a comment about to be stored. Author is empty for edits,
+// which do not say who made them.
+type Content struct {
+	Author string
+	Text   string
+}
+
+// Rule is a single content check. Check returns an allow decision when the
+// content passes.
+type Rule interface {
+	Name() string
+	Check(ctx context.Context, c Content) Decision
+}
+
+// Recorder is a rule that remembers content once it is stored, e.g. to spot
+// repeats of it.
+type Recorder interface {
+	Record(ctx context.Context, c Content)
+}
+
+// Pipeline runs its rules in order. The first reject wins; otherwise the first
+// hold does. A nil pipeline allows everything.
+type Pipeline struct {
+	rules []Rule
+}
+
+func New(rules ...Rule) *Pipeline {
+	return &Pipeline{rules: rules}
+}
+
+func (p *Pipeline) Check(ctx context.Context, c Content) Decision {
+	if p == nil {
+		return allowed
+	}
+
+	decision := allowed
+	for _, rule := range p.rules {
+		d := rule.Check(ctx, c)
+		switch d.Action {
+		case Reject:
+			return d
+		case Hold:
+			if decision.Action == Allow {
+				decision = d
+			}
+		}
+	}
+
+	return decision
+}
+
+// Accept passes content that was stored to the rules that remember it.
+// Services call it only after saving, so content turned away by a rule, the
+// spam classifier or a failed write leaves no trace.
+func (p *Pipeline) Accept(ctx context.Context, c Content) {
+	if p == nil {
+		return
+	}
+
+	for _, rule := range p.rules {
+		if r, ok := rule.(Recorder); ok {
+			r.Record(ctx, c)
+		}
+	}
+}
+
+// RejectedError is returned by the services when the pipeline turns content
+// away. Decision.Action is HOLD when the content could not be held, e.g.
+// posts and edits, which have no moderation queue.
+type RejectedError struct {
+	Decision Decision
+}
+
+func (e *RejectedError) Error() string {
+	return "content not allowed: " + e.Decision.Reason
+}
+
+// decide builds the decision a rule with the given action reports when it
+// fires.
+func decide(action Action, rule, reason string) Decision {
+	return Decision{Action: action, Rule: rule, Reason: reason}
+}
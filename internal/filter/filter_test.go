@@ -0,0 +1,158 @@
+package filter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		rule Rule
+		text string
+		want Action
+	}{
+		{name: "banned_word", rule: NewBannedWords(Reject, []string{"Casino"}), text: "Best CASINO in town!", want: Reject},
+		{name: "banned_word_inside_other_word", rule: NewBannedWords(Reject, []string{"casino"}), text: "casinos are fine", want: Allow},
+		{name: "links_over_limit", rule: NewLinkLimit(Hold, 1), text: "see https://a.example and www.b.example", want: Hold},
+		{name: "links_at_limit", rule: NewLinkLimit(Hold, 2), text: "see https://a.example and www.b.example", want: Allow},
+		{name: "repeated_chars", rule: NewRepeatedChars(Reject, 4), text: "wow!!!!!", want: Reject},
+		{name: "repeated_spaces", rule: NewRepeatedChars(Reject, 4), text: "wide      gap", want: Allow},
+		{name: "all_caps", rule: NewAllCaps(Hold, 10, 0.8), text: "THIS IS VERY IMPORTANT", want: Hold},
+		{name: "short_caps", rule: NewAllCaps(Hold, 10, 0.8), text: "OK NASA", want: Allow},
+		{name: "mixed_case", rule: NewAllCaps(Hold, 10, 0.8), text: "This is Very Important", want: Allow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.rule.Check(context.Background(), Content{Author: "Alice", Text: tt.text})
+
+			assert.Equal(t, tt.want, got.Action)
+			if tt.want != Allow {
+				assert.Equal(t, tt.rule.Name(), got.Rule)
+				assert.NotEmpty(t, got.Reason)
+			}
+		})
+	}
+}
+
+func TestDuplicateText(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Date(2026, 2, 13, 10, 0, 0, 0, time.UTC)
+	rule := NewDuplicateText(Reject, 10*time.Minute)
+	rule.now = func() time.Time { return now }
+
+	// Checking alone remembers nothing; only recorded texts count.
+	assert.Equal(t, Allow, rule.Check(ctx, Content{Author: "Alice", Text: "Hello  world"}).Action)
+	assert.Equal(t, Allow, rule.Check(ctx, Content{Author: "Alice", Text: "hello world"}).Action)
+
+	rule.Record(ctx, Content{Author: "Alice", Text: "Hello  world"})
+	assert.Equal(t, Reject, rule.Check(ctx, Content{Author: "Alice", Text: "hello world"}).Action)
+	assert.Equal(t, Allow, rule.Check(ctx, Content{Author: "Bob", Text: "hello world"}).Action)
+
+	rule.Record(ctx, Content{Text: "hello world"})
+	assert.Equal(t, Allow, rule.Check(ctx, Content{Text: "hello world"}).Action)
+
+	now = now.Add(11 * time.Minute)
+	assert.Equal(t, Allow, rule.Check(ctx, Content{Author: "Alice", Text: "hello world"}).Action)
+	assert.Empty(t, rule.seen)
+}
+
+func TestPipeline(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pipeline := New(
+		NewAllCaps(Hold, 5, 0.8),
+		NewLinkLimit(Hold, 0),
+		NewBannedWords(Reject, []string{"spam"}),
+	)
+
+	assert.Equal(t, Decision{Action: Allow}, pipeline.Check(ctx, Content{Text: "hello"}))
+	assert.Equal(t, "all_caps", pipeline.Check(ctx, Content{Text: "HELLO WWW.A.EXAMPLE"}).Rule)
+	assert.Equal(t, Reject, pipeline.Check(ctx, Content{Text: "HELLO SPAM"}).Action)
+
+	var empty *Pipeline
+	assert.Equal(t, Allow, empty.Check(ctx, Content{Text: "SPAM"}).Action)
+	empty.Accept(ctx, Content{Text: "SPAM"})
+}
+
+func TestPipeline_Accept(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pipeline := New(
+		NewBannedWords(Reject, []string{"spam"}),
+		NewDuplicateText(Reject, 10*time.Minute),
+	)
+	post := Content{Author: "Alice", Text: "hello"}
+
+	assert.Equal(t, Allow, pipeline.Check(ctx, post).Action)
+	assert.Equal(t, Allow, pipeline.Check(ctx, post).Action)
+
+	pipeline.Accept(ctx, post)
+	assert.Equal(t, "duplicate_text", pipeline.Check(ctx, post).Rule)
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	write := func(t *testing.T, data string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "filter.json")
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+		return path
+	}
+
+	t.Run("all_rules", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline, err := Load(write(t, `{"rules": [
+			{"type": "banned_words", "words": ["casino"]},
+			{"type": "links", "action": "hold", "max": 2},
+			{"type": "repeated_chars", "action": "REJECT", "max": 8},
+			{"type": "all_caps", "action": "hold"},
+			{"type": "duplicate_text", "window": "10m"}
+		]}`))
+
+		require.NoError(t, err)
+		require.Len(t, pipeline.rules, 5)
+		assert.Equal(t, Reject, pipeline.Check(context.Background(), Content{Text: "casino"}).Action)
+		assert.Equal(t, &AllCaps{action: Hold, minLetters: defaultMinLetters, ratio: defaultCapsRatio}, pipeline.rules[3])
+	})
+
+	t.Run("no_file", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline, err := Load("")
+
+		require.NoError(t, err)
+		assert.Empty(t, pipeline.rules)
+	})
+
+	t.Run("invalid_rules", func(t *testing.T) {
+		t.Parallel()
+
+		for data, wantErr := range map[string]string{
+			`{"rules": [{"type": "shouting"}]}`:                         `content filter rule 1: unknown rule type "shouting"`,
+			`{"rules": [{"type": "links", "action": "ban"}]}`:           `content filter rule 1: unknown action "ban"`,
+			`{"rules": [{"type": "duplicate_text", "window": "soon"}]}`: `content filter rule 1: duplicate_text needs a positive window such as "10m"`,
+			`{"rules": [{"type": "repeated_chars"}]}`:                   `content filter rule 1: repeated_chars needs max of at least 1`,
+		} {
+			_, err := Load(write(t, data))
+			assert.EqualError(t, err, wantErr)
+		}
+	})
+}
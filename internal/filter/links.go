@@ -0,0 +1,31 @@
+package filter
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// LinkLimit fires when the text holds more than max links.
+type LinkLimit struct {
+	action Action
+	max    int
+}
+
+func NewLinkLimit(action Action, max int) *LinkLimit {
+	return &LinkLimit{action: action, max: max}
+}
+
+func (r *LinkLimit) Name() string {
+	return "links"
+}
+
+func (r *LinkLimit) Check(ctx context.Context, c Content) Decision {
+	if n := len(linkPattern.FindAllStringIndex(c.Text, -1)); n > r.max {
+		return decide(r.action, r.Name(), "text contains more than "+strconv.Itoa(r.max)+" links")
+	}
+
+	return allowed
+}
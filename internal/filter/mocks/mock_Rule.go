@@ -0,0 +1,129 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	filter "github.com/Saracomethstein/ozon-test-task/internal/filter"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRule is an autogenerated mock type for the Rule type
+type MockRule struct {
+	mock.Mock
+}
+
+type MockRule_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRule) EXPECT() *MockRule_Expecter {
+	return &MockRule_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx, c
+func (_m *MockRule) Check(ctx context.Context, c filter.Content) filter.Decision {
+	ret := _m.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 filter.Decision
+	if rf, ok := ret.Get(0).(func(context.Context, filter.Content) filter.Decision); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Get(0).(filter.Decision)
+	}
+
+	return r0
+}
+
+// MockRule_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockRule_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c filter.Content
+func (_e *MockRule_Expecter) Check(ctx interface{}, c interface{}) *MockRule_Check_Call {
+	return &MockRule_Check_Call{Call: _e.mock.On("Check", ctx, c)}
+}
+
+func (_c *MockRule_Check_Call) Run(run func(ctx context.Context, c filter.Content)) *MockRule_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(filter.Content))
+	})
+	return _c
+}
+
+func (_c *MockRule_Check_Call) Return(_a0 filter.Decision) *MockRule_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRule_Check_Call) RunAndReturn(run func(context.Context, filter.Content) filter.Decision) *MockRule_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function with no fields
+func (_m *MockRule) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockRule_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockRule_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockRule_Expecter) Name() *MockRule_Name_Call {
+	return &MockRule_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockRule_Name_Call) Run(run func()) *MockRule_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRule_Name_Call) Return(_a0 string) *MockRule_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRule_Name_Call) RunAndReturn(run func() string) *MockRule_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRule creates a new instance of MockRule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRule(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRule {
+	mock := &MockRule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
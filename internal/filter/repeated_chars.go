@@ -0,0 +1,39 @@
+package filter
+
+import (
+	"context"
+	"unicode"
+)
+
+// RepeatedChars fires when a character other than whitespace repeats more
+// than max times in a row, as in "!!!!!!!!" or "soooooooo".
+type RepeatedChars struct {
+	action Action
+	max    int
+}
+
+func NewRepeatedChars(action Action, max int) *RepeatedChars {
+	return &RepeatedChars{action: action, max: max}
+}
+
+func (r *RepeatedChars) Name() string {
+	return "repeated_chars"
+}
+
+func (r *RepeatedChars) Check(ctx context.Context, c Content) Decision {
+	var prev rune
+	run := 0
+	for _, ch := range c.Text {
+		if ch == prev && !unicode.IsSpace(ch) {
+			run++
+		} else {
+			prev, run = ch, 1
+		}
+
+		if run > r.max {
+			return decide(r.action, r.Name(), "text repeats a character too many times")
+		}
+	}
+
+	return allowed
+}
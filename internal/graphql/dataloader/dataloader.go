@@ -3,49 +3,167 @@ package dataloader
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/graph-gophers/dataloader"
+	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
 )
 
 type ctxKey string
 
-const Key = ctxKey("dataloader.comment.children")
+const (
+	Key           = ctxKey("dataloader.comment.children")
+	ReplyCountKey = ctxKey("dataloader.comment.replyCount")
+)
 
-func (l *CommentLoader) BatchGetChildren(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
-	parentIDs := make([]int64, len(keys))
+// pageArgs are the pagination arguments of a comment page. Keys that share
+// them are fetched with one repository call.
+type pageArgs struct {
+	order    models.CommentOrder
+	limit    int32
+	cursor   string
+	backward bool
+}
+
+type pageKey struct {
+	args pageArgs
+	id   int64
+}
+
+const (
+	pageAfter  = "after"
+	pageBefore = "before"
+)
+
+// pageKeyFor builds the loader key of one page of comments below id, e.g.
+// "TOP:21:after::42" or "NEWEST:6:before:<cursor>:42". Cursors are base64url,
+// so they never contain the separator.
+func pageKeyFor(order models.CommentOrder, limit int32, cursor string, backward bool, id int64) dataloader.Key {
+	direction := pageAfter
+	if backward {
+		direction = pageBefore
+	}
+
+	return dataloader.StringKey(string(order) + ":" + strconv.FormatInt(int64(limit), 10) + ":" + direction + ":" + cursor + ":" + strconv.FormatInt(id, 10))
+}
+
+// parsePageKeys parses the keys of a batch and groups their ids by page
+// arguments.
+func parsePageKeys(keys dataloader.Keys) ([]pageKey, map[pageArgs][]int64, error) {
+	parsed := make([]pageKey, len(keys))
+	idsByArgs := make(map[pageArgs][]int64)
 	for i, key := range keys {
-		id, err := strconv.ParseInt(key.String(), 10, 64)
+		parts := strings.Split(key.String(), ":")
+		if len(parts) != 5 || (parts[2] != pageAfter && parts[2] != pageBefore) {
+			return nil, nil, errors.New("invalid page key")
+		}
+
+		limit, err := strconv.ParseInt(parts[1], 10, 32)
 		if err != nil {
-			errResults := make([]*dataloader.Result, len(keys))
-			for j := range errResults {
-				errResults[j] = &dataloader.Result{Error: err}
-			}
-			return errResults
+			return nil, nil, errors.New("invalid page key")
+		}
+		id, err := strconv.ParseInt(parts[4], 10, 64)
+		if err != nil {
+			return nil, nil, errors.New("invalid page key")
+		}
+
+		args := pageArgs{
+			order:    models.CommentOrder(parts[0]),
+			limit:    int32(limit),
+			cursor:   parts[3],
+			backward: parts[2] == pageBefore,
 		}
-		parentIDs[i] = id
+		parsed[i] = pageKey{args: args, id: id}
+		idsByArgs[args] = append(idsByArgs[args], id)
 	}
 
-	comments, err := l.repo.GetChildBatch(ctx, parentIDs)
+	return parsed, idsByArgs, nil
+}
+
+func (a pageArgs) position() (*models.CommentCursor, error) {
+	return cursor.ParseComment(a.order, &a.cursor)
+}
+
+// ChildrenKeyFor builds the loader key of one page of the replies to a
+// comment, read after the cursor or before it when backward. limit is the
+// number of rows to fetch, callers ask for one more than they show to learn
+// whether another page exists.
+func ChildrenKeyFor(order models.CommentOrder, limit int32, cursor string, backward bool, parentID int64) dataloader.Key {
+	return pageKeyFor(order, limit, cursor, backward, parentID)
+}
+
+// BatchGetChildren resolves the requested pages of replies with one
+// repository call per set of page arguments. Each result holds the sorted
+// page of its key, nil when the comment has no replies on that side of the
+// cursor.
+func (l *CommentLoader) BatchGetChildren(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	parsed, idsByArgs, err := parsePageKeys(keys)
 	if err != nil {
-		errResults := make([]*dataloader.Result, len(keys))
-		for i := range errResults {
-			errResults[i] = &dataloader.Result{Error: err}
+		return errorResults(len(keys), err)
+	}
+
+	groups := make(map[pageKey][]*models.Comment, len(keys))
+	for args, parentIDs := range idsByArgs {
+		pos, err := args.position()
+		if err != nil {
+			return errorResults(len(keys), err)
+		}
+
+		comments, err := l.repo.GetChildBatch(ctx, parentIDs, args.order, pos, args.backward, args.limit)
+		if err != nil {
+			return errorResults(len(keys), err)
 		}
-		return errResults
+
+		for _, c := range comments {
+			if c.ParentID != nil {
+				k := pageKey{args: args, id: *c.ParentID}
+				groups[k] = append(groups[k], c)
+			}
+		}
+	}
+
+	results := make([]*dataloader.Result, len(keys))
+	for i, k := range parsed {
+		results[i] = &dataloader.Result{Data: groups[k]}
 	}
 
-	groups := make(map[int64][]*models.Comment, len(parentIDs))
-	for _, c := range comments {
-		if c.ParentID != nil {
-			groups[*c.ParentID] = append(groups[*c.ParentID], c)
+	return results
+}
+
+// ReplyCountKeyFor builds the loader key of the reply count of a comment.
+func ReplyCountKeyFor(commentID int64) dataloader.Key {
+	return dataloader.StringKey(strconv.FormatInt(commentID, 10))
+}
+
+// BatchCountReplies resolves the number of direct replies of every requested
+// comment with one repository call. Keys are comment ids and each result
+// holds an int64.
+func (l *CommentLoader) BatchCountReplies(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	ids := make([]int64, len(keys))
+	for i, key := range keys {
+		id, err := strconv.ParseInt(key.String(), 10, 64)
+		if err != nil {
+			return errorResults(len(keys), errors.New("invalid reply count key"))
 		}
+		ids[i] = id
+	}
+
+	counts, err := l.repo.ReplyCountBatch(ctx, ids)
+	if err != nil {
+		return errorResults(len(keys), err)
+	}
+
+	byParent := make(map[int64]int64, len(counts))
+	for _, c := range counts {
+		byParent[c.ParentID] = c.Count
 	}
 
 	results := make([]*dataloader.Result, len(keys))
-	for i, pid := range parentIDs {
-		results[i] = &dataloader.Result{Data: groups[pid]}
+	for i, id := range ids {
+		results[i] = &dataloader.Result{Data: byParent[id]}
 	}
 
 	return results
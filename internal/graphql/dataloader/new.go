@@ -9,3 +9,19 @@ type CommentLoader struct {
 func NewCommentLoader(repo repository.CommentUC) *CommentLoader {
 	return &CommentLoader{repo: repo}
 }
+
+type ReactionLoader struct {
+	repo repository.ReactionUC
+}
+
+func NewReactionLoader(repo repository.ReactionUC) *ReactionLoader {
+	return &ReactionLoader{repo: repo}
+}
+
+type PostLoader struct {
+	repo repository.PostUC
+}
+
+func NewPostLoader(repo repository.PostUC) *PostLoader {
+	return &PostLoader{repo: repo}
+}
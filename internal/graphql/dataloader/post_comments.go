@@ -0,0 +1,88 @@
+package dataloader
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	PostCommentsKey = ctxKey("dataloader.post.comments")
+	CommentCountKey = ctxKey("dataloader.post.commentCount")
+)
+
+// PostCommentsKeyFor builds the loader key of one page of a post's root
+// comments, read after the cursor or before it when backward. Posts asking
+// for the same page arguments are fetched together.
+func PostCommentsKeyFor(order models.CommentOrder, limit int32, cursor string, backward bool, postID int64) dataloader.Key {
+	return pageKeyFor(order, limit, cursor, backward, postID)
+}
+
+// BatchGetPostComments resolves the requested pages with one repository call
+// per set of page arguments. Each result holds the root comments of its key,
+// nil when the post has none.
+func (l *CommentLoader) BatchGetPostComments(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	parsed, idsByArgs, err := parsePageKeys(keys)
+	if err != nil {
+		return errorResults(len(keys), err)
+	}
+
+	groups := make(map[pageKey][]*models.Comment, len(keys))
+	for args, postIDs := range idsByArgs {
+		pos, err := args.position()
+		if err != nil {
+			return errorResults(len(keys), err)
+		}
+
+		comments, err := l.repo.GetRootBatch(ctx, postIDs, args.order, pos, args.backward, args.limit)
+		if err != nil {
+			return errorResults(len(keys), err)
+		}
+
+		for _, c := range comments {
+			k := pageKey{args: args, id: c.PostID}
+			groups[k] = append(groups[k], c)
+		}
+	}
+
+	results := make([]*dataloader.Result, len(keys))
+	for i, k := range parsed {
+		results[i] = &dataloader.Result{Data: groups[k]}
+	}
+
+	return results
+}
+
+// BatchCountComments resolves the comment counts of every requested post with
+// one repository call. Keys are post ids and each result holds an int64.
+func (l *CommentLoader) BatchCountComments(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	ids := make([]int64, len(keys))
+	for i, key := range keys {
+		id, err := strconv.ParseInt(key.String(), 10, 64)
+		if err != nil {
+			return errorResults(len(keys), errors.New("invalid comment count key"))
+		}
+		ids[i] = id
+	}
+
+	counts, err := l.repo.TotalCountBatch(ctx, ids)
+	if err != nil {
+		return errorResults(len(keys), err)
+	}
+
+	byPost := make(map[int64]int64, len(counts))
+	for _, c := range counts {
+		byPost[c.PostID] = c.Count
+	}
+
+	results := make([]*dataloader.Result, len(keys))
+	for i, id := range ids {
+		results[i] = &dataloader.Result{Data: byPost[id]}
+	}
+
+	return results
+}
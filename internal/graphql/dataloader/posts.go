@@ -0,0 +1,48 @@
+package dataloader
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const PostKey = ctxKey("dataloader.posts")
+
+func PostKeyFor(postID int64) dataloader.Key {
+	return dataloader.StringKey(strconv.FormatInt(postID, 10))
+}
+
+// BatchGetPosts resolves every requested post with one repository call. Each
+// result holds the *models.Post of its key, nil when the post is missing or
+// deleted.
+func (l *PostLoader) BatchGetPosts(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	ids := make([]int64, len(keys))
+	for i, key := range keys {
+		id, err := strconv.ParseInt(key.String(), 10, 64)
+		if err != nil {
+			return errorResults(len(keys), errors.New("invalid post key"))
+		}
+		ids[i] = id
+	}
+
+	posts, err := l.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return errorResults(len(keys), err)
+	}
+
+	byID := make(map[int64]*models.Post, len(posts))
+	for _, p := range posts {
+		byID[p.ID] = p
+	}
+
+	results := make([]*dataloader.Result, len(keys))
+	for i, id := range ids {
+		results[i] = &dataloader.Result{Data: byID[id]}
+	}
+
+	return results
+}
@@ -0,0 +1,70 @@
+package dataloader
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const ReactionKey = ctxKey("dataloader.reactions")
+
+// ReactionKeyFor builds the loader key of a reaction target, e.g. "COMMENT:42".
+func ReactionKeyFor(target models.ReactionTarget, targetID int64) dataloader.Key {
+	return dataloader.StringKey(string(target) + ":" + strconv.FormatInt(targetID, 10))
+}
+
+// BatchGetReactions resolves the reaction counts of every requested target
+// with one repository call per target type. Each result holds the
+// []*models.ReactionCount of its key, nil when nobody reacted.
+func (l *ReactionLoader) BatchGetReactions(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	type targetKey struct {
+		target models.ReactionTarget
+		id     int64
+	}
+
+	parsed := make([]targetKey, len(keys))
+	idsByTarget := make(map[models.ReactionTarget][]int64)
+	for i, key := range keys {
+		target, rawID, ok := strings.Cut(key.String(), ":")
+		id, err := strconv.ParseInt(rawID, 10, 64)
+		if !ok || err != nil {
+			return errorResults(len(keys), errors.New("invalid reaction key"))
+		}
+
+		parsed[i] = targetKey{target: models.ReactionTarget(target), id: id}
+		idsByTarget[parsed[i].target] = append(idsByTarget[parsed[i].target], id)
+	}
+
+	groups := make(map[targetKey][]*models.ReactionCount, len(keys))
+	for target, ids := range idsByTarget {
+		counts, err := l.repo.CountBatch(ctx, target, ids)
+		if err != nil {
+			return errorResults(len(keys), err)
+		}
+
+		for _, c := range counts {
+			k := targetKey{target: target, id: c.TargetID}
+			groups[k] = append(groups[k], c)
+		}
+	}
+
+	results := make([]*dataloader.Result, len(keys))
+	for i, k := range parsed {
+		results[i] = &dataloader.Result{Data: groups[k]}
+	}
+
+	return results
+}
+
+func errorResults(n int, err error) []*dataloader.Result {
+	results := make([]*dataloader.Result, n)
+	for i := range results {
+		results[i] = &dataloader.Result{Error: err}
+	}
+	return results
+}
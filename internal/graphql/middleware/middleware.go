@@ -7,10 +7,11 @@ import (
 
 	"github.com/graph-gophers/dataloader"
 
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
 	myLoader "github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
 )
 
-func DataloaderMiddleware(commentLoader myLoader.CommentLoader) func(http.Handler) http.Handler {
+func DataloaderMiddleware(commentLoader myLoader.CommentLoader, reactionLoader myLoader.ReactionLoader, postLoader myLoader.PostLoader) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			loader := dataloader.NewBatchedLoader(
@@ -19,7 +20,52 @@ func DataloaderMiddleware(commentLoader myLoader.CommentLoader) func(http.Handle
 				dataloader.WithBatchCapacity(50),
 			)
 
+			reactions := dataloader.NewBatchedLoader(
+				reactionLoader.BatchGetReactions,
+				dataloader.WithWait(2*time.Millisecond),
+				dataloader.WithBatchCapacity(100),
+			)
+
+			postComments := dataloader.NewBatchedLoader(
+				commentLoader.BatchGetPostComments,
+				dataloader.WithWait(2*time.Millisecond),
+				dataloader.WithBatchCapacity(100),
+			)
+
+			commentCounts := dataloader.NewBatchedLoader(
+				commentLoader.BatchCountComments,
+				dataloader.WithWait(2*time.Millisecond),
+				dataloader.WithBatchCapacity(100),
+			)
+
+			replyCounts := dataloader.NewBatchedLoader(
+				commentLoader.BatchCountReplies,
+				dataloader.WithWait(2*time.Millisecond),
+				dataloader.WithBatchCapacity(100),
+			)
+
+			posts := dataloader.NewBatchedLoader(
+				postLoader.BatchGetPosts,
+				dataloader.WithWait(2*time.Millisecond),
+				dataloader.WithBatchCapacity(100),
+			)
+
 			ctx := context.WithValue(r.Context(), myLoader.Key, loader)
+			ctx = context.WithValue(ctx, myLoader.ReplyCountKey, replyCounts)
+			ctx = context.WithValue(ctx, myLoader.ReactionKey, reactions)
+			ctx = context.WithValue(ctx, myLoader.PostKey, posts)
+			ctx = context.WithValue(ctx, myLoader.PostCommentsKey, postComments)
+			ctx = context.WithValue(ctx, myLoader.CommentCountKey, commentCounts)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func AuthMiddleware(moderatorToken string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := auth.WithAuthorization(r.Context(), r.Header.Get("Authorization"), moderatorToken)
+			ctx = auth.WithCaller(ctx, r.RemoteAddr)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -0,0 +1,107 @@
+package sse
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type ctxKey string
+
+const (
+	lastEventIDKey = ctxKey("sse.last_event_id")
+	eventIDsKey    = ctxKey("sse.event_ids")
+
+	// eventIDExtension is the response extension that carries the id of the
+	// event from the executor to the transport. The transport removes it
+	// before writing the response.
+	eventIDExtension = "sseEventId"
+)
+
+// eventIDs is a FIFO of ids for the items a subscription is about to send.
+// Resolvers push an id right before sending an item, and EventIDs pops one
+// for every response that resolves an item, so responses without an item,
+// such as errors, leave the queue alone.
+type eventIDs struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (q *eventIDs) push(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.ids = append(q.ids, id)
+}
+
+// claim moves the next id into the response of ctx, once per response.
+func (q *eventIDs) claim(ctx context.Context) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.ids) == 0 || graphql.GetExtension(ctx, eventIDExtension) != nil {
+		return
+	}
+
+	id := q.ids[0]
+	q.ids = q.ids[1:]
+
+	if id != "" {
+		graphql.RegisterExtension(ctx, eventIDExtension, id)
+	}
+}
+
+// LastEventID returns the Last-Event-ID sent by a reconnecting SSE client.
+func LastEventID(ctx context.Context) string {
+	id, _ := ctx.Value(lastEventIDKey).(string)
+	return id
+}
+
+// PushEventID attaches id to the response carrying the next item the current
+// subscription sends. Outside of an SSE request it does nothing.
+func PushEventID(ctx context.Context, id string) {
+	if q, ok := ctx.Value(eventIDsKey).(*eventIDs); ok {
+		q.push(id)
+	}
+}
+
+// EventIDs is the executor extension that pairs pushed ids with responses.
+// The first field resolved on a subscription item claims the next id for the
+// response that item is written to.
+type EventIDs struct{}
+
+var (
+	_ graphql.HandlerExtension = EventIDs{}
+	_ graphql.FieldInterceptor = EventIDs{}
+)
+
+func (EventIDs) ExtensionName() string {
+	return "SSEEventIDs"
+}
+
+func (EventIDs) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (EventIDs) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	q, ok := ctx.Value(eventIDsKey).(*eventIDs)
+	if !ok {
+		return next(ctx)
+	}
+
+	// Item fields are resolved per response and have no parent field; the
+	// subscription field itself runs once, before anything is pushed.
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Parent == nil && fc.Object != "Subscription" {
+		q.claim(ctx)
+	}
+
+	return next(ctx)
+}
+
+func withEventIDs(ctx context.Context, lastEventID string) context.Context {
+	ctx = context.WithValue(ctx, lastEventIDKey, lastEventID)
+	ctx = context.WithValue(ctx, eventIDsKey, &eventIDs{})
+
+	return ctx
+}
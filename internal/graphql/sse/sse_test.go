@@ -0,0 +1,196 @@
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// fakeEvent is one response of a fakeExecutor: an item, with the id its
+// resolver pushed, or an error that carries no item.
+type fakeEvent struct {
+	item string
+	id   string
+	err  string
+}
+
+// fakeExecutor emits one response per event the way gqlgen's executor does:
+// the resolver pushes every id up front, and each item is resolved through
+// EventIDs in a response context of its own.
+type fakeExecutor struct {
+	events      []fakeEvent
+	lastEventID string
+}
+
+func (e *fakeExecutor) CreateOperationContext(ctx context.Context, params *graphql.RawParams) (*graphql.OperationContext, gqlerror.List) {
+	return &graphql.OperationContext{RawQuery: params.Query}, nil
+}
+
+func (e *fakeExecutor) DispatchOperation(ctx context.Context, opCtx *graphql.OperationContext) (graphql.ResponseHandler, context.Context) {
+	e.lastEventID = LastEventID(ctx)
+
+	for _, ev := range e.events {
+		if ev.err == "" {
+			PushEventID(ctx, ev.id)
+		}
+	}
+
+	i := 0
+	return func(ctx context.Context) *graphql.Response {
+		if i == len(e.events) {
+			return nil
+		}
+
+		ev := e.events[i]
+		i++
+
+		ctx = graphql.WithResponseContext(ctx, graphql.DefaultErrorPresenter, graphql.DefaultRecover)
+		if ev.err != "" {
+			return &graphql.Response{Errors: gqlerror.List{gqlerror.Errorf("%s", ev.err)}}
+		}
+
+		item, _ := EventIDs{}.InterceptField(
+			graphql.WithFieldContext(ctx, &graphql.FieldContext{Object: "Item"}),
+			func(ctx context.Context) (any, error) { return ev.item, nil },
+		)
+		data, _ := json.Marshal(map[string]any{"item": item})
+
+		return &graphql.Response{Data: data, Extensions: graphql.GetExtensions(ctx)}
+	}, ctx
+}
+
+func (e *fakeExecutor) DispatchError(ctx context.Context, list gqlerror.List) *graphql.Response {
+	return &graphql.Response{Errors: list}
+}
+
+func newRequest(lastEventID string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"query":"subscription { commentAdded(postId: \"1\") { id } }"}`))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		r.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	return r
+}
+
+func TestTransport_Do(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		lastEventID     string
+		events          []fakeEvent
+		expectedBody    string
+		expectedEventID string
+	}{
+		{
+			name:   "writes_event_ids",
+			events: []fakeEvent{{item: "a", id: "c1"}, {item: "b", id: "c2"}},
+			expectedBody: ":\n\n" +
+				"id: c1\nevent: next\ndata: {\"data\":{\"item\":\"a\"}}\n\n" +
+				"id: c2\nevent: next\ndata: {\"data\":{\"item\":\"b\"}}\n\n" +
+				"event: complete\n\n",
+		},
+		{
+			name:   "omits_missing_ids",
+			events: []fakeEvent{{item: "a"}},
+			expectedBody: ":\n\n" +
+				"event: next\ndata: {\"data\":{\"item\":\"a\"}}\n\n" +
+				"event: complete\n\n",
+		},
+		{
+			name: "error_between_items_keeps_ids",
+			events: []fakeEvent{
+				{item: "a", id: "c1"},
+				{err: "boom"},
+				{item: "b", id: "c2"},
+			},
+			expectedBody: ":\n\n" +
+				"id: c1\nevent: next\ndata: {\"data\":{\"item\":\"a\"}}\n\n" +
+				"event: next\ndata: {\"errors\":[{\"message\":\"boom\"}],\"data\":null}\n\n" +
+				"id: c2\nevent: next\ndata: {\"data\":{\"item\":\"b\"}}\n\n" +
+				"event: complete\n\n",
+		},
+		{
+			name:            "exposes_last_event_id",
+			lastEventID:     "c1",
+			expectedBody:    ":\n\nevent: complete\n\n",
+			expectedEventID: "c1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			exec := &fakeExecutor{events: tt.events}
+			w := httptest.NewRecorder()
+
+			Transport{}.Do(w, newRequest(tt.lastEventID), exec)
+
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			assert.Equal(t, tt.expectedEventID, exec.lastEventID)
+		})
+	}
+}
+
+func TestTransport_Supports(t *testing.T) {
+	t.Parallel()
+
+	tr := Transport{SSE: transport.SSE{}}
+
+	assert.True(t, tr.Supports(newRequest("")))
+	assert.False(t, tr.Supports(httptest.NewRequest(http.MethodGet, "/query", nil)))
+}
+
+func TestPushEventID_OutsideSSE(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	PushEventID(ctx, "c1")
+	assert.Equal(t, "", LastEventID(ctx))
+}
+
+func TestEventIDs_InterceptField(t *testing.T) {
+	t.Parallel()
+
+	next := func(ctx context.Context) (any, error) { return "ok", nil }
+	field := func(ctx context.Context, object string) {
+		res, err := EventIDs{}.InterceptField(graphql.WithFieldContext(ctx, &graphql.FieldContext{Object: object}), next)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	}
+
+	ctx := withEventIDs(context.Background(), "")
+
+	// The subscription field runs before the resolver pushes anything and
+	// must not claim ids.
+	field(graphql.WithResponseContext(ctx, graphql.DefaultErrorPresenter, graphql.DefaultRecover), "Subscription")
+
+	PushEventID(ctx, "c1")
+	PushEventID(ctx, "c2")
+
+	// Every field of an item resolves in the same response; only the first
+	// claims an id.
+	first := graphql.WithResponseContext(ctx, graphql.DefaultErrorPresenter, graphql.DefaultRecover)
+	field(first, "Comment")
+	field(first, "Comment")
+	assert.Equal(t, "c1", graphql.GetExtension(first, eventIDExtension))
+
+	second := graphql.WithResponseContext(ctx, graphql.DefaultErrorPresenter, graphql.DefaultRecover)
+	field(second, "Comment")
+	assert.Equal(t, "c2", graphql.GetExtension(second, eventIDExtension))
+
+	// Outside of an SSE request the field just resolves.
+	field(context.Background(), "Comment")
+}
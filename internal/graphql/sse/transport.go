@@ -0,0 +1,155 @@
+package sse
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Transport is gqlgen's SSE transport extended with event ids: the id
+// EventIDs puts on a response is written as the "id:" line of its event, and
+// the Last-Event-ID header of a reconnecting client is exposed to resolvers
+// through the context. It writes the stream itself, so an id always lands on
+// the event of the response that carries it.
+type Transport struct {
+	transport.SSE
+}
+
+var _ graphql.Transport = Transport{}
+
+func (t Transport) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		transport.SendErrorf(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	ctx := withEventIDs(r.Context(), r.Header.Get("Last-Event-ID"))
+
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Content-Type", "application/json")
+
+	start := graphql.Now()
+	params := &graphql.RawParams{Headers: r.Header}
+	if err := json.NewDecoder(r.Body).Decode(params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		resp := exec.DispatchError(ctx, gqlerror.List{gqlerror.Errorf("json request body could not be decoded: %+v", err)})
+		_ = json.NewEncoder(w).Encode(resp)
+		return
+	}
+	params.ReadTime = graphql.TraceTiming{Start: start, End: graphql.Now()}
+
+	opCtx, opErr := exec.CreateOperationContext(ctx, params)
+	ctx = graphql.WithOperationContext(ctx, opCtx)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+
+	s := &stream{w: w, f: flusher}
+	s.write(":\n\n")
+
+	stopKeepAlive := func() {}
+	if t.KeepAlivePingInterval > 0 {
+		stopKeepAlive = s.keepAlive(t.KeepAlivePingInterval)
+		defer stopKeepAlive()
+	}
+
+	if opErr != nil {
+		s.next(exec.DispatchError(ctx, opErr))
+	} else {
+		responses, ctx := exec.DispatchOperation(ctx, opCtx)
+		for {
+			resp := responses(ctx)
+			if resp == nil {
+				break
+			}
+			s.next(resp)
+		}
+	}
+
+	stopKeepAlive()
+	s.write("event: complete\n\n")
+}
+
+// stream serialises the events of one response with its keep-alive pings.
+type stream struct {
+	mu       sync.Mutex
+	w        http.ResponseWriter
+	f        http.Flusher
+	ticker   *time.Ticker
+	interval time.Duration
+}
+
+func (s *stream) write(event string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fmt.Fprint(s.w, event)
+	s.f.Flush()
+}
+
+// next writes a response as a "next" event, with the id EventIDs put on it.
+func (s *stream) next(resp *graphql.Response) {
+	id, _ := resp.Extensions[eventIDExtension].(string)
+	delete(resp.Extensions, eventIDExtension)
+	if len(resp.Extensions) == 0 {
+		resp.Extensions = nil
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal %s: %w", string(resp.Data), err))
+	}
+
+	event := fmt.Sprintf("event: next\ndata: %s\n\n", data)
+	if id != "" {
+		event = "id: " + id + "\n" + event
+	}
+
+	s.write(event)
+	s.resetKeepAlive()
+}
+
+// keepAlive pings the client whenever the stream has been quiet for interval.
+// The returned func stops it and may be called more than once.
+func (s *stream) keepAlive(interval time.Duration) func() {
+	s.mu.Lock()
+	s.ticker = time.NewTicker(interval)
+	s.interval = interval
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-s.ticker.C:
+				s.write(": ping\n\n")
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			s.ticker.Stop()
+		})
+	}
+}
+
+func (s *stream) resetKeepAlive() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ticker != nil {
+		s.ticker.Reset(s.interval)
+	}
+}
@@ -0,0 +1,49 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *commentResolver) Ancestors(ctx context.Context, obj *graphql.Comment) ([]*graphql.Comment, error) {
+	if obj.ParentID == nil {
+		return []*graphql.Comment{}, nil
+	}
+
+	commentID, err := globalid.DecodeAs(globalid.Comment, obj.ID)
+	if err != nil {
+		return nil, errors.New("invalid comment ID")
+	}
+
+	ancestors, err := r.service.CommentService.Ancestors(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*graphql.Comment, len(ancestors))
+	for i, c := range ancestors {
+		node := &graphql.Comment{
+			ID:        globalid.Encode(globalid.Comment, c.ID),
+			PostID:    globalid.Encode(globalid.Post, c.PostID),
+			Author:    c.Author,
+			Text:      c.Text,
+			CreatedAt: c.CreatedAt,
+			EditedAt:  c.EditedAt,
+			IsDeleted: c.IsDeleted,
+			Status:    graphql.CommentStatus(c.Status),
+		}
+
+		if c.ParentID != nil {
+			pid := globalid.Encode(globalid.Comment, *c.ParentID)
+			node.ParentID = &pid
+		}
+
+		result[i] = node
+	}
+
+	return result, nil
+}
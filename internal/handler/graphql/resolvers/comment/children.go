@@ -2,21 +2,27 @@ package comment
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
-func (r *commentResolver) Children(ctx context.Context, obj *graphql.Comment, first *int32, after *string) (*graphql.CommentConnection, error) {
-	parentID, err := strconv.ParseInt(obj.ID, 10, 64)
+func (r *commentResolver) Children(ctx context.Context, obj *graphql.Comment, first *int32, after *string, last *int32, before *string, orderBy *graphql.CommentOrder) (*graphql.CommentConnection, error) {
+	parentID, err := globalid.DecodeAs(globalid.Comment, obj.ID)
 	if err != nil {
 		return nil, errors.New("invalid comment ID")
 	}
 
-	conn, err := r.service.CommentService.Children(ctx, parentID, first, after)
+	var order *models.CommentOrder
+	if orderBy != nil {
+		o := models.CommentOrder(*orderBy)
+		order = &o
+	}
+
+	conn, err := r.service.CommentService.Children(ctx, parentID, first, after, last, before, order)
 	if err != nil {
 		return nil, err
 	}
@@ -32,15 +38,18 @@ func convertToGraphQLCommentConnection(conn *models.CommentConnection) *graphql.
 	edges := make([]*graphql.CommentEdge, len(conn.Edges))
 	for i, edge := range conn.Edges {
 		node := &graphql.Comment{
-			ID:        strconv.FormatInt(edge.Node.ID, 10),
-			PostID:    strconv.FormatInt(edge.Node.PostID, 10),
+			ID:        globalid.Encode(globalid.Comment, edge.Node.ID),
+			PostID:    globalid.Encode(globalid.Post, edge.Node.PostID),
 			Author:    edge.Node.Author,
 			Text:      edge.Node.Text,
 			CreatedAt: edge.Node.CreatedAt,
+			EditedAt:  edge.Node.EditedAt,
+			IsDeleted: edge.Node.IsDeleted,
+			Status:    graphql.CommentStatus(edge.Node.Status),
 		}
 
 		if edge.Node.ParentID != nil {
-			pid := strconv.FormatInt(*edge.Node.ParentID, 10)
+			pid := globalid.Encode(globalid.Comment, *edge.Node.ParentID)
 			node.ParentID = &pid
 		}
 
@@ -53,8 +62,10 @@ func convertToGraphQLCommentConnection(conn *models.CommentConnection) *graphql.
 	return &graphql.CommentConnection{
 		Edges: edges,
 		PageInfo: &graphql.PageInfo{
-			EndCursor:   conn.PageInfo.EndCursor,
-			HasNextPage: conn.PageInfo.HasNextPage,
+			StartCursor:     conn.PageInfo.StartCursor,
+			EndCursor:       conn.PageInfo.EndCursor,
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
 		},
 		TotalCount: conn.TotalCount,
 	}
@@ -12,18 +12,23 @@ import (
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/service"
 	mockComment "github.com/Saracomethstein/ozon-test-task/internal/service/comment/mocks"
+	mockPost "github.com/Saracomethstein/ozon-test-task/internal/service/post/mocks"
+	mockReaction "github.com/Saracomethstein/ozon-test-task/internal/service/reaction/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func TestCommentResolver_Children(t *testing.T) {
 	t.Parallel()
 
-	commentID := "123"
+	commentID := globalid.Encode(globalid.Comment, 123)
 	commentIDInt := int64(123)
 	first := int32(2)
 	after := "cursor123"
 	totalCount := int32(3)
 	hasNextPage := true
 	endCursor := "nextCursor"
+	orderBy := graphql.CommentOrderTop
+	order := models.CommentOrderTop
 
 	child1 := &models.Comment{
 		ID:        4,
@@ -59,6 +64,7 @@ func TestCommentResolver_Children(t *testing.T) {
 		obj         *graphql.Comment
 		first       *int32
 		after       *string
+		orderBy     *graphql.CommentOrder
 		mockSetup   func(mockSvc *mockComment.MockUseCase)
 		expected    *graphql.CommentConnection
 		expectedErr string
@@ -72,7 +78,7 @@ func TestCommentResolver_Children(t *testing.T) {
 			after: nil,
 			mockSetup: func(mockSvc *mockComment.MockUseCase) {
 				mockSvc.EXPECT().
-					Children(mock.Anything, commentIDInt, (*int32)(nil), (*string)(nil)).
+					Children(mock.Anything, commentIDInt, (*int32)(nil), (*string)(nil), (*int32)(nil), (*string)(nil), (*models.CommentOrder)(nil)).
 					Return(serviceConnection, nil)
 			},
 			expected: &graphql.CommentConnection{
@@ -80,8 +86,8 @@ func TestCommentResolver_Children(t *testing.T) {
 					{
 						Cursor: "cursor1",
 						Node: &graphql.Comment{
-							ID:        "4",
-							PostID:    "123",
+							ID:        globalid.Encode(globalid.Comment, 4),
+							PostID:    globalid.Encode(globalid.Post, 123),
 							ParentID:  &commentID,
 							Author:    child1.Author,
 							Text:      child1.Text,
@@ -91,8 +97,8 @@ func TestCommentResolver_Children(t *testing.T) {
 					{
 						Cursor: "cursor2",
 						Node: &graphql.Comment{
-							ID:        "5",
-							PostID:    "123",
+							ID:        globalid.Encode(globalid.Comment, 5),
+							PostID:    globalid.Encode(globalid.Post, 123),
 							ParentID:  &commentID,
 							Author:    child2.Author,
 							Text:      child2.Text,
@@ -112,11 +118,12 @@ func TestCommentResolver_Children(t *testing.T) {
 			obj: &graphql.Comment{
 				ID: commentID,
 			},
-			first: &first,
-			after: &after,
+			first:   &first,
+			after:   &after,
+			orderBy: &orderBy,
 			mockSetup: func(mockSvc *mockComment.MockUseCase) {
 				mockSvc.EXPECT().
-					Children(mock.Anything, commentIDInt, &first, &after).
+					Children(mock.Anything, commentIDInt, &first, &after, (*int32)(nil), (*string)(nil), &order).
 					Return(serviceConnection, nil)
 			},
 			expected: &graphql.CommentConnection{
@@ -124,8 +131,8 @@ func TestCommentResolver_Children(t *testing.T) {
 					{
 						Cursor: "cursor1",
 						Node: &graphql.Comment{
-							ID:        "4",
-							PostID:    "123",
+							ID:        globalid.Encode(globalid.Comment, 4),
+							PostID:    globalid.Encode(globalid.Post, 123),
 							ParentID:  &commentID,
 							Author:    child1.Author,
 							Text:      child1.Text,
@@ -135,8 +142,8 @@ func TestCommentResolver_Children(t *testing.T) {
 					{
 						Cursor: "cursor2",
 						Node: &graphql.Comment{
-							ID:        "5",
-							PostID:    "123",
+							ID:        globalid.Encode(globalid.Comment, 5),
+							PostID:    globalid.Encode(globalid.Post, 123),
 							ParentID:  &commentID,
 							Author:    child2.Author,
 							Text:      child2.Text,
@@ -160,7 +167,7 @@ func TestCommentResolver_Children(t *testing.T) {
 			after: nil,
 			mockSetup: func(mockSvc *mockComment.MockUseCase) {
 				mockSvc.EXPECT().
-					Children(mock.Anything, commentIDInt, (*int32)(nil), (*string)(nil)).
+					Children(mock.Anything, commentIDInt, (*int32)(nil), (*string)(nil), (*int32)(nil), (*string)(nil), (*models.CommentOrder)(nil)).
 					Return(nil, errors.New("db error"))
 			},
 			expectedErr: "db error",
@@ -190,7 +197,7 @@ func TestCommentResolver_Children(t *testing.T) {
 
 			tt.mockSetup(mockCommentService)
 
-			got, err := resolver.Children(context.Background(), tt.obj, tt.first, tt.after)
+			got, err := resolver.Children(context.Background(), tt.obj, tt.first, tt.after, nil, nil, tt.orderBy)
 
 			if tt.expectedErr != "" {
 				assert.Error(t, err)
@@ -205,3 +212,441 @@ func TestCommentResolver_Children(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentResolver_ReplyCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Comment
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    int32
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 7)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().ReplyCount(mock.Anything, int64(7)).Return(int64(3), nil)
+			},
+			expected: 3,
+		},
+		{
+			name: "service_error",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 7)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().ReplyCount(mock.Anything, int64(7)).Return(int64(0), errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			name:        "invalid_comment_ID",
+			obj:         &graphql.Comment{ID: "abc"},
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "invalid comment ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &commentResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.ReplyCount(context.Background(), tt.obj)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCommentResolver_Revisions(t *testing.T) {
+	t.Parallel()
+
+	endCursor := "cursor1"
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Comment
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *graphql.CommentRevisionConnection
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					Revisions(mock.Anything, int64(5), (*int32)(nil), (*string)(nil)).
+					Return(&models.CommentRevisionConnection{
+						Edges: []*models.CommentRevisionEdge{
+							{Cursor: "cursor1", Node: &models.CommentRevision{ID: 1, CommentID: 5, Text: "Frist", CreatedAt: "2023-01-01T13:00:00Z"}},
+						},
+						PageInfo:   &models.PageInfo{EndCursor: &endCursor},
+						TotalCount: 1,
+					}, nil)
+			},
+			expected: &graphql.CommentRevisionConnection{
+				Edges: []*graphql.CommentRevisionEdge{
+					{Cursor: "cursor1", Node: &graphql.CommentRevision{ID: globalid.Encode(globalid.Revision, 1), Text: "Frist", CreatedAt: "2023-01-01T13:00:00Z"}},
+				},
+				PageInfo:   &graphql.PageInfo{EndCursor: &endCursor},
+				TotalCount: 1,
+			},
+		},
+		{
+			name: "service_error",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					Revisions(mock.Anything, int64(5), (*int32)(nil), (*string)(nil)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			name:        "invalid_comment_ID",
+			obj:         &graphql.Comment{ID: "abc"},
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "invalid comment ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &commentResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.Revisions(context.Background(), tt.obj, nil, nil)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCommentResolver_Reactions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Comment
+		mockSetup   func(mockSvc *mockReaction.MockUseCase)
+		expected    *graphql.ReactionSummary
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5)},
+			mockSetup: func(mockSvc *mockReaction.MockUseCase) {
+				mockSvc.EXPECT().
+					Reactions(mock.Anything, models.ReactionTargetComment, int64(5)).
+					Return(&models.ReactionSummary{
+						Upvotes: 1,
+						Score:   1,
+						Counts:  []*models.ReactionCount{{TargetID: 5, Kind: "wow", Count: 2}},
+					}, nil)
+			},
+			expected: &graphql.ReactionSummary{
+				Upvotes: 1,
+				Score:   1,
+				Counts:  []*graphql.ReactionCount{{Kind: "wow", Count: 2}},
+			},
+		},
+		{
+			name: "service_error",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5)},
+			mockSetup: func(mockSvc *mockReaction.MockUseCase) {
+				mockSvc.EXPECT().
+					Reactions(mock.Anything, models.ReactionTargetComment, int64(5)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			name:        "invalid_comment_ID",
+			obj:         &graphql.Comment{ID: "abc"},
+			mockSetup:   func(mockSvc *mockReaction.MockUseCase) {},
+			expectedErr: "invalid comment ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReactionService := mockReaction.NewMockUseCase(t)
+			resolver := &commentResolver{
+				service: &service.Container{
+					ReactionService: mockReactionService,
+				},
+			}
+
+			tt.mockSetup(mockReactionService)
+
+			got, err := resolver.Reactions(context.Background(), tt.obj)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCommentResolver_Ancestors(t *testing.T) {
+	t.Parallel()
+
+	rootID := int64(1)
+	rootIDStr := globalid.Encode(globalid.Comment, 1)
+	midIDStr := globalid.Encode(globalid.Comment, 2)
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Comment
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    []*graphql.Comment
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 3), ParentID: &midIDStr},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					Ancestors(mock.Anything, int64(3)).
+					Return([]*models.Comment{
+						{ID: 1, PostID: 1, Author: "A", Text: "root"},
+						{ID: 2, PostID: 1, ParentID: &rootID, Author: "B", Text: "mid"},
+					}, nil)
+			},
+			expected: []*graphql.Comment{
+				{ID: globalid.Encode(globalid.Comment, 1), PostID: globalid.Encode(globalid.Post, 1), Author: "A", Text: "root"},
+				{ID: globalid.Encode(globalid.Comment, 2), PostID: globalid.Encode(globalid.Post, 1), ParentID: &rootIDStr, Author: "B", Text: "mid"},
+			},
+		},
+		{
+			name:      "root_comment",
+			obj:       &graphql.Comment{ID: globalid.Encode(globalid.Comment, 1)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {},
+			expected:  []*graphql.Comment{},
+		},
+		{
+			name: "service_error",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 3), ParentID: &midIDStr},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					Ancestors(mock.Anything, int64(3)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			name:        "invalid_comment_ID",
+			obj:         &graphql.Comment{ID: "abc", ParentID: &midIDStr},
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "invalid comment ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &commentResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.Ancestors(context.Background(), tt.obj)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCommentResolver_Post(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Comment
+		mockSetup   func(mockSvc *mockPost.MockUseCase)
+		expected    *graphql.Post
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), PostID: globalid.Encode(globalid.Post, 1)},
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					LoadPost(mock.Anything, int64(1)).
+					Return(&models.Post{ID: 1, Title: "Title", Body: "Body", Author: "Alice", CommentPolicy: models.CommentPolicyOpen, Tags: []string{"go"}}, nil)
+			},
+			expected: &graphql.Post{ID: globalid.Encode(globalid.Post, 1), Title: "Title", Body: "Body", Author: "Alice", CommentPolicy: graphql.CommentPolicyOpen, Tags: []string{"go"}},
+		},
+		{
+			name: "deleted_post",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), PostID: globalid.Encode(globalid.Post, 1)},
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					LoadPost(mock.Anything, int64(1)).
+					Return(nil, nil)
+			},
+			expected: nil,
+		},
+		{
+			name: "service_error",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), PostID: globalid.Encode(globalid.Post, 1)},
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					LoadPost(mock.Anything, int64(1)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			name:        "invalid_post_ID",
+			obj:         &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), PostID: "abc"},
+			mockSetup:   func(mockSvc *mockPost.MockUseCase) {},
+			expectedErr: "invalid post ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPostService := mockPost.NewMockUseCase(t)
+			resolver := &commentResolver{
+				service: &service.Container{
+					PostService: mockPostService,
+				},
+			}
+
+			tt.mockSetup(mockPostService)
+
+			got, err := resolver.Post(context.Background(), tt.obj)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCommentResolver_SpamScore(t *testing.T) {
+	t.Parallel()
+
+	score := 0.97
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Comment
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *float64
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), Text: "Casino bonus"},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().SpamScore(mock.Anything, int64(5)).Return(&score, nil)
+			},
+			expected: &score,
+		},
+		{
+			name: "not_scored",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), Text: "Hi"},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().SpamScore(mock.Anything, int64(5)).Return(nil, nil)
+			},
+		},
+		{
+			name: "deleted_comment",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), IsDeleted: true},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().SpamScore(mock.Anything, int64(5)).Return(&score, nil)
+			},
+		},
+		{
+			name: "not_a_moderator",
+			obj:  &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), IsDeleted: true},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().SpamScore(mock.Anything, int64(5)).Return(nil, errors.New("moderator access required"))
+			},
+			expectedErr: "moderator access required",
+		},
+		{
+			name:        "invalid_id",
+			obj:         &graphql.Comment{ID: globalid.Encode(globalid.Post, 5)},
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "invalid comment ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &commentResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.SpamScore(context.Background(), tt.obj)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *commentResolver) Post(ctx context.Context, obj *graphql.Comment) (*graphql.Post, error) {
+	postID, err := globalid.DecodeAs(globalid.Post, obj.PostID)
+	if err != nil {
+		return nil, errors.New("invalid post ID")
+	}
+
+	post, err := r.service.PostService.LoadPost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, nil
+	}
+
+	return &graphql.Post{
+		ID:              globalid.Encode(globalid.Post, post.ID),
+		Title:           post.Title,
+		Body:            post.Body,
+		Author:          post.Author,
+		CommentPolicy:   graphql.CommentPolicy(post.CommentPolicy),
+		CommentsCloseAt: post.CommentsCloseAt,
+		CreatedAt:       post.CreatedAt,
+		DeletedAt:       post.DeletedAt,
+		Tags:            post.Tags,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *commentResolver) Reactions(ctx context.Context, obj *graphql.Comment) (*graphql.ReactionSummary, error) {
+	commentID, err := globalid.DecodeAs(globalid.Comment, obj.ID)
+	if err != nil {
+		return nil, errors.New("invalid comment ID")
+	}
+
+	summary, err := r.service.ReactionService.Reactions(ctx, models.ReactionTargetComment, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]*graphql.ReactionCount, len(summary.Counts))
+	for i, c := range summary.Counts {
+		counts[i] = &graphql.ReactionCount{
+			Kind:  c.Kind,
+			Count: c.Count,
+		}
+	}
+
+	return &graphql.ReactionSummary{
+		Upvotes:   summary.Upvotes,
+		Downvotes: summary.Downvotes,
+		Score:     summary.Score,
+		Counts:    counts,
+	}, nil
+}
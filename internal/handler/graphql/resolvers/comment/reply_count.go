@@ -0,0 +1,24 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *commentResolver) ReplyCount(ctx context.Context, obj *graphql.Comment) (int32, error) {
+	commentID, err := globalid.DecodeAs(globalid.Comment, obj.ID)
+	if err != nil {
+		return 0, errors.New("invalid comment ID")
+	}
+
+	count, err := r.service.CommentService.ReplyCount(ctx, commentID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(count), nil
+}
@@ -0,0 +1,54 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *commentResolver) Revisions(ctx context.Context, obj *graphql.Comment, first *int32, after *string) (*graphql.CommentRevisionConnection, error) {
+	commentID, err := globalid.DecodeAs(globalid.Comment, obj.ID)
+	if err != nil {
+		return nil, errors.New("invalid comment ID")
+	}
+
+	conn, err := r.service.CommentService.Revisions(ctx, commentID, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToGraphQLRevisionConnection(conn), nil
+}
+
+func convertToGraphQLRevisionConnection(conn *models.CommentRevisionConnection) *graphql.CommentRevisionConnection {
+	if conn == nil {
+		return nil
+	}
+
+	edges := make([]*graphql.CommentRevisionEdge, len(conn.Edges))
+	for i, edge := range conn.Edges {
+		edges[i] = &graphql.CommentRevisionEdge{
+			Cursor: edge.Cursor,
+			Node: &graphql.CommentRevision{
+				ID:        globalid.Encode(globalid.Revision, edge.Node.ID),
+				Text:      edge.Node.Text,
+				CreatedAt: edge.Node.CreatedAt,
+			},
+		}
+	}
+
+	return &graphql.CommentRevisionConnection{
+		Edges: edges,
+		PageInfo: &graphql.PageInfo{
+			StartCursor:     conn.PageInfo.StartCursor,
+			EndCursor:       conn.PageInfo.EndCursor,
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+		},
+		TotalCount: conn.TotalCount,
+	}
+}
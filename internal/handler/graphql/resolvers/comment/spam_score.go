@@ -0,0 +1,29 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *commentResolver) SpamScore(ctx context.Context, obj *graphql.Comment) (*float64, error) {
+	commentID, err := globalid.DecodeAs(globalid.Comment, obj.ID)
+	if err != nil {
+		return nil, errors.New("invalid comment ID")
+	}
+
+	score, err := r.service.CommentService.SpamScore(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A deleted comment has no text left to judge.
+	if obj.IsDeleted {
+		return nil, nil
+	}
+
+	return score, nil
+}
@@ -2,12 +2,15 @@ package mutation
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	commentSvc "github.com/Saracomethstein/ozon-test-task/internal/service/comment"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func (r *mutationResolver) AddComment(ctx context.Context, input graphql.AddCommentInput) (*graphql.Comment, error) {
@@ -22,23 +25,62 @@ func (r *mutationResolver) AddComment(ctx context.Context, input graphql.AddComm
 		Text:     input.Text,
 	})
 	if err != nil {
+		var policyErr *commentSvc.CommentPolicyError
+		if errors.As(err, &policyErr) {
+			return nil, commentNotAllowed(policyErr)
+		}
+		var filterErr *filter.RejectedError
+		if errors.As(err, &filterErr) {
+			return nil, contentNotAllowed(filterErr)
+		}
+		var depthErr *commentSvc.ReplyDepthError
+		if errors.As(err, &depthErr) {
+			return nil, replyDepthExceeded(depthErr)
+		}
 		return nil, err
 	}
 
 	var parentIDPtr *string
 	if comment.ParentID != nil {
-		pid := strconv.FormatInt(*comment.ParentID, 10)
+		pid := globalid.Encode(globalid.Comment, *comment.ParentID)
 		parentIDPtr = &pid
 	}
 
 	gqlComment := &graphql.Comment{
-		ID:        strconv.FormatInt(comment.ID, 10),
-		PostID:    strconv.FormatInt(comment.PostID, 10),
+		ID:        globalid.Encode(globalid.Comment, comment.ID),
+		PostID:    globalid.Encode(globalid.Post, comment.PostID),
 		ParentID:  parentIDPtr,
 		Author:    comment.Author,
 		Text:      comment.Text,
 		CreatedAt: comment.CreatedAt,
+		Status:    graphql.CommentStatus(comment.Status),
 	}
 
 	return gqlComment, nil
 }
+
+// commentNotAllowed turns a comment policy rejection into a GraphQL error
+// clients can branch on: extensions.code is always COMMENT_NOT_ALLOWED and
+// extensions.reason holds a CommentDenialReason.
+func commentNotAllowed(err *commentSvc.CommentPolicyError) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message: err.Error(),
+		Extensions: map[string]interface{}{
+			"code":   "COMMENT_NOT_ALLOWED",
+			"reason": graphql.CommentDenialReason(err.Reason),
+		},
+	}
+}
+
+// replyDepthExceeded turns a too-deep reply into a GraphQL error clients can
+// branch on: extensions.code is REPLY_DEPTH_EXCEEDED and extensions.maxDepth
+// holds the post's limit.
+func replyDepthExceeded(err *commentSvc.ReplyDepthError) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message: err.Error(),
+		Extensions: map[string]interface{}{
+			"code":     "REPLY_DEPTH_EXCEEDED",
+			"maxDepth": err.MaxDepth,
+		},
+	}
+}
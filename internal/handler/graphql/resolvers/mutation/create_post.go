@@ -2,12 +2,14 @@ package mutation
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func (r *mutationResolver) CreatePost(ctx context.Context, input graphql.CreatePostInput) (*graphql.Post, error) {
@@ -15,22 +17,52 @@ func (r *mutationResolver) CreatePost(ctx context.Context, input graphql.CreateP
 		return nil, errors.New("title, author and body are required fields")
 	}
 
+	var policy *models.CommentPolicy
+	if input.CommentPolicy != nil {
+		p := models.CommentPolicy(*input.CommentPolicy)
+		policy = &p
+	}
+
 	out, err := r.service.PostService.CreatePost(ctx, models.CreatePostInput{
-		Title:         input.Title,
-		Body:          input.Body,
-		Author:        input.Author,
-		AllowComments: input.AllowComments,
+		Title:           input.Title,
+		Body:            input.Body,
+		Author:          input.Author,
+		AllowComments:   input.AllowComments,
+		CommentPolicy:   policy,
+		CommentsCloseAt: input.CommentsCloseAt,
+		Tags:            input.Tags,
+		MaxReplyDepth:   input.MaxReplyDepth,
 	})
 	if err != nil {
+		var filterErr *filter.RejectedError
+		if errors.As(err, &filterErr) {
+			return nil, contentNotAllowed(filterErr)
+		}
 		return nil, errors.Wrap(err, "failed to create post")
 	}
 
 	return &graphql.Post{
-		ID:            strconv.FormatInt(out.ID, 10),
-		Title:         out.Title,
-		Body:          out.Body,
-		Author:        out.Author,
-		AllowComments: out.AllowComments,
-		CreatedAt:     out.CreatedAt,
+		ID:              globalid.Encode(globalid.Post, out.ID),
+		Title:           out.Title,
+		Body:            out.Body,
+		Author:          out.Author,
+		CommentPolicy:   graphql.CommentPolicy(out.CommentPolicy),
+		CommentsCloseAt: out.CommentsCloseAt,
+		CreatedAt:       out.CreatedAt,
+		Tags:            out.Tags,
 	}, nil
 }
+
+// contentNotAllowed turns a content filter rejection into a GraphQL error:
+// extensions.code is always CONTENT_NOT_ALLOWED, extensions.action is REJECT
+// or HOLD and extensions.rule names the rule that fired.
+func contentNotAllowed(err *filter.RejectedError) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message: err.Error(),
+		Extensions: map[string]interface{}{
+			"code":   "CONTENT_NOT_ALLOWED",
+			"action": string(err.Decision.Action),
+			"rule":   err.Decision.Rule,
+		},
+	}
+}
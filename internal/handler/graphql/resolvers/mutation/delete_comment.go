@@ -0,0 +1,22 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+)
+
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string) (*graphql.Comment, error) {
+	if id == "" {
+		return nil, errors.New("id cannot be empty")
+	}
+
+	comment, err := r.service.CommentService.DeleteComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToGraphQLComment(comment), nil
+}
@@ -0,0 +1,22 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+)
+
+func (r *mutationResolver) DeletePost(ctx context.Context, id string) (*graphql.Post, error) {
+	if id == "" {
+		return nil, errors.New("id cannot be empty")
+	}
+
+	out, err := r.service.PostService.DeletePost(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete post")
+	}
+
+	return convertToGraphQLPost(out), nil
+}
@@ -0,0 +1,50 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *mutationResolver) EditComment(ctx context.Context, id string, text string) (*graphql.Comment, error) {
+	if id == "" || text == "" {
+		return nil, errors.New("id or text cannot be empty")
+	}
+
+	comment, err := r.service.CommentService.EditComment(ctx, id, text)
+	if err != nil {
+		var filterErr *filter.RejectedError
+		if errors.As(err, &filterErr) {
+			return nil, contentNotAllowed(filterErr)
+		}
+		return nil, err
+	}
+
+	return convertToGraphQLComment(comment), nil
+}
+
+func convertToGraphQLComment(comment *models.Comment) *graphql.Comment {
+	var parentIDPtr *string
+	if comment.ParentID != nil {
+		pid := globalid.Encode(globalid.Comment, *comment.ParentID)
+		parentIDPtr = &pid
+	}
+
+	return &graphql.Comment{
+		ID:              globalid.Encode(globalid.Comment, comment.ID),
+		PostID:          globalid.Encode(globalid.Post, comment.PostID),
+		ParentID:        parentIDPtr,
+		Author:          comment.Author,
+		Text:            comment.Text,
+		CreatedAt:       comment.CreatedAt,
+		EditedAt:        comment.EditedAt,
+		IsDeleted:       comment.IsDeleted,
+		Status:          graphql.CommentStatus(comment.Status),
+		RejectionReason: comment.RejectionReason,
+	}
+}
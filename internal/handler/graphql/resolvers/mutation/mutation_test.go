@@ -7,20 +7,27 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/service"
+	commentSvc "github.com/Saracomethstein/ozon-test-task/internal/service/comment"
 	mockComment "github.com/Saracomethstein/ozon-test-task/internal/service/comment/mocks"
 	mockPost "github.com/Saracomethstein/ozon-test-task/internal/service/post/mocks"
+	mockReaction "github.com/Saracomethstein/ozon-test-task/internal/service/reaction/mocks"
+	mockReport "github.com/Saracomethstein/ozon-test-task/internal/service/report/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func TestMutationResolver_AddComment(t *testing.T) {
 	t.Parallel()
 
-	postID := "123"
+	postID := globalid.Encode(globalid.Post, 123)
 	postIDInt := int64(123)
-	parentID := "456"
+	parentID := globalid.Encode(globalid.Comment, 456)
 	parentIDInt := int64(456)
 	author := "John Doe"
 	text := "Test comment"
@@ -59,7 +66,7 @@ func TestMutationResolver_AddComment(t *testing.T) {
 					}, nil)
 			},
 			expected: &graphql.Comment{
-				ID:        "1",
+				ID:        globalid.Encode(globalid.Comment, 1),
 				PostID:    postID,
 				Author:    author,
 				Text:      text,
@@ -93,7 +100,7 @@ func TestMutationResolver_AddComment(t *testing.T) {
 					}, nil)
 			},
 			expected: &graphql.Comment{
-				ID:        "2",
+				ID:        globalid.Encode(globalid.Comment, 2),
 				PostID:    postID,
 				Author:    author,
 				Text:      text,
@@ -185,6 +192,106 @@ func TestMutationResolver_AddComment(t *testing.T) {
 	}
 }
 
+func TestMutationResolver_AddComment_NotAllowed(t *testing.T) {
+	t.Parallel()
+
+	input := graphql.AddCommentInput{
+		PostID: globalid.Encode(globalid.Post, 123),
+		Author: "John Doe",
+		Text:   "Test comment",
+	}
+
+	mockCommentService := mockComment.NewMockUseCase(t)
+	mockCommentService.EXPECT().
+		AddComment(mock.Anything, mock.Anything).
+		Return(nil, &commentSvc.CommentPolicyError{Reason: models.CommentDeniedAuthorOnly})
+
+	resolver := &mutationResolver{
+		service: &service.Container{
+			CommentService: mockCommentService,
+		},
+	}
+
+	got, err := resolver.AddComment(context.Background(), input)
+
+	assert.Nil(t, got)
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr))
+	assert.Equal(t, "only the post author can comment on this post", gqlErr.Message)
+	assert.Equal(t, "COMMENT_NOT_ALLOWED", gqlErr.Extensions["code"])
+	assert.Equal(t, graphql.CommentDenialReasonAuthorOnly, gqlErr.Extensions["reason"])
+}
+
+func TestMutationResolver_AddComment_ReplyDepthExceeded(t *testing.T) {
+	t.Parallel()
+
+	parentID := globalid.Encode(globalid.Comment, 7)
+	input := graphql.AddCommentInput{
+		PostID:   globalid.Encode(globalid.Post, 123),
+		ParentID: &parentID,
+		Author:   "John Doe",
+		Text:     "Test reply",
+	}
+
+	mockCommentService := mockComment.NewMockUseCase(t)
+	mockCommentService.EXPECT().
+		AddComment(mock.Anything, mock.Anything).
+		Return(nil, &commentSvc.ReplyDepthError{MaxDepth: 3})
+
+	resolver := &mutationResolver{
+		service: &service.Container{
+			CommentService: mockCommentService,
+		},
+	}
+
+	got, err := resolver.AddComment(context.Background(), input)
+
+	assert.Nil(t, got)
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr))
+	assert.Equal(t, "replies cannot be nested deeper than 3 levels", gqlErr.Message)
+	assert.Equal(t, "REPLY_DEPTH_EXCEEDED", gqlErr.Extensions["code"])
+	assert.Equal(t, int32(3), gqlErr.Extensions["maxDepth"])
+}
+
+func TestMutationResolver_ContentNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	rejected := &filter.RejectedError{Decision: filter.Decision{
+		Action: filter.Hold,
+		Rule:   "links",
+		Reason: "text contains more than 2 links",
+	}}
+
+	mockPostService := mockPost.NewMockUseCase(t)
+	mockPostService.EXPECT().
+		CreatePost(mock.Anything, mock.Anything).
+		Return(nil, rejected)
+	mockCommentService := mockComment.NewMockUseCase(t)
+	mockCommentService.EXPECT().
+		EditComment(mock.Anything, "5", "spam").
+		Return(nil, rejected)
+
+	resolver := &mutationResolver{
+		service: &service.Container{
+			PostService:    mockPostService,
+			CommentService: mockCommentService,
+		},
+	}
+
+	_, postErr := resolver.CreatePost(context.Background(), graphql.CreatePostInput{Title: "t", Author: "a", Body: "b"})
+	_, editErr := resolver.EditComment(context.Background(), "5", "spam")
+
+	for _, err := range []error{postErr, editErr} {
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "content not allowed: text contains more than 2 links", gqlErr.Message)
+		assert.Equal(t, "CONTENT_NOT_ALLOWED", gqlErr.Extensions["code"])
+		assert.Equal(t, "HOLD", gqlErr.Extensions["action"])
+		assert.Equal(t, "links", gqlErr.Extensions["rule"])
+	}
+}
+
 func TestMutationResolver_CreatePost(t *testing.T) {
 	t.Parallel()
 
@@ -223,16 +330,16 @@ func TestMutationResolver_CreatePost(t *testing.T) {
 						Title:         title,
 						Author:        author,
 						Body:          body,
-						AllowComments: allowComments,
+						CommentPolicy: models.CommentPolicyOpen,
 						CreatedAt:     createdAt,
 					}, nil)
 			},
 			expected: &graphql.Post{
-				ID:            "1",
+				ID:            globalid.Encode(globalid.Post, 1),
 				Title:         title,
 				Author:        author,
 				Body:          body,
-				AllowComments: allowComments,
+				CommentPolicy: graphql.CommentPolicyOpen,
 				CreatedAt:     createdAt,
 			},
 		},
@@ -323,7 +430,7 @@ func TestMutationResolver_CreatePost(t *testing.T) {
 func TestMutationResolver_SetPostCommentsAllowed(t *testing.T) {
 	t.Parallel()
 
-	postID := "123"
+	postID := globalid.Encode(globalid.Post, 123)
 	postIDInt := int64(123)
 	allow := true
 	title := "Test Title"
@@ -351,7 +458,7 @@ func TestMutationResolver_SetPostCommentsAllowed(t *testing.T) {
 						Title:         title,
 						Author:        author,
 						Body:          body,
-						AllowComments: allow,
+						CommentPolicy: models.CommentPolicyOpen,
 						CreatedAt:     createdAt,
 					}, nil)
 			},
@@ -360,7 +467,7 @@ func TestMutationResolver_SetPostCommentsAllowed(t *testing.T) {
 				Title:         title,
 				Author:        author,
 				Body:          body,
-				AllowComments: allow,
+				CommentPolicy: graphql.CommentPolicyOpen,
 				CreatedAt:     createdAt,
 			},
 		},
@@ -412,3 +519,941 @@ func TestMutationResolver_SetPostCommentsAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestMutationResolver_SetPostCommentPolicy(t *testing.T) {
+	t.Parallel()
+
+	postID := globalid.Encode(globalid.Post, 123)
+	postIDInt := int64(123)
+	closeAt := "2026-03-01T00:00:00Z"
+
+	tests := []struct {
+		name        string
+		postID      string
+		policy      graphql.CommentPolicy
+		closeAt     *string
+		mockSetup   func(mockSvc *mockPost.MockUseCase)
+		expected    *graphql.Post
+		expectedErr string
+	}{
+		{
+			name:    "success",
+			postID:  postID,
+			policy:  graphql.CommentPolicyRepliesOnly,
+			closeAt: &closeAt,
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					SetPostCommentPolicy(mock.Anything, postID, models.CommentPolicyRepliesOnly, &closeAt).
+					Return(&models.Post{
+						ID:              postIDInt,
+						Title:           "Test Title",
+						CommentPolicy:   models.CommentPolicyRepliesOnly,
+						CommentsCloseAt: &closeAt,
+					}, nil)
+			},
+			expected: &graphql.Post{
+				ID:              postID,
+				Title:           "Test Title",
+				CommentPolicy:   graphql.CommentPolicyRepliesOnly,
+				CommentsCloseAt: &closeAt,
+			},
+		},
+		{
+			name:        "validation_error",
+			postID:      "",
+			policy:      graphql.CommentPolicyOpen,
+			mockSetup:   func(mockSvc *mockPost.MockUseCase) {},
+			expectedErr: "postId cannot be empty",
+		},
+		{
+			name:   "service_error",
+			postID: postID,
+			policy: graphql.CommentPolicyOpen,
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					SetPostCommentPolicy(mock.Anything, postID, models.CommentPolicyOpen, (*string)(nil)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "failed to set post comment policy: db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPostService := mockPost.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					PostService: mockPostService,
+				},
+			}
+
+			tt.mockSetup(mockPostService)
+
+			got, err := resolver.SetPostCommentPolicy(context.Background(), tt.postID, tt.policy, tt.closeAt)
+
+			if tt.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			mockPostService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMutationResolver_UpdatePost(t *testing.T) {
+	t.Parallel()
+
+	title := "New Title"
+
+	tests := []struct {
+		name        string
+		id          string
+		input       graphql.UpdatePostInput
+		mockSetup   func(mockSvc *mockPost.MockUseCase)
+		expected    *graphql.Post
+		expectedErr string
+	}{
+		{
+			name:  "success",
+			id:    "123",
+			input: graphql.UpdatePostInput{Title: &title},
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					UpdatePost(mock.Anything, "123", models.UpdatePostInput{Title: &title}).
+					Return(&models.Post{ID: 123, Title: title, Body: "Body", CreatedAt: "2023-01-01T12:00:00Z"}, nil)
+			},
+			expected: &graphql.Post{ID: globalid.Encode(globalid.Post, 123), Title: title, Body: "Body", CreatedAt: "2023-01-01T12:00:00Z"},
+		},
+		{
+			name:        "validation_error",
+			id:          "",
+			mockSetup:   func(mockSvc *mockPost.MockUseCase) {},
+			expectedErr: "id cannot be empty",
+		},
+		{
+			name:  "service_error",
+			id:    "123",
+			input: graphql.UpdatePostInput{Title: &title},
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					UpdatePost(mock.Anything, "123", models.UpdatePostInput{Title: &title}).
+					Return(nil, errors.New("post not found"))
+			},
+			expectedErr: "failed to update post: post not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPostService := mockPost.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					PostService: mockPostService,
+				},
+			}
+
+			tt.mockSetup(mockPostService)
+
+			got, err := resolver.UpdatePost(context.Background(), tt.id, tt.input)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_DeleteRestorePost(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := "2023-01-02T12:00:00Z"
+
+	tests := []struct {
+		name        string
+		id          string
+		call        func(r *mutationResolver, id string) (*graphql.Post, error)
+		mockSetup   func(mockSvc *mockPost.MockUseCase)
+		expected    *graphql.Post
+		expectedErr string
+	}{
+		{
+			name: "delete_success",
+			id:   "123",
+			call: func(r *mutationResolver, id string) (*graphql.Post, error) {
+				return r.DeletePost(context.Background(), id)
+			},
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					DeletePost(mock.Anything, "123").
+					Return(&models.Post{ID: 123, Title: "Title", DeletedAt: &deletedAt}, nil)
+			},
+			expected: &graphql.Post{ID: globalid.Encode(globalid.Post, 123), Title: "Title", DeletedAt: &deletedAt},
+		},
+		{
+			name: "delete_service_error",
+			id:   "123",
+			call: func(r *mutationResolver, id string) (*graphql.Post, error) {
+				return r.DeletePost(context.Background(), id)
+			},
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					DeletePost(mock.Anything, "123").
+					Return(nil, errors.New("post not found"))
+			},
+			expectedErr: "failed to delete post: post not found",
+		},
+		{
+			name: "restore_success",
+			id:   "123",
+			call: func(r *mutationResolver, id string) (*graphql.Post, error) {
+				return r.RestorePost(context.Background(), id)
+			},
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					RestorePost(mock.Anything, "123").
+					Return(&models.Post{ID: 123, Title: "Title"}, nil)
+			},
+			expected: &graphql.Post{ID: globalid.Encode(globalid.Post, 123), Title: "Title"},
+		},
+		{
+			name: "restore_validation_error",
+			id:   "",
+			call: func(r *mutationResolver, id string) (*graphql.Post, error) {
+				return r.RestorePost(context.Background(), id)
+			},
+			mockSetup:   func(mockSvc *mockPost.MockUseCase) {},
+			expectedErr: "id cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPostService := mockPost.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					PostService: mockPostService,
+				},
+			}
+
+			tt.mockSetup(mockPostService)
+
+			got, err := tt.call(resolver, tt.id)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_EditComment(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(3)
+	parentIDStr := globalid.Encode(globalid.Comment, 3)
+	editedAt := "2023-01-01T13:00:00Z"
+
+	tests := []struct {
+		name        string
+		id          string
+		text        string
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *graphql.Comment
+		expectedErr string
+	}{
+		{
+			name: "success",
+			id:   "5",
+			text: "Fixed",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					EditComment(mock.Anything, "5", "Fixed").
+					Return(&models.Comment{
+						ID:        5,
+						PostID:    1,
+						ParentID:  &parentID,
+						Author:    "Alice",
+						Text:      "Fixed",
+						CreatedAt: "2023-01-01T12:00:00Z",
+						EditedAt:  &editedAt,
+					}, nil)
+			},
+			expected: &graphql.Comment{
+				ID:        globalid.Encode(globalid.Comment, 5),
+				PostID:    globalid.Encode(globalid.Post, 1),
+				ParentID:  &parentIDStr,
+				Author:    "Alice",
+				Text:      "Fixed",
+				CreatedAt: "2023-01-01T12:00:00Z",
+				EditedAt:  &editedAt,
+			},
+		},
+		{
+			name:        "validation_error",
+			id:          "5",
+			text:        "",
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "id or text cannot be empty",
+		},
+		{
+			name: "service_error",
+			id:   "5",
+			text: "Fixed",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					EditComment(mock.Anything, "5", "Fixed").
+					Return(nil, errors.New("comment not found"))
+			},
+			expectedErr: "comment not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.EditComment(context.Background(), tt.id, tt.text)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		id          string
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *graphql.Comment
+		expectedErr string
+	}{
+		{
+			name: "success",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					DeleteComment(mock.Anything, "5").
+					Return(&models.Comment{
+						ID:        5,
+						PostID:    1,
+						CreatedAt: "2023-01-01T12:00:00Z",
+						IsDeleted: true,
+					}, nil)
+			},
+			expected: &graphql.Comment{
+				ID:        globalid.Encode(globalid.Comment, 5),
+				PostID:    globalid.Encode(globalid.Post, 1),
+				CreatedAt: "2023-01-01T12:00:00Z",
+				IsDeleted: true,
+			},
+		},
+		{
+			name:        "validation_error",
+			id:          "",
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "id cannot be empty",
+		},
+		{
+			name: "service_error",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					DeleteComment(mock.Anything, "5").
+					Return(nil, errors.New("comment not found"))
+			},
+			expectedErr: "comment not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.DeleteComment(context.Background(), tt.id)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_PurgeComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		id          string
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name: "success",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					PurgeComment(mock.Anything, "5").
+					Return([]*models.Comment{{ID: 5, PostID: 1}, {ID: 7, PostID: 1}}, nil)
+			},
+			expected: []string{globalid.Encode(globalid.Comment, 5), globalid.Encode(globalid.Comment, 7)},
+		},
+		{
+			name:        "validation_error",
+			id:          "",
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "id cannot be empty",
+		},
+		{
+			name: "not_a_moderator",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					PurgeComment(mock.Anything, "5").
+					Return(nil, errors.New("moderator access required"))
+			},
+			expectedErr: "moderator access required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.PurgeComment(context.Background(), tt.id)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_ApproveComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		id          string
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *graphql.Comment
+		expectedErr string
+	}{
+		{
+			name: "success",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					ApproveComment(mock.Anything, "5").
+					Return(&models.Comment{ID: 5, PostID: 1, Author: "Alice", Text: "Held", Status: models.CommentStatusPublished}, nil)
+			},
+			expected: &graphql.Comment{
+				ID:     globalid.Encode(globalid.Comment, 5),
+				PostID: globalid.Encode(globalid.Post, 1),
+				Author: "Alice",
+				Text:   "Held",
+				Status: graphql.CommentStatusPublished,
+			},
+		},
+		{
+			name:        "validation_error",
+			id:          "",
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "id cannot be empty",
+		},
+		{
+			name: "not_pending",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					ApproveComment(mock.Anything, "5").
+					Return(nil, errors.New("comment is not awaiting moderation"))
+			},
+			expectedErr: "comment is not awaiting moderation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.ApproveComment(context.Background(), tt.id)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_RejectComment(t *testing.T) {
+	t.Parallel()
+
+	reason := "spam"
+
+	tests := []struct {
+		name        string
+		id          string
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *graphql.Comment
+		expectedErr string
+	}{
+		{
+			name: "success",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					RejectComment(mock.Anything, "5", &reason).
+					Return(&models.Comment{ID: 5, PostID: 1, Status: models.CommentStatusRejected, RejectionReason: &reason}, nil)
+			},
+			expected: &graphql.Comment{
+				ID:              globalid.Encode(globalid.Comment, 5),
+				PostID:          globalid.Encode(globalid.Post, 1),
+				Status:          graphql.CommentStatusRejected,
+				RejectionReason: &reason,
+			},
+		},
+		{
+			name:        "validation_error",
+			id:          "",
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "id cannot be empty",
+		},
+		{
+			name: "not_a_moderator",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					RejectComment(mock.Anything, "5", &reason).
+					Return(nil, errors.New("moderator access required"))
+			},
+			expectedErr: "moderator access required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.RejectComment(context.Background(), tt.id, &reason)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_React(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		targetID    string
+		author      string
+		kind        string
+		mockSetup   func(mockSvc *mockReaction.MockUseCase)
+		expected    *graphql.ReactionSummary
+		expectedErr string
+	}{
+		{
+			name:     "success",
+			targetID: "3",
+			author:   "Alice",
+			kind:     "like",
+			mockSetup: func(mockSvc *mockReaction.MockUseCase) {
+				mockSvc.EXPECT().
+					React(mock.Anything, models.ReactionTargetComment, "3", "Alice", "like").
+					Return(&models.ReactionSummary{
+						Upvotes:   2,
+						Downvotes: 1,
+						Score:     1,
+						Counts:    []*models.ReactionCount{{TargetID: 3, Kind: "like", Count: 1}},
+					}, nil)
+			},
+			expected: &graphql.ReactionSummary{
+				Upvotes:   2,
+				Downvotes: 1,
+				Score:     1,
+				Counts:    []*graphql.ReactionCount{{Kind: "like", Count: 1}},
+			},
+		},
+		{
+			name:        "validation_error",
+			targetID:    "3",
+			author:      "",
+			kind:        "like",
+			mockSetup:   func(mockSvc *mockReaction.MockUseCase) {},
+			expectedErr: "targetId, author and kind are required fields",
+		},
+		{
+			name:     "service_error",
+			targetID: "3",
+			author:   "Alice",
+			kind:     "shrug",
+			mockSetup: func(mockSvc *mockReaction.MockUseCase) {
+				mockSvc.EXPECT().
+					React(mock.Anything, models.ReactionTargetComment, "3", "Alice", "shrug").
+					Return(nil, errors.New("unknown reaction kind"))
+			},
+			expectedErr: "unknown reaction kind",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReactionService := mockReaction.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					ReactionService: mockReactionService,
+				},
+			}
+
+			tt.mockSetup(mockReactionService)
+
+			got, err := resolver.React(context.Background(), graphql.ReactionTargetComment, tt.targetID, tt.author, tt.kind)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_Unreact(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		author      string
+		mockSetup   func(mockSvc *mockReaction.MockUseCase)
+		expected    *graphql.ReactionSummary
+		expectedErr string
+	}{
+		{
+			name:   "success",
+			author: "Alice",
+			mockSetup: func(mockSvc *mockReaction.MockUseCase) {
+				mockSvc.EXPECT().
+					Unreact(mock.Anything, models.ReactionTargetPost, "1", "Alice").
+					Return(&models.ReactionSummary{Counts: []*models.ReactionCount{}}, nil)
+			},
+			expected: &graphql.ReactionSummary{Counts: []*graphql.ReactionCount{}},
+		},
+		{
+			name:        "validation_error",
+			author:      "",
+			mockSetup:   func(mockSvc *mockReaction.MockUseCase) {},
+			expectedErr: "targetId and author are required fields",
+		},
+		{
+			name:   "reaction_not_found",
+			author: "Alice",
+			mockSetup: func(mockSvc *mockReaction.MockUseCase) {
+				mockSvc.EXPECT().
+					Unreact(mock.Anything, models.ReactionTargetPost, "1", "Alice").
+					Return(nil, errors.New("reaction not found"))
+			},
+			expectedErr: "reaction not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReactionService := mockReaction.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					ReactionService: mockReactionService,
+				},
+			}
+
+			tt.mockSetup(mockReactionService)
+
+			got, err := resolver.Unreact(context.Background(), graphql.ReactionTargetPost, "1", tt.author)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_Report(t *testing.T) {
+	t.Parallel()
+
+	commentID := globalid.Encode(globalid.Comment, 7)
+	details := "casino links"
+
+	tests := []struct {
+		name        string
+		reporter    string
+		mockSetup   func(mockSvc *mockReport.MockUseCase)
+		expected    *graphql.Report
+		expectedErr string
+	}{
+		{
+			name:     "success",
+			reporter: "Alice",
+			mockSetup: func(mockSvc *mockReport.MockUseCase) {
+				mockSvc.EXPECT().
+					Report(mock.Anything, commentID, "Alice", models.ReportReasonSpam, &details).
+					Return(&models.Report{
+						ID:           3,
+						Target:       models.ReportTargetComment,
+						TargetID:     7,
+						TargetAuthor: "Bob",
+						Reporter:     "Alice",
+						Reason:       models.ReportReasonSpam,
+						Details:      &details,
+						Status:       models.ReportStatusOpen,
+						TargetHidden: true,
+						CreatedAt:    "2026-02-13T10:00:00Z",
+					}, nil)
+			},
+			expected: &graphql.Report{
+				ID:           globalid.Encode(globalid.Report, 3),
+				TargetID:     commentID,
+				TargetType:   graphql.ReportTargetComment,
+				TargetAuthor: "Bob",
+				Reporter:     "Alice",
+				Reason:       graphql.ReportReasonSpam,
+				Details:      &details,
+				Status:       graphql.ReportStatusOpen,
+				TargetHidden: true,
+				CreatedAt:    "2026-02-13T10:00:00Z",
+			},
+		},
+		{
+			name:        "validation_error",
+			reporter:    "",
+			mockSetup:   func(mockSvc *mockReport.MockUseCase) {},
+			expectedErr: "targetId and reporter are required fields",
+		},
+		{
+			name:     "banned_reporter",
+			reporter: "Alice",
+			mockSetup: func(mockSvc *mockReport.MockUseCase) {
+				mockSvc.EXPECT().
+					Report(mock.Anything, commentID, "Alice", models.ReportReasonSpam, &details).
+					Return(nil, errors.New("reporter is banned"))
+			},
+			expectedErr: "reporter is banned",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReportService := mockReport.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					ReportService: mockReportService,
+				},
+			}
+
+			tt.mockSetup(mockReportService)
+
+			got, err := resolver.Report(context.Background(), commentID, tt.reporter, graphql.ReportReasonSpam, &details)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutationResolver_ResolveReport(t *testing.T) {
+	t.Parallel()
+
+	reportID := globalid.Encode(globalid.Report, 3)
+	action := models.ReportActionBanAuthor
+	resolvedAt := "2026-02-13T11:00:00Z"
+	gqlAction := graphql.ReportActionBanAuthor
+
+	tests := []struct {
+		name        string
+		id          string
+		mockSetup   func(mockSvc *mockReport.MockUseCase)
+		expected    *graphql.Report
+		expectedErr string
+	}{
+		{
+			name: "success",
+			id:   reportID,
+			mockSetup: func(mockSvc *mockReport.MockUseCase) {
+				mockSvc.EXPECT().
+					ResolveReport(mock.Anything, reportID, models.ReportActionBanAuthor).
+					Return(&models.Report{
+						ID:           3,
+						Target:       models.ReportTargetPost,
+						TargetID:     1,
+						TargetAuthor: "Bob",
+						Reporter:     "Alice",
+						Reason:       models.ReportReasonAbuse,
+						Status:       models.ReportStatusResolved,
+						Action:       &action,
+						CreatedAt:    "2026-02-13T10:00:00Z",
+						ResolvedAt:   &resolvedAt,
+					}, nil)
+			},
+			expected: &graphql.Report{
+				ID:           reportID,
+				TargetID:     globalid.Encode(globalid.Post, 1),
+				TargetType:   graphql.ReportTargetPost,
+				TargetAuthor: "Bob",
+				Reporter:     "Alice",
+				Reason:       graphql.ReportReasonAbuse,
+				Status:       graphql.ReportStatusResolved,
+				Action:       &gqlAction,
+				CreatedAt:    "2026-02-13T10:00:00Z",
+				ResolvedAt:   &resolvedAt,
+			},
+		},
+		{
+			name:        "validation_error",
+			id:          "",
+			mockSetup:   func(mockSvc *mockReport.MockUseCase) {},
+			expectedErr: "id cannot be empty",
+		},
+		{
+			name: "already_resolved",
+			id:   reportID,
+			mockSetup: func(mockSvc *mockReport.MockUseCase) {
+				mockSvc.EXPECT().
+					ResolveReport(mock.Anything, reportID, models.ReportActionBanAuthor).
+					Return(nil, errors.New("report is already resolved"))
+			},
+			expectedErr: "report is already resolved",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReportService := mockReport.NewMockUseCase(t)
+			resolver := &mutationResolver{
+				service: &service.Container{
+					ReportService: mockReportService,
+				},
+			}
+
+			tt.mockSetup(mockReportService)
+
+			got, err := resolver.ResolveReport(context.Background(), tt.id, graphql.ReportActionBanAuthor)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *mutationResolver) PurgeComment(ctx context.Context, id string) ([]string, error) {
+	if id == "" {
+		return nil, errors.New("id cannot be empty")
+	}
+
+	purged, err := r.service.CommentService.PurgeComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(purged))
+	for i, comment := range purged {
+		ids[i] = globalid.Encode(globalid.Comment, comment.ID)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,53 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *mutationResolver) React(ctx context.Context, target graphql.ReactionTarget, targetID string, author string, kind string) (*graphql.ReactionSummary, error) {
+	if targetID == "" || author == "" || kind == "" {
+		return nil, errors.New("targetId, author and kind are required fields")
+	}
+
+	summary, err := r.service.ReactionService.React(ctx, models.ReactionTarget(target), targetID, author, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToGraphQLReactionSummary(summary), nil
+}
+
+func (r *mutationResolver) Unreact(ctx context.Context, target graphql.ReactionTarget, targetID string, author string) (*graphql.ReactionSummary, error) {
+	if targetID == "" || author == "" {
+		return nil, errors.New("targetId and author are required fields")
+	}
+
+	summary, err := r.service.ReactionService.Unreact(ctx, models.ReactionTarget(target), targetID, author)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToGraphQLReactionSummary(summary), nil
+}
+
+func convertToGraphQLReactionSummary(summary *models.ReactionSummary) *graphql.ReactionSummary {
+	counts := make([]*graphql.ReactionCount, len(summary.Counts))
+	for i, c := range summary.Counts {
+		counts[i] = &graphql.ReactionCount{
+			Kind:  c.Kind,
+			Count: c.Count,
+		}
+	}
+
+	return &graphql.ReactionSummary{
+		Upvotes:   summary.Upvotes,
+		Downvotes: summary.Downvotes,
+		Score:     summary.Score,
+		Counts:    counts,
+	}
+}
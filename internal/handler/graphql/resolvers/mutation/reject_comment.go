@@ -0,0 +1,22 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+)
+
+func (r *mutationResolver) RejectComment(ctx context.Context, id string, reason *string) (*graphql.Comment, error) {
+	if id == "" {
+		return nil, errors.New("id cannot be empty")
+	}
+
+	comment, err := r.service.CommentService.RejectComment(ctx, id, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToGraphQLComment(comment), nil
+}
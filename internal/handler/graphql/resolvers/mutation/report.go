@@ -0,0 +1,51 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *mutationResolver) Report(ctx context.Context, targetID string, reporter string, reason graphql.ReportReason, details *string) (*graphql.Report, error) {
+	if targetID == "" || reporter == "" {
+		return nil, errors.New("targetId and reporter are required fields")
+	}
+
+	report, err := r.service.ReportService.Report(ctx, targetID, reporter, models.ReportReason(reason), details)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToGraphQLReport(report), nil
+}
+
+func convertToGraphQLReport(report *models.Report) *graphql.Report {
+	targetType := globalid.Post
+	if report.Target == models.ReportTargetComment {
+		targetType = globalid.Comment
+	}
+
+	out := &graphql.Report{
+		ID:           globalid.Encode(globalid.Report, report.ID),
+		TargetID:     globalid.Encode(targetType, report.TargetID),
+		TargetType:   graphql.ReportTarget(report.Target),
+		TargetAuthor: report.TargetAuthor,
+		Reporter:     report.Reporter,
+		Reason:       graphql.ReportReason(report.Reason),
+		Details:      report.Details,
+		Status:       graphql.ReportStatus(report.Status),
+		TargetHidden: report.TargetHidden,
+		CreatedAt:    report.CreatedAt,
+		ResolvedAt:   report.ResolvedAt,
+	}
+	if report.Action != nil {
+		action := graphql.ReportAction(*report.Action)
+		out.Action = &action
+	}
+
+	return out
+}
@@ -0,0 +1,23 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *mutationResolver) ResolveReport(ctx context.Context, id string, action graphql.ReportAction) (*graphql.Report, error) {
+	if id == "" {
+		return nil, errors.New("id cannot be empty")
+	}
+
+	report, err := r.service.ReportService.ResolveReport(ctx, id, models.ReportAction(action))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToGraphQLReport(report), nil
+}
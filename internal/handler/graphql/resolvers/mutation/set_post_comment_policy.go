@@ -0,0 +1,33 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *mutationResolver) SetPostCommentPolicy(ctx context.Context, postID string, policy graphql.CommentPolicy, closeAt *string) (*graphql.Post, error) {
+	if postID == "" {
+		return nil, errors.New("postId cannot be empty")
+	}
+
+	out, err := r.service.PostService.SetPostCommentPolicy(ctx, postID, models.CommentPolicy(policy), closeAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set post comment policy")
+	}
+
+	return &graphql.Post{
+		ID:              globalid.Encode(globalid.Post, out.ID),
+		Title:           out.Title,
+		Body:            out.Body,
+		Author:          out.Author,
+		CommentPolicy:   graphql.CommentPolicy(out.CommentPolicy),
+		CommentsCloseAt: out.CommentsCloseAt,
+		CreatedAt:       out.CreatedAt,
+		Tags:            out.Tags,
+	}, nil
+}
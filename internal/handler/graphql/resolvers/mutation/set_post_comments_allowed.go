@@ -2,11 +2,11 @@ package mutation
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func (r *mutationResolver) SetPostCommentsAllowed(ctx context.Context, postID string, allow bool) (*graphql.Post, error) {
@@ -20,11 +20,13 @@ func (r *mutationResolver) SetPostCommentsAllowed(ctx context.Context, postID st
 	}
 
 	return &graphql.Post{
-		ID:            strconv.FormatInt(out.ID, 10),
-		Title:         out.Title,
-		Body:          out.Body,
-		Author:        out.Author,
-		AllowComments: out.AllowComments,
-		CreatedAt:     out.CreatedAt,
+		ID:              globalid.Encode(globalid.Post, out.ID),
+		Title:           out.Title,
+		Body:            out.Body,
+		Author:          out.Author,
+		CommentPolicy:   graphql.CommentPolicy(out.CommentPolicy),
+		CommentsCloseAt: out.CommentsCloseAt,
+		CreatedAt:       out.CreatedAt,
+		Tags:            out.Tags,
 	}, nil
 }
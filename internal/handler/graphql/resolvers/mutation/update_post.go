@@ -0,0 +1,46 @@
+package mutation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *mutationResolver) UpdatePost(ctx context.Context, id string, input graphql.UpdatePostInput) (*graphql.Post, error) {
+	if id == "" {
+		return nil, errors.New("id cannot be empty")
+	}
+
+	out, err := r.service.PostService.UpdatePost(ctx, id, models.UpdatePostInput{
+		Title: input.Title,
+		Body:  input.Body,
+	})
+	if err != nil {
+		var filterErr *filter.RejectedError
+		if errors.As(err, &filterErr) {
+			return nil, contentNotAllowed(filterErr)
+		}
+		return nil, errors.Wrap(err, "failed to update post")
+	}
+
+	return convertToGraphQLPost(out), nil
+}
+
+func convertToGraphQLPost(post *models.Post) *graphql.Post {
+	return &graphql.Post{
+		ID:              globalid.Encode(globalid.Post, post.ID),
+		Title:           post.Title,
+		Body:            post.Body,
+		Author:          post.Author,
+		CommentPolicy:   graphql.CommentPolicy(post.CommentPolicy),
+		CommentsCloseAt: post.CommentsCloseAt,
+		CreatedAt:       post.CreatedAt,
+		DeletedAt:       post.DeletedAt,
+		Tags:            post.Tags,
+	}
+}
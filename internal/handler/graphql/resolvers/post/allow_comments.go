@@ -0,0 +1,24 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+)
+
+// AllowComments keeps the deprecated boolean working on top of the comment
+// policy: a post allows comments unless it is CLOSED or past its auto-close
+// time.
+func (r *postResolver) AllowComments(ctx context.Context, obj *graphql.Post) (bool, error) {
+	if obj.CommentPolicy == graphql.CommentPolicyClosed {
+		return false, nil
+	}
+
+	if obj.CommentsCloseAt != nil {
+		now := time.Now().UTC().Format(time.RFC3339)
+		return now < *obj.CommentsCloseAt, nil
+	}
+
+	return true, nil
+}
@@ -0,0 +1,24 @@
+package post
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *postResolver) CommentCount(ctx context.Context, obj *graphql.Post) (int32, error) {
+	postID, err := globalid.DecodeAs(globalid.Post, obj.ID)
+	if err != nil {
+		return 0, errors.New("invalid post ID")
+	}
+
+	count, err := r.service.CommentService.CommentCount(ctx, postID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(count), nil
+}
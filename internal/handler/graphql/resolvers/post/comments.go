@@ -0,0 +1,64 @@
+package post
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *postResolver) Comments(ctx context.Context, obj *graphql.Post, first *int32, after *string, last *int32, before *string, orderBy *graphql.CommentOrder) (*graphql.CommentConnection, error) {
+	postID, err := globalid.DecodeAs(globalid.Post, obj.ID)
+	if err != nil {
+		return nil, errors.New("invalid post ID")
+	}
+
+	var order *models.CommentOrder
+	if orderBy != nil {
+		o := models.CommentOrder(*orderBy)
+		order = &o
+	}
+
+	conn, err := r.service.CommentService.PostComments(ctx, postID, first, after, last, before, order)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*graphql.CommentEdge, len(conn.Edges))
+	for i, edge := range conn.Edges {
+		node := &graphql.Comment{
+			ID:        globalid.Encode(globalid.Comment, edge.Node.ID),
+			PostID:    globalid.Encode(globalid.Post, edge.Node.PostID),
+			Author:    edge.Node.Author,
+			Text:      edge.Node.Text,
+			CreatedAt: edge.Node.CreatedAt,
+			EditedAt:  edge.Node.EditedAt,
+			IsDeleted: edge.Node.IsDeleted,
+			Status:    graphql.CommentStatus(edge.Node.Status),
+		}
+
+		if edge.Node.ParentID != nil {
+			pid := globalid.Encode(globalid.Comment, *edge.Node.ParentID)
+			node.ParentID = &pid
+		}
+
+		edges[i] = &graphql.CommentEdge{
+			Cursor: edge.Cursor,
+			Node:   node,
+		}
+	}
+
+	return &graphql.CommentConnection{
+		Edges: edges,
+		PageInfo: &graphql.PageInfo{
+			StartCursor:     conn.PageInfo.StartCursor,
+			EndCursor:       conn.PageInfo.EndCursor,
+			HasPreviousPage: conn.PageInfo.HasPreviousPage,
+			HasNextPage:     conn.PageInfo.HasNextPage,
+		},
+		TotalCount: conn.TotalCount,
+	}, nil
+}
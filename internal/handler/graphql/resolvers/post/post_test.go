@@ -0,0 +1,281 @@
+package post
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/service"
+	mockComment "github.com/Saracomethstein/ozon-test-task/internal/service/comment/mocks"
+	mockReaction "github.com/Saracomethstein/ozon-test-task/internal/service/reaction/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func TestPostResolver_Reactions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Post
+		mockSetup   func(mockSvc *mockReaction.MockUseCase)
+		expected    *graphql.ReactionSummary
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Post{ID: globalid.Encode(globalid.Post, 5)},
+			mockSetup: func(mockSvc *mockReaction.MockUseCase) {
+				mockSvc.EXPECT().
+					Reactions(mock.Anything, models.ReactionTargetPost, int64(5)).
+					Return(&models.ReactionSummary{
+						Upvotes: 1,
+						Score:   1,
+						Counts:  []*models.ReactionCount{{TargetID: 5, Kind: "wow", Count: 2}},
+					}, nil)
+			},
+			expected: &graphql.ReactionSummary{
+				Upvotes: 1,
+				Score:   1,
+				Counts:  []*graphql.ReactionCount{{Kind: "wow", Count: 2}},
+			},
+		},
+		{
+			name: "service_error",
+			obj:  &graphql.Post{ID: globalid.Encode(globalid.Post, 5)},
+			mockSetup: func(mockSvc *mockReaction.MockUseCase) {
+				mockSvc.EXPECT().
+					Reactions(mock.Anything, models.ReactionTargetPost, int64(5)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			name:        "invalid_post_ID",
+			obj:         &graphql.Post{ID: "abc"},
+			mockSetup:   func(mockSvc *mockReaction.MockUseCase) {},
+			expectedErr: "invalid post ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReactionService := mockReaction.NewMockUseCase(t)
+			resolver := &postResolver{
+				service: &service.Container{
+					ReactionService: mockReactionService,
+				},
+			}
+
+			tt.mockSetup(mockReactionService)
+
+			got, err := resolver.Reactions(context.Background(), tt.obj)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPostResolver_Comments(t *testing.T) {
+	t.Parallel()
+
+	first := int32(1)
+	endCursor := "cursor1"
+	orderBy := graphql.CommentOrderTop
+	order := models.CommentOrderTop
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Post
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *graphql.CommentConnection
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Post{ID: globalid.Encode(globalid.Post, 5)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					PostComments(mock.Anything, int64(5), &first, (*string)(nil), (*int32)(nil), (*string)(nil), &order).
+					Return(&models.CommentConnection{
+						Edges: []*models.CommentEdge{
+							{Cursor: endCursor, Node: &models.Comment{ID: 9, PostID: 5, Author: "Alice", Text: "Hi", CreatedAt: "2023-01-01T12:00:00Z"}},
+						},
+						PageInfo:   &models.PageInfo{EndCursor: &endCursor, HasNextPage: true},
+						TotalCount: 4,
+					}, nil)
+			},
+			expected: &graphql.CommentConnection{
+				Edges: []*graphql.CommentEdge{
+					{Cursor: endCursor, Node: &graphql.Comment{ID: globalid.Encode(globalid.Comment, 9), PostID: globalid.Encode(globalid.Post, 5), Author: "Alice", Text: "Hi", CreatedAt: "2023-01-01T12:00:00Z"}},
+				},
+				PageInfo:   &graphql.PageInfo{EndCursor: &endCursor, HasNextPage: true},
+				TotalCount: 4,
+			},
+		},
+		{
+			name: "service_error",
+			obj:  &graphql.Post{ID: globalid.Encode(globalid.Post, 5)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					PostComments(mock.Anything, int64(5), &first, (*string)(nil), (*int32)(nil), (*string)(nil), &order).
+					Return(nil, errors.New("invalid cursor format"))
+			},
+			expectedErr: "invalid cursor format",
+		},
+		{
+			name:        "invalid_post_ID",
+			obj:         &graphql.Post{ID: "abc"},
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "invalid post ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &postResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.Comments(context.Background(), tt.obj, &first, nil, nil, nil, &orderBy)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPostResolver_CommentCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		obj         *graphql.Post
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    int32
+		expectedErr string
+	}{
+		{
+			name: "success",
+			obj:  &graphql.Post{ID: globalid.Encode(globalid.Post, 5)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().CommentCount(mock.Anything, int64(5)).Return(int64(12), nil)
+			},
+			expected: 12,
+		},
+		{
+			name: "service_error",
+			obj:  &graphql.Post{ID: globalid.Encode(globalid.Post, 5)},
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().CommentCount(mock.Anything, int64(5)).Return(int64(0), errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			name:        "invalid_post_ID",
+			obj:         &graphql.Post{ID: "abc"},
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "invalid post ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &postResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.CommentCount(context.Background(), tt.obj)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPostResolver_AllowComments(t *testing.T) {
+	t.Parallel()
+
+	past := "2020-01-01T00:00:00Z"
+	future := "2999-01-01T00:00:00Z"
+
+	tests := []struct {
+		name     string
+		obj      *graphql.Post
+		expected bool
+	}{
+		{
+			name:     "open",
+			obj:      &graphql.Post{CommentPolicy: graphql.CommentPolicyOpen},
+			expected: true,
+		},
+		{
+			name:     "closed",
+			obj:      &graphql.Post{CommentPolicy: graphql.CommentPolicyClosed},
+			expected: false,
+		},
+		{
+			name:     "author_only",
+			obj:      &graphql.Post{CommentPolicy: graphql.CommentPolicyAuthorOnly},
+			expected: true,
+		},
+		{
+			name:     "close_time_passed",
+			obj:      &graphql.Post{CommentPolicy: graphql.CommentPolicyOpen, CommentsCloseAt: &past},
+			expected: false,
+		},
+		{
+			name:     "close_time_ahead",
+			obj:      &graphql.Post{CommentPolicy: graphql.CommentPolicyOpen, CommentsCloseAt: &future},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolver := &postResolver{}
+
+			got, err := resolver.AllowComments(context.Background(), tt.obj)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
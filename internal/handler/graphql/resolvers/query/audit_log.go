@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *queryResolver) AuditLog(ctx context.Context, first *int32, after *string) (*graphql.AuditEntryConnection, error) {
+	connection, err := r.service.ReportService.AuditLog(ctx, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*graphql.AuditEntryEdge, len(connection.Edges))
+	for i, edge := range connection.Edges {
+		node := &graphql.AuditEntry{
+			ID:         globalid.Encode(globalid.AuditEntry, edge.Node.ID),
+			Actor:      edge.Node.Actor,
+			Action:     graphql.AuditAction(edge.Node.Action),
+			TargetID:   encodeReportTarget(edge.Node.Target, edge.Node.TargetID),
+			TargetType: graphql.ReportTarget(edge.Node.Target),
+			Details:    edge.Node.Details,
+			CreatedAt:  edge.Node.CreatedAt,
+		}
+		if edge.Node.ReportID != nil {
+			reportID := globalid.Encode(globalid.Report, *edge.Node.ReportID)
+			node.ReportID = &reportID
+		}
+
+		edges[i] = &graphql.AuditEntryEdge{
+			Cursor: edge.Cursor,
+			Node:   node,
+		}
+	}
+
+	pageInfo := &graphql.PageInfo{
+		StartCursor:     connection.PageInfo.StartCursor,
+		EndCursor:       connection.PageInfo.EndCursor,
+		HasPreviousPage: connection.PageInfo.HasPreviousPage,
+		HasNextPage:     connection.PageInfo.HasNextPage,
+	}
+
+	return &graphql.AuditEntryConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: connection.TotalCount,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *queryResolver) Comment(ctx context.Context, id string) (*graphql.Comment, error) {
+	if id == "" {
+		return nil, errors.New("id cannot be empty")
+	}
+
+	c, err := r.service.CommentService.GetComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &graphql.Comment{
+		ID:        globalid.Encode(globalid.Comment, c.ID),
+		PostID:    globalid.Encode(globalid.Post, c.PostID),
+		Author:    c.Author,
+		Text:      c.Text,
+		CreatedAt: c.CreatedAt,
+		EditedAt:  c.EditedAt,
+		IsDeleted: c.IsDeleted,
+		Status:    graphql.CommentStatus(c.Status),
+	}
+
+	if c.ParentID != nil {
+		pid := globalid.Encode(globalid.Comment, *c.ParentID)
+		out.ParentID = &pid
+	}
+
+	return out, nil
+}
@@ -2,19 +2,26 @@ package query
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
-func (r *queryResolver) CommentsByPost(ctx context.Context, postID string, first *int32, after *string) (*graphql.CommentConnection, error) {
+func (r *queryResolver) CommentsByPost(ctx context.Context, postID string, first *int32, after *string, last *int32, before *string, orderBy *graphql.CommentOrder) (*graphql.CommentConnection, error) {
 	if postID == "" {
 		return nil, errors.New("postID cannot be empty")
 	}
 
-	connection, err := r.service.CommentService.GetRootComments(ctx, postID, first, after)
+	var order *models.CommentOrder
+	if orderBy != nil {
+		o := models.CommentOrder(*orderBy)
+		order = &o
+	}
+
+	connection, err := r.service.CommentService.GetRootComments(ctx, postID, first, after, last, before, order)
 	if err != nil {
 		return nil, err
 	}
@@ -22,12 +29,15 @@ func (r *queryResolver) CommentsByPost(ctx context.Context, postID string, first
 	edges := make([]*graphql.CommentEdge, len(connection.Edges))
 	for i, edge := range connection.Edges {
 		node := &graphql.Comment{
-			ID:        strconv.FormatInt(edge.Node.ID, 10),
-			PostID:    strconv.FormatInt(edge.Node.PostID, 10),
+			ID:        globalid.Encode(globalid.Comment, edge.Node.ID),
+			PostID:    globalid.Encode(globalid.Post, edge.Node.PostID),
 			ParentID:  nil,
 			Author:    edge.Node.Author,
 			Text:      edge.Node.Text,
 			CreatedAt: edge.Node.CreatedAt,
+			EditedAt:  edge.Node.EditedAt,
+			IsDeleted: edge.Node.IsDeleted,
+			Status:    graphql.CommentStatus(edge.Node.Status),
 		}
 		edges[i] = &graphql.CommentEdge{
 			Cursor: edge.Cursor,
@@ -36,8 +46,10 @@ func (r *queryResolver) CommentsByPost(ctx context.Context, postID string, first
 	}
 
 	pageInfo := &graphql.PageInfo{
-		EndCursor:   connection.PageInfo.EndCursor,
-		HasNextPage: connection.PageInfo.HasNextPage,
+		StartCursor:     connection.PageInfo.StartCursor,
+		EndCursor:       connection.PageInfo.EndCursor,
+		HasPreviousPage: connection.PageInfo.HasPreviousPage,
+		HasNextPage:     connection.PageInfo.HasNextPage,
 	}
 
 	return &graphql.CommentConnection{
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *queryResolver) CommentThread(ctx context.Context, postID string, rootID *string, maxDepth *int32, maxPerLevel *int32) ([]*graphql.ThreadComment, error) {
+	if postID == "" {
+		return nil, errors.New("postID cannot be empty")
+	}
+
+	thread, err := r.service.CommentService.GetThread(ctx, postID, rootID, maxDepth, maxPerLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*graphql.ThreadComment, len(thread))
+	for i, entry := range thread {
+		c := entry.Comment
+		node := &graphql.Comment{
+			ID:        globalid.Encode(globalid.Comment, c.ID),
+			PostID:    globalid.Encode(globalid.Post, c.PostID),
+			Author:    c.Author,
+			Text:      c.Text,
+			CreatedAt: c.CreatedAt,
+			EditedAt:  c.EditedAt,
+			IsDeleted: c.IsDeleted,
+			Status:    graphql.CommentStatus(c.Status),
+		}
+
+		if c.ParentID != nil {
+			pid := globalid.Encode(globalid.Comment, *c.ParentID)
+			node.ParentID = &pid
+		}
+
+		path := make([]string, len(entry.Path))
+		for j, id := range entry.Path {
+			path[j] = globalid.Encode(globalid.Comment, id)
+		}
+
+		result[i] = &graphql.ThreadComment{
+			Depth:   entry.Depth,
+			Path:    path,
+			Comment: node,
+		}
+	}
+
+	return result, nil
+}
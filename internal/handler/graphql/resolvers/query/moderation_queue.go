@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *queryResolver) ModerationQueue(ctx context.Context, first *int32, after *string, postID *string) (*graphql.CommentConnection, error) {
+	connection, err := r.service.CommentService.ModerationQueue(ctx, postID, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*graphql.CommentEdge, len(connection.Edges))
+	for i, edge := range connection.Edges {
+		node := &graphql.Comment{
+			ID:        globalid.Encode(globalid.Comment, edge.Node.ID),
+			PostID:    globalid.Encode(globalid.Post, edge.Node.PostID),
+			Author:    edge.Node.Author,
+			Text:      edge.Node.Text,
+			CreatedAt: edge.Node.CreatedAt,
+			EditedAt:  edge.Node.EditedAt,
+			IsDeleted: edge.Node.IsDeleted,
+			Status:    graphql.CommentStatus(edge.Node.Status),
+		}
+		if edge.Node.ParentID != nil {
+			pid := globalid.Encode(globalid.Comment, *edge.Node.ParentID)
+			node.ParentID = &pid
+		}
+
+		edges[i] = &graphql.CommentEdge{
+			Cursor: edge.Cursor,
+			Node:   node,
+		}
+	}
+
+	pageInfo := &graphql.PageInfo{
+		StartCursor:     connection.PageInfo.StartCursor,
+		EndCursor:       connection.PageInfo.EndCursor,
+		HasPreviousPage: connection.PageInfo.HasPreviousPage,
+		HasNextPage:     connection.PageInfo.HasNextPage,
+	}
+
+	return &graphql.CommentConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: connection.TotalCount,
+	}, nil
+}
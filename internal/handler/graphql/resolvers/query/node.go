@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+// Node refetches a post or comment by its global ID, dispatching on the type
+// encoded in the ID. An ID whose object is unknown or deleted resolves to null.
+func (r *queryResolver) Node(ctx context.Context, id string) (graphql.Node, error) {
+	typ, _, err := globalid.Decode(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid node id")
+	}
+
+	switch typ {
+	case globalid.Post:
+		post, err := r.Post(ctx, id)
+		if err != nil || post == nil {
+			return nil, notFoundAsNil(err)
+		}
+		return post, nil
+	case globalid.Comment:
+		comment, err := r.Comment(ctx, id)
+		if err != nil || comment == nil {
+			return nil, notFoundAsNil(err)
+		}
+		return comment, nil
+	default:
+		return nil, errors.New("unknown node type")
+	}
+}
+
+func notFoundAsNil(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	return err
+}
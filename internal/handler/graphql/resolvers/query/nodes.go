@@ -0,0 +1,22 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+)
+
+// Nodes refetches several objects by global ID, in the order of ids. Unknown
+// or deleted objects are null in their position.
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]graphql.Node, error) {
+	nodes := make([]graphql.Node, len(ids))
+	for i, id := range ids {
+		node, err := r.Node(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+
+	return nodes, nil
+}
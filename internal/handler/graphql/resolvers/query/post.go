@@ -2,25 +2,27 @@ package query
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func (r *queryResolver) Post(ctx context.Context, id string) (*graphql.Post, error) {
 	post, err := r.service.PostService.GetPostById(ctx, id)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get post by ID: %w")
+		return nil, errors.Wrap(err, "failed to get post by ID")
 	}
 
 	return &graphql.Post{
-		ID:            strconv.FormatInt(post.ID, 10),
-		Title:         post.Title,
-		Body:          post.Body,
-		Author:        post.Author,
-		AllowComments: post.AllowComments,
-		CreatedAt:     post.CreatedAt,
+		ID:              globalid.Encode(globalid.Post, post.ID),
+		Title:           post.Title,
+		Body:            post.Body,
+		Author:          post.Author,
+		CommentPolicy:   graphql.CommentPolicy(post.CommentPolicy),
+		CommentsCloseAt: post.CommentsCloseAt,
+		CreatedAt:       post.CreatedAt,
+		Tags:            post.Tags,
 	}, nil
 }
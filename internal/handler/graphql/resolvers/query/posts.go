@@ -2,13 +2,20 @@ package query
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
-func (r *queryResolver) Posts(ctx context.Context, first *int32, after *string) (*graphql.PostConnection, error) {
-	connection, err := r.service.PostService.GetPosts(ctx, first, after)
+func (r *queryResolver) Posts(ctx context.Context, first *int32, after *string, last *int32, before *string, tags []string, match *graphql.TagMatch) (*graphql.PostConnection, error) {
+	var tagMatch *models.TagMatch
+	if match != nil {
+		m := models.TagMatch(*match)
+		tagMatch = &m
+	}
+
+	connection, err := r.service.PostService.GetPosts(ctx, first, after, last, before, tags, tagMatch)
 	if err != nil {
 		return nil, err
 	}
@@ -16,12 +23,14 @@ func (r *queryResolver) Posts(ctx context.Context, first *int32, after *string)
 	edges := make([]*graphql.PostEdge, 0, len(connection.Edges))
 	for _, edge := range connection.Edges {
 		node := &graphql.Post{
-			ID:            strconv.FormatInt(edge.Node.ID, 10),
-			Title:         edge.Node.Title,
-			Body:          edge.Node.Body,
-			Author:        edge.Node.Author,
-			AllowComments: edge.Node.AllowComments,
-			CreatedAt:     edge.Node.CreatedAt,
+			ID:              globalid.Encode(globalid.Post, edge.Node.ID),
+			Title:           edge.Node.Title,
+			Body:            edge.Node.Body,
+			Author:          edge.Node.Author,
+			CommentPolicy:   graphql.CommentPolicy(edge.Node.CommentPolicy),
+			CommentsCloseAt: edge.Node.CommentsCloseAt,
+			CreatedAt:       edge.Node.CreatedAt,
+			Tags:            edge.Node.Tags,
 		}
 
 		edges = append(edges, &graphql.PostEdge{
@@ -31,8 +40,10 @@ func (r *queryResolver) Posts(ctx context.Context, first *int32, after *string)
 	}
 
 	pageInfo := &graphql.PageInfo{
-		EndCursor:   connection.PageInfo.EndCursor,
-		HasNextPage: connection.PageInfo.HasNextPage,
+		StartCursor:     connection.PageInfo.StartCursor,
+		EndCursor:       connection.PageInfo.EndCursor,
+		HasPreviousPage: connection.PageInfo.HasPreviousPage,
+		HasNextPage:     connection.PageInfo.HasNextPage,
 	}
 
 	postConnection := &graphql.PostConnection{
@@ -2,17 +2,23 @@ package query
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
 	"github.com/Saracomethstein/ozon-test-task/internal/service"
 	mockComment "github.com/Saracomethstein/ozon-test-task/internal/service/comment/mocks"
 	mockPost "github.com/Saracomethstein/ozon-test-task/internal/service/post/mocks"
+	mockReport "github.com/Saracomethstein/ozon-test-task/internal/service/report/mocks"
+	mockSearch "github.com/Saracomethstein/ozon-test-task/internal/service/search/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func TestQueryResolver_Posts(t *testing.T) {
@@ -23,21 +29,25 @@ func TestQueryResolver_Posts(t *testing.T) {
 	totalCount := int32(5)
 	hasNextPage := true
 	endCursor := "nextCursor"
+	tags := []string{"go"}
+	matchAll := graphql.TagMatchAll
+	serviceMatchAll := models.TagMatchAll
 
 	post1 := &models.Post{
 		ID:            1,
 		Title:         "Post 1",
 		Body:          "Body 1",
 		Author:        "Author 1",
-		AllowComments: true,
+		CommentPolicy: models.CommentPolicyOpen,
 		CreatedAt:     "2023-01-01T12:00:00Z",
+		Tags:          []string{"go", "graphql"},
 	}
 	post2 := &models.Post{
 		ID:            2,
 		Title:         "Post 2",
 		Body:          "Body 2",
 		Author:        "Author 2",
-		AllowComments: false,
+		CommentPolicy: models.CommentPolicyClosed,
 		CreatedAt:     "2023-01-02T12:00:00Z",
 	}
 
@@ -57,6 +67,8 @@ func TestQueryResolver_Posts(t *testing.T) {
 		name        string
 		first       *int32
 		after       *string
+		tags        []string
+		match       *graphql.TagMatch
 		mockSetup   func(mockSvc *mockPost.MockUseCase)
 		expected    *graphql.PostConnection
 		expectedErr string
@@ -67,7 +79,7 @@ func TestQueryResolver_Posts(t *testing.T) {
 			after: nil,
 			mockSetup: func(mockSvc *mockPost.MockUseCase) {
 				mockSvc.EXPECT().
-					GetPosts(mock.Anything, (*int32)(nil), (*string)(nil)).
+					GetPosts(mock.Anything, (*int32)(nil), (*string)(nil), (*int32)(nil), (*string)(nil), []string(nil), (*models.TagMatch)(nil)).
 					Return(serviceConnection, nil)
 			},
 			expected: &graphql.PostConnection{
@@ -75,23 +87,26 @@ func TestQueryResolver_Posts(t *testing.T) {
 					{
 						Cursor: "cursor1",
 						Node: &graphql.Post{
-							ID:            "1",
-							Title:         post1.Title,
-							Body:          post1.Body,
-							Author:        post1.Author,
-							AllowComments: post1.AllowComments,
-							CreatedAt:     post1.CreatedAt,
+							ID:              globalid.Encode(globalid.Post, 1),
+							Title:           post1.Title,
+							Body:            post1.Body,
+							Author:          post1.Author,
+							CommentPolicy:   graphql.CommentPolicy(post1.CommentPolicy),
+							CommentsCloseAt: post1.CommentsCloseAt,
+							CreatedAt:       post1.CreatedAt,
+							Tags:            post1.Tags,
 						},
 					},
 					{
 						Cursor: "cursor2",
 						Node: &graphql.Post{
-							ID:            "2",
-							Title:         post2.Title,
-							Body:          post2.Body,
-							Author:        post2.Author,
-							AllowComments: post2.AllowComments,
-							CreatedAt:     post2.CreatedAt,
+							ID:              globalid.Encode(globalid.Post, 2),
+							Title:           post2.Title,
+							Body:            post2.Body,
+							Author:          post2.Author,
+							CommentPolicy:   graphql.CommentPolicy(post2.CommentPolicy),
+							CommentsCloseAt: post2.CommentsCloseAt,
+							CreatedAt:       post2.CreatedAt,
 						},
 					},
 				},
@@ -103,12 +118,14 @@ func TestQueryResolver_Posts(t *testing.T) {
 			},
 		},
 		{
-			name:  "success_with_pagination",
+			name:  "success_with_pagination_and_tags",
 			first: &first,
 			after: &after,
+			tags:  tags,
+			match: &matchAll,
 			mockSetup: func(mockSvc *mockPost.MockUseCase) {
 				mockSvc.EXPECT().
-					GetPosts(mock.Anything, &first, &after).
+					GetPosts(mock.Anything, &first, &after, (*int32)(nil), (*string)(nil), tags, &serviceMatchAll).
 					Return(serviceConnection, nil)
 			},
 			expected: &graphql.PostConnection{
@@ -116,23 +133,26 @@ func TestQueryResolver_Posts(t *testing.T) {
 					{
 						Cursor: "cursor1",
 						Node: &graphql.Post{
-							ID:            "1",
-							Title:         post1.Title,
-							Body:          post1.Body,
-							Author:        post1.Author,
-							AllowComments: post1.AllowComments,
-							CreatedAt:     post1.CreatedAt,
+							ID:              globalid.Encode(globalid.Post, 1),
+							Title:           post1.Title,
+							Body:            post1.Body,
+							Author:          post1.Author,
+							CommentPolicy:   graphql.CommentPolicy(post1.CommentPolicy),
+							CommentsCloseAt: post1.CommentsCloseAt,
+							CreatedAt:       post1.CreatedAt,
+							Tags:            post1.Tags,
 						},
 					},
 					{
 						Cursor: "cursor2",
 						Node: &graphql.Post{
-							ID:            "2",
-							Title:         post2.Title,
-							Body:          post2.Body,
-							Author:        post2.Author,
-							AllowComments: post2.AllowComments,
-							CreatedAt:     post2.CreatedAt,
+							ID:              globalid.Encode(globalid.Post, 2),
+							Title:           post2.Title,
+							Body:            post2.Body,
+							Author:          post2.Author,
+							CommentPolicy:   graphql.CommentPolicy(post2.CommentPolicy),
+							CommentsCloseAt: post2.CommentsCloseAt,
+							CreatedAt:       post2.CreatedAt,
 						},
 					},
 				},
@@ -149,7 +169,7 @@ func TestQueryResolver_Posts(t *testing.T) {
 			after: nil,
 			mockSetup: func(mockSvc *mockPost.MockUseCase) {
 				mockSvc.EXPECT().
-					GetPosts(mock.Anything, (*int32)(nil), (*string)(nil)).
+					GetPosts(mock.Anything, (*int32)(nil), (*string)(nil), (*int32)(nil), (*string)(nil), []string(nil), (*models.TagMatch)(nil)).
 					Return(nil, errors.New("db error"))
 			},
 			expectedErr: "db error",
@@ -169,7 +189,7 @@ func TestQueryResolver_Posts(t *testing.T) {
 
 			tt.mockSetup(mockPostService)
 
-			got, err := resolver.Posts(context.Background(), tt.first, tt.after)
+			got, err := resolver.Posts(context.Background(), tt.first, tt.after, nil, nil, tt.tags, tt.match)
 
 			if tt.expectedErr != "" {
 				assert.Error(t, err)
@@ -188,14 +208,14 @@ func TestQueryResolver_Posts(t *testing.T) {
 func TestQueryResolver_Post(t *testing.T) {
 	t.Parallel()
 
-	postID := "123"
+	postID := globalid.Encode(globalid.Post, 123)
 	postIDInt := int64(123)
 	post := &models.Post{
 		ID:            postIDInt,
 		Title:         "Test Post",
 		Body:          "Test Body",
 		Author:        "John Doe",
-		AllowComments: true,
+		CommentPolicy: models.CommentPolicyOpen,
 		CreatedAt:     "2023-01-01T12:00:00Z",
 	}
 
@@ -215,12 +235,13 @@ func TestQueryResolver_Post(t *testing.T) {
 					Return(post, nil)
 			},
 			expected: &graphql.Post{
-				ID:            postID,
-				Title:         post.Title,
-				Body:          post.Body,
-				Author:        post.Author,
-				AllowComments: post.AllowComments,
-				CreatedAt:     post.CreatedAt,
+				ID:              postID,
+				Title:           post.Title,
+				Body:            post.Body,
+				Author:          post.Author,
+				CommentPolicy:   graphql.CommentPolicy(post.CommentPolicy),
+				CommentsCloseAt: post.CommentsCloseAt,
+				CreatedAt:       post.CreatedAt,
 			},
 		},
 		{
@@ -267,13 +288,15 @@ func TestQueryResolver_Post(t *testing.T) {
 func TestQueryResolver_CommentsByPost(t *testing.T) {
 	t.Parallel()
 
-	postID := "123"
+	postID := globalid.Encode(globalid.Post, 123)
 	postIDInt := int64(123)
 	first := int32(2)
 	after := "cursorX"
 	totalCount := int32(3)
 	hasNextPage := true
 	endCursor := "nextCursor"
+	orderBy := graphql.CommentOrderTop
+	order := models.CommentOrderTop
 
 	comment1 := &models.Comment{
 		ID:        1,
@@ -309,6 +332,7 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 		postID      string
 		first       *int32
 		after       *string
+		orderBy     *graphql.CommentOrder
 		mockSetup   func(mockSvc *mockComment.MockUseCase)
 		expected    *graphql.CommentConnection
 		expectedErr string
@@ -320,7 +344,7 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 			after:  nil,
 			mockSetup: func(mockSvc *mockComment.MockUseCase) {
 				mockSvc.EXPECT().
-					GetRootComments(mock.Anything, postID, (*int32)(nil), (*string)(nil)).
+					GetRootComments(mock.Anything, postID, (*int32)(nil), (*string)(nil), (*int32)(nil), (*string)(nil), (*models.CommentOrder)(nil)).
 					Return(serviceConnection, nil)
 			},
 			expected: &graphql.CommentConnection{
@@ -328,7 +352,7 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 					{
 						Cursor: "cursor1",
 						Node: &graphql.Comment{
-							ID:        "1",
+							ID:        globalid.Encode(globalid.Comment, 1),
 							PostID:    postID,
 							ParentID:  nil,
 							Author:    comment1.Author,
@@ -339,7 +363,7 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 					{
 						Cursor: "cursor2",
 						Node: &graphql.Comment{
-							ID:        "2",
+							ID:        globalid.Encode(globalid.Comment, 2),
 							PostID:    postID,
 							ParentID:  nil,
 							Author:    comment2.Author,
@@ -356,13 +380,14 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 			},
 		},
 		{
-			name:   "success_with_pagination",
-			postID: postID,
-			first:  &first,
-			after:  &after,
+			name:    "success_with_pagination",
+			postID:  postID,
+			first:   &first,
+			after:   &after,
+			orderBy: &orderBy,
 			mockSetup: func(mockSvc *mockComment.MockUseCase) {
 				mockSvc.EXPECT().
-					GetRootComments(mock.Anything, postID, &first, &after).
+					GetRootComments(mock.Anything, postID, &first, &after, (*int32)(nil), (*string)(nil), &order).
 					Return(serviceConnection, nil)
 			},
 			expected: &graphql.CommentConnection{
@@ -370,7 +395,7 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 					{
 						Cursor: "cursor1",
 						Node: &graphql.Comment{
-							ID:        "1",
+							ID:        globalid.Encode(globalid.Comment, 1),
 							PostID:    postID,
 							ParentID:  nil,
 							Author:    comment1.Author,
@@ -381,7 +406,7 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 					{
 						Cursor: "cursor2",
 						Node: &graphql.Comment{
-							ID:        "2",
+							ID:        globalid.Encode(globalid.Comment, 2),
 							PostID:    postID,
 							ParentID:  nil,
 							Author:    comment2.Author,
@@ -412,7 +437,7 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 			after:  nil,
 			mockSetup: func(mockSvc *mockComment.MockUseCase) {
 				mockSvc.EXPECT().
-					GetRootComments(mock.Anything, postID, (*int32)(nil), (*string)(nil)).
+					GetRootComments(mock.Anything, postID, (*int32)(nil), (*string)(nil), (*int32)(nil), (*string)(nil), (*models.CommentOrder)(nil)).
 					Return(nil, errors.New("db error"))
 			},
 			expectedErr: "db error",
@@ -432,7 +457,7 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 
 			tt.mockSetup(mockCommentService)
 
-			got, err := resolver.CommentsByPost(context.Background(), tt.postID, tt.first, tt.after)
+			got, err := resolver.CommentsByPost(context.Background(), tt.postID, tt.first, tt.after, nil, nil, tt.orderBy)
 
 			if tt.expectedErr != "" {
 				assert.Error(t, err)
@@ -447,3 +472,772 @@ func TestQueryResolver_CommentsByPost(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryResolver_Tags(t *testing.T) {
+	t.Parallel()
+
+	prefix := "go"
+	first := int32(5)
+
+	tests := []struct {
+		name        string
+		prefix      *string
+		first       *int32
+		mockSetup   func(mockSvc *mockPost.MockUseCase)
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name:   "success",
+			prefix: &prefix,
+			first:  &first,
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					GetTags(mock.Anything, &prefix, &first).
+					Return([]string{"go", "golang"}, nil)
+			},
+			expected: []string{"go", "golang"},
+		},
+		{
+			name: "service_error",
+			mockSetup: func(mockSvc *mockPost.MockUseCase) {
+				mockSvc.EXPECT().
+					GetTags(mock.Anything, (*string)(nil), (*int32)(nil)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPostService := mockPost.NewMockUseCase(t)
+			resolver := &queryResolver{
+				service: &service.Container{
+					PostService: mockPostService,
+				},
+			}
+
+			tt.mockSetup(mockPostService)
+
+			got, err := resolver.Tags(context.Background(), tt.prefix, tt.first)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_Search(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(1)
+	parentIDStr := globalid.Encode(globalid.Comment, 1)
+	endCursor := "cursor2"
+
+	serviceConnection := &models.SearchConnection{
+		Edges: []*models.SearchEdge{
+			{
+				Cursor: "cursor1",
+				Node: &models.SearchHit{
+					Rank:    0.9,
+					Snippet: "<b>go</b> tips",
+					Post:    &models.Post{ID: 4, Title: "Go", Body: "go tips", Author: "Alice", CreatedAt: "2023-01-01T12:00:00Z", Tags: []string{"go"}},
+				},
+			},
+			{
+				Cursor: "cursor2",
+				Node: &models.SearchHit{
+					Rank:    0.4,
+					Snippet: "I like <b>go</b>",
+					Comment: &models.Comment{ID: 7, PostID: 4, ParentID: &parentID, Author: "Bob", Text: "I like go", CreatedAt: "2023-01-01T13:00:00Z"},
+				},
+			},
+		},
+		PageInfo: &models.PageInfo{
+			EndCursor:   &endCursor,
+			HasNextPage: false,
+		},
+		TotalCount: 2,
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		mockSetup   func(mockSvc *mockSearch.MockUseCase)
+		expected    *graphql.SearchConnection
+		expectedErr string
+	}{
+		{
+			name:  "success",
+			query: "go",
+			mockSetup: func(mockSvc *mockSearch.MockUseCase) {
+				mockSvc.EXPECT().
+					Search(mock.Anything, "go", (*int32)(nil), (*string)(nil)).
+					Return(serviceConnection, nil)
+			},
+			expected: &graphql.SearchConnection{
+				Edges: []*graphql.SearchEdge{
+					{
+						Cursor:  "cursor1",
+						Rank:    0.9,
+						Snippet: "<b>go</b> tips",
+						Node: &graphql.Post{
+							ID:        globalid.Encode(globalid.Post, 4),
+							Title:     "Go",
+							Body:      "go tips",
+							Author:    "Alice",
+							CreatedAt: "2023-01-01T12:00:00Z",
+							Tags:      []string{"go"},
+						},
+					},
+					{
+						Cursor:  "cursor2",
+						Rank:    0.4,
+						Snippet: "I like <b>go</b>",
+						Node: &graphql.Comment{
+							ID:        globalid.Encode(globalid.Comment, 7),
+							PostID:    globalid.Encode(globalid.Post, 4),
+							ParentID:  &parentIDStr,
+							Author:    "Bob",
+							Text:      "I like go",
+							CreatedAt: "2023-01-01T13:00:00Z",
+						},
+					},
+				},
+				PageInfo: &graphql.PageInfo{
+					EndCursor:   &endCursor,
+					HasNextPage: false,
+				},
+				TotalCount: 2,
+			},
+		},
+		{
+			name:        "empty_query",
+			query:       "",
+			mockSetup:   func(mockSvc *mockSearch.MockUseCase) {},
+			expectedErr: "query cannot be empty",
+		},
+		{
+			name:  "service_error",
+			query: "go",
+			mockSetup: func(mockSvc *mockSearch.MockUseCase) {
+				mockSvc.EXPECT().
+					Search(mock.Anything, "go", (*int32)(nil), (*string)(nil)).
+					Return(nil, errors.New("invalid cursor format"))
+			},
+			expectedErr: "invalid cursor format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockSearchService := mockSearch.NewMockUseCase(t)
+			resolver := &queryResolver{
+				service: &service.Container{
+					SearchService: mockSearchService,
+				},
+			}
+
+			tt.mockSetup(mockSearchService)
+
+			got, err := resolver.Search(context.Background(), tt.query, nil, nil)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_CommentThread(t *testing.T) {
+	t.Parallel()
+
+	rootID := int64(5)
+	rootIDStr := globalid.Encode(globalid.Comment, 5)
+	editedAt := "2023-01-01T13:00:00Z"
+
+	tests := []struct {
+		name        string
+		postID      string
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    []*graphql.ThreadComment
+		expectedErr string
+	}{
+		{
+			name:   "success",
+			postID: "1",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					GetThread(mock.Anything, "1", (*string)(nil), (*int32)(nil), (*int32)(nil)).
+					Return([]*models.ThreadComment{
+						{Comment: &models.Comment{ID: 5, PostID: 1, Author: "Alice", Text: "Root", CreatedAt: "2023-01-01T12:00:00Z"}, Depth: 0, Path: []int64{5}},
+						{Comment: &models.Comment{ID: 8, PostID: 1, ParentID: &rootID, Text: "", CreatedAt: "2023-01-01T12:30:00Z", EditedAt: &editedAt, IsDeleted: true}, Depth: 1, Path: []int64{5, 8}},
+					}, nil)
+			},
+			expected: []*graphql.ThreadComment{
+				{
+					Depth:   0,
+					Path:    []string{globalid.Encode(globalid.Comment, 5)},
+					Comment: &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), PostID: globalid.Encode(globalid.Post, 1), Author: "Alice", Text: "Root", CreatedAt: "2023-01-01T12:00:00Z"},
+				},
+				{
+					Depth:   1,
+					Path:    []string{globalid.Encode(globalid.Comment, 5), globalid.Encode(globalid.Comment, 8)},
+					Comment: &graphql.Comment{ID: globalid.Encode(globalid.Comment, 8), PostID: globalid.Encode(globalid.Post, 1), ParentID: &rootIDStr, CreatedAt: "2023-01-01T12:30:00Z", EditedAt: &editedAt, IsDeleted: true},
+				},
+			},
+		},
+		{
+			name:        "empty_postID",
+			postID:      "",
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "postID cannot be empty",
+		},
+		{
+			name:   "service_error",
+			postID: "1",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					GetThread(mock.Anything, "1", (*string)(nil), (*int32)(nil), (*int32)(nil)).
+					Return(nil, errors.New("maxDepth must be between 0 and 20"))
+			},
+			expectedErr: "maxDepth must be between 0 and 20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &queryResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.CommentThread(context.Background(), tt.postID, nil, nil, nil)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_Comment(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(2)
+	parentIDStr := globalid.Encode(globalid.Comment, 2)
+
+	tests := []struct {
+		name        string
+		id          string
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *graphql.Comment
+		expectedErr string
+	}{
+		{
+			name: "success",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					GetComment(mock.Anything, "5").
+					Return(&models.Comment{ID: 5, PostID: 1, ParentID: &parentID, Author: "Bob", Text: "deep", CreatedAt: "2023-01-01T12:00:00Z"}, nil)
+			},
+			expected: &graphql.Comment{ID: globalid.Encode(globalid.Comment, 5), PostID: globalid.Encode(globalid.Post, 1), ParentID: &parentIDStr, Author: "Bob", Text: "deep", CreatedAt: "2023-01-01T12:00:00Z"},
+		},
+		{
+			name:        "empty_id",
+			id:          "",
+			mockSetup:   func(mockSvc *mockComment.MockUseCase) {},
+			expectedErr: "id cannot be empty",
+		},
+		{
+			name: "not_found",
+			id:   "5",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					GetComment(mock.Anything, "5").
+					Return(nil, errors.New("comment not found"))
+			},
+			expectedErr: "comment not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &queryResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.Comment(context.Background(), tt.id)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_ModerationQueue(t *testing.T) {
+	t.Parallel()
+
+	postID := globalid.Encode(globalid.Post, 1)
+	endCursor := "cursor"
+
+	tests := []struct {
+		name        string
+		mockSetup   func(mockSvc *mockComment.MockUseCase)
+		expected    *graphql.CommentConnection
+		expectedErr string
+	}{
+		{
+			name: "success",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					ModerationQueue(mock.Anything, &postID, (*int32)(nil), (*string)(nil)).
+					Return(&models.CommentConnection{
+						Edges: []*models.CommentEdge{
+							{Cursor: endCursor, Node: &models.Comment{ID: 5, PostID: 1, Author: "Alice", Text: "Held", CreatedAt: "2023-01-01T12:00:00Z", Status: models.CommentStatusPending}},
+						},
+						PageInfo:   &models.PageInfo{StartCursor: &endCursor, EndCursor: &endCursor},
+						TotalCount: 1,
+					}, nil)
+			},
+			expected: &graphql.CommentConnection{
+				Edges: []*graphql.CommentEdge{
+					{
+						Cursor: endCursor,
+						Node: &graphql.Comment{
+							ID:        globalid.Encode(globalid.Comment, 5),
+							PostID:    postID,
+							Author:    "Alice",
+							Text:      "Held",
+							CreatedAt: "2023-01-01T12:00:00Z",
+							Status:    graphql.CommentStatusPending,
+						},
+					},
+				},
+				PageInfo:   &graphql.PageInfo{StartCursor: &endCursor, EndCursor: &endCursor},
+				TotalCount: 1,
+			},
+		},
+		{
+			name: "not_a_moderator",
+			mockSetup: func(mockSvc *mockComment.MockUseCase) {
+				mockSvc.EXPECT().
+					ModerationQueue(mock.Anything, &postID, (*int32)(nil), (*string)(nil)).
+					Return(nil, errors.New("moderator access required"))
+			},
+			expectedErr: "moderator access required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &queryResolver{
+				service: &service.Container{
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockCommentService)
+
+			got, err := resolver.ModerationQueue(context.Background(), nil, nil, &postID)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_Reports(t *testing.T) {
+	t.Parallel()
+
+	endCursor := "cursor"
+	status := graphql.ReportStatusResolved
+	modelStatus := models.ReportStatusResolved
+	action := models.ReportActionDismiss
+	gqlAction := graphql.ReportActionDismiss
+	resolvedAt := "2026-02-13T11:00:00Z"
+
+	tests := []struct {
+		name        string
+		mockSetup   func(mockSvc *mockReport.MockUseCase)
+		expected    *graphql.ReportConnection
+		expectedErr string
+	}{
+		{
+			name: "success",
+			mockSetup: func(mockSvc *mockReport.MockUseCase) {
+				mockSvc.EXPECT().
+					Reports(mock.Anything, &modelStatus, (*int32)(nil), (*string)(nil)).
+					Return(&models.ReportConnection{
+						Edges: []*models.ReportEdge{
+							{Cursor: endCursor, Node: &models.Report{
+								ID:           3,
+								Target:       models.ReportTargetComment,
+								TargetID:     7,
+								TargetAuthor: "Bob",
+								Reporter:     "Alice",
+								Reason:       models.ReportReasonSpam,
+								Status:       models.ReportStatusResolved,
+								Action:       &action,
+								CreatedAt:    "2026-02-13T10:00:00Z",
+								ResolvedAt:   &resolvedAt,
+							}},
+						},
+						PageInfo:   &models.PageInfo{StartCursor: &endCursor, EndCursor: &endCursor},
+						TotalCount: 1,
+					}, nil)
+			},
+			expected: &graphql.ReportConnection{
+				Edges: []*graphql.ReportEdge{
+					{
+						Cursor: endCursor,
+						Node: &graphql.Report{
+							ID:           globalid.Encode(globalid.Report, 3),
+							TargetID:     globalid.Encode(globalid.Comment, 7),
+							TargetType:   graphql.ReportTargetComment,
+							TargetAuthor: "Bob",
+							Reporter:     "Alice",
+							Reason:       graphql.ReportReasonSpam,
+							Status:       graphql.ReportStatusResolved,
+							Action:       &gqlAction,
+							CreatedAt:    "2026-02-13T10:00:00Z",
+							ResolvedAt:   &resolvedAt,
+						},
+					},
+				},
+				PageInfo:   &graphql.PageInfo{StartCursor: &endCursor, EndCursor: &endCursor},
+				TotalCount: 1,
+			},
+		},
+		{
+			name: "not_a_moderator",
+			mockSetup: func(mockSvc *mockReport.MockUseCase) {
+				mockSvc.EXPECT().
+					Reports(mock.Anything, &modelStatus, (*int32)(nil), (*string)(nil)).
+					Return(nil, errors.New("moderator access required"))
+			},
+			expectedErr: "moderator access required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReportService := mockReport.NewMockUseCase(t)
+			resolver := &queryResolver{
+				service: &service.Container{
+					ReportService: mockReportService,
+				},
+			}
+
+			tt.mockSetup(mockReportService)
+
+			got, err := resolver.Reports(context.Background(), &status, nil, nil)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_AuditLog(t *testing.T) {
+	t.Parallel()
+
+	endCursor := "cursor"
+	reportID := int64(3)
+	globalReportID := globalid.Encode(globalid.Report, reportID)
+	details := "Bob"
+
+	tests := []struct {
+		name        string
+		mockSetup   func(mockSvc *mockReport.MockUseCase)
+		expected    *graphql.AuditEntryConnection
+		expectedErr string
+	}{
+		{
+			name: "success",
+			mockSetup: func(mockSvc *mockReport.MockUseCase) {
+				mockSvc.EXPECT().
+					AuditLog(mock.Anything, (*int32)(nil), (*string)(nil)).
+					Return(&models.AuditEntryConnection{
+						Edges: []*models.AuditEntryEdge{
+							{Cursor: endCursor, Node: &models.AuditEntry{
+								ID:        12,
+								Actor:     models.AuditActorModerator,
+								Action:    models.AuditActionBanAuthor,
+								Target:    models.ReportTargetPost,
+								TargetID:  1,
+								ReportID:  &reportID,
+								Details:   &details,
+								CreatedAt: "2026-02-13T11:00:00Z",
+							}},
+							{Cursor: endCursor, Node: &models.AuditEntry{
+								ID:        11,
+								Actor:     "Alice",
+								Action:    models.AuditActionReport,
+								Target:    models.ReportTargetComment,
+								TargetID:  7,
+								CreatedAt: "2026-02-13T10:00:00Z",
+							}},
+						},
+						PageInfo:   &models.PageInfo{StartCursor: &endCursor, EndCursor: &endCursor},
+						TotalCount: 2,
+					}, nil)
+			},
+			expected: &graphql.AuditEntryConnection{
+				Edges: []*graphql.AuditEntryEdge{
+					{
+						Cursor: endCursor,
+						Node: &graphql.AuditEntry{
+							ID:         globalid.Encode(globalid.AuditEntry, 12),
+							Actor:      models.AuditActorModerator,
+							Action:     graphql.AuditActionBanAuthor,
+							TargetID:   globalid.Encode(globalid.Post, 1),
+							TargetType: graphql.ReportTargetPost,
+							ReportID:   &globalReportID,
+							Details:    &details,
+							CreatedAt:  "2026-02-13T11:00:00Z",
+						},
+					},
+					{
+						Cursor: endCursor,
+						Node: &graphql.AuditEntry{
+							ID:         globalid.Encode(globalid.AuditEntry, 11),
+							Actor:      "Alice",
+							Action:     graphql.AuditActionReport,
+							TargetID:   globalid.Encode(globalid.Comment, 7),
+							TargetType: graphql.ReportTargetComment,
+							CreatedAt:  "2026-02-13T10:00:00Z",
+						},
+					},
+				},
+				PageInfo:   &graphql.PageInfo{StartCursor: &endCursor, EndCursor: &endCursor},
+				TotalCount: 2,
+			},
+		},
+		{
+			name: "not_a_moderator",
+			mockSetup: func(mockSvc *mockReport.MockUseCase) {
+				mockSvc.EXPECT().
+					AuditLog(mock.Anything, (*int32)(nil), (*string)(nil)).
+					Return(nil, errors.New("moderator access required"))
+			},
+			expectedErr: "moderator access required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReportService := mockReport.NewMockUseCase(t)
+			resolver := &queryResolver{
+				service: &service.Container{
+					ReportService: mockReportService,
+				},
+			}
+
+			tt.mockSetup(mockReportService)
+
+			got, err := resolver.AuditLog(context.Background(), nil, nil)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_Node(t *testing.T) {
+	t.Parallel()
+
+	postID := globalid.Encode(globalid.Post, 7)
+	commentID := globalid.Encode(globalid.Comment, 7)
+
+	tests := []struct {
+		name        string
+		id          string
+		mockSetup   func(postSvc *mockPost.MockUseCase, commentSvc *mockComment.MockUseCase)
+		expected    graphql.Node
+		expectedErr string
+	}{
+		{
+			name: "post",
+			id:   postID,
+			mockSetup: func(postSvc *mockPost.MockUseCase, commentSvc *mockComment.MockUseCase) {
+				postSvc.EXPECT().
+					GetPostById(mock.Anything, postID).
+					Return(&models.Post{ID: 7, Title: "Title", Body: "Body", Author: "Alice", CreatedAt: "2023-01-01T12:00:00Z"}, nil)
+			},
+			expected: &graphql.Post{ID: postID, Title: "Title", Body: "Body", Author: "Alice", CreatedAt: "2023-01-01T12:00:00Z"},
+		},
+		{
+			name: "comment_with_same_row_id",
+			id:   commentID,
+			mockSetup: func(postSvc *mockPost.MockUseCase, commentSvc *mockComment.MockUseCase) {
+				commentSvc.EXPECT().
+					GetComment(mock.Anything, commentID).
+					Return(&models.Comment{ID: 7, PostID: 1, Author: "Bob", Text: "hi", CreatedAt: "2023-01-01T12:00:00Z"}, nil)
+			},
+			expected: &graphql.Comment{ID: commentID, PostID: globalid.Encode(globalid.Post, 1), Author: "Bob", Text: "hi", CreatedAt: "2023-01-01T12:00:00Z"},
+		},
+		{
+			name:        "raw_row_id",
+			id:          "7",
+			mockSetup:   func(postSvc *mockPost.MockUseCase, commentSvc *mockComment.MockUseCase) {},
+			expectedErr: "invalid node id: invalid id encoding",
+		},
+		{
+			name:        "unknown_type",
+			id:          base64.RawURLEncoding.EncodeToString([]byte("User:7")),
+			mockSetup:   func(postSvc *mockPost.MockUseCase, commentSvc *mockComment.MockUseCase) {},
+			expectedErr: "invalid node id: unknown id type",
+		},
+		{
+			name: "comment_not_found",
+			id:   commentID,
+			mockSetup: func(postSvc *mockPost.MockUseCase, commentSvc *mockComment.MockUseCase) {
+				commentSvc.EXPECT().
+					GetComment(mock.Anything, commentID).
+					Return(nil, errors.WithMessage(repository.ErrNotFound, "comment"))
+			},
+		},
+		{
+			name: "deleted_post",
+			id:   postID,
+			mockSetup: func(postSvc *mockPost.MockUseCase, commentSvc *mockComment.MockUseCase) {
+				postSvc.EXPECT().
+					GetPostById(mock.Anything, postID).
+					Return(nil, errors.WithMessage(repository.ErrNotFound, "post"))
+			},
+		},
+		{
+			name: "lookup_error",
+			id:   commentID,
+			mockSetup: func(postSvc *mockPost.MockUseCase, commentSvc *mockComment.MockUseCase) {
+				commentSvc.EXPECT().
+					GetComment(mock.Anything, commentID).
+					Return(nil, errors.New("connection refused"))
+			},
+			expectedErr: "connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPostService := mockPost.NewMockUseCase(t)
+			mockCommentService := mockComment.NewMockUseCase(t)
+			resolver := &queryResolver{
+				service: &service.Container{
+					PostService:    mockPostService,
+					CommentService: mockCommentService,
+				},
+			}
+
+			tt.mockSetup(mockPostService, mockCommentService)
+
+			got, err := resolver.Node(context.Background(), tt.id)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_Nodes(t *testing.T) {
+	t.Parallel()
+
+	postID := globalid.Encode(globalid.Post, 1)
+	commentID := globalid.Encode(globalid.Comment, 2)
+
+	mockPostService := mockPost.NewMockUseCase(t)
+	mockCommentService := mockComment.NewMockUseCase(t)
+	resolver := &queryResolver{
+		service: &service.Container{
+			PostService:    mockPostService,
+			CommentService: mockCommentService,
+		},
+	}
+
+	mockCommentService.EXPECT().
+		GetComment(mock.Anything, commentID).
+		Return(&models.Comment{ID: 2, PostID: 1, Author: "Bob", Text: "hi"}, nil)
+	mockPostService.EXPECT().
+		GetPostById(mock.Anything, postID).
+		Return(&models.Post{ID: 1, Title: "Title"}, nil)
+
+	missingID := globalid.Encode(globalid.Comment, 3)
+	mockCommentService.EXPECT().
+		GetComment(mock.Anything, missingID).
+		Return(nil, errors.WithMessage(repository.ErrNotFound, "comment"))
+
+	got, err := resolver.Nodes(context.Background(), []string{commentID, missingID, postID})
+
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, commentID, got[0].GetID())
+	assert.Nil(t, got[1])
+	assert.Equal(t, postID, got[2].GetID())
+}
@@ -0,0 +1,9 @@
+package query
+
+import (
+	"context"
+)
+
+func (r *queryResolver) ReactionKinds(ctx context.Context) ([]string, error) {
+	return r.service.ReactionService.Kinds(), nil
+}
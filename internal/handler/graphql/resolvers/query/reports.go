@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *queryResolver) Reports(ctx context.Context, status *graphql.ReportStatus, first *int32, after *string) (*graphql.ReportConnection, error) {
+	var st *models.ReportStatus
+	if status != nil {
+		s := models.ReportStatus(*status)
+		st = &s
+	}
+
+	connection, err := r.service.ReportService.Reports(ctx, st, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*graphql.ReportEdge, len(connection.Edges))
+	for i, edge := range connection.Edges {
+		node := &graphql.Report{
+			ID:           globalid.Encode(globalid.Report, edge.Node.ID),
+			TargetID:     encodeReportTarget(edge.Node.Target, edge.Node.TargetID),
+			TargetType:   graphql.ReportTarget(edge.Node.Target),
+			TargetAuthor: edge.Node.TargetAuthor,
+			Reporter:     edge.Node.Reporter,
+			Reason:       graphql.ReportReason(edge.Node.Reason),
+			Details:      edge.Node.Details,
+			Status:       graphql.ReportStatus(edge.Node.Status),
+			TargetHidden: edge.Node.TargetHidden,
+			CreatedAt:    edge.Node.CreatedAt,
+			ResolvedAt:   edge.Node.ResolvedAt,
+		}
+		if edge.Node.Action != nil {
+			action := graphql.ReportAction(*edge.Node.Action)
+			node.Action = &action
+		}
+
+		edges[i] = &graphql.ReportEdge{
+			Cursor: edge.Cursor,
+			Node:   node,
+		}
+	}
+
+	pageInfo := &graphql.PageInfo{
+		StartCursor:     connection.PageInfo.StartCursor,
+		EndCursor:       connection.PageInfo.EndCursor,
+		HasPreviousPage: connection.PageInfo.HasPreviousPage,
+		HasNextPage:     connection.PageInfo.HasNextPage,
+	}
+
+	return &graphql.ReportConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: connection.TotalCount,
+	}, nil
+}
+
+// encodeReportTarget builds the global ID of a reported post or comment.
+func encodeReportTarget(target models.ReportTarget, id int64) string {
+	if target == models.ReportTargetComment {
+		return globalid.Encode(globalid.Comment, id)
+	}
+
+	return globalid.Encode(globalid.Post, id)
+}
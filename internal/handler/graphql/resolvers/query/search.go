@@ -0,0 +1,78 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *queryResolver) Search(ctx context.Context, query string, first *int32, after *string) (*graphql.SearchConnection, error) {
+	if query == "" {
+		return nil, errors.New("query cannot be empty")
+	}
+
+	connection, err := r.service.SearchService.Search(ctx, query, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*graphql.SearchEdge, len(connection.Edges))
+	for i, edge := range connection.Edges {
+		edges[i] = &graphql.SearchEdge{
+			Cursor:  edge.Cursor,
+			Rank:    edge.Node.Rank,
+			Snippet: edge.Node.Snippet,
+			Node:    convertSearchResult(edge.Node),
+		}
+	}
+
+	pageInfo := &graphql.PageInfo{
+		StartCursor:     connection.PageInfo.StartCursor,
+		EndCursor:       connection.PageInfo.EndCursor,
+		HasPreviousPage: connection.PageInfo.HasPreviousPage,
+		HasNextPage:     connection.PageInfo.HasNextPage,
+	}
+
+	return &graphql.SearchConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: connection.TotalCount,
+	}, nil
+}
+
+func convertSearchResult(hit *models.SearchHit) graphql.SearchResult {
+	if hit.Post != nil {
+		return &graphql.Post{
+			ID:              globalid.Encode(globalid.Post, hit.Post.ID),
+			Title:           hit.Post.Title,
+			Body:            hit.Post.Body,
+			Author:          hit.Post.Author,
+			CommentPolicy:   graphql.CommentPolicy(hit.Post.CommentPolicy),
+			CommentsCloseAt: hit.Post.CommentsCloseAt,
+			CreatedAt:       hit.Post.CreatedAt,
+			Tags:            hit.Post.Tags,
+		}
+	}
+
+	var parentID *string
+	if hit.Comment.ParentID != nil {
+		id := globalid.Encode(globalid.Comment, *hit.Comment.ParentID)
+		parentID = &id
+	}
+
+	return &graphql.Comment{
+		ID:        globalid.Encode(globalid.Comment, hit.Comment.ID),
+		PostID:    globalid.Encode(globalid.Post, hit.Comment.PostID),
+		ParentID:  parentID,
+		Author:    hit.Comment.Author,
+		Text:      hit.Comment.Text,
+		CreatedAt: hit.Comment.CreatedAt,
+		EditedAt:  hit.Comment.EditedAt,
+		IsDeleted: hit.Comment.IsDeleted,
+		Status:    graphql.CommentStatus(hit.Comment.Status),
+	}
+}
@@ -0,0 +1,9 @@
+package query
+
+import (
+	"context"
+)
+
+func (r *queryResolver) Tags(ctx context.Context, prefix *string, first *int32) ([]string, error) {
+	return r.service.PostService.GetTags(ctx, prefix, first)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
 	"github.com/Saracomethstein/ozon-test-task/internal/handler/graphql/resolvers/comment"
 	"github.com/Saracomethstein/ozon-test-task/internal/handler/graphql/resolvers/mutation"
+	"github.com/Saracomethstein/ozon-test-task/internal/handler/graphql/resolvers/post"
 	"github.com/Saracomethstein/ozon-test-task/internal/handler/graphql/resolvers/query"
 	"github.com/Saracomethstein/ozon-test-task/internal/handler/graphql/resolvers/subscription"
 	"github.com/Saracomethstein/ozon-test-task/internal/service"
@@ -32,3 +33,7 @@ func (r *Resolver) Subscription() graphql.SubscriptionResolver {
 func (r *Resolver) Comment() graphql.CommentResolver {
 	return comment.New(r.service)
 }
+
+func (r *Resolver) Post() graphql.PostResolver {
+	return post.New(r.service)
+}
@@ -2,11 +2,68 @@ package subscription
 
 import (
 	"context"
-	"fmt"
+
+	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/graphql/sse"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
-func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID string) (<-chan *graphql.Comment, error) {
-	panic(fmt.Errorf("not implemented: CommentAdded - commentAdded"))
+func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID string, after *string) (<-chan *graphql.Comment, error) {
+	if postID == "" {
+		return nil, errors.New("postID cannot be empty")
+	}
+
+	// A reconnecting SSE client resumes from the id of the last event it saw.
+	if after == nil {
+		if lastEventID := sse.LastEventID(ctx); lastEventID != "" {
+			after = &lastEventID
+		}
+	}
+
+	comments, err := r.service.CommentService.CommentAdded(ctx, postID, after)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *graphql.Comment, 1)
+
+	go func() {
+		defer close(out)
+
+		for comment := range comments {
+			sse.PushEventID(ctx, cursor.Encode(comment.CreatedAt, comment.ID))
+
+			select {
+			case out <- convertToGraphQLComment(comment):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func convertToGraphQLComment(comment *models.Comment) *graphql.Comment {
+	var parentIDPtr *string
+	if comment.ParentID != nil {
+		pid := globalid.Encode(globalid.Comment, *comment.ParentID)
+		parentIDPtr = &pid
+	}
+
+	return &graphql.Comment{
+		ID:        globalid.Encode(globalid.Comment, comment.ID),
+		PostID:    globalid.Encode(globalid.Post, comment.PostID),
+		ParentID:  parentIDPtr,
+		Author:    comment.Author,
+		Text:      comment.Text,
+		CreatedAt: comment.CreatedAt,
+		EditedAt:  comment.EditedAt,
+		IsDeleted: comment.IsDeleted,
+		Status:    graphql.CommentStatus(comment.Status),
+	}
 }
@@ -0,0 +1,59 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *subscriptionResolver) CommentEvents(ctx context.Context, postID string) (<-chan graphql.CommentEvent, error) {
+	if postID == "" {
+		return nil, errors.New("postID cannot be empty")
+	}
+
+	events, err := r.service.CommentService.CommentEvents(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan graphql.CommentEvent, 1)
+
+	go func() {
+		defer close(out)
+
+		for event := range events {
+			gqlEvent := convertToGraphQLCommentEvent(event)
+			if gqlEvent == nil {
+				continue
+			}
+
+			select {
+			case out <- gqlEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func convertToGraphQLCommentEvent(event *models.Event) graphql.CommentEvent {
+	switch event.Type {
+	case models.EventCommentAdded:
+		return &graphql.CommentAddedEvent{Comment: convertToGraphQLComment(event.Comment)}
+	case models.EventCommentUpdated:
+		return &graphql.CommentUpdatedEvent{Comment: convertToGraphQLComment(event.Comment)}
+	case models.EventCommentDeleted:
+		return &graphql.CommentDeletedEvent{
+			CommentID: globalid.Encode(globalid.Comment, event.Comment.ID),
+			PostID:    globalid.Encode(globalid.Post, event.PostID),
+		}
+	default:
+		return nil
+	}
+}
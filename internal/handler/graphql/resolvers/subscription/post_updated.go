@@ -0,0 +1,48 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (r *subscriptionResolver) PostUpdated(ctx context.Context, postID string) (<-chan *graphql.Post, error) {
+	if postID == "" {
+		return nil, errors.New("postID cannot be empty")
+	}
+
+	posts, err := r.service.PostService.PostUpdated(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *graphql.Post, 1)
+
+	go func() {
+		defer close(out)
+
+		for post := range posts {
+			node := &graphql.Post{
+				ID:              globalid.Encode(globalid.Post, post.ID),
+				Title:           post.Title,
+				Body:            post.Body,
+				Author:          post.Author,
+				CommentPolicy:   graphql.CommentPolicy(post.CommentPolicy),
+				CommentsCloseAt: post.CommentsCloseAt,
+				CreatedAt:       post.CreatedAt,
+				Tags:            post.Tags,
+			}
+
+			select {
+			case out <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,235 @@
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/generated/graphql"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/service"
+	mockComment "github.com/Saracomethstein/ozon-test-task/internal/service/comment/mocks"
+	mockPost "github.com/Saracomethstein/ozon-test-task/internal/service/post/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func TestSubscriptionResolver_CommentAdded(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(5)
+	parentIDStr := globalid.Encode(globalid.Comment, 5)
+
+	t.Run("forwards_comments", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		comments := make(chan *models.Comment, 2)
+		comments <- &models.Comment{ID: 1, PostID: 7, Author: "Alice", Text: "Hi", CreatedAt: "2023-01-01T12:00:00Z"}
+		comments <- &models.Comment{ID: 2, PostID: 7, ParentID: &parentID, Author: "Bob", Text: "Re", CreatedAt: "2023-01-01T12:01:00Z"}
+		close(comments)
+
+		mockSvc := mockComment.NewMockUseCase(t)
+		mockSvc.EXPECT().
+			CommentAdded(mock.Anything, "7", (*string)(nil)).
+			Return((<-chan *models.Comment)(comments), nil)
+
+		r := New(&service.Container{CommentService: mockSvc})
+		ch, err := r.CommentAdded(ctx, "7", nil)
+		require.NoError(t, err)
+
+		var got []*graphql.Comment
+		for c := range ch {
+			got = append(got, c)
+		}
+
+		assert.Equal(t, []*graphql.Comment{
+			{ID: globalid.Encode(globalid.Comment, 1), PostID: globalid.Encode(globalid.Post, 7), Author: "Alice", Text: "Hi", CreatedAt: "2023-01-01T12:00:00Z"},
+			{ID: globalid.Encode(globalid.Comment, 2), PostID: globalid.Encode(globalid.Post, 7), ParentID: &parentIDStr, Author: "Bob", Text: "Re", CreatedAt: "2023-01-01T12:01:00Z"},
+		}, got)
+	})
+
+	t.Run("stops_on_cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		comments := make(chan *models.Comment)
+
+		mockSvc := mockComment.NewMockUseCase(t)
+		mockSvc.EXPECT().
+			CommentAdded(mock.Anything, "7", (*string)(nil)).
+			Return((<-chan *models.Comment)(comments), nil)
+
+		r := New(&service.Container{CommentService: mockSvc})
+		ch, err := r.CommentAdded(ctx, "7", nil)
+		require.NoError(t, err)
+
+		cancel()
+		go func() { comments <- &models.Comment{ID: 1, PostID: 7} }()
+
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("channel was not closed after cancel")
+		}
+	})
+
+	t.Run("passes_after_cursor", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		after := "cursor123"
+		comments := make(chan *models.Comment)
+		close(comments)
+
+		mockSvc := mockComment.NewMockUseCase(t)
+		mockSvc.EXPECT().
+			CommentAdded(mock.Anything, "7", &after).
+			Return((<-chan *models.Comment)(comments), nil)
+
+		r := New(&service.Container{CommentService: mockSvc})
+		ch, err := r.CommentAdded(ctx, "7", &after)
+		require.NoError(t, err)
+
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+
+	t.Run("empty_postID", func(t *testing.T) {
+		r := New(&service.Container{CommentService: mockComment.NewMockUseCase(t)})
+
+		ch, err := r.CommentAdded(context.Background(), "", nil)
+
+		assert.EqualError(t, err, "postID cannot be empty")
+		assert.Nil(t, ch)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockSvc := mockComment.NewMockUseCase(t)
+		mockSvc.EXPECT().
+			CommentAdded(mock.Anything, "abc", (*string)(nil)).
+			Return(nil, errors.New("invalid postID format"))
+
+		r := New(&service.Container{CommentService: mockSvc})
+		ch, err := r.CommentAdded(context.Background(), "abc", nil)
+
+		assert.EqualError(t, err, "invalid postID format")
+		assert.Nil(t, ch)
+	})
+}
+
+func TestSubscriptionResolver_PostUpdated(t *testing.T) {
+	t.Parallel()
+
+	t.Run("forwards_posts", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		posts := make(chan *models.Post, 1)
+		posts <- &models.Post{ID: 7, Title: "Title", Body: "Body", Author: "Alice", CommentPolicy: models.CommentPolicyClosed, CreatedAt: "2023-01-01T12:00:00Z"}
+		close(posts)
+
+		mockSvc := mockPost.NewMockUseCase(t)
+		mockSvc.EXPECT().
+			PostUpdated(mock.Anything, "7").
+			Return((<-chan *models.Post)(posts), nil)
+
+		r := New(&service.Container{PostService: mockSvc})
+		ch, err := r.PostUpdated(ctx, "7")
+		require.NoError(t, err)
+
+		var got []*graphql.Post
+		for p := range ch {
+			got = append(got, p)
+		}
+
+		assert.Equal(t, []*graphql.Post{
+			{ID: globalid.Encode(globalid.Post, 7), Title: "Title", Body: "Body", Author: "Alice", CommentPolicy: graphql.CommentPolicyClosed, CreatedAt: "2023-01-01T12:00:00Z"},
+		}, got)
+	})
+
+	t.Run("empty_postID", func(t *testing.T) {
+		r := New(&service.Container{PostService: mockPost.NewMockUseCase(t)})
+
+		ch, err := r.PostUpdated(context.Background(), "")
+
+		assert.EqualError(t, err, "postID cannot be empty")
+		assert.Nil(t, ch)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockSvc := mockPost.NewMockUseCase(t)
+		mockSvc.EXPECT().
+			PostUpdated(mock.Anything, "abc").
+			Return(nil, errors.New("invalid post ID format"))
+
+		r := New(&service.Container{PostService: mockSvc})
+		ch, err := r.PostUpdated(context.Background(), "abc")
+
+		assert.EqualError(t, err, "invalid post ID format")
+		assert.Nil(t, ch)
+	})
+}
+
+func TestSubscriptionResolver_CommentEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("converts_event_types", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		comment := &models.Comment{ID: 1, PostID: 7, Author: "Alice", Text: "Hi", CreatedAt: "2023-01-01T12:00:00Z"}
+
+		events := make(chan *models.Event, 3)
+		events <- &models.Event{Type: models.EventCommentAdded, PostID: 7, Comment: comment}
+		events <- &models.Event{Type: models.EventCommentUpdated, PostID: 7, Comment: comment}
+		events <- &models.Event{Type: models.EventCommentDeleted, PostID: 7, Comment: comment}
+		close(events)
+
+		mockSvc := mockComment.NewMockUseCase(t)
+		mockSvc.EXPECT().
+			CommentEvents(mock.Anything, "7").
+			Return((<-chan *models.Event)(events), nil)
+
+		r := New(&service.Container{CommentService: mockSvc})
+		ch, err := r.CommentEvents(ctx, "7")
+		require.NoError(t, err)
+
+		var got []graphql.CommentEvent
+		for e := range ch {
+			got = append(got, e)
+		}
+
+		gqlComment := &graphql.Comment{ID: globalid.Encode(globalid.Comment, 1), PostID: globalid.Encode(globalid.Post, 7), Author: "Alice", Text: "Hi", CreatedAt: "2023-01-01T12:00:00Z"}
+		assert.Equal(t, []graphql.CommentEvent{
+			&graphql.CommentAddedEvent{Comment: gqlComment},
+			&graphql.CommentUpdatedEvent{Comment: gqlComment},
+			&graphql.CommentDeletedEvent{CommentID: globalid.Encode(globalid.Comment, 1), PostID: globalid.Encode(globalid.Post, 7)},
+		}, got)
+	})
+
+	t.Run("empty_postID", func(t *testing.T) {
+		r := New(&service.Container{CommentService: mockComment.NewMockUseCase(t)})
+
+		ch, err := r.CommentEvents(context.Background(), "")
+
+		assert.EqualError(t, err, "postID cannot be empty")
+		assert.Nil(t, ch)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		mockSvc := mockComment.NewMockUseCase(t)
+		mockSvc.EXPECT().
+			CommentEvents(mock.Anything, "abc").
+			Return(nil, errors.New("invalid postID format"))
+
+		r := New(&service.Container{CommentService: mockSvc})
+		ch, err := r.CommentEvents(context.Background(), "abc")
+
+		assert.EqualError(t, err, "invalid postID format")
+		assert.Nil(t, ch)
+	})
+}
@@ -8,12 +8,92 @@ type AddCommentInput struct {
 }
 
 type Comment struct {
-	ID        int64
-	PostID    int64
-	ParentID  *int64
-	Author    string
-	Text      string
+	ID              int64
+	PostID          int64
+	ParentID        *int64
+	Author          string
+	Text            string
+	CreatedAt       string
+	EditedAt        *string
+	IsDeleted       bool
+	Upvotes         int32
+	Downvotes       int32
+	Depth           int32
+	Status          CommentStatus
+	RejectionReason *string
+	// PublishedSeq orders comments by when they were published rather than
+	// written: an approved comment gets the next value when it is approved.
+	// Resumed subscriptions replay by it. Zero while the comment was never
+	// published.
+	PublishedSeq int64
+	// SpamScore is what the spam classifier scored the comment when it was
+	// added, nil when the classifier could not score it then.
+	SpamScore *float64
+}
+
+// CommentStatus tracks a comment through pre-moderation. Only PUBLISHED
+// comments are listed, counted and announced to subscribers.
+type CommentStatus string
+
+const (
+	CommentStatusPublished CommentStatus = "PUBLISHED"
+	CommentStatusPending   CommentStatus = "PENDING"
+	CommentStatusRejected  CommentStatus = "REJECTED"
+)
+
+// CommentCount is the number of comments on one post.
+type CommentCount struct {
+	PostID int64
+	Count  int64
+}
+
+// ReplyCount is the number of direct replies to one comment.
+type ReplyCount struct {
+	ParentID int64
+	Count    int64
+}
+
+// ReplyParent is the comment a reply is attached to. MaxReplyDepth is the
+// post's own nesting limit, nil when the post uses the configured default.
+type ReplyParent struct {
+	PostID        int64
+	Depth         int32
+	MaxReplyDepth *int32
+}
+
+// ReplyDepthPolicy decides what happens to a reply that would nest deeper
+// than its post allows.
+type ReplyDepthPolicy string
+
+const (
+	ReplyDepthReject  ReplyDepthPolicy = "reject"
+	ReplyDepthFlatten ReplyDepthPolicy = "flatten"
+)
+
+// ThreadComment is one entry of a flattened comment thread. Depth is 0 for
+// the thread roots and Path holds the comment ids from the root down to the
+// comment itself.
+type ThreadComment struct {
+	Comment *Comment
+	Depth   int32
+	Path    []int64
+}
+
+type CommentOrder string
+
+const (
+	CommentOrderNewest        CommentOrder = "NEWEST"
+	CommentOrderOldest        CommentOrder = "OLDEST"
+	CommentOrderTop           CommentOrder = "TOP"
+	CommentOrderControversial CommentOrder = "CONTROVERSIAL"
+)
+
+// CommentCursor is a keyset position inside one comment ordering. NEWEST and
+// OLDEST sort by CreatedAt, TOP and CONTROVERSIAL by Score; ID breaks ties.
+type CommentCursor struct {
 	CreatedAt string
+	Score     int64
+	ID        int64
 }
 
 type CommentConnection struct {
@@ -27,26 +107,102 @@ type CommentEdge struct {
 	Node   *Comment
 }
 
+type CommentRevision struct {
+	ID        int64
+	CommentID int64
+	Text      string
+	CreatedAt string
+}
+
+type CommentRevisionConnection struct {
+	Edges      []*CommentRevisionEdge
+	PageInfo   *PageInfo
+	TotalCount int32
+}
+
+type CommentRevisionEdge struct {
+	Cursor string
+	Node   *CommentRevision
+}
+
 type CreatePostInput struct {
-	Title         string
-	Body          string
-	Author        string
-	AllowComments *bool
+	Title           string
+	Body            string
+	Author          string
+	AllowComments   *bool
+	CommentPolicy   *CommentPolicy
+	CommentsCloseAt *string
+	Tags            []string
+	MaxReplyDepth   *int32
+}
+
+type UpdatePostInput struct {
+	Title *string
+	Body  *string
 }
 
 type PageInfo struct {
-	EndCursor   *string
-	HasNextPage bool
+	StartCursor     *string
+	EndCursor       *string
+	HasPreviousPage bool
+	HasNextPage     bool
 }
 
 type Post struct {
-	ID            int64
-	Title         string
-	Body          string
-	Author        string
-	AllowComments bool
-	CreatedAt     string
-	Comments      *CommentConnection
+	ID              int64
+	Title           string
+	Body            string
+	Author          string
+	CommentPolicy   CommentPolicy
+	CommentsCloseAt *string
+	CreatedAt       string
+	DeletedAt       *string
+	Tags            []string
+	MaxReplyDepth   *int32
+	Comments        *CommentConnection
+}
+
+// CommentPolicy decides who may comment on a post. CommentsCloseAt closes a
+// post of any policy once it has passed.
+type CommentPolicy string
+
+const (
+	CommentPolicyOpen        CommentPolicy = "OPEN"
+	CommentPolicyClosed      CommentPolicy = "CLOSED"
+	CommentPolicyAuthorOnly  CommentPolicy = "AUTHOR_ONLY"
+	CommentPolicyRepliesOnly CommentPolicy = "REPLIES_ONLY"
+	CommentPolicyModerated   CommentPolicy = "MODERATED"
+)
+
+// CommentDenialReason is why the comment policy of a post turned a new
+// comment away.
+type CommentDenialReason string
+
+const (
+	CommentDeniedClosed      CommentDenialReason = "CLOSED"
+	CommentDeniedAutoClosed  CommentDenialReason = "AUTO_CLOSED"
+	CommentDeniedAuthorOnly  CommentDenialReason = "AUTHOR_ONLY"
+	CommentDeniedRepliesOnly CommentDenialReason = "REPLIES_ONLY"
+)
+
+// PostCommentPolicy is what the policy check of a new comment reads from its
+// post.
+type PostCommentPolicy struct {
+	Policy  CommentPolicy
+	CloseAt *string
+	Author  string
+}
+
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "ANY"
+	TagMatchAll TagMatch = "ALL"
+)
+
+type PostFilter struct {
+	Tags  []string
+	Match TagMatch
 }
 
 type PostConnection struct {
@@ -59,3 +215,194 @@ type PostEdge struct {
 	Cursor string
 	Node   *Post
 }
+
+type ReactionTarget string
+
+const (
+	ReactionTargetPost    ReactionTarget = "POST"
+	ReactionTargetComment ReactionTarget = "COMMENT"
+)
+
+const (
+	ReactionUpvote   = "up"
+	ReactionDownvote = "down"
+)
+
+type Reaction struct {
+	Target    ReactionTarget
+	TargetID  int64
+	Author    string
+	Kind      string
+	CreatedAt string
+}
+
+type ReactionCount struct {
+	TargetID int64
+	Kind     string
+	Count    int32
+}
+
+type ReactionSummary struct {
+	Upvotes   int32
+	Downvotes int32
+	Score     int32
+	Counts    []*ReactionCount
+}
+
+type SearchHit struct {
+	Rank    float64
+	Snippet string
+	Post    *Post
+	Comment *Comment
+}
+
+type SearchConnection struct {
+	Edges      []*SearchEdge
+	PageInfo   *PageInfo
+	TotalCount int32
+}
+
+type SearchEdge struct {
+	Cursor string
+	Node   *SearchHit
+}
+
+type EventType string
+
+const (
+	EventCommentAdded   EventType = "comment_added"
+	EventCommentUpdated EventType = "comment_updated"
+	EventCommentDeleted EventType = "comment_deleted"
+	EventPostUpdated    EventType = "post_updated"
+)
+
+type Event struct {
+	Type    EventType
+	PostID  int64
+	Comment *Comment
+	Post    *Post
+}
+
+// SpamStats are the token statistics the spam classifier learns from
+// moderation outcomes. Counts are in comments: a token counts once per comment
+// however often it repeats.
+type SpamStats struct {
+	SpamDocs int64
+	HamDocs  int64
+	Tokens   map[string]SpamTokenCount
+}
+
+type SpamTokenCount struct {
+	Spam int64
+	Ham  int64
+}
+
+// ReportTarget is the kind of content a report is about.
+type ReportTarget string
+
+const (
+	ReportTargetPost    ReportTarget = "POST"
+	ReportTargetComment ReportTarget = "COMMENT"
+)
+
+type ReportReason string
+
+const (
+	ReportReasonSpam     ReportReason = "SPAM"
+	ReportReasonAbuse    ReportReason = "ABUSE"
+	ReportReasonOffTopic ReportReason = "OFF_TOPIC"
+	ReportReasonOther    ReportReason = "OTHER"
+)
+
+type ReportStatus string
+
+const (
+	ReportStatusOpen     ReportStatus = "OPEN"
+	ReportStatusResolved ReportStatus = "RESOLVED"
+)
+
+// ReportAction is how a moderator resolves a report.
+type ReportAction string
+
+const (
+	ReportActionDismiss   ReportAction = "DISMISS"
+	ReportActionHide      ReportAction = "HIDE"
+	ReportActionDelete    ReportAction = "DELETE"
+	ReportActionBanAuthor ReportAction = "BAN_AUTHOR"
+)
+
+// Report is one reporter's complaint about a post or a comment. TargetAuthor
+// is read when the report is made, so the author can still be banned once the
+// target is hidden. TargetHidden is set on the open reports of a target that
+// was hidden automatically after too many reports.
+type Report struct {
+	ID           int64
+	Target       ReportTarget
+	TargetID     int64
+	TargetAuthor string
+	Reporter     string
+	// Caller is the host the report came from, or "" when it is unknown. It is
+	// only recorded; the report limits count reporters.
+	Caller       string
+	Reason       ReportReason
+	Details      *string
+	Status       ReportStatus
+	Action       *ReportAction
+	TargetHidden bool
+	CreatedAt    string
+	ResolvedAt   *string
+}
+
+type ReportConnection struct {
+	Edges      []*ReportEdge
+	PageInfo   *PageInfo
+	TotalCount int32
+}
+
+type ReportEdge struct {
+	Cursor string
+	Node   *Report
+}
+
+// AuditAction is what an audit log entry records: a report, a target hidden
+// automatically, or a moderator resolving a report.
+type AuditAction string
+
+const (
+	AuditActionReport    AuditAction = "REPORT"
+	AuditActionAutoHide  AuditAction = "AUTO_HIDE"
+	AuditActionDismiss   AuditAction = "DISMISS"
+	AuditActionHide      AuditAction = "HIDE"
+	AuditActionDelete    AuditAction = "DELETE"
+	AuditActionBanAuthor AuditAction = "BAN_AUTHOR"
+)
+
+// AuditEntry is one line of the moderation audit log. Actor is the reporter
+// for reports, AuditActorSystem for automatic actions and AuditActorModerator
+// for resolutions, as moderators share one token and have no name.
+type AuditEntry struct {
+	ID        int64
+	Actor     string
+	Action    AuditAction
+	Target    ReportTarget
+	TargetID  int64
+	ReportID  *int64
+	Details   *string
+	CreatedAt string
+}
+
+const (
+	AuditActorSystem    = "system"
+	AuditActorModerator = "moderator"
+)
+
+type AuditEntryConnection struct {
+	Edges      []*AuditEntryEdge
+	PageInfo   *PageInfo
+	TotalCount int32
+}
+
+type AuditEntryEdge struct {
+	Cursor string
+	Node   *AuditEntry
+}
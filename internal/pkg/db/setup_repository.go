@@ -10,24 +10,49 @@ import (
 
 	"github.com/Saracomethstein/ozon-test-task/internal/cfg"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	memBan "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/ban"
 	memComment "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/comment"
 	memPost "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/post"
+	memReaction "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/reaction"
+	memReport "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/report"
+	memSpam "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/spam"
+	memTx "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/tx"
+	pgBan "github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/ban"
 	pgComment "github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/comment"
 	pgPost "github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/post"
+	pgReaction "github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/reaction"
+	pgReport "github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/report"
+	pgSpam "github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/spam"
+	pgTx "github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/tx"
 )
 
 func NewPostgresContainer(db *pgxpool.Pool) *repository.Container {
 	rPost := pgPost.New(db)
 	rComment := pgComment.New(db)
+	rReaction := pgReaction.New(db)
+	rSpam := pgSpam.New(db)
+	rReport := pgReport.New(db)
+	rBan := pgBan.New(db)
 
-	return repository.New(rPost, rComment)
+	return repository.New(rPost, rComment, rReaction, rSpam, rReport, rBan, pgTx.New(db))
 }
 
-func NewInmemoryContainer() *repository.Container {
+// NewInmemoryContainer keeps everything in memory except the spam classifier
+// statistics, which are saved to spamModelFile when it is set.
+func NewInmemoryContainer(spamModelFile string) *repository.Container {
 	rPost := memPost.New()
 	rComment := memComment.New(rPost)
+	rReaction := memReaction.New(rPost, rComment)
 
-	return repository.New(rPost, rComment)
+	rSpam, err := memSpam.New(spamModelFile)
+	if err != nil {
+		log.Fatalf("Unable to load the spam model: %v", err)
+	}
+
+	rReport := memReport.New()
+	rBan := memBan.New()
+
+	return repository.New(rPost, rComment, rReaction, rSpam, rReport, rBan, memTx.New())
 }
 
 func SetupDB(config cfg.Config) *pgxpool.Pool {
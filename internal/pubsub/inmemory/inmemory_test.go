@@ -0,0 +1,110 @@
+package inmemory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func TestBroker_PublishSubscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fan_out_to_post_subscribers", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		b := New()
+		ch1, err := b.Subscribe(ctx, 1)
+		require.NoError(t, err)
+		ch2, err := b.Subscribe(ctx, 1)
+		require.NoError(t, err)
+		other, err := b.Subscribe(ctx, 2)
+		require.NoError(t, err)
+
+		require.NoError(t, b.Publish(ctx, commentAdded(models.Comment{ID: 10, PostID: 1, Text: "hello"})))
+
+		for _, ch := range []<-chan *models.Event{ch1, ch2} {
+			select {
+			case got := <-ch:
+				assert.Equal(t, models.EventCommentAdded, got.Type)
+				assert.Equal(t, int64(10), got.Comment.ID)
+				assert.Equal(t, "hello", got.Comment.Text)
+			case <-time.After(time.Second):
+				t.Fatal("event was not delivered")
+			}
+		}
+
+		select {
+		case got := <-other:
+			t.Fatalf("unexpected event for other post: %v", got)
+		default:
+		}
+	})
+
+	t.Run("subscribers_get_copies", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		b := New()
+		ch1, _ := b.Subscribe(ctx, 1)
+		ch2, _ := b.Subscribe(ctx, 1)
+
+		require.NoError(t, b.Publish(ctx, commentAdded(models.Comment{ID: 1, PostID: 1, Text: "original"})))
+
+		got1 := <-ch1
+		got1.Comment.Text = "changed"
+		got2 := <-ch2
+		assert.Equal(t, "original", got2.Comment.Text)
+	})
+
+	t.Run("cancel_closes_channel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		b := New()
+		ch, err := b.Subscribe(ctx, 1)
+		require.NoError(t, err)
+
+		cancel()
+
+		select {
+		case _, ok := <-ch:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("channel was not closed after cancel")
+		}
+
+		require.NoError(t, b.Publish(context.Background(), commentAdded(models.Comment{ID: 1, PostID: 1})))
+	})
+
+	t.Run("slow_subscriber_is_dropped", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		b := New()
+		ch, err := b.Subscribe(ctx, 1)
+		require.NoError(t, err)
+
+		for i := 0; i <= SubscriberBufferSize; i++ {
+			require.NoError(t, b.Publish(ctx, commentAdded(models.Comment{ID: int64(i + 1), PostID: 1})))
+		}
+
+		received := 0
+		for range ch {
+			received++
+		}
+		assert.Equal(t, SubscriberBufferSize, received)
+	})
+}
+
+func commentAdded(comment models.Comment) models.Event {
+	return models.Event{
+		Type:    models.EventCommentAdded,
+		PostID:  comment.PostID,
+		Comment: &comment,
+	}
+}
@@ -0,0 +1,27 @@
+package inmemory
+
+import (
+	"sync"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/pubsub"
+)
+
+const (
+	SubscriberBufferSize = 16
+)
+
+type subscriber struct {
+	ch chan *models.Event
+}
+
+type broker struct {
+	mu          sync.RWMutex
+	subscribers map[int64]map[*subscriber]struct{}
+}
+
+func New() pubsub.Broker {
+	return &broker{
+		subscribers: make(map[int64]map[*subscriber]struct{}),
+	}
+}
@@ -0,0 +1,45 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (b *broker) Publish(ctx context.Context, event models.Event) error {
+	b.mu.RLock()
+
+	var slow []*subscriber
+	for sub := range b.subscribers[event.PostID] {
+		select {
+		case sub.ch <- cloneEvent(event):
+		default:
+			slow = append(slow, sub)
+		}
+	}
+
+	b.mu.RUnlock()
+
+	// A subscriber whose buffer is full is dropped instead of silently losing
+	// events: its channel is closed, so the client sees the stream end.
+	for _, sub := range slow {
+		b.unsubscribe(event.PostID, sub)
+	}
+
+	return nil
+}
+
+func cloneEvent(event models.Event) *models.Event {
+	clone := event
+
+	if event.Comment != nil {
+		comment := *event.Comment
+		clone.Comment = &comment
+	}
+	if event.Post != nil {
+		post := *event.Post
+		clone.Post = &post
+	}
+
+	return &clone
+}
@@ -0,0 +1,47 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (b *broker) Subscribe(ctx context.Context, postID int64) (<-chan *models.Event, error) {
+	sub := &subscriber{
+		ch: make(chan *models.Event, SubscriberBufferSize),
+	}
+
+	b.mu.Lock()
+	if _, ok := b.subscribers[postID]; !ok {
+		b.subscribers[postID] = make(map[*subscriber]struct{})
+	}
+	b.subscribers[postID][sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(postID, sub)
+	}()
+
+	return sub.ch, nil
+}
+
+func (b *broker) unsubscribe(postID int64, sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subs, ok := b.subscribers[postID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(b.subscribers, postID)
+	}
+
+	close(sub.ch)
+}
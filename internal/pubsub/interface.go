@@ -0,0 +1,12 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+type Broker interface {
+	Publish(ctx context.Context, event models.Event) error
+	Subscribe(ctx context.Context, postID int64) (<-chan *models.Event, error)
+}
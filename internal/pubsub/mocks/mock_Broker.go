@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/Saracomethstein/ozon-test-task/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockBroker is an autogenerated mock type for the Broker type
+type MockBroker struct {
+	mock.Mock
+}
+
+type MockBroker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBroker) EXPECT() *MockBroker_Expecter {
+	return &MockBroker_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, event
+func (_m *MockBroker) Publish(ctx context.Context, event models.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBroker_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockBroker_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event models.Event
+func (_e *MockBroker_Expecter) Publish(ctx interface{}, event interface{}) *MockBroker_Publish_Call {
+	return &MockBroker_Publish_Call{Call: _e.mock.On("Publish", ctx, event)}
+}
+
+func (_c *MockBroker_Publish_Call) Run(run func(ctx context.Context, event models.Event)) *MockBroker_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Event))
+	})
+	return _c
+}
+
+func (_c *MockBroker_Publish_Call) Return(_a0 error) *MockBroker_Publish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroker_Publish_Call) RunAndReturn(run func(context.Context, models.Event) error) *MockBroker_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx, postID
+func (_m *MockBroker) Subscribe(ctx context.Context, postID int64) (<-chan *models.Event, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan *models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (<-chan *models.Event, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) <-chan *models.Event); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockBroker_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+func (_e *MockBroker_Expecter) Subscribe(ctx interface{}, postID interface{}) *MockBroker_Subscribe_Call {
+	return &MockBroker_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, postID)}
+}
+
+func (_c *MockBroker_Subscribe_Call) Run(run func(ctx context.Context, postID int64)) *MockBroker_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBroker_Subscribe_Call) Return(_a0 <-chan *models.Event, _a1 error) *MockBroker_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBroker_Subscribe_Call) RunAndReturn(run func(context.Context, int64) (<-chan *models.Event, error)) *MockBroker_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBroker creates a new instance of MockBroker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBroker {
+	mock := &MockBroker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
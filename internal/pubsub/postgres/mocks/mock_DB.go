@@ -0,0 +1,167 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgconn "github.com/jackc/pgconn"
+	mock "github.com/stretchr/testify/mock"
+
+	pgxpool "github.com/jackc/pgx/v4/pgxpool"
+)
+
+// MockDB is an autogenerated mock type for the DB type
+type MockDB struct {
+	mock.Mock
+}
+
+type MockDB_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDB) EXPECT() *MockDB_Expecter {
+	return &MockDB_Expecter{mock: &_m.Mock}
+}
+
+// Acquire provides a mock function with given fields: ctx
+func (_m *MockDB) Acquire(ctx context.Context) (*pgxpool.Conn, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acquire")
+	}
+
+	var r0 *pgxpool.Conn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*pgxpool.Conn, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *pgxpool.Conn); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pgxpool.Conn)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_Acquire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Acquire'
+type MockDB_Acquire_Call struct {
+	*mock.Call
+}
+
+// Acquire is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDB_Expecter) Acquire(ctx interface{}) *MockDB_Acquire_Call {
+	return &MockDB_Acquire_Call{Call: _e.mock.On("Acquire", ctx)}
+}
+
+func (_c *MockDB_Acquire_Call) Run(run func(ctx context.Context)) *MockDB_Acquire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDB_Acquire_Call) Return(_a0 *pgxpool.Conn, _a1 error) *MockDB_Acquire_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_Acquire_Call) RunAndReturn(run func(context.Context) (*pgxpool.Conn, error)) *MockDB_Acquire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exec provides a mock function with given fields: ctx, sql, args
+func (_m *MockDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sql)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 pgconn.CommandTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (pgconn.CommandTag, error)); ok {
+		return rf(ctx, sql, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) pgconn.CommandTag); ok {
+		r0 = rf(ctx, sql, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgconn.CommandTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+		r1 = rf(ctx, sql, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockDB_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sql string
+//   - args ...interface{}
+func (_e *MockDB_Expecter) Exec(ctx interface{}, sql interface{}, args ...interface{}) *MockDB_Exec_Call {
+	return &MockDB_Exec_Call{Call: _e.mock.On("Exec",
+		append([]interface{}{ctx, sql}, args...)...)}
+}
+
+func (_c *MockDB_Exec_Call) Run(run func(ctx context.Context, sql string, args ...interface{})) *MockDB_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDB_Exec_Call) Return(_a0 pgconn.CommandTag, _a1 error) *MockDB_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_Exec_Call) RunAndReturn(run func(context.Context, string, ...interface{}) (pgconn.CommandTag, error)) *MockDB_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDB creates a new instance of MockDB. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDB(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDB {
+	mock := &MockDB{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
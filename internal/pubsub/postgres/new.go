@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/pubsub"
+	"github.com/Saracomethstein/ozon-test-task/internal/pubsub/inmemory"
+)
+
+type broker struct {
+	db    DB
+	local pubsub.Broker
+}
+
+// New starts a dedicated listener connection taken from db and fans received
+// notifications out to local subscribers. The listener runs until ctx is done.
+func New(ctx context.Context, db DB) pubsub.Broker {
+	b := &broker{
+		db:    db,
+		local: inmemory.New(),
+	}
+
+	go b.listen(ctx)
+
+	return b
+}
+
+type DB interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
+}
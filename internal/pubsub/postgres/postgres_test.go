@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pashagolub/pgxmock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/pubsub/inmemory"
+)
+
+func TestBroker_Publish(t *testing.T) {
+	t.Parallel()
+
+	event := models.Event{
+		Type:   models.EventCommentAdded,
+		PostID: 1,
+		Comment: &models.Comment{
+			ID:        7,
+			PostID:    1,
+			Author:    "Alice",
+			Text:      "Hello",
+			CreatedAt: "2026-02-12T19:57:26Z",
+		},
+	}
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`select pg_notify\(\$1, \$2\)`).
+					WithArgs(eventsChannel, string(payload)).
+					WillReturnResult(pgxmock.NewResult("SELECT", 1))
+			},
+		},
+		{
+			name: "db_error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`select pg_notify\(\$1, \$2\)`).
+					WithArgs(eventsChannel, string(payload)).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			b := &broker{db: mock, local: inmemory.New()}
+			err = b.Publish(context.Background(), event)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBroker_PublishLargeEvent(t *testing.T) {
+	t.Parallel()
+
+	// Go escapes every '<' to a 6 byte \u003c, so a 2000 character comment
+	// grows to over 12000 bytes of JSON.
+	event := models.Event{
+		Type:    models.EventCommentAdded,
+		PostID:  1,
+		Comment: &models.Comment{ID: 7, PostID: 1, Text: strings.Repeat("<", 2000)},
+	}
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+	require.Greater(t, len(payload), 8000)
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec(`with pruned as \( delete from event_payloads .* \), stored as \( insert into event_payloads \(payload\) values \(\$2\) returning id \) select pg_notify\(\$1, json_build_object\('ref', id\)::text\) from stored`).
+		WithArgs(eventsChannel, string(payload)).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+	b := &broker{db: mock, local: inmemory.New()}
+	require.NoError(t, b.Publish(context.Background(), event))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDecodeNotification(t *testing.T) {
+	t.Parallel()
+
+	event := models.Event{
+		Type:    models.EventCommentAdded,
+		PostID:  1,
+		Comment: &models.Comment{ID: 7, PostID: 1, Text: strings.Repeat("<", 2000)},
+	}
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	t.Run("inline", func(t *testing.T) {
+		t.Parallel()
+
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		got, err := decodeNotification(context.Background(), mock, string(payload))
+
+		require.NoError(t, err)
+		assert.Equal(t, event, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("spilled", func(t *testing.T) {
+		t.Parallel()
+
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`select payload from event_payloads where id = \$1`).
+			WithArgs(int64(5)).
+			WillReturnRows(pgxmock.NewRows([]string{"payload"}).AddRow(string(payload)))
+
+		got, err := decodeNotification(context.Background(), mock, `{"ref" : 5}`)
+
+		require.NoError(t, err)
+		assert.Equal(t, event, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("spilled_payload_missing", func(t *testing.T) {
+		t.Parallel()
+
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`select payload from event_payloads`).
+			WithArgs(int64(5)).
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err = decodeNotification(context.Background(), mock, `{"ref" : 5}`)
+
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+}
+
+func TestBroker_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local := inmemory.New()
+	b := &broker{local: local}
+
+	ch, err := b.Subscribe(ctx, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, local.Publish(ctx, models.Event{
+		Type:   models.EventPostUpdated,
+		PostID: 1,
+		Post:   &models.Post{ID: 1},
+	}))
+
+	select {
+	case got := <-ch:
+		assert.Equal(t, models.EventPostUpdated, got.Type)
+		assert.Equal(t, int64(1), got.Post.ID)
+	case <-time.After(time.Second):
+		t.Fatal("event was not delivered")
+	}
+}
@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	eventsChannel = "post_events"
+
+	// maxNotifyPayload keeps notifications under the 8000 byte limit
+	// Postgres puts on a NOTIFY payload.
+	maxNotifyPayload = 7999
+
+	notifyQuery = `select pg_notify($1, $2)`
+
+	// Events too large for a notification are stored in event_payloads and
+	// the notification carries their key. Spilled payloads are only read
+	// right after the notification, so old ones are pruned on the way.
+	spillEventQuery = `
+		with pruned as (
+			delete from event_payloads where created_at < now() - interval '10 minutes'
+		), stored as (
+			insert into event_payloads (payload) values ($2) returning id
+		)
+		select pg_notify($1, json_build_object('ref', id)::text) from stored
+	`
+)
+
+func (b *broker) Publish(ctx context.Context, event models.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if len(payload) > maxNotifyPayload {
+		_, err = b.db.Exec(ctx, spillEventQuery, eventsChannel, string(payload))
+		return err
+	}
+
+	_, err = b.db.Exec(ctx, notifyQuery, eventsChannel, string(payload))
+
+	return err
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	getSpilledEventQuery = `select payload from event_payloads where id = $1`
+
+	minReconnectDelay = 500 * time.Millisecond
+	maxReconnectDelay = 30 * time.Second
+)
+
+func (b *broker) Subscribe(ctx context.Context, postID int64) (<-chan *models.Event, error) {
+	return b.local.Subscribe(ctx, postID)
+}
+
+func (b *broker) listen(ctx context.Context) {
+	delay := minReconnectDelay
+
+	for {
+		connected, err := b.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			delay = minReconnectDelay
+		}
+
+		log.Printf("event listener disconnected: %v, reconnecting in %s", err, delay)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+func (b *broker) listenOnce(ctx context.Context) (connected bool, err error) {
+	pooled, err := b.db.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// A LISTEN-ing connection must not be handed out to other queries,
+	// so it is taken out of the pool and closed by the listener itself.
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "listen "+pgx.Identifier{eventsChannel}.Sanitize()); err != nil {
+		return false, err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		event, err := decodeNotification(ctx, conn, notification.Payload)
+		if err != nil {
+			log.Printf("failed to decode event notification: %v", err)
+			continue
+		}
+
+		if err := b.local.Publish(ctx, event); err != nil {
+			log.Printf("failed to publish %s event for post %d: %v", event.Type, event.PostID, err)
+		}
+	}
+}
+
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// decodeNotification turns a notification payload back into the event,
+// loading it from event_payloads when Publish had to store it there.
+func decodeNotification(ctx context.Context, db rowQuerier, payload string) (models.Event, error) {
+	var ref struct {
+		Ref int64 `json:"ref"`
+	}
+	if err := json.Unmarshal([]byte(payload), &ref); err != nil {
+		return models.Event{}, err
+	}
+
+	if ref.Ref != 0 {
+		if err := db.QueryRow(ctx, getSpilledEventQuery, ref.Ref).Scan(&payload); err != nil {
+			return models.Event{}, err
+		}
+	}
+
+	var event models.Event
+	err := json.Unmarshal([]byte(payload), &event)
+
+	return event, err
+}
@@ -0,0 +1,8 @@
+package repository
+
+import "errors"
+
+// ErrNotFound is wrapped by the not-found errors of every backend, so callers
+// above the repositories can tell a missing row from a failed lookup without
+// knowing which backend is in use.
+var ErrNotFound = errors.New("not found")
@@ -0,0 +1,26 @@
+package ban
+
+import (
+	"context"
+)
+
+// Ban bans the author; banning an author twice keeps the first ban.
+func (r *ban) Ban(ctx context.Context, author, createdAt string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.banned[author]; !ok {
+		r.banned[author] = createdAt
+	}
+
+	return nil
+}
+
+func (r *ban) IsBanned(ctx context.Context, author string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.banned[author]
+
+	return ok, nil
+}
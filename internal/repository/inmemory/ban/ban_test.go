@@ -0,0 +1,31 @@
+package ban
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBanRepo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := New()
+
+	banned, err := repo.IsBanned(ctx, "Alice")
+	require.NoError(t, err)
+	assert.False(t, banned)
+
+	require.NoError(t, repo.Ban(ctx, "Alice", "2026-02-13T10:00:00Z"))
+	require.NoError(t, repo.Ban(ctx, "Alice", "2026-02-13T11:00:00Z"))
+
+	banned, err = repo.IsBanned(ctx, "Alice")
+	require.NoError(t, err)
+	assert.True(t, banned)
+
+	banned, err = repo.IsBanned(ctx, "Bob")
+	require.NoError(t, err)
+	assert.False(t, banned)
+}
@@ -0,0 +1,19 @@
+package ban
+
+import (
+	"sync"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+type ban struct {
+	mu sync.RWMutex
+	// banned maps an author to the time of the ban.
+	banned map[string]string
+}
+
+func New() repository.BanUC {
+	return &ban{
+		banned: make(map[string]string),
+	}
+}
@@ -2,6 +2,7 @@ package comment
 
 import (
 	"context"
+	"slices"
 
 	"github.com/pkg/errors"
 
@@ -24,26 +25,81 @@ func (r *comment) Add(ctx context.Context, comment models.Comment) (*models.Comm
 		Author:    comment.Author,
 		Text:      comment.Text,
 		CreatedAt: comment.CreatedAt,
+		Depth:     comment.Depth,
+		Status:    comment.Status,
+		SpamScore: comment.SpamScore,
 	}
 	r.comments[id] = &clone
-	r.byPost[clone.PostID] = append(r.byPost[clone.PostID], id)
 
-	parentKey := RootParent
-	if clone.ParentID != nil {
-		parentKey = *clone.ParentID
+	if clone.Status == models.CommentStatusPending {
+		r.pending = append(r.pending, id)
+	} else {
+		r.link(&clone)
 	}
-	r.byParent[parentKey] = append(r.byParent[parentKey], id)
 
 	return &clone, nil
 }
 
-func (r *comment) CheckAllowComments(ctx context.Context, postID int64) (bool, error) {
+// link adds a published comment to the indexes and counters. The caller holds
+// the write lock. The lists stay in id order, so an approved comment takes its
+// place among the replies written after it, as in postgres.
+func (r *comment) link(c *models.Comment) {
+	r.publishSeq++
+	c.PublishedSeq = r.publishSeq
+
+	r.index.Add(c.ID, c.Text)
+	r.byPost[c.PostID] = insertID(r.byPost[c.PostID], c.ID)
+
+	parentKey := RootParent
+	if c.ParentID != nil {
+		parentKey = *c.ParentID
+		r.replyCounts[parentKey]++
+	} else {
+		r.rootCounts[c.PostID]++
+	}
+	r.byParent[parentKey] = insertID(r.byParent[parentKey], c.ID)
+	r.commentCounts[c.PostID]++
+}
+
+// insertID adds id to the sorted ids. New comments have the highest id and
+// land at the end.
+func insertID(ids []int64, id int64) []int64 {
+	i, _ := slices.BinarySearch(ids, id)
+	return slices.Insert(ids, i, id)
+}
+
+// unlink takes a published comment out of the indexes and counters again. Its
+// replies stay where they are. The caller holds the write lock.
+func (r *comment) unlink(c *models.Comment) {
+	r.index.Remove(c.ID)
+	r.byPost[c.PostID] = slices.DeleteFunc(r.byPost[c.PostID], func(id int64) bool {
+		return id == c.ID
+	})
+
+	parentKey := RootParent
+	if c.ParentID != nil {
+		parentKey = *c.ParentID
+		r.replyCounts[parentKey]--
+	} else {
+		r.rootCounts[c.PostID]--
+	}
+	r.byParent[parentKey] = slices.DeleteFunc(r.byParent[parentKey], func(id int64) bool {
+		return id == c.ID
+	})
+	r.commentCounts[c.PostID]--
+}
+
+func (r *comment) GetCommentPolicy(ctx context.Context, postID int64) (*models.PostCommentPolicy, error) {
 	post, err := r.repoPost.GetByID(ctx, postID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return post.AllowComments, nil
+	return &models.PostCommentPolicy{
+		Policy:  post.CommentPolicy,
+		CloseAt: post.CommentsCloseAt,
+		Author:  post.Author,
+	}, nil
 }
 
 func (r *comment) CheckParentExists(ctx context.Context, parentID int64) (int64, error) {
@@ -51,9 +107,46 @@ func (r *comment) CheckParentExists(ctx context.Context, parentID int64) (int64,
 	defer r.mu.RUnlock()
 
 	parent, ok := r.comments[parentID]
-	if !ok {
+	if !ok || parent.IsDeleted || parent.Status != models.CommentStatusPublished {
 		return 0, errors.New("parent comment not found")
 	}
 
 	return parent.PostID, nil
 }
+
+func (r *comment) GetReplyParent(ctx context.Context, parentID int64) (*models.ReplyParent, error) {
+	r.mu.RLock()
+	parent, ok := r.comments[parentID]
+	if !ok || parent.IsDeleted || parent.Status != models.CommentStatusPublished {
+		r.mu.RUnlock()
+		return nil, errors.New("parent comment not found")
+	}
+	out := &models.ReplyParent{PostID: parent.PostID, Depth: parent.Depth}
+	r.mu.RUnlock()
+
+	post, err := r.repoPost.GetByID(ctx, out.PostID)
+	if err != nil {
+		return nil, err
+	}
+	out.MaxReplyDepth = post.MaxReplyDepth
+
+	return out, nil
+}
+
+// GetAncestorAt walks up from commentID to the ancestor stored at depth. The
+// walk only happens when a reply gets flattened, so its cost is bounded by how
+// far the reply overshoots the limit.
+func (r *comment) GetAncestorAt(ctx context.Context, commentID int64, depth int32) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.comments[commentID]
+	for ok && c.Depth > depth && c.ParentID != nil {
+		c, ok = r.comments[*c.ParentID]
+	}
+	if !ok || c.Depth != depth {
+		return 0, ErrCommentNotFound
+	}
+
+	return c.ID, nil
+}
@@ -0,0 +1,57 @@
+package comment
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// GetPublishedSeq returns the publish sequence of the comment a cursor names,
+// or of the newest comment published at or before the cursor when that
+// comment is gone.
+func (r *comment) GetPublishedSeq(ctx context.Context, postID int64, createdAt string, commentID int64) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if c, ok := r.comments[commentID]; ok && c.PostID == postID && c.PublishedSeq != 0 {
+		return c.PublishedSeq, nil
+	}
+
+	var seq int64
+	for _, id := range r.byPost[postID] {
+		c := r.comments[id]
+		if c.CreatedAt < createdAt || (c.CreatedAt == createdAt && c.ID <= commentID) {
+			seq = max(seq, c.PublishedSeq)
+		}
+	}
+
+	return seq, nil
+}
+
+func (r *comment) GetAddedAfter(ctx context.Context, postID int64, afterSeq int64, limit int32) ([]*models.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var added []*models.Comment
+	for _, id := range r.byPost[postID] {
+		c := r.comments[id]
+		if c.PublishedSeq > afterSeq {
+			added = append(added, c)
+		}
+	}
+
+	sort.Slice(added, func(i, j int) bool {
+		return added[i].PublishedSeq < added[j].PublishedSeq
+	})
+
+	endIdx := min(int(limit), len(added))
+
+	result := make([]*models.Comment, endIdx)
+	for i := 0; i < endIdx; i++ {
+		clone := *added[i]
+		result[i] = &clone
+	}
+
+	return result, nil
+}
@@ -0,0 +1,48 @@
+package comment
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *comment) GetByID(ctx context.Context, commentID int64) (*models.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.comments[commentID]
+	if !ok || c.Status != models.CommentStatusPublished {
+		return nil, ErrCommentNotFound
+	}
+
+	clone := *c
+	return &clone, nil
+}
+
+// GetAncestors walks the parent chain of commentID and returns it root first,
+// without the comment itself. Like the recursive query in postgres, an unknown
+// comment has no ancestors and held or rejected ancestors come back without
+// their author and text.
+func (r *comment) GetAncestors(ctx context.Context, commentID int64) ([]*models.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ancestors := make([]*models.Comment, 0)
+	c, ok := r.comments[commentID]
+	for ok && c.ParentID != nil {
+		if c, ok = r.comments[*c.ParentID]; !ok {
+			break
+		}
+
+		clone := *c
+		if clone.Status != models.CommentStatusPublished {
+			clone.Author = ""
+			clone.Text = ""
+		}
+		ancestors = append(ancestors, &clone)
+	}
+	slices.Reverse(ancestors)
+
+	return ancestors, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/post"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
 )
 
 func TestCommentRepo_Add(t *testing.T) {
@@ -20,7 +21,7 @@ func TestCommentRepo_Add(t *testing.T) {
 
 	t.Run("successful_add_root_comment", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 
 		comment := models.Comment{
 			PostID:    postID,
@@ -43,14 +44,14 @@ func TestCommentRepo_Add(t *testing.T) {
 		total, _ := repo.TotalCount(ctx, postID)
 		assert.Equal(t, int64(1), total)
 
-		roots, _ := repo.GetRootByPost(ctx, postID, nil, 0, 10)
+		roots, _ := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 10)
 		require.Len(t, roots, 1)
 		assert.Equal(t, got.ID, roots[0].ID)
 	})
 
 	t.Run("successful_add_child_comment", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 		parent := addComment(t, repo, postID, nil, "Parent", "Parent text", now)
 
 		child := models.Comment{
@@ -67,14 +68,14 @@ func TestCommentRepo_Add(t *testing.T) {
 		assert.Equal(t, int64(2), got.ID)
 		assert.Equal(t, parent.ID, *got.ParentID)
 
-		children, _ := repo.GetChild(ctx, parent.ID, nil, 0, 10)
+		children, _ := repo.GetChild(ctx, parent.ID, models.CommentOrderNewest, nil, 10)
 		require.Len(t, children, 1)
 		assert.Equal(t, got.ID, children[0].ID)
 	})
 
 	t.Run("multiple_adds_increment_id", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 
 		c1 := addComment(t, repo, postID, nil, "A", "text1", now)
 		c2 := addComment(t, repo, postID, nil, "B", "text2", now.Add(time.Hour))
@@ -84,38 +85,56 @@ func TestCommentRepo_Add(t *testing.T) {
 	})
 }
 
-func TestCommentRepo_CheckAllowComments(t *testing.T) {
+func TestCommentRepo_GetCommentPolicy(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	t.Run("post_allows_comments", func(t *testing.T) {
+	t.Run("open_post", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 
-		allow, err := repo.CheckAllowComments(ctx, postID)
+		got, err := repo.GetCommentPolicy(ctx, postID)
 
 		assert.NoError(t, err)
-		assert.True(t, allow)
+		assert.Equal(t, &models.PostCommentPolicy{Policy: models.CommentPolicyOpen, Author: "Tester"}, got)
 	})
 
-	t.Run("post_disallows_comments", func(t *testing.T) {
+	t.Run("closed_post_with_close_time", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, false)
+		postID := createTestPost(t, postRepo, models.CommentPolicyClosed)
+		closeAt := "2026-03-01T00:00:00Z"
+		_, err := postRepo.SetCommentPolicy(ctx, postID, models.CommentPolicyAuthorOnly, &closeAt)
+		require.NoError(t, err)
 
-		allow, err := repo.CheckAllowComments(ctx, postID)
+		got, err := repo.GetCommentPolicy(ctx, postID)
 
 		assert.NoError(t, err)
-		assert.False(t, allow)
+		assert.Equal(t, &models.PostCommentPolicy{
+			Policy:  models.CommentPolicyAuthorOnly,
+			CloseAt: &closeAt,
+			Author:  "Tester",
+		}, got)
 	})
 
 	t.Run("post_not_found", func(t *testing.T) {
 		repo, _ := setupCommentRepo(t)
 
-		allow, err := repo.CheckAllowComments(ctx, 999)
+		got, err := repo.GetCommentPolicy(ctx, 999)
+
+		assert.EqualError(t, err, "post not found")
+		assert.Nil(t, got)
+	})
+
+	t.Run("post_deleted", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		_, err := postRepo.Delete(ctx, postID, time.Now().Format(time.RFC3339))
+		require.NoError(t, err)
+
+		got, err := repo.GetCommentPolicy(ctx, postID)
 
-		assert.Error(t, err)
 		assert.EqualError(t, err, "post not found")
-		assert.False(t, allow)
+		assert.Nil(t, got)
 	})
 }
 
@@ -126,7 +145,7 @@ func TestCommentRepo_CheckParentExists(t *testing.T) {
 
 	t.Run("parent_exists", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 		parent := addComment(t, repo, postID, nil, "Parent", "text", now)
 
 		gotPostID, err := repo.CheckParentExists(ctx, parent.ID)
@@ -146,6 +165,266 @@ func TestCommentRepo_CheckParentExists(t *testing.T) {
 	})
 }
 
+func TestCommentRepo_GetReplyParent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("default_limit", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "A", "root", now)
+		reply, err := repo.Add(ctx, models.Comment{PostID: postID, ParentID: &root.ID, Author: "B", Text: "reply", Depth: 1, Status: models.CommentStatusPublished})
+		require.NoError(t, err)
+
+		got, err := repo.GetReplyParent(ctx, reply.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &models.ReplyParent{PostID: postID, Depth: 1}, got)
+	})
+
+	t.Run("post_limit", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		maxDepth := int32(3)
+		p, err := postRepo.Save(ctx, models.Post{Title: "T", Body: "B", Author: "A", MaxReplyDepth: &maxDepth})
+		require.NoError(t, err)
+		root := addComment(t, repo, p.ID, nil, "A", "root", now)
+
+		got, err := repo.GetReplyParent(ctx, root.ID)
+
+		require.NoError(t, err)
+		require.NotNil(t, got.MaxReplyDepth)
+		assert.Equal(t, maxDepth, *got.MaxReplyDepth)
+	})
+
+	t.Run("deleted_parent", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "A", "root", now)
+		_, err := repo.Delete(ctx, root.ID)
+		require.NoError(t, err)
+
+		got, err := repo.GetReplyParent(ctx, root.ID)
+
+		assert.EqualError(t, err, "parent comment not found")
+		assert.Nil(t, got)
+	})
+}
+
+func TestCommentRepo_GetAncestorAt(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo, postRepo := setupCommentRepo(t)
+	postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+
+	var chain []*models.Comment
+	var parentID *int64
+	for depth := int32(0); depth < 5; depth++ {
+		c, err := repo.Add(ctx, models.Comment{PostID: postID, ParentID: parentID, Author: "A", Text: "c", Depth: depth, Status: models.CommentStatusPublished})
+		require.NoError(t, err)
+		chain = append(chain, c)
+		parentID = &c.ID
+	}
+
+	t.Run("ancestor", func(t *testing.T) {
+		got, err := repo.GetAncestorAt(ctx, chain[4].ID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, chain[1].ID, got)
+	})
+
+	t.Run("self", func(t *testing.T) {
+		got, err := repo.GetAncestorAt(ctx, chain[2].ID, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, chain[2].ID, got)
+	})
+
+	t.Run("deeper_than_comment", func(t *testing.T) {
+		_, err := repo.GetAncestorAt(ctx, chain[1].ID, 3)
+
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+	})
+
+	t.Run("comment_not_found", func(t *testing.T) {
+		_, err := repo.GetAncestorAt(ctx, 999, 0)
+
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+	})
+}
+
+func TestCommentRepo_GetByID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	repo, postRepo := setupCommentRepo(t)
+	postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	c := addComment(t, repo, postID, nil, "Alice", "hello", now)
+
+	got, err := repo.GetByID(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, c, got)
+
+	_, err = repo.GetByID(ctx, 999)
+	assert.ErrorIs(t, err, ErrCommentNotFound)
+}
+
+func TestCommentRepo_GetByIDAnyStatus(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	repo, postRepo := setupCommentRepo(t)
+	postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	c := addComment(t, repo, postID, nil, "Alice", "hello", now)
+	_, err := repo.Hold(ctx, c.ID)
+	require.NoError(t, err)
+
+	got, err := repo.GetByIDAnyStatus(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CommentStatusPending, got.Status)
+
+	_, err = repo.GetByIDAnyStatus(ctx, 999)
+	assert.ErrorIs(t, err, ErrCommentNotFound)
+}
+
+func TestCommentRepo_GetSpamScore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo, postRepo := setupCommentRepo(t)
+	postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	score := 0.97
+	scored, err := repo.Add(ctx, models.Comment{PostID: postID, Author: "Alice", Text: "Casino", Status: models.CommentStatusPending, SpamScore: &score})
+	require.NoError(t, err)
+	unscored := addComment(t, repo, postID, nil, "Bob", "hello", time.Now().UTC())
+
+	got, err := repo.GetSpamScore(ctx, scored.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &score, got)
+
+	got, err = repo.GetSpamScore(ctx, unscored.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = repo.GetSpamScore(ctx, 999)
+	assert.ErrorIs(t, err, ErrCommentNotFound)
+}
+
+func TestCommentRepo_GetAncestors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	repo, postRepo := setupCommentRepo(t)
+	postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	root := addComment(t, repo, postID, nil, "A", "root", now)
+	mid := addComment(t, repo, postID, &root.ID, "B", "mid", now)
+	leaf := addComment(t, repo, postID, &mid.ID, "C", "leaf", now)
+
+	t.Run("root_first", func(t *testing.T) {
+		got, err := repo.GetAncestors(ctx, leaf.ID)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, root.ID, got[0].ID)
+		assert.Equal(t, mid.ID, got[1].ID)
+	})
+
+	t.Run("root_has_none", func(t *testing.T) {
+		got, err := repo.GetAncestors(ctx, root.ID)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("unknown_comment", func(t *testing.T) {
+		got, err := repo.GetAncestors(ctx, 999)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("held_ancestor_is_tombstone", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "A", "root", now)
+		mid := addComment(t, repo, postID, &root.ID, "B", "mid", now)
+		leaf := addComment(t, repo, postID, &mid.ID, "C", "leaf", now)
+		_, err := repo.Hold(ctx, mid.ID)
+		require.NoError(t, err)
+
+		got, err := repo.GetAncestors(ctx, leaf.ID)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, "root", got[0].Text)
+		assert.Equal(t, mid.ID, got[1].ID)
+		assert.Equal(t, models.CommentStatusPending, got[1].Status)
+		assert.Empty(t, got[1].Author)
+		assert.Empty(t, got[1].Text)
+	})
+}
+
+func TestCommentRepo_GetRootBatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	repo, postRepo := setupCommentRepo(t)
+	first := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	second := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	empty := createTestPost(t, postRepo, models.CommentPolicyOpen)
+
+	a1 := addComment(t, repo, first, nil, "A", "a1", now)
+	a2 := addComment(t, repo, first, nil, "A", "a2", now.Add(time.Minute))
+	a3 := addComment(t, repo, first, nil, "A", "a3", now.Add(2*time.Minute))
+	addComment(t, repo, first, &a3.ID, "B", "reply", now.Add(3*time.Minute))
+	b1 := addComment(t, repo, second, nil, "B", "b1", now)
+
+	t.Run("limit_per_post", func(t *testing.T) {
+		got, err := repo.GetRootBatch(ctx, []int64{first, second, empty}, models.CommentOrderNewest, nil, false, 2)
+
+		require.NoError(t, err)
+		ids := make([]int64, len(got))
+		for i, c := range got {
+			ids[i] = c.ID
+		}
+		assert.Equal(t, []int64{a3.ID, a2.ID, b1.ID}, ids)
+	})
+
+	t.Run("after_cursor", func(t *testing.T) {
+		after := cursor.Position(models.CommentOrderOldest, a1)
+
+		got, err := repo.GetRootBatch(ctx, []int64{first}, models.CommentOrderOldest, &after, false, 10)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, a2.ID, got[0].ID)
+		assert.Equal(t, a3.ID, got[1].ID)
+	})
+}
+
+func TestCommentRepo_TotalCountBatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	repo, postRepo := setupCommentRepo(t)
+	first := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	second := createTestPost(t, postRepo, models.CommentPolicyOpen)
+
+	root := addComment(t, repo, first, nil, "A", "root", now)
+	addComment(t, repo, first, &root.ID, "B", "reply", now)
+
+	got, err := repo.TotalCountBatch(ctx, []int64{first, second})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*models.CommentCount{{PostID: first, Count: 2}}, got)
+}
+
 func TestCommentRepo_TotalCount(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -153,7 +432,7 @@ func TestCommentRepo_TotalCount(t *testing.T) {
 
 	t.Run("no_comments_for_post", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 
 		count, err := repo.TotalCount(ctx, postID)
 
@@ -163,7 +442,7 @@ func TestCommentRepo_TotalCount(t *testing.T) {
 
 	t.Run("with_comments", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 		addComment(t, repo, postID, nil, "A", "text1", now)
 		addComment(t, repo, postID, nil, "B", "text2", now.Add(time.Hour))
 
@@ -172,6 +451,18 @@ func TestCommentRepo_TotalCount(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), count)
 	})
+
+	t.Run("replies_not_counted", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "A", "root", now)
+		addComment(t, repo, postID, &root.ID, "B", "reply", now)
+
+		count, err := repo.TotalCount(ctx, postID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
 }
 
 func TestCommentRepo_GetRootByPost(t *testing.T) {
@@ -182,7 +473,7 @@ func TestCommentRepo_GetRootByPost(t *testing.T) {
 
 	setupWithRoots := func(t *testing.T) (repository.CommentUC, int64) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 
 		addComment(t, repo, postID, nil, "Root1", "text1", fixedTime)
 		addComment(t, repo, postID, nil, "Root2", "text2", fixedTime.Add(-1*time.Hour))
@@ -197,7 +488,7 @@ func TestCommentRepo_GetRootByPost(t *testing.T) {
 		repo, postID := setupWithRoots(t)
 		limit := int32(2)
 
-		got, err := repo.GetRootByPost(ctx, postID, nil, 0, limit)
+		got, err := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, limit)
 
 		require.NoError(t, err)
 		require.Len(t, got, 2)
@@ -215,7 +506,7 @@ func TestCommentRepo_GetRootByPost(t *testing.T) {
 		afterID := int64(2)
 		limit := int32(2)
 
-		got, err := repo.GetRootByPost(ctx, postID, &afterCreatedAt, afterID, limit)
+		got, err := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID}, false, limit)
 
 		require.NoError(t, err)
 		require.Len(t, got, 2)
@@ -224,11 +515,26 @@ func TestCommentRepo_GetRootByPost(t *testing.T) {
 		assert.Equal(t, fixedTime.Add(-2*time.Hour).Format(time.RFC3339), got[0].CreatedAt)
 	})
 
+	t.Run("backward_before_cursor", func(t *testing.T) {
+		repo, postID := setupWithRoots(t)
+		before := &models.CommentCursor{CreatedAt: fixedTime.Add(-2 * time.Hour).Format(time.RFC3339), ID: 3}
+
+		got, err := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, before, true, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, idsOf(got))
+
+		got, err = repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, true, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 4}, idsOf(got))
+	})
+
 	t.Run("post_with_no comments", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 
-		got, err := repo.GetRootByPost(ctx, postID, nil, 0, 10)
+		got, err := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 10)
 
 		require.NoError(t, err)
 		assert.Empty(t, got)
@@ -236,15 +542,182 @@ func TestCommentRepo_GetRootByPost(t *testing.T) {
 
 	t.Run("returns_copies", func(t *testing.T) {
 		repo, postID := setupWithRoots(t)
-		got, _ := repo.GetRootByPost(ctx, postID, nil, 0, 1)
+		got, _ := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 1)
 		require.Len(t, got, 1)
 		got[0].Author = "Hacked"
 
-		original, _ := repo.GetRootByPost(ctx, postID, nil, 0, 10)
+		original, _ := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 10)
 		assert.Equal(t, "Root1", original[0].Author)
 	})
 }
 
+func TestCommentRepo_Orderings(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	fixedTime, _ := time.Parse(time.RFC3339, "2023-01-01T12:00:00Z")
+
+	repo, postRepo := setupCommentRepo(t)
+	postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	parent := addComment(t, repo, postID, nil, "Parent", "parent", fixedTime.Add(-time.Hour))
+
+	votes := []struct{ up, down int32 }{{1, 0}, {4, 3}, {0, 2}, {5, 0}}
+	for i, v := range votes {
+		createdAt := fixedTime.Add(time.Duration(i) * time.Minute)
+		root := addComment(t, repo, postID, nil, "Root", "root", createdAt)
+		child := addComment(t, repo, postID, &parent.ID, "Child", "child", createdAt)
+
+		repo.(*comment).SetVotes(root.ID, v.up, v.down)
+		repo.(*comment).SetVotes(child.ID, v.up, v.down)
+	}
+
+	tests := []struct {
+		order    models.CommentOrder
+		wantRoot []int64
+	}{
+		{order: models.CommentOrderNewest, wantRoot: []int64{8, 6, 4, 2, 1}},
+		{order: models.CommentOrderOldest, wantRoot: []int64{1, 2, 4, 6, 8}},
+		{order: models.CommentOrderTop, wantRoot: []int64{8, 4, 2, 1, 6}},
+		{order: models.CommentOrderControversial, wantRoot: []int64{4, 8, 6, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.order), func(t *testing.T) {
+			var got []int64
+			var after *models.CommentCursor
+			for {
+				page, err := repo.GetRootByPost(ctx, postID, tt.order, after, false, 2)
+				require.NoError(t, err)
+				if len(page) == 0 {
+					break
+				}
+
+				for _, c := range page {
+					got = append(got, c.ID)
+				}
+				last := cursor.Position(tt.order, page[len(page)-1])
+				after = &last
+			}
+
+			assert.Equal(t, tt.wantRoot, got)
+
+			// paging backward from the end walks the same ordering in reverse
+			var back []int64
+			var before *models.CommentCursor
+			for {
+				page, err := repo.GetRootByPost(ctx, postID, tt.order, before, true, 2)
+				require.NoError(t, err)
+				if len(page) == 0 {
+					break
+				}
+
+				back = append(append([]int64{}, idsOf(page)...), back...)
+				first := cursor.Position(tt.order, page[0])
+				before = &first
+			}
+
+			assert.Equal(t, tt.wantRoot, back)
+
+			children, err := repo.GetChildBatch(ctx, []int64{parent.ID}, tt.order, nil, false, 100)
+			require.NoError(t, err)
+
+			var childIDs []int64
+			for _, c := range children {
+				childIDs = append(childIDs, c.ID)
+			}
+			// children mirror the roots: each one was added right after its root
+			wantChildren := make([]int64, 0, len(tt.wantRoot))
+			for _, id := range tt.wantRoot {
+				if id != parent.ID {
+					wantChildren = append(wantChildren, id+1)
+				}
+			}
+			assert.Equal(t, wantChildren, childIDs)
+		})
+	}
+}
+
+func TestCommentRepo_GetThread(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// 1
+	// ├── 2
+	// │   └── 4
+	// │       └── 6
+	// └── 3
+	// 5
+	setupThread := func(t *testing.T) (repository.CommentUC, int64) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		otherPostID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+
+		root := addComment(t, repo, postID, nil, "A", "1", now)
+		reply := addComment(t, repo, postID, &root.ID, "B", "2", now)
+		addComment(t, repo, postID, &root.ID, "C", "3", now)
+		nested := addComment(t, repo, postID, &reply.ID, "D", "4", now)
+		addComment(t, repo, postID, nil, "E", "5", now)
+		addComment(t, repo, postID, &nested.ID, "F", "6", now)
+		addComment(t, repo, otherPostID, nil, "G", "other post", now)
+
+		return repo, postID
+	}
+
+	flatten := func(thread []*models.ThreadComment) (ids []int64, depths []int32) {
+		for _, entry := range thread {
+			ids = append(ids, entry.Comment.ID)
+			depths = append(depths, entry.Depth)
+		}
+		return ids, depths
+	}
+
+	t.Run("whole_post_depth_first", func(t *testing.T) {
+		repo, postID := setupThread(t)
+
+		got, err := repo.GetThread(ctx, postID, nil, 10, 10)
+
+		require.NoError(t, err)
+		ids, depths := flatten(got)
+		assert.Equal(t, []int64{1, 2, 4, 6, 3, 5}, ids)
+		assert.Equal(t, []int32{0, 1, 2, 3, 1, 0}, depths)
+		assert.Equal(t, []int64{1, 2, 4, 6}, got[3].Path)
+	})
+
+	t.Run("subtree_with_depth_limit", func(t *testing.T) {
+		repo, postID := setupThread(t)
+		rootID := int64(2)
+
+		got, err := repo.GetThread(ctx, postID, &rootID, 1, 10)
+
+		require.NoError(t, err)
+		ids, depths := flatten(got)
+		assert.Equal(t, []int64{2, 4}, ids)
+		assert.Equal(t, []int32{0, 1}, depths)
+		assert.Equal(t, []int64{2, 4}, got[1].Path)
+	})
+
+	t.Run("per_level_limit", func(t *testing.T) {
+		repo, postID := setupThread(t)
+
+		got, err := repo.GetThread(ctx, postID, nil, 10, 1)
+
+		require.NoError(t, err)
+		ids, _ := flatten(got)
+		assert.Equal(t, []int64{1, 2, 4, 6}, ids)
+	})
+
+	t.Run("root_of_other_post", func(t *testing.T) {
+		repo, postID := setupThread(t)
+		rootID := int64(7)
+
+		got, err := repo.GetThread(ctx, postID, &rootID, 10, 10)
+
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+		assert.Nil(t, got)
+	})
+}
+
 func TestCommentRepo_GetChild(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -252,7 +725,7 @@ func TestCommentRepo_GetChild(t *testing.T) {
 
 	setupWithChildren := func(t *testing.T) (repository.CommentUC, int64, int64) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 
 		parent := addComment(t, repo, postID, nil, "Parent", "parent", now.Add(-1*time.Hour))
 
@@ -267,7 +740,7 @@ func TestCommentRepo_GetChild(t *testing.T) {
 		repo, _, parentID := setupWithChildren(t)
 		limit := int32(2)
 
-		got, err := repo.GetChild(ctx, parentID, nil, 0, limit)
+		got, err := repo.GetChild(ctx, parentID, models.CommentOrderNewest, nil, limit)
 
 		require.NoError(t, err)
 		require.Len(t, got, 2)
@@ -281,7 +754,7 @@ func TestCommentRepo_GetChild(t *testing.T) {
 		afterID := int64(3)
 		limit := int32(2)
 
-		got, err := repo.GetChild(ctx, parentID, &afterCreatedAt, afterID, limit)
+		got, err := repo.GetChild(ctx, parentID, models.CommentOrderNewest, &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID}, limit)
 
 		require.NoError(t, err)
 		require.Len(t, got, 1)
@@ -290,10 +763,10 @@ func TestCommentRepo_GetChild(t *testing.T) {
 
 	t.Run("parent_with_no_children", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 		parent := addComment(t, repo, postID, nil, "Parent", "text", now)
 
-		got, err := repo.GetChild(ctx, parent.ID, nil, 0, 10)
+		got, err := repo.GetChild(ctx, parent.ID, models.CommentOrderNewest, nil, 10)
 
 		require.NoError(t, err)
 		assert.Empty(t, got)
@@ -307,7 +780,7 @@ func TestCommentRepo_GetChildBatch(t *testing.T) {
 
 	setupForBatch := func(t *testing.T) (repository.CommentUC, []int64) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 
 		parent1 := addComment(t, repo, postID, nil, "Parent1", "p1", now.Add(-2*time.Hour))
 		parent2 := addComment(t, repo, postID, nil, "Parent2", "p2", now.Add(-3*time.Hour))
@@ -322,7 +795,7 @@ func TestCommentRepo_GetChildBatch(t *testing.T) {
 	t.Run("successful_batch", func(t *testing.T) {
 		repo, parentIDs := setupForBatch(t)
 
-		got, err := repo.GetChildBatch(ctx, parentIDs)
+		got, err := repo.GetChildBatch(ctx, parentIDs, models.CommentOrderNewest, nil, false, 100)
 
 		require.NoError(t, err)
 		require.Len(t, got, 3)
@@ -338,11 +811,11 @@ func TestCommentRepo_GetChildBatch(t *testing.T) {
 
 	t.Run("some_parents_have_no_children", func(t *testing.T) {
 		repo, postRepo := setupCommentRepo(t)
-		postID := createTestPost(t, postRepo, true)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
 		parent1 := addComment(t, repo, postID, nil, "Parent1", "p1", now)
 		parent2 := addComment(t, repo, postID, nil, "Parent2", "p2", now)
 
-		got, err := repo.GetChildBatch(ctx, []int64{parent1.ID, parent2.ID})
+		got, err := repo.GetChildBatch(ctx, []int64{parent1.ID, parent2.ID}, models.CommentOrderNewest, nil, false, 100)
 
 		require.NoError(t, err)
 		assert.Empty(t, got)
@@ -351,52 +824,724 @@ func TestCommentRepo_GetChildBatch(t *testing.T) {
 	t.Run("empty_parentIDs_slice", func(t *testing.T) {
 		repo, _ := setupCommentRepo(t)
 
-		got, err := repo.GetChildBatch(ctx, []int64{})
+		got, err := repo.GetChildBatch(ctx, []int64{}, models.CommentOrderNewest, nil, false, 100)
 
 		require.NoError(t, err)
 		assert.Empty(t, got)
 	})
 
+	t.Run("limit_and_cursor_apply_per_parent", func(t *testing.T) {
+		repo, parentIDs := setupForBatch(t)
+
+		got, err := repo.GetChildBatch(ctx, parentIDs, models.CommentOrderNewest, nil, false, 1)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, int64(3), got[0].ID)
+		assert.Equal(t, int64(5), got[1].ID)
+
+		after := cursor.Position(models.CommentOrderNewest, got[0])
+		got, err = repo.GetChildBatch(ctx, parentIDs[:1], models.CommentOrderNewest, &after, false, 10)
+
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, int64(4), got[0].ID)
+	})
+
+	t.Run("backward_applies_per_parent", func(t *testing.T) {
+		repo, parentIDs := setupForBatch(t)
+
+		got, err := repo.GetChildBatch(ctx, parentIDs, models.CommentOrderNewest, nil, true, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{4, 5}, idsOf(got))
+	})
+
 	t.Run("returns_copies", func(t *testing.T) {
 		repo, parentIDs := setupForBatch(t)
-		got, _ := repo.GetChildBatch(ctx, parentIDs)
+		got, _ := repo.GetChildBatch(ctx, parentIDs, models.CommentOrderNewest, nil, false, 100)
 		require.NotEmpty(t, got)
 		got[0].Author = "Hacked"
 
-		original, _ := repo.GetChild(ctx, parentIDs[0], nil, 0, 10)
+		original, _ := repo.GetChild(ctx, parentIDs[0], models.CommentOrderNewest, nil, 10)
 		assert.Equal(t, "Child1A", original[0].Author)
 	})
 }
 
-func setupCommentRepo(t *testing.T) (repository.CommentUC, repository.PostUC) {
-	t.Helper()
-	postRepo := post.New()
-	commentRepo := New(postRepo)
-	return commentRepo, postRepo
-}
+func TestCommentRepo_ReplyCountBatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now()
+
+	repo, postRepo := setupCommentRepo(t)
+	postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+	parent1 := addComment(t, repo, postID, nil, "Parent1", "p1", now)
+	parent2 := addComment(t, repo, postID, nil, "Parent2", "p2", now)
+	child := addComment(t, repo, postID, &parent1.ID, "Child1A", "a", now)
+	addComment(t, repo, postID, &parent1.ID, "Child1B", "b", now)
+	addComment(t, repo, postID, &child.ID, "Grandchild", "g", now)
+
+	got, err := repo.ReplyCountBatch(ctx, []int64{parent1.ID, parent2.ID, 999})
 
-func createTestPost(t *testing.T, postRepo repository.PostUC, allowComments bool) int64 {
-	t.Helper()
-	p, err := postRepo.Save(context.Background(), models.Post{
-		Title:         "Test Post",
-		Body:          "Content",
-		Author:        "Tester",
-		AllowComments: allowComments,
-		CreatedAt:     time.Now().Format(time.RFC3339),
-	})
 	require.NoError(t, err)
-	return p.ID
+	require.Len(t, got, 1)
+	assert.Equal(t, parent1.ID, got[0].ParentID)
+	assert.Equal(t, int64(2), got[0].Count)
 }
 
-func addComment(t *testing.T, repo repository.CommentUC, postID int64, parentID *int64, author, text string, createdAt time.Time) *models.Comment {
-	t.Helper()
-	c, err := repo.Add(context.Background(), models.Comment{
-		PostID:    postID,
-		ParentID:  parentID,
-		Author:    author,
-		Text:      text,
-		CreatedAt: createdAt.Format(time.RFC3339),
-	})
-	require.NoError(t, err)
-	return c
+func TestCommentRepo_GetAddedAfter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("returns_all_levels_in_publish_order", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		otherPostID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+
+		old := addComment(t, repo, postID, nil, "Old", "old", now.Add(-2*time.Hour))
+		root := addComment(t, repo, postID, nil, "Root", "root", now.Add(-1*time.Hour))
+		reply := addComment(t, repo, postID, &root.ID, "Reply", "reply", now)
+		addComment(t, repo, otherPostID, nil, "Other", "other", now)
+
+		got, err := repo.GetAddedAfter(ctx, postID, old.PublishedSeq, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{root.ID, reply.ID}, idsOf(got))
+	})
+
+	t.Run("approved_comment_comes_after_newer_ones", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyModerated)
+
+		held, err := repo.Add(ctx, models.Comment{
+			PostID:    postID,
+			Author:    "Alice",
+			Text:      "Held",
+			CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339),
+			Status:    models.CommentStatusPending,
+		})
+		require.NoError(t, err)
+		assert.Zero(t, held.PublishedSeq)
+		newer := addComment(t, repo, postID, nil, "Newer", "newer", now)
+
+		approved, err := repo.Approve(ctx, held.ID)
+		require.NoError(t, err)
+		assert.Greater(t, approved.PublishedSeq, newer.PublishedSeq)
+
+		got, err := repo.GetAddedAfter(ctx, postID, newer.PublishedSeq, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{held.ID}, idsOf(got))
+	})
+
+	t.Run("respects_limit", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+
+		first := addComment(t, repo, postID, nil, "A", "a", now.Add(-2*time.Hour))
+		addComment(t, repo, postID, nil, "B", "b", now.Add(-1*time.Hour))
+		addComment(t, repo, postID, nil, "C", "c", now)
+
+		got, err := repo.GetAddedAfter(ctx, postID, 0, 2)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, first.ID, got[0].ID)
+	})
+
+	t.Run("post_with_no_comments", func(t *testing.T) {
+		repo, _ := setupCommentRepo(t)
+
+		got, err := repo.GetAddedAfter(ctx, 999, 0, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func TestCommentRepo_GetPublishedSeq(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("cursor_comment", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		first := addComment(t, repo, postID, nil, "A", "a", now)
+		addComment(t, repo, postID, nil, "B", "b", now)
+
+		seq, err := repo.GetPublishedSeq(ctx, postID, first.CreatedAt, first.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, first.PublishedSeq, seq)
+	})
+
+	t.Run("purged_cursor_comment", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		first := addComment(t, repo, postID, nil, "A", "a", now.Add(-time.Hour))
+		purged := addComment(t, repo, postID, nil, "B", "b", now)
+		addComment(t, repo, postID, nil, "C", "c", now.Add(time.Hour))
+
+		_, err := repo.Purge(ctx, purged.ID)
+		require.NoError(t, err)
+
+		seq, err := repo.GetPublishedSeq(ctx, postID, purged.CreatedAt, purged.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, first.PublishedSeq, seq)
+	})
+
+	t.Run("unknown_post", func(t *testing.T) {
+		repo, _ := setupCommentRepo(t)
+
+		seq, err := repo.GetPublishedSeq(ctx, 999, now.Format(time.RFC3339), 1)
+
+		require.NoError(t, err)
+		assert.Zero(t, seq)
+	})
+}
+
+func TestCommentRepo_EditRevisions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	base := time.Date(2026, 2, 12, 19, 0, 0, 0, time.UTC)
+
+	t.Run("edit_records_revision", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		c := addComment(t, repo, postID, nil, "Alice", "Frist", base)
+
+		editedAt := base.Add(time.Minute).Format(time.RFC3339)
+		edited, err := repo.Edit(ctx, c.ID, "First", editedAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, "First", edited.Text)
+		require.NotNil(t, edited.EditedAt)
+		assert.Equal(t, editedAt, *edited.EditedAt)
+
+		revisions, err := repo.GetRevisions(ctx, c.ID, nil, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		assert.Equal(t, "Frist", revisions[0].Text)
+		assert.Equal(t, editedAt, revisions[0].CreatedAt)
+		assert.Equal(t, c.ID, revisions[0].CommentID)
+
+		roots, _ := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 10)
+		require.Len(t, roots, 1)
+		assert.Equal(t, "First", roots[0].Text)
+	})
+
+	t.Run("revisions_paginate_newest_first", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		c := addComment(t, repo, postID, nil, "Alice", "v1", base)
+
+		for i, text := range []string{"v2", "v3", "v4"} {
+			_, err := repo.Edit(ctx, c.ID, text, base.Add(time.Duration(i+1)*time.Minute).Format(time.RFC3339))
+			require.NoError(t, err)
+		}
+
+		page, err := repo.GetRevisions(ctx, c.ID, nil, 0, 2)
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, "v3", page[0].Text)
+		assert.Equal(t, "v2", page[1].Text)
+
+		last := page[1]
+		page, err = repo.GetRevisions(ctx, c.ID, &last.CreatedAt, last.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, "v1", page[0].Text)
+
+		count, err := repo.RevisionCount(ctx, c.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("comment_not_found", func(t *testing.T) {
+		repo, _ := setupCommentRepo(t)
+
+		edited, err := repo.Edit(ctx, 999, "text", base.Format(time.RFC3339))
+
+		assert.EqualError(t, err, "comment not found")
+		assert.Nil(t, edited)
+	})
+}
+
+func TestCommentRepo_Delete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("tombstone_keeps_children", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		parent := addComment(t, repo, postID, nil, "Alice", "Parent", now.Add(-time.Hour))
+		child := addComment(t, repo, postID, &parent.ID, "Bob", "Child", now)
+		_, err := repo.Edit(ctx, parent.ID, "Parent!", now.Format(time.RFC3339))
+		require.NoError(t, err)
+
+		deleted, err := repo.Delete(ctx, parent.ID)
+
+		require.NoError(t, err)
+		assert.True(t, deleted.IsDeleted)
+		assert.Empty(t, deleted.Author)
+		assert.Empty(t, deleted.Text)
+
+		roots, _ := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 10)
+		require.Len(t, roots, 1)
+		assert.True(t, roots[0].IsDeleted)
+
+		children, _ := repo.GetChildBatch(ctx, []int64{parent.ID}, models.CommentOrderNewest, nil, false, 100)
+		require.Len(t, children, 1)
+		assert.Equal(t, child.ID, children[0].ID)
+
+		revisions, _ := repo.GetRevisions(ctx, parent.ID, nil, 0, 10)
+		assert.Empty(t, revisions)
+	})
+
+	t.Run("tombstone_rejects_edits_and_replies", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		c := addComment(t, repo, postID, nil, "Alice", "Text", now)
+		_, err := repo.Delete(ctx, c.ID)
+		require.NoError(t, err)
+
+		_, err = repo.Edit(ctx, c.ID, "Back", now.Format(time.RFC3339))
+		assert.EqualError(t, err, "comment not found")
+
+		_, err = repo.Delete(ctx, c.ID)
+		assert.EqualError(t, err, "comment not found")
+
+		_, err = repo.CheckParentExists(ctx, c.ID)
+		assert.EqualError(t, err, "parent comment not found")
+	})
+}
+
+func TestCommentRepo_Purge(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("removes_subtree", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "Alice", "Root", now.Add(-3*time.Hour))
+		keep := addComment(t, repo, postID, nil, "Carol", "Keep", now.Add(-2*time.Hour))
+		child := addComment(t, repo, postID, &root.ID, "Bob", "Child", now.Add(-time.Hour))
+		grandchild := addComment(t, repo, postID, &child.ID, "Dan", "Grandchild", now)
+
+		purged, err := repo.Purge(ctx, root.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*models.Comment{
+			{ID: root.ID, PostID: postID, Status: models.CommentStatusPublished},
+			{ID: child.ID, PostID: postID, Status: models.CommentStatusPublished},
+			{ID: grandchild.ID, PostID: postID, Status: models.CommentStatusPublished},
+		}, purged)
+
+		roots, _ := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 10)
+		require.Len(t, roots, 1)
+		assert.Equal(t, keep.ID, roots[0].ID)
+
+		added, _ := repo.GetAddedAfter(ctx, postID, 0, 10)
+		require.Len(t, added, 1)
+		assert.Equal(t, keep.ID, added[0].ID)
+
+		_, err = repo.CheckParentExists(ctx, child.ID)
+		assert.EqualError(t, err, "parent comment not found")
+
+		total, _ := repo.TotalCount(ctx, postID)
+		assert.Equal(t, int64(1), total)
+
+		counts, _ := repo.TotalCountBatch(ctx, []int64{postID})
+		assert.Equal(t, []*models.CommentCount{{PostID: postID, Count: 1}}, counts)
+	})
+
+	t.Run("purge_reply_detaches_from_parent", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "Alice", "Root", now.Add(-time.Hour))
+		child := addComment(t, repo, postID, &root.ID, "Bob", "Child", now)
+
+		_, err := repo.Purge(ctx, child.ID)
+		require.NoError(t, err)
+
+		children, _ := repo.GetChild(ctx, root.ID, models.CommentOrderNewest, nil, 10)
+		assert.Empty(t, children)
+
+		replies, _ := repo.ReplyCountBatch(ctx, []int64{root.ID})
+		assert.Empty(t, replies)
+
+		total, _ := repo.TotalCount(ctx, postID)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("comment_not_found", func(t *testing.T) {
+		repo, _ := setupCommentRepo(t)
+
+		purged, err := repo.Purge(ctx, 999)
+
+		assert.EqualError(t, err, "comment not found")
+		assert.Nil(t, purged)
+	})
+}
+
+func TestCommentRepo_Moderation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	addPending := func(t *testing.T, repo repository.CommentUC, postID int64, parentID *int64, text string, createdAt time.Time) *models.Comment {
+		t.Helper()
+		c, err := repo.Add(ctx, models.Comment{
+			PostID:    postID,
+			ParentID:  parentID,
+			Author:    "Alice",
+			Text:      text,
+			CreatedAt: createdAt.Format(time.RFC3339),
+			Status:    models.CommentStatusPending,
+		})
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("pending_comment_is_hidden", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyModerated)
+		held := addPending(t, repo, postID, nil, "Held back", now)
+
+		roots, _ := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 10)
+		assert.Empty(t, roots)
+
+		total, _ := repo.TotalCount(ctx, postID)
+		assert.Equal(t, int64(0), total)
+
+		_, err := repo.GetByID(ctx, held.ID)
+		assert.EqualError(t, err, "comment not found")
+
+		_, err = repo.CheckParentExists(ctx, held.ID)
+		assert.EqualError(t, err, "parent comment not found")
+
+		hits, _ := repo.Search(ctx, "held", nil, 0, 10)
+		assert.Empty(t, hits)
+	})
+
+	t.Run("queue_pages_oldest_first", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyModerated)
+		otherID := createTestPost(t, postRepo, models.CommentPolicyModerated)
+		second := addPending(t, repo, postID, nil, "Second", now)
+		first := addPending(t, repo, postID, nil, "First", now.Add(-time.Hour))
+		other := addPending(t, repo, otherID, nil, "Other", now.Add(-2*time.Hour))
+		addComment(t, repo, postID, nil, "Bob", "Published", now)
+
+		all, err := repo.GetPending(ctx, nil, nil, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{other.ID, first.ID, second.ID}, idsOf(all))
+
+		page, err := repo.GetPending(ctx, &postID, &first.CreatedAt, first.ID, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{second.ID}, idsOf(page))
+
+		count, _ := repo.PendingCount(ctx, nil)
+		assert.Equal(t, int64(3), count)
+		count, _ = repo.PendingCount(ctx, &postID)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("approve_publishes_comment", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyModerated)
+		root := addComment(t, repo, postID, nil, "Bob", "Root", now.Add(-time.Hour))
+		held := addPending(t, repo, postID, &root.ID, "Reply", now)
+
+		got, err := repo.Approve(ctx, held.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.CommentStatusPublished, got.Status)
+
+		children, _ := repo.GetChild(ctx, root.ID, models.CommentOrderNewest, nil, 10)
+		assert.Equal(t, []int64{held.ID}, idsOf(children))
+
+		replies, _ := repo.ReplyCountBatch(ctx, []int64{root.ID})
+		assert.Equal(t, []*models.ReplyCount{{ParentID: root.ID, Count: 1}}, replies)
+
+		count, _ := repo.PendingCount(ctx, nil)
+		assert.Equal(t, int64(0), count)
+
+		_, err = repo.Approve(ctx, held.ID)
+		assert.ErrorIs(t, err, ErrCommentNotPending)
+	})
+
+	t.Run("approve_keeps_thread_order", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyModerated)
+		first := addComment(t, repo, postID, nil, "Bob", "First", now.Add(-time.Hour))
+		heldRoot := addPending(t, repo, postID, nil, "Held root", now.Add(-time.Hour))
+		last := addComment(t, repo, postID, nil, "Carol", "Last", now.Add(-time.Hour))
+		heldReply := addPending(t, repo, postID, &first.ID, "Held reply", now)
+		reply := addComment(t, repo, postID, &first.ID, "Dan", "Reply", now)
+
+		_, err := repo.Approve(ctx, heldReply.ID)
+		require.NoError(t, err)
+		_, err = repo.Approve(ctx, heldRoot.ID)
+		require.NoError(t, err)
+
+		thread, err := repo.GetThread(ctx, postID, nil, 10, 10)
+
+		require.NoError(t, err)
+		ids := make([]int64, len(thread))
+		for i, entry := range thread {
+			ids[i] = entry.Comment.ID
+		}
+		assert.Equal(t, []int64{first.ID, heldReply.ID, reply.ID, heldRoot.ID, last.ID}, ids)
+	})
+
+	t.Run("reject_keeps_comment_hidden", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyModerated)
+		held := addPending(t, repo, postID, nil, "Spam", now)
+		reason := "spam"
+
+		got, err := repo.Reject(ctx, held.ID, &reason)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.CommentStatusRejected, got.Status)
+		assert.Equal(t, &reason, got.RejectionReason)
+
+		roots, _ := repo.GetRootByPost(ctx, postID, models.CommentOrderNewest, nil, false, 10)
+		assert.Empty(t, roots)
+
+		count, _ := repo.PendingCount(ctx, &postID)
+		assert.Equal(t, int64(0), count)
+
+		_, err = repo.Approve(ctx, held.ID)
+		assert.ErrorIs(t, err, ErrCommentNotPending)
+	})
+
+	t.Run("purge_drops_pending_replies", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyModerated)
+		root := addComment(t, repo, postID, nil, "Bob", "Root", now.Add(-time.Hour))
+		held := addPending(t, repo, postID, &root.ID, "Reply", now)
+
+		_, err := repo.Purge(ctx, root.ID)
+		require.NoError(t, err)
+
+		_, err = repo.Approve(ctx, held.ID)
+		assert.ErrorIs(t, err, ErrCommentNotPending)
+	})
+
+	t.Run("hold_hides_published_comment", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "Bob", "Root", now.Add(-time.Hour))
+		reply := addComment(t, repo, postID, &root.ID, "Carol", "Reported reply", now)
+
+		got, err := repo.Hold(ctx, reply.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.CommentStatusPending, got.Status)
+
+		children, _ := repo.GetChild(ctx, root.ID, models.CommentOrderNewest, nil, 10)
+		assert.Empty(t, children)
+
+		replies, _ := repo.ReplyCountBatch(ctx, []int64{root.ID})
+		assert.Empty(t, replies)
+
+		counts, _ := repo.TotalCountBatch(ctx, []int64{postID})
+		assert.Equal(t, []*models.CommentCount{{PostID: postID, Count: 1}}, counts)
+
+		hits, _ := repo.Search(ctx, "reported", nil, 0, 10)
+		assert.Empty(t, hits)
+
+		pending, _ := repo.GetPending(ctx, &postID, nil, 0, 10)
+		assert.Equal(t, []int64{reply.ID}, idsOf(pending))
+
+		_, err = repo.Hold(ctx, reply.ID)
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+
+		_, err = repo.Approve(ctx, reply.ID)
+		require.NoError(t, err)
+		children, _ = repo.GetChild(ctx, root.ID, models.CommentOrderNewest, nil, 10)
+		assert.Equal(t, []int64{reply.ID}, idsOf(children))
+	})
+
+	t.Run("purge_held_comment_with_replies", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "Bob", "Root", now.Add(-time.Hour))
+		reply := addComment(t, repo, postID, &root.ID, "Carol", "Reply", now)
+
+		_, err := repo.Hold(ctx, root.ID)
+		require.NoError(t, err)
+
+		purged, err := repo.Purge(ctx, root.ID)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int64{root.ID, reply.ID}, idsOf(purged))
+
+		counts, _ := repo.TotalCountBatch(ctx, []int64{postID})
+		assert.Empty(t, counts)
+
+		count, _ := repo.PendingCount(ctx, nil)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("purge_drops_rejected_replies", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		root := addComment(t, repo, postID, nil, "Bob", "Root", now.Add(-2*time.Hour))
+		reply := addComment(t, repo, postID, &root.ID, "Carol", "Reply", now.Add(-time.Hour))
+		nested := addComment(t, repo, postID, &reply.ID, "Dan", "Nested", now)
+
+		_, err := repo.Hold(ctx, reply.ID)
+		require.NoError(t, err)
+		_, err = repo.Reject(ctx, reply.ID, nil)
+		require.NoError(t, err)
+
+		purged, err := repo.Purge(ctx, root.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*models.Comment{
+			{ID: root.ID, PostID: postID, Status: models.CommentStatusPublished},
+			{ID: reply.ID, PostID: postID, Status: models.CommentStatusRejected},
+			{ID: nested.ID, PostID: postID, Status: models.CommentStatusPublished},
+		}, purged)
+		assert.Empty(t, repo.(*comment).comments)
+
+		counts, _ := repo.TotalCountBatch(ctx, []int64{postID})
+		assert.Empty(t, counts)
+	})
+
+	t.Run("not_pending", func(t *testing.T) {
+		repo, _ := setupCommentRepo(t)
+
+		_, err := repo.Reject(ctx, 999, nil)
+
+		assert.EqualError(t, err, "comment is not awaiting moderation")
+	})
+}
+
+func TestCommentRepo_Search(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("add_indexes_comment", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		first := addComment(t, repo, postID, nil, "Alice", "GraphQL subscriptions are neat", now)
+		addComment(t, repo, postID, nil, "Bob", "Unrelated text", now)
+
+		hits, err := repo.Search(ctx, "subscriptions", nil, 0, 10)
+
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, first.ID, hits[0].Comment.ID)
+		assert.Nil(t, hits[0].Post)
+		assert.Equal(t, "GraphQL <b>subscriptions</b> are neat", hits[0].Snippet)
+
+		count, err := repo.SearchCount(ctx, "subscriptions")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("keyset_by_rank_and_id", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		c1 := addComment(t, repo, postID, nil, "A", "go", now)
+		c2 := addComment(t, repo, postID, nil, "B", "go", now)
+		c3 := addComment(t, repo, postID, nil, "C", "go", now)
+
+		page, err := repo.Search(ctx, "go", nil, 0, 2)
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, c3.ID, page[0].Comment.ID)
+		assert.Equal(t, c2.ID, page[1].Comment.ID)
+
+		rest, err := repo.Search(ctx, "go", &page[1].Rank, page[1].Comment.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+		assert.Equal(t, c1.ID, rest[0].Comment.ID)
+	})
+
+	t.Run("edit_and_delete_update_index", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		edited := addComment(t, repo, postID, nil, "A", "before", now)
+		deleted := addComment(t, repo, postID, nil, "B", "doomed", now)
+
+		_, err := repo.Edit(ctx, edited.ID, "after", now.Format(time.RFC3339))
+		require.NoError(t, err)
+		_, err = repo.Delete(ctx, deleted.ID)
+		require.NoError(t, err)
+
+		hits, _ := repo.Search(ctx, "before", nil, 0, 10)
+		assert.Empty(t, hits)
+		hits, _ = repo.Search(ctx, "after", nil, 0, 10)
+		assert.Len(t, hits, 1)
+		hits, _ = repo.Search(ctx, "doomed", nil, 0, 10)
+		assert.Empty(t, hits)
+	})
+
+	t.Run("hides_comments_of_deleted_posts", func(t *testing.T) {
+		repo, postRepo := setupCommentRepo(t)
+		postID := createTestPost(t, postRepo, models.CommentPolicyOpen)
+		addComment(t, repo, postID, nil, "A", "hidden", now)
+		_, err := postRepo.Delete(ctx, postID, now.Format(time.RFC3339))
+		require.NoError(t, err)
+
+		hits, err := repo.Search(ctx, "hidden", nil, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, hits)
+
+		count, _ := repo.SearchCount(ctx, "hidden")
+		assert.Zero(t, count)
+	})
+}
+
+func setupCommentRepo(t *testing.T) (repository.CommentUC, repository.PostUC) {
+	t.Helper()
+	postRepo := post.New()
+	commentRepo := New(postRepo)
+	return commentRepo, postRepo
+}
+
+func createTestPost(t *testing.T, postRepo repository.PostUC, policy models.CommentPolicy) int64 {
+	t.Helper()
+	p, err := postRepo.Save(context.Background(), models.Post{
+		Title:         "Test Post",
+		Body:          "Content",
+		Author:        "Tester",
+		CommentPolicy: policy,
+		CreatedAt:     time.Now().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+	return p.ID
+}
+
+func addComment(t *testing.T, repo repository.CommentUC, postID int64, parentID *int64, author, text string, createdAt time.Time) *models.Comment {
+	t.Helper()
+	c, err := repo.Add(context.Background(), models.Comment{
+		PostID:    postID,
+		ParentID:  parentID,
+		Author:    author,
+		Text:      text,
+		CreatedAt: createdAt.Format(time.RFC3339),
+		Status:    models.CommentStatusPublished,
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func idsOf(comments []*models.Comment) []int64 {
+	ids := make([]int64, len(comments))
+	for i, c := range comments {
+		ids[i] = c.ID
+	}
+	return ids
 }
@@ -5,9 +5,10 @@ import (
 	"sort"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
 )
 
-func (r *comment) GetRootByPost(ctx context.Context, postID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
+func (r *comment) GetRootByPost(ctx context.Context, postID int64, order models.CommentOrder, pos *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -24,38 +25,10 @@ func (r *comment) GetRootByPost(ctx context.Context, postID int64, afterCreatedA
 		}
 	}
 
-	sort.Slice(roots, func(i, j int) bool {
-		if roots[i].CreatedAt == roots[j].CreatedAt {
-			return roots[i].ID > roots[j].ID
-		}
-		return roots[i].CreatedAt > roots[j].CreatedAt
-	})
-
-	startIdx := 0
-	if afterCreatedAt != nil && afterID > 0 {
-		for i, c := range roots {
-			if c.CreatedAt == *afterCreatedAt && c.ID == afterID {
-				startIdx = i + 1
-				break
-			}
-		}
-	}
-	if startIdx >= len(roots) {
-		return []*models.Comment{}, nil
-	}
-
-	endIdx := min(startIdx+int(limit), len(roots))
-
-	result := make([]*models.Comment, endIdx-startIdx)
-	for i := startIdx; i < endIdx; i++ {
-		clone := *roots[i]
-		result[i-startIdx] = &clone
-	}
-
-	return result, nil
+	return page(roots, order, pos, backward, limit), nil
 }
 
-func (r *comment) GetChild(ctx context.Context, parentID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
+func (r *comment) GetChild(ctx context.Context, parentID int64, order models.CommentOrder, after *models.CommentCursor, limit int32) ([]*models.Comment, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -69,81 +42,89 @@ func (r *comment) GetChild(ctx context.Context, parentID int64, afterCreatedAt *
 		children = append(children, r.comments[id])
 	}
 
-	sort.Slice(children, func(i, j int) bool {
-		if children[i].CreatedAt == children[j].CreatedAt {
-			return children[i].ID > children[j].ID
-		}
+	return page(children, order, after, false, limit), nil
+}
 
-		return children[i].CreatedAt > children[j].CreatedAt
-	})
+// GetChildBatch pages the replies of every parent on its own, like the
+// lateral join in postgres: each parent gets up to limit replies after the
+// cursor, or before it when backward, in the parent order of parentIDs.
+func (r *comment) GetChildBatch(ctx context.Context, parentIDs []int64, order models.CommentOrder, pos *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
-	startIdx := 0
-	if afterCreatedAt != nil && afterID > 0 {
-		for i, c := range children {
-			if c.CreatedAt == *afterCreatedAt && c.ID == afterID {
-				startIdx = i + 1
-				break
-			}
+	result := make([]*models.Comment, 0)
+	for _, parentID := range parentIDs {
+		children := make([]*models.Comment, 0, len(r.byParent[parentID]))
+		for _, id := range r.byParent[parentID] {
+			children = append(children, r.comments[id])
 		}
-	}
-	if startIdx >= len(children) {
-		return []*models.Comment{}, nil
-	}
 
-	endIdx := min(startIdx+int(limit), len(children))
-
-	result := make([]*models.Comment, endIdx-startIdx)
-	for i := startIdx; i < endIdx; i++ {
-		clone := *children[i]
-		result[i-startIdx] = &clone
+		result = append(result, page(children, order, pos, backward, limit)...)
 	}
 
 	return result, nil
 }
 
-func (r *comment) GetChildBatch(ctx context.Context, parentIDs []int64) ([]*models.Comment, error) {
+// ReplyCountBatch returns the number of direct replies of every comment.
+// Comments without replies are left out.
+func (r *comment) ReplyCountBatch(ctx context.Context, parentIDs []int64) ([]*models.ReplyCount, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	need := make(map[int64]bool, len(parentIDs))
-	for _, pid := range parentIDs {
-		need[pid] = true
-	}
-
-	var result []*models.Comment
-	for _, c := range r.comments {
-		if c.ParentID != nil && need[*c.ParentID] {
-			clone := *c
-			result = append(result, &clone)
+	counts := make([]*models.ReplyCount, 0, len(parentIDs))
+	for _, parentID := range parentIDs {
+		if n := r.replyCounts[parentID]; n > 0 {
+			counts = append(counts, &models.ReplyCount{ParentID: parentID, Count: n})
 		}
 	}
 
-	sort.Slice(result, func(i, j int) bool {
-		pi := *result[i].ParentID
-		pj := *result[j].ParentID
+	return counts, nil
+}
 
-		if pi != pj {
-			return pi < pj
-		}
+// page sorts the comments by the ordering and returns clones of up to limit
+// comments that come strictly after the cursor, or the last limit ones
+// strictly before it when backward, mirroring the keyset conditions of the
+// postgres queries.
+func page(comments []*models.Comment, order models.CommentOrder, pos *models.CommentCursor, backward bool, limit int32) []*models.Comment {
+	sort.Slice(comments, func(i, j int) bool {
+		return cursor.Less(order, cursor.Position(order, comments[i]), cursor.Position(order, comments[j]))
+	})
 
-		if result[i].CreatedAt == result[j].CreatedAt {
-			return result[i].ID > result[j].ID
+	var startIdx, endIdx int
+	if backward {
+		endIdx = len(comments)
+		if pos != nil {
+			endIdx = sort.Search(len(comments), func(i int) bool {
+				return !cursor.Less(order, cursor.Position(order, comments[i]), *pos)
+			})
+		}
+		startIdx = max(endIdx-int(limit), 0)
+	} else {
+		if pos != nil {
+			startIdx = sort.Search(len(comments), func(i int) bool {
+				return cursor.Less(order, *pos, cursor.Position(order, comments[i]))
+			})
+		}
+		if startIdx >= len(comments) {
+			return []*models.Comment{}
 		}
+		endIdx = min(startIdx+int(limit), len(comments))
+	}
 
-		return result[i].CreatedAt > result[j].CreatedAt
-	})
+	result := make([]*models.Comment, endIdx-startIdx)
+	for i := startIdx; i < endIdx; i++ {
+		clone := *comments[i]
+		result[i-startIdx] = &clone
+	}
 
-	return result, nil
+	return result
 }
 
+// TotalCount returns the number of root comments on a post, replies are
+// counted by their parents.
 func (r *comment) TotalCount(ctx context.Context, postID int64) (int64, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	ids, ok := r.byPost[postID]
-	if !ok {
-		return 0, nil
-	}
-
-	return int64(len(ids)), nil
+	return r.rootCounts[postID], nil
 }
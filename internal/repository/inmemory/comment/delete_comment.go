@@ -0,0 +1,97 @@
+package comment
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *comment) Delete(ctx context.Context, commentID int64) (*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.comments[commentID]
+	if !ok || c.IsDeleted || c.Status != models.CommentStatusPublished {
+		return nil, ErrCommentNotFound
+	}
+
+	c.Author = ""
+	c.Text = ""
+	c.IsDeleted = true
+	delete(r.revisions, commentID)
+	r.index.Remove(commentID)
+
+	clone := *c
+
+	return &clone, nil
+}
+
+func (r *comment) Purge(ctx context.Context, commentID int64) ([]*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	root, ok := r.comments[commentID]
+	if !ok {
+		return nil, ErrCommentNotFound
+	}
+
+	// Only a published comment is in its parent's list and the counters. One
+	// that is not may still have published replies when it was held after
+	// they were written, so the subtree walk below runs either way.
+	if root.Status == models.CommentStatusPublished {
+		parentKey := RootParent
+		if root.ParentID != nil {
+			parentKey = *root.ParentID
+			r.replyCounts[parentKey]--
+		} else {
+			r.rootCounts[root.PostID]--
+		}
+		r.byParent[parentKey] = slices.DeleteFunc(r.byParent[parentKey], func(id int64) bool {
+			return id == commentID
+		})
+	}
+
+	// byParent lists published replies only, so the subtree is collected by
+	// parent id instead: held and rejected replies go with it as in postgres.
+	children := make(map[int64][]int64)
+	for id, c := range r.comments {
+		if c.PostID == root.PostID && c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], id)
+		}
+	}
+
+	var purged []*models.Comment
+	queue := []int64{commentID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		c := r.comments[id]
+		purged = append(purged, &models.Comment{ID: c.ID, PostID: c.PostID, Status: c.Status})
+
+		replies := children[id]
+		slices.Sort(replies)
+		queue = append(queue, replies...)
+
+		delete(r.comments, id)
+		delete(r.byParent, id)
+		delete(r.replyCounts, id)
+		if c.Status == models.CommentStatusPublished {
+			r.commentCounts[c.PostID]--
+		}
+		delete(r.revisions, id)
+		r.index.Remove(id)
+	}
+
+	r.byPost[root.PostID] = slices.DeleteFunc(r.byPost[root.PostID], func(id int64) bool {
+		_, ok := r.comments[id]
+		return !ok
+	})
+	r.pending = slices.DeleteFunc(r.pending, func(id int64) bool {
+		_, ok := r.comments[id]
+		return !ok
+	})
+
+	return purged, nil
+}
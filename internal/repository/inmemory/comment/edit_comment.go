@@ -0,0 +1,70 @@
+package comment
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *comment) Edit(ctx context.Context, commentID int64, text string, editedAt string) (*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.comments[commentID]
+	if !ok || c.IsDeleted || c.Status != models.CommentStatusPublished {
+		return nil, ErrCommentNotFound
+	}
+
+	r.revSeq++
+	r.revisions[commentID] = append(r.revisions[commentID], &models.CommentRevision{
+		ID:        r.revSeq,
+		CommentID: commentID,
+		Text:      c.Text,
+		CreatedAt: editedAt,
+	})
+
+	c.Text = text
+	c.EditedAt = &editedAt
+	r.index.Add(commentID, text)
+
+	clone := *c
+
+	return &clone, nil
+}
+
+func (r *comment) GetRevisions(ctx context.Context, commentID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.CommentRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var revisions []*models.CommentRevision
+	for _, rev := range r.revisions[commentID] {
+		if afterCreatedAt == nil || rev.CreatedAt < *afterCreatedAt || (rev.CreatedAt == *afterCreatedAt && rev.ID < afterID) {
+			revisions = append(revisions, rev)
+		}
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		if revisions[i].CreatedAt == revisions[j].CreatedAt {
+			return revisions[i].ID > revisions[j].ID
+		}
+		return revisions[i].CreatedAt > revisions[j].CreatedAt
+	})
+
+	endIdx := min(int(limit), len(revisions))
+
+	result := make([]*models.CommentRevision, endIdx)
+	for i := 0; i < endIdx; i++ {
+		clone := *revisions[i]
+		result[i] = &clone
+	}
+
+	return result, nil
+}
+
+func (r *comment) RevisionCount(ctx context.Context, commentID int64) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.revisions[commentID])), nil
+}
@@ -0,0 +1,150 @@
+package comment
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// GetPending returns the comments waiting for a moderator oldest first, on one
+// post or on all of them when postID is nil.
+func (r *comment) GetPending(ctx context.Context, postID *int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var pending []*models.Comment
+	for _, id := range r.pending {
+		c := r.comments[id]
+		if postID != nil && c.PostID != *postID {
+			continue
+		}
+		if afterCreatedAt == nil || c.CreatedAt > *afterCreatedAt || (c.CreatedAt == *afterCreatedAt && c.ID > afterID) {
+			pending = append(pending, c)
+		}
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].CreatedAt == pending[j].CreatedAt {
+			return pending[i].ID < pending[j].ID
+		}
+		return pending[i].CreatedAt < pending[j].CreatedAt
+	})
+
+	endIdx := min(int(limit), len(pending))
+
+	result := make([]*models.Comment, endIdx)
+	for i := 0; i < endIdx; i++ {
+		clone := *pending[i]
+		result[i] = &clone
+	}
+
+	return result, nil
+}
+
+func (r *comment) PendingCount(ctx context.Context, postID *int64) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, id := range r.pending {
+		if postID == nil || r.comments[id].PostID == *postID {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *comment) Approve(ctx context.Context, commentID int64) (*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, err := r.takePending(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Status = models.CommentStatusPublished
+	r.link(c)
+
+	clone := *c
+	return &clone, nil
+}
+
+func (r *comment) Reject(ctx context.Context, commentID int64, reason *string) (*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, err := r.takePending(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Status = models.CommentStatusRejected
+	c.RejectionReason = reason
+
+	clone := *c
+	return &clone, nil
+}
+
+func (r *comment) Hold(ctx context.Context, commentID int64) (*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.comments[commentID]
+	if !ok || c.IsDeleted || c.Status != models.CommentStatusPublished {
+		return nil, ErrCommentNotFound
+	}
+
+	r.unlink(c)
+	c.Status = models.CommentStatusPending
+	r.pending = append(r.pending, commentID)
+
+	clone := *c
+	return &clone, nil
+}
+
+// GetByIDAnyStatus returns a comment whatever its moderation status, so
+// moderation can settle a comment by the state it is in now.
+func (r *comment) GetByIDAnyStatus(ctx context.Context, commentID int64) (*models.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.comments[commentID]
+	if !ok {
+		return nil, ErrCommentNotFound
+	}
+
+	clone := *c
+	return &clone, nil
+}
+
+// GetSpamScore returns the spam score stored with a comment when it was added.
+func (r *comment) GetSpamScore(ctx context.Context, commentID int64) (*float64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.comments[commentID]
+	if !ok {
+		return nil, ErrCommentNotFound
+	}
+
+	return c.SpamScore, nil
+}
+
+// takePending removes a comment from the moderation queue. The caller holds
+// the write lock.
+func (r *comment) takePending(commentID int64) (*models.Comment, error) {
+	c, ok := r.comments[commentID]
+	if !ok || c.Status != models.CommentStatusPending {
+		return nil, ErrCommentNotPending
+	}
+
+	r.pending = slices.DeleteFunc(r.pending, func(id int64) bool {
+		return id == commentID
+	})
+
+	return c, nil
+}
@@ -1,10 +1,19 @@
 package comment
 
 import (
+	"fmt"
 	"sync"
 
+	"github.com/pkg/errors"
+
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/index"
+)
+
+var (
+	ErrCommentNotFound   = fmt.Errorf("comment %w", repository.ErrNotFound)
+	ErrCommentNotPending = errors.New("comment is not awaiting moderation")
 )
 
 type comment struct {
@@ -12,9 +21,30 @@ type comment struct {
 	comments map[int64]*models.Comment
 	seq      int64
 
+	// byPost, byParent, the counters and the search index only hold
+	// published comments, so every listing skips pending and rejected ones.
 	byPost   map[int64][]int64
 	byParent map[int64][]int64
 	repoPost repository.PostUC
+
+	// pending holds the comments waiting for a moderator in the order they
+	// were added.
+	pending []int64
+
+	// Counters kept next to the indexes above and updated under the same
+	// lock, so a reader never sees a comment without its counts.
+	commentCounts map[int64]int64
+	rootCounts    map[int64]int64
+	replyCounts   map[int64]int64
+
+	revisions map[int64][]*models.CommentRevision
+	revSeq    int64
+
+	// publishSeq hands out the publish sequence of comments as they are
+	// published.
+	publishSeq int64
+
+	index *index.Index
 }
 
 func New(repoPost repository.PostUC) repository.CommentUC {
@@ -23,5 +53,13 @@ func New(repoPost repository.PostUC) repository.CommentUC {
 		byPost:   make(map[int64][]int64),
 		byParent: make(map[int64][]int64),
 		repoPost: repoPost,
+
+		commentCounts: make(map[int64]int64),
+		rootCounts:    make(map[int64]int64),
+		replyCounts:   make(map[int64]int64),
+
+		revisions: make(map[int64][]*models.CommentRevision),
+
+		index: index.New(),
 	}
 }
@@ -0,0 +1,45 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// GetRootBatch pages the root comments of every post on its own, matching the
+// lateral join in postgres: each post gets up to limit comments after the
+// cursor, or before it when backward.
+func (r *comment) GetRootBatch(ctx context.Context, postIDs []int64, order models.CommentOrder, pos *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*models.Comment, 0)
+	for _, postID := range postIDs {
+		var roots []*models.Comment
+		for _, id := range r.byPost[postID] {
+			if c := r.comments[id]; c.ParentID == nil {
+				roots = append(roots, c)
+			}
+		}
+
+		result = append(result, page(roots, order, pos, backward, limit)...)
+	}
+
+	return result, nil
+}
+
+// TotalCountBatch returns the number of comments on every post, replies at
+// any depth included. Posts without comments are left out.
+func (r *comment) TotalCountBatch(ctx context.Context, postIDs []int64) ([]*models.CommentCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make([]*models.CommentCount, 0, len(postIDs))
+	for _, postID := range postIDs {
+		if n := r.commentCounts[postID]; n > 0 {
+			counts = append(counts, &models.CommentCount{PostID: postID, Count: n})
+		}
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,58 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/index"
+)
+
+func (r *comment) Search(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32) ([]*models.SearchHit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	hits := make([]*models.SearchHit, 0, limit)
+	for _, match := range r.index.Search(query) {
+		if len(hits) == int(limit) {
+			break
+		}
+
+		if afterRank != nil && (match.Score > *afterRank || match.Score == *afterRank && match.ID >= afterID) {
+			continue
+		}
+
+		c := r.comments[match.ID]
+		if !r.postAlive(ctx, c.PostID) {
+			continue
+		}
+
+		clone := *c
+		hits = append(hits, &models.SearchHit{
+			Rank:    match.Score,
+			Snippet: index.Snippet(c.Text, query),
+			Comment: &clone,
+		})
+	}
+
+	return hits, nil
+}
+
+func (r *comment) SearchCount(ctx context.Context, query string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, match := range r.index.Search(query) {
+		if r.postAlive(ctx, r.comments[match.ID].PostID) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// postAlive hides comments of soft deleted posts from search results.
+func (r *comment) postAlive(ctx context.Context, postID int64) bool {
+	_, err := r.repoPost.GetByID(ctx, postID)
+	return err == nil
+}
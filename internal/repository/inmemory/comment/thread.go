@@ -0,0 +1,67 @@
+package comment
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// GetThread walks byParent depth first, so the result is ordered by path like
+// the recursive query in postgres. Every level keeps the first maxPerLevel
+// replies of each comment in reply order.
+func (r *comment) GetThread(ctx context.Context, postID int64, rootID *int64, maxDepth, maxPerLevel int32) ([]*models.ThreadComment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var roots []int64
+	if rootID != nil {
+		root, ok := r.comments[*rootID]
+		if !ok || root.PostID != postID || root.Status != models.CommentStatusPublished {
+			return nil, ErrCommentNotFound
+		}
+		roots = []int64{root.ID}
+	} else {
+		for _, id := range r.byPost[postID] {
+			if r.comments[id].ParentID == nil {
+				roots = append(roots, id)
+			}
+		}
+		roots = firstN(roots, maxPerLevel)
+	}
+
+	thread := make([]*models.ThreadComment, 0, len(roots))
+
+	var walk func(id int64, depth int32, path []int64)
+	walk = func(id int64, depth int32, path []int64) {
+		path = append(slices.Clone(path), id)
+
+		clone := *r.comments[id]
+		thread = append(thread, &models.ThreadComment{
+			Comment: &clone,
+			Depth:   depth,
+			Path:    path,
+		})
+
+		if depth >= maxDepth {
+			return
+		}
+
+		for _, childID := range firstN(r.byParent[id], maxPerLevel) {
+			walk(childID, depth+1, path)
+		}
+	}
+
+	for _, id := range roots {
+		walk(id, 0, nil)
+	}
+
+	return thread, nil
+}
+
+func firstN(ids []int64, n int32) []int64 {
+	if len(ids) > int(n) {
+		return ids[:n]
+	}
+	return ids
+}
@@ -0,0 +1,18 @@
+package comment
+
+// SetVotes stores the up and down vote tallies used by the TOP and
+// CONTROVERSIAL orderings. The in-memory reaction repository calls it after
+// every comment reaction change, like the trigger on comment_reactions does
+// in postgres.
+func (r *comment) SetVotes(commentID int64, upvotes, downvotes int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.comments[commentID]
+	if !ok {
+		return
+	}
+
+	c.Upvotes = upvotes
+	c.Downvotes = downvotes
+}
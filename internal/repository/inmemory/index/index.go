@@ -0,0 +1,212 @@
+// Package index implements the tokenizing inverted index used by the
+// in-memory repositories for full-text search. It is not safe for concurrent
+// use; callers guard it with their own lock.
+package index
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/highlight"
+)
+
+const (
+	snippetWordsBefore = 8
+	snippetWordsAfter  = 16
+)
+
+type Match struct {
+	ID    int64
+	Score float64
+}
+
+type Index struct {
+	postings map[string]map[int64]int
+	terms    map[int64][]string
+	lengths  map[int64]int
+}
+
+func New() *Index {
+	return &Index{
+		postings: make(map[string]map[int64]int),
+		terms:    make(map[int64][]string),
+		lengths:  make(map[int64]int),
+	}
+}
+
+// Add indexes text under id, replacing whatever was indexed for it before.
+func (i *Index) Add(id int64, text string) {
+	i.Remove(id)
+
+	tokens := Tokenize(text)
+	if len(tokens) == 0 {
+		return
+	}
+
+	for _, token := range tokens {
+		docs, ok := i.postings[token]
+		if !ok {
+			docs = make(map[int64]int)
+			i.postings[token] = docs
+		}
+
+		if docs[id] == 0 {
+			i.terms[id] = append(i.terms[id], token)
+		}
+		docs[id]++
+	}
+
+	i.lengths[id] = len(tokens)
+}
+
+func (i *Index) Remove(id int64) {
+	for _, token := range i.terms[id] {
+		delete(i.postings[token], id)
+		if len(i.postings[token]) == 0 {
+			delete(i.postings, token)
+		}
+	}
+
+	delete(i.terms, id)
+	delete(i.lengths, id)
+}
+
+// Search returns the documents containing every query term, ordered by score
+// desc and id desc. The score is the term frequency normalised by the
+// logarithm of the document length, similar in spirit to ts_rank.
+func (i *Index) Search(query string) []Match {
+	terms := unique(Tokenize(query))
+	if len(terms) == 0 {
+		return nil
+	}
+
+	candidates := i.postings[terms[0]]
+	matches := make([]Match, 0, len(candidates))
+
+	for id := range candidates {
+		var freq int
+		for _, term := range terms {
+			tf := i.postings[term][id]
+			if tf == 0 {
+				freq = 0
+				break
+			}
+			freq += tf
+		}
+
+		if freq == 0 {
+			continue
+		}
+
+		matches = append(matches, Match{
+			ID:    id,
+			Score: float64(freq) / (1 + math.Log(float64(i.lengths[id]))),
+		})
+	}
+
+	sort.Slice(matches, func(a, b int) bool {
+		if matches[a].Score == matches[b].Score {
+			return matches[a].ID > matches[b].ID
+		}
+		return matches[a].Score > matches[b].Score
+	})
+
+	return matches
+}
+
+// Tokenize lowercases text and splits it into letter and digit runs.
+func Tokenize(text string) []string {
+	spans := wordSpans(text)
+
+	tokens := make([]string, len(spans))
+	for n, span := range spans {
+		tokens[n] = strings.ToLower(text[span[0]:span[1]])
+	}
+
+	return tokens
+}
+
+// Snippet cuts a window of words around the first query term found in text
+// and wraps every matching word in <b> tags. The text is HTML-escaped.
+func Snippet(text, query string) string {
+	terms := make(map[string]struct{})
+	for _, term := range Tokenize(query) {
+		terms[term] = struct{}{}
+	}
+
+	spans := wordSpans(text)
+	if len(spans) == 0 {
+		return ""
+	}
+
+	isMatch := func(span [2]int) bool {
+		_, ok := terms[strings.ToLower(text[span[0]:span[1]])]
+		return ok
+	}
+
+	first := 0
+	for n, span := range spans {
+		if isMatch(span) {
+			first = n
+			break
+		}
+	}
+
+	from := max(first-snippetWordsBefore, 0)
+	to := min(first+snippetWordsAfter, len(spans)-1)
+
+	var b strings.Builder
+	pos := spans[from][0]
+	for _, span := range spans[from : to+1] {
+		b.WriteString(highlight.Strip(text[pos:span[0]]))
+		if isMatch(span) {
+			b.WriteString(highlight.StartSel)
+			b.WriteString(text[span[0]:span[1]])
+			b.WriteString(highlight.StopSel)
+		} else {
+			b.WriteString(text[span[0]:span[1]])
+		}
+		pos = span[1]
+	}
+
+	return highlight.HTML(b.String())
+}
+
+func wordSpans(text string) [][2]int {
+	spans := make([][2]int, 0)
+	start := -1
+
+	for pos, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case isWord && start < 0:
+			start = pos
+		case !isWord && start >= 0:
+			spans = append(spans, [2]int{start, pos})
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(text)})
+	}
+
+	return spans
+}
+
+func unique(tokens []string) []string {
+	seen := make(map[string]struct{}, len(tokens))
+	out := make([]string, 0, len(tokens))
+
+	for _, token := range tokens {
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+		out = append(out, token)
+	}
+
+	return out
+}
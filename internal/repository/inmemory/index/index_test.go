@@ -0,0 +1,127 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "punctuation_and_case",
+			text: "Hello, GraphQL-world!",
+			want: []string{"hello", "graphql", "world"},
+		},
+		{
+			name: "unicode_and_digits",
+			text: "Привет мир 2026",
+			want: []string{"привет", "мир", "2026"},
+		},
+		{
+			name: "empty",
+			text: " ... ",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Tokenize(tt.text))
+		})
+	}
+}
+
+func TestIndex_Search(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all_terms_must_match", func(t *testing.T) {
+		idx := New()
+		idx.Add(1, "go generics in practice")
+		idx.Add(2, "go channels")
+		idx.Add(3, "rust generics")
+
+		got := idx.Search("Go generics")
+
+		assert.Len(t, got, 1)
+		assert.Equal(t, int64(1), got[0].ID)
+	})
+
+	t.Run("ranked_by_frequency_then_id", func(t *testing.T) {
+		idx := New()
+		idx.Add(1, "go is fun")
+		idx.Add(2, "go go go")
+		idx.Add(3, "go is fun")
+
+		got := idx.Search("go")
+
+		ids := make([]int64, len(got))
+		for i, m := range got {
+			ids[i] = m.ID
+		}
+		assert.Equal(t, []int64{2, 3, 1}, ids)
+		assert.Greater(t, got[0].Score, got[1].Score)
+		assert.Equal(t, got[1].Score, got[2].Score)
+	})
+
+	t.Run("add_replaces_previous_text", func(t *testing.T) {
+		idx := New()
+		idx.Add(1, "old text")
+		idx.Add(1, "new text")
+
+		assert.Empty(t, idx.Search("old"))
+		assert.Len(t, idx.Search("new"), 1)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		idx := New()
+		idx.Add(1, "hello")
+		idx.Remove(1)
+
+		assert.Empty(t, idx.Search("hello"))
+		assert.Empty(t, idx.postings)
+	})
+
+	t.Run("empty_query", func(t *testing.T) {
+		idx := New()
+		idx.Add(1, "hello")
+
+		assert.Empty(t, idx.Search("!!"))
+	})
+}
+
+func TestSnippet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("highlights_matches", func(t *testing.T) {
+		got := Snippet("Learning Go, one go routine at a time.", "go")
+
+		assert.Equal(t, "Learning <b>Go</b>, one <b>go</b> routine at a time", got)
+	})
+
+	t.Run("window_around_first_match", func(t *testing.T) {
+		text := "a b c d e f g h i j k l m n o p q r s t u v w x y z"
+
+		got := Snippet(text, "k")
+
+		assert.Equal(t, "c d e f g h i j <b>k</b> l m n o p q r s t u v w x y z", got)
+	})
+
+	t.Run("escapes_html", func(t *testing.T) {
+		got := Snippet("Tom & Jerry <script>alert(1)</script> \x02go\x03 go", "script go")
+
+		assert.Equal(t, "Tom &amp; Jerry &lt;<b>script</b>&gt;alert(1)&lt;/<b>script</b>&gt; <b>go</b> <b>go</b>", got)
+	})
+
+	t.Run("no_words", func(t *testing.T) {
+		assert.Equal(t, "", Snippet("...", "go"))
+	})
+}
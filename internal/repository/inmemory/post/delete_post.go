@@ -0,0 +1,67 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *post) Delete(ctx context.Context, postID int64, deletedAt string) (*models.Post, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	post, ok := r.posts[postID]
+	if !ok || post.DeletedAt != nil {
+		return nil, ErrPostNotFound
+	}
+
+	post.DeletedAt = &deletedAt
+
+	clone := *post
+
+	return &clone, nil
+}
+
+// Hide deletes the post on behalf of a moderator. A post its author already
+// deleted keeps its deletion time but can no longer be restored by the author.
+func (r *post) Hide(ctx context.Context, postID int64, hiddenAt string) (*models.Post, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	post, ok := r.posts[postID]
+	if !ok {
+		return nil, ErrPostNotFound
+	}
+
+	if post.DeletedAt == nil {
+		post.DeletedAt = &hiddenAt
+	}
+	r.hidden[postID] = struct{}{}
+
+	clone := *post
+
+	return &clone, nil
+}
+
+func (r *post) Restore(ctx context.Context, postID int64, unhide bool) (*models.Post, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	post, ok := r.posts[postID]
+	if !ok {
+		return nil, ErrPostNotFound
+	}
+	if post.DeletedAt == nil {
+		return nil, ErrPostNotDeleted
+	}
+	if _, hidden := r.hidden[postID]; hidden && !unhide {
+		return nil, ErrPostHidden
+	}
+
+	post.DeletedAt = nil
+	delete(r.hidden, postID)
+
+	clone := *post
+
+	return &clone, nil
+}
@@ -1,26 +1,39 @@
 package post
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/index"
 )
 
 var (
-	ErrPostNotFound = errors.New("post not found")
+	ErrPostNotFound   = fmt.Errorf("post %w", repository.ErrNotFound)
+	ErrPostNotDeleted = errors.New("post is not deleted")
+	ErrPostHidden     = errors.New("post was hidden by a moderator")
 )
 
 type post struct {
 	mu    sync.RWMutex
 	posts map[int64]*models.Post
+	tags  map[string]map[int64]struct{}
+	index *index.Index
 	seq   int64
+
+	// hidden holds the deleted posts a moderator hid; Restore leaves them
+	// deleted unless asked to unhide them.
+	hidden map[int64]struct{}
 }
 
 func New() repository.PostUC {
 	return &post{
-		posts: make(map[int64]*models.Post),
+		posts:  make(map[int64]*models.Post),
+		tags:   make(map[string]map[int64]struct{}),
+		index:  index.New(),
+		hidden: make(map[int64]struct{}),
 	}
 }
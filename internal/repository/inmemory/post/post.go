@@ -11,10 +11,30 @@ func (r *post) GetByID(ctx context.Context, postID int64) (*models.Post, error)
 	defer r.mu.RUnlock()
 
 	post, ok := r.posts[postID]
-	if !ok {
+	if !ok || post.DeletedAt != nil {
 		return nil, ErrPostNotFound
 	}
 
 	clone := *post
 	return &clone, nil
 }
+
+// GetByIDs returns the live posts among postIDs, deleted and unknown ids are
+// skipped.
+func (r *post) GetByIDs(ctx context.Context, postIDs []int64) ([]*models.Post, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]*models.Post, 0, len(postIDs))
+	for _, id := range postIDs {
+		post, ok := r.posts[id]
+		if !ok || post.DeletedAt != nil {
+			continue
+		}
+
+		clone := *post
+		out = append(out, &clone)
+	}
+
+	return out, nil
+}
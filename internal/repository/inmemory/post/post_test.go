@@ -24,7 +24,7 @@ func TestPostRepo_Save(t *testing.T) {
 			Title:         "First Post",
 			Body:          "Content",
 			Author:        "Alice",
-			AllowComments: true,
+			CommentPolicy: models.CommentPolicyOpen,
 			CreatedAt:     now,
 		}
 
@@ -35,7 +35,7 @@ func TestPostRepo_Save(t *testing.T) {
 		assert.Equal(t, input.Title, got.Title)
 		assert.Equal(t, input.Body, got.Body)
 		assert.Equal(t, input.Author, got.Author)
-		assert.Equal(t, input.AllowComments, got.AllowComments)
+		assert.Equal(t, input.CommentPolicy, got.CommentPolicy)
 		assert.Equal(t, input.CreatedAt, got.CreatedAt)
 	})
 
@@ -89,30 +89,66 @@ func TestPostRepo_GetByID(t *testing.T) {
 	})
 }
 
-func TestPostRepo_SetCommentsAllowed(t *testing.T) {
+func TestPostRepo_GetByIDs(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	now := time.Now().Format(time.RFC3339)
 
+	repo := New()
+	first, _ := repo.Save(ctx, models.Post{Title: "First", CreatedAt: now})
+	second, _ := repo.Save(ctx, models.Post{Title: "Second", CreatedAt: now})
+	deleted, _ := repo.Save(ctx, models.Post{Title: "Deleted", CreatedAt: now})
+	_, err := repo.Delete(ctx, deleted.ID, now)
+	assert.NoError(t, err)
+
+	got, err := repo.GetByIDs(ctx, []int64{second.ID, 999, deleted.ID, first.ID})
+
+	assert.NoError(t, err)
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, "Second", got[0].Title)
+		assert.Equal(t, "First", got[1].Title)
+	}
+}
+
+func TestPostRepo_SetCommentPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now().Format(time.RFC3339)
+	closeAt := "2026-03-01T00:00:00Z"
+
 	t.Run("update_existing_post", func(t *testing.T) {
 		repo := New()
-		saved, _ := repo.Save(ctx, models.Post{Title: "Test", AllowComments: false, CreatedAt: now})
+		saved, _ := repo.Save(ctx, models.Post{Title: "Test", CommentPolicy: models.CommentPolicyClosed, CreatedAt: now})
 
-		updated, err := repo.SetCommentsAllowed(ctx, saved.ID, true)
+		updated, err := repo.SetCommentPolicy(ctx, saved.ID, models.CommentPolicyAuthorOnly, &closeAt)
 
 		assert.NoError(t, err)
-		assert.True(t, updated.AllowComments)
+		assert.Equal(t, models.CommentPolicyAuthorOnly, updated.CommentPolicy)
+		assert.Equal(t, &closeAt, updated.CommentsCloseAt)
 		assert.Equal(t, saved.ID, updated.ID)
 
 		got, _ := repo.GetByID(ctx, saved.ID)
-		assert.True(t, got.AllowComments)
+		assert.Equal(t, models.CommentPolicyAuthorOnly, got.CommentPolicy)
+		assert.Equal(t, &closeAt, got.CommentsCloseAt)
+	})
+
+	t.Run("clear_close_time", func(t *testing.T) {
+		repo := New()
+		saved, _ := repo.Save(ctx, models.Post{Title: "Test", CommentsCloseAt: &closeAt, CreatedAt: now})
+
+		updated, err := repo.SetCommentPolicy(ctx, saved.ID, models.CommentPolicyOpen, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.CommentPolicyOpen, updated.CommentPolicy)
+		assert.Nil(t, updated.CommentsCloseAt)
 	})
 
 	t.Run("post_not_found", func(t *testing.T) {
 		repo := New()
 
-		updated, err := repo.SetCommentsAllowed(ctx, 999, true)
+		updated, err := repo.SetCommentPolicy(ctx, 999, models.CommentPolicyOpen, nil)
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "post not found")
@@ -121,9 +157,9 @@ func TestPostRepo_SetCommentsAllowed(t *testing.T) {
 
 	t.Run("return_copy", func(t *testing.T) {
 		repo := New()
-		saved, _ := repo.Save(ctx, models.Post{Title: "Test", AllowComments: false, CreatedAt: now})
+		saved, _ := repo.Save(ctx, models.Post{Title: "Test", CommentPolicy: models.CommentPolicyClosed, CreatedAt: now})
 
-		updated, _ := repo.SetCommentsAllowed(ctx, saved.ID, true)
+		updated, _ := repo.SetCommentPolicy(ctx, saved.ID, models.CommentPolicyOpen, nil)
 		updated.Title = "Hacked"
 
 		original, _ := repo.GetByID(ctx, saved.ID)
@@ -139,7 +175,7 @@ func TestPostRepo_TotalCount(t *testing.T) {
 
 	t.Run("empty_repo", func(t *testing.T) {
 		repo := New()
-		count, err := repo.TotalCount(ctx)
+		count, err := repo.TotalCount(ctx, models.PostFilter{})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), count)
 	})
@@ -149,7 +185,7 @@ func TestPostRepo_TotalCount(t *testing.T) {
 		repo.Save(ctx, models.Post{Title: "1", CreatedAt: now})
 		repo.Save(ctx, models.Post{Title: "2", CreatedAt: now})
 
-		count, err := repo.TotalCount(ctx)
+		count, err := repo.TotalCount(ctx, models.PostFilter{})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), count)
 	})
@@ -185,7 +221,7 @@ func TestPostRepo_Get(t *testing.T) {
 		repo := fillRepo()
 		limit := int32(2)
 
-		got, err := repo.Get(ctx, nil, 0, limit)
+		got, err := repo.Get(ctx, models.PostFilter{}, nil, 0, false, limit)
 
 		require.NoError(t, err)
 		require.Len(t, got, 2)
@@ -199,7 +235,7 @@ func TestPostRepo_Get(t *testing.T) {
 		afterID := int64(2)
 		limit := int32(2)
 
-		got, err := repo.Get(ctx, &afterCreated, afterID, limit)
+		got, err := repo.Get(ctx, models.PostFilter{}, &afterCreated, afterID, false, limit)
 
 		require.NoError(t, err)
 		require.Len(t, got, 2)
@@ -213,7 +249,7 @@ func TestPostRepo_Get(t *testing.T) {
 		afterID := int64(4)
 		limit := int32(2)
 
-		got, err := repo.Get(ctx, &afterCreated, afterID, limit)
+		got, err := repo.Get(ctx, models.PostFilter{}, &afterCreated, afterID, false, limit)
 
 		require.NoError(t, err)
 		assert.Empty(t, got)
@@ -225,7 +261,7 @@ func TestPostRepo_Get(t *testing.T) {
 		afterID := int64(2)
 		limit := int32(10)
 
-		got, err := repo.Get(ctx, &afterCreated, afterID, limit)
+		got, err := repo.Get(ctx, models.PostFilter{}, &afterCreated, afterID, false, limit)
 
 		require.NoError(t, err)
 		require.Len(t, got, 2)
@@ -233,16 +269,40 @@ func TestPostRepo_Get(t *testing.T) {
 		assert.Equal(t, "Post 4", got[1].Title)
 	})
 
+	t.Run("backward_before_cursor", func(t *testing.T) {
+		repo := fillRepo()
+		beforeCreated := posts[3].CreatedAt
+		beforeID := int64(4)
+
+		got, err := repo.Get(ctx, models.PostFilter{}, &beforeCreated, beforeID, true, 2)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, "Post 2", got[0].Title)
+		assert.Equal(t, "Post 3", got[1].Title)
+	})
+
+	t.Run("backward_without_cursor_returns_last_page", func(t *testing.T) {
+		repo := fillRepo()
+
+		got, err := repo.Get(ctx, models.PostFilter{}, nil, 0, true, 3)
+
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, "Post 2", got[0].Title)
+		assert.Equal(t, "Post 4", got[2].Title)
+	})
+
 	t.Run("empty_repo", func(t *testing.T) {
 		repo := New()
-		got, err := repo.Get(ctx, nil, 0, 10)
+		got, err := repo.Get(ctx, models.PostFilter{}, nil, 0, false, 10)
 		require.NoError(t, err)
 		assert.Empty(t, got)
 	})
 
 	t.Run("returns_copies", func(t *testing.T) {
 		repo := fillRepo()
-		got, _ := repo.Get(ctx, nil, 0, 1)
+		got, _ := repo.Get(ctx, models.PostFilter{}, nil, 0, false, 1)
 		require.Len(t, got, 1)
 		got[0].Title = "Changed"
 
@@ -250,3 +310,324 @@ func TestPostRepo_Get(t *testing.T) {
 		assert.Equal(t, "Post 1", original.Title)
 	})
 }
+
+func TestPostRepo_Update(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().Format(time.RFC3339)
+
+	t.Run("updates_given_fields", func(t *testing.T) {
+		repo := New()
+		saved, _ := repo.Save(ctx, models.Post{Title: "Old", Body: "Body", CreatedAt: now})
+
+		title := "New"
+		updated, err := repo.Update(ctx, saved.ID, &title, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "New", updated.Title)
+		assert.Equal(t, "Body", updated.Body)
+
+		got, _ := repo.GetByID(ctx, saved.ID)
+		assert.Equal(t, "New", got.Title)
+	})
+
+	t.Run("deleted_post", func(t *testing.T) {
+		repo := New()
+		saved, _ := repo.Save(ctx, models.Post{Title: "Old", CreatedAt: now})
+		_, _ = repo.Delete(ctx, saved.ID, now)
+
+		title := "New"
+		updated, err := repo.Update(ctx, saved.ID, &title, nil)
+
+		assert.EqualError(t, err, "post not found")
+		assert.Nil(t, updated)
+	})
+}
+
+func TestPostRepo_DeleteRestore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	fillRepo := func() repository.PostUC {
+		repo := New()
+		for i := range 3 {
+			repo.Save(ctx, models.Post{
+				Title:     "Post",
+				CreatedAt: now.Add(-time.Duration(i) * time.Hour).Format(time.RFC3339),
+			})
+		}
+
+		return repo
+	}
+
+	t.Run("hides_deleted_post", func(t *testing.T) {
+		repo := fillRepo()
+
+		deleted, err := repo.Delete(ctx, 2, "2026-01-01T00:00:00Z")
+		require.NoError(t, err)
+		require.NotNil(t, deleted.DeletedAt)
+		assert.Equal(t, "2026-01-01T00:00:00Z", *deleted.DeletedAt)
+
+		_, err = repo.GetByID(ctx, 2)
+		assert.EqualError(t, err, "post not found")
+
+		count, err := repo.TotalCount(ctx, models.PostFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		got, err := repo.Get(ctx, models.PostFilter{}, nil, 0, false, 10)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, int64(1), got[0].ID)
+		assert.Equal(t, int64(3), got[1].ID)
+	})
+
+	t.Run("delete_twice", func(t *testing.T) {
+		repo := fillRepo()
+		_, _ = repo.Delete(ctx, 1, "2026-01-01T00:00:00Z")
+
+		deleted, err := repo.Delete(ctx, 1, "2026-01-01T00:00:00Z")
+		assert.EqualError(t, err, "post not found")
+		assert.Nil(t, deleted)
+	})
+
+	t.Run("restore_deleted_post", func(t *testing.T) {
+		repo := fillRepo()
+		_, _ = repo.Delete(ctx, 2, "2026-01-01T00:00:00Z")
+
+		restored, err := repo.Restore(ctx, 2, false)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+
+		got, err := repo.GetByID(ctx, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), got.ID)
+
+		count, _ := repo.TotalCount(ctx, models.PostFilter{})
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("restore_missing_post", func(t *testing.T) {
+		repo := New()
+
+		restored, err := repo.Restore(ctx, 999, false)
+		assert.EqualError(t, err, "post not found")
+		assert.Nil(t, restored)
+	})
+
+	t.Run("restore_live_post", func(t *testing.T) {
+		repo := fillRepo()
+
+		restored, err := repo.Restore(ctx, 1, false)
+		assert.ErrorIs(t, err, ErrPostNotDeleted)
+		assert.Nil(t, restored)
+	})
+
+	t.Run("hidden_post_needs_unhide", func(t *testing.T) {
+		repo := fillRepo()
+
+		hidden, err := repo.Hide(ctx, 2, "2026-01-01T00:00:00Z")
+		require.NoError(t, err)
+		require.NotNil(t, hidden.DeletedAt)
+
+		_, err = repo.GetByID(ctx, 2)
+		assert.EqualError(t, err, "post not found")
+
+		restored, err := repo.Restore(ctx, 2, false)
+		assert.ErrorIs(t, err, ErrPostHidden)
+		assert.Nil(t, restored)
+
+		restored, err = repo.Restore(ctx, 2, true)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+
+		_, _ = repo.Delete(ctx, 2, "2026-01-02T00:00:00Z")
+		restored, err = repo.Restore(ctx, 2, false)
+		require.NoError(t, err, "unhiding clears the moderator hide")
+		assert.Nil(t, restored.DeletedAt)
+	})
+
+	t.Run("hide_deleted_post", func(t *testing.T) {
+		repo := fillRepo()
+		_, _ = repo.Delete(ctx, 2, "2026-01-01T00:00:00Z")
+
+		hidden, err := repo.Hide(ctx, 2, "2026-01-02T00:00:00Z")
+		require.NoError(t, err)
+		assert.Equal(t, "2026-01-01T00:00:00Z", *hidden.DeletedAt)
+
+		_, err = repo.Restore(ctx, 2, false)
+		assert.ErrorIs(t, err, ErrPostHidden)
+	})
+}
+
+func TestPostRepo_Tags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	fillRepo := func() repository.PostUC {
+		repo := New()
+		repo.Save(ctx, models.Post{Title: "Post 1", CreatedAt: now.Format(time.RFC3339), Tags: []string{"go", "graphql"}})
+		repo.Save(ctx, models.Post{Title: "Post 2", CreatedAt: now.Add(-1 * time.Hour).Format(time.RFC3339), Tags: []string{"go"}})
+		repo.Save(ctx, models.Post{Title: "Post 3", CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339), Tags: []string{"graphql", "postgres"}})
+		repo.Save(ctx, models.Post{Title: "Post 4", CreatedAt: now.Add(-3 * time.Hour).Format(time.RFC3339)})
+		return repo
+	}
+
+	titles := func(posts []*models.Post) []string {
+		out := make([]string, len(posts))
+		for i, p := range posts {
+			out[i] = p.Title
+		}
+		return out
+	}
+
+	t.Run("save_returns_tags", func(t *testing.T) {
+		repo := fillRepo()
+
+		got, err := repo.GetByID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go", "graphql"}, got.Tags)
+	})
+
+	t.Run("match_any", func(t *testing.T) {
+		repo := fillRepo()
+		filter := models.PostFilter{Tags: []string{"go", "postgres"}, Match: models.TagMatchAny}
+
+		got, err := repo.Get(ctx, filter, nil, 0, false, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Post 1", "Post 2", "Post 3"}, titles(got))
+
+		count, err := repo.TotalCount(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("match_all", func(t *testing.T) {
+		repo := fillRepo()
+		filter := models.PostFilter{Tags: []string{"go", "graphql"}, Match: models.TagMatchAll}
+
+		got, err := repo.Get(ctx, filter, nil, 0, false, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Post 1"}, titles(got))
+
+		count, err := repo.TotalCount(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("cursor_outside_filtered_set", func(t *testing.T) {
+		repo := fillRepo()
+		filter := models.PostFilter{Tags: []string{"graphql"}, Match: models.TagMatchAny}
+		afterCreated := now.Add(-1 * time.Hour).Format(time.RFC3339)
+
+		got, err := repo.Get(ctx, filter, &afterCreated, 2, false, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Post 3"}, titles(got))
+	})
+
+	t.Run("unknown_tag", func(t *testing.T) {
+		repo := fillRepo()
+
+		got, err := repo.Get(ctx, models.PostFilter{Tags: []string{"rust"}}, nil, 0, false, 10)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("autocomplete_by_prefix", func(t *testing.T) {
+		repo := fillRepo()
+
+		got, err := repo.Tags(ctx, "g", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go", "graphql"}, got)
+
+		got, err = repo.Tags(ctx, "", 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go", "graphql"}, got)
+	})
+
+	t.Run("autocomplete_skips_deleted_posts", func(t *testing.T) {
+		repo := fillRepo()
+		_, _ = repo.Delete(ctx, 3, now.Format(time.RFC3339))
+
+		got, err := repo.Tags(ctx, "p", 10)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+
+		posts, err := repo.Get(ctx, models.PostFilter{Tags: []string{"postgres"}}, nil, 0, false, 10)
+		require.NoError(t, err)
+		assert.Empty(t, posts)
+	})
+}
+
+func TestPostRepo_Search(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	t.Run("save_indexes_title_and_body", func(t *testing.T) {
+		repo := New()
+		byTitle, _ := repo.Save(ctx, models.Post{Title: "Postgres tips", Body: "Use indexes", CreatedAt: now})
+		byBody, _ := repo.Save(ctx, models.Post{Title: "Misc", Body: "Postgres is a database", CreatedAt: now})
+		repo.Save(ctx, models.Post{Title: "Other", Body: "Nothing here", CreatedAt: now})
+
+		hits, err := repo.Search(ctx, "postgres", nil, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, hits, 2)
+
+		ids := []int64{hits[0].Post.ID, hits[1].Post.ID}
+		assert.ElementsMatch(t, []int64{byTitle.ID, byBody.ID}, ids)
+		assert.Nil(t, hits[0].Comment)
+
+		count, err := repo.SearchCount(ctx, "postgres")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("snippet_from_body", func(t *testing.T) {
+		repo := New()
+		repo.Save(ctx, models.Post{Title: "T", Body: "Learning Go today", CreatedAt: now})
+
+		hits, err := repo.Search(ctx, "go", nil, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, "Learning <b>Go</b> today", hits[0].Snippet)
+	})
+
+	t.Run("update_reindexes", func(t *testing.T) {
+		repo := New()
+		saved, _ := repo.Save(ctx, models.Post{Title: "T", Body: "old words", CreatedAt: now})
+		body := "new words"
+		_, err := repo.Update(ctx, saved.ID, nil, &body)
+		require.NoError(t, err)
+
+		hits, _ := repo.Search(ctx, "old", nil, 0, 10)
+		assert.Empty(t, hits)
+		hits, _ = repo.Search(ctx, "new", nil, 0, 10)
+		assert.Len(t, hits, 1)
+	})
+
+	t.Run("keyset_and_deleted_posts", func(t *testing.T) {
+		repo := New()
+		p1, _ := repo.Save(ctx, models.Post{Title: "go", Body: "x", CreatedAt: now})
+		p2, _ := repo.Save(ctx, models.Post{Title: "go", Body: "x", CreatedAt: now})
+		p3, _ := repo.Save(ctx, models.Post{Title: "go", Body: "x", CreatedAt: now})
+		_, _ = repo.Delete(ctx, p2.ID, now)
+
+		page, err := repo.Search(ctx, "go", nil, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, p3.ID, page[0].Post.ID)
+
+		rest, err := repo.Search(ctx, "go", &page[0].Rank, page[0].Post.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+		assert.Equal(t, p1.ID, rest[0].Post.ID)
+
+		count, _ := repo.SearchCount(ctx, "go")
+		assert.Equal(t, int64(2), count)
+	})
+}
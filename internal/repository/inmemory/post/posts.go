@@ -7,14 +7,13 @@ import (
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 )
 
-func (r *post) Get(ctx context.Context, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Post, error) {
+// Get returns up to limit posts after the cursor, or the last limit posts
+// before it when backward, newest first.
+func (r *post) Get(ctx context.Context, filter models.PostFilter, cursorCreatedAt *string, cursorID int64, backward bool, limit int32) ([]*models.Post, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	all := make([]*models.Post, 0, len(r.posts))
-	for _, p := range r.posts {
-		all = append(all, p)
-	}
+	all := r.filtered(filter)
 
 	sort.Slice(all, func(i, j int) bool {
 		if all[i].CreatedAt == all[j].CreatedAt {
@@ -23,22 +22,30 @@ func (r *post) Get(ctx context.Context, afterCreatedAt *string, afterID int64, l
 		return all[i].CreatedAt > all[j].CreatedAt
 	})
 
-	startIdx := 0
-	if afterCreatedAt != nil && afterID > 0 {
-		for i, p := range all {
-			if p.CreatedAt == *afterCreatedAt && p.ID == afterID {
-				startIdx = i + 1
-				break
-			}
+	hasCursor := cursorCreatedAt != nil && cursorID > 0
+	var startIdx, endIdx int
+	if backward {
+		endIdx = len(all)
+		if hasCursor {
+			endIdx = sort.Search(len(all), func(i int) bool {
+				p := all[i]
+				return p.CreatedAt < *cursorCreatedAt || (p.CreatedAt == *cursorCreatedAt && p.ID <= cursorID)
+			})
 		}
+		startIdx = max(endIdx-int(limit), 0)
+	} else {
+		if hasCursor {
+			startIdx = sort.Search(len(all), func(i int) bool {
+				p := all[i]
+				return p.CreatedAt < *cursorCreatedAt || (p.CreatedAt == *cursorCreatedAt && p.ID < cursorID)
+			})
+		}
+		if startIdx >= len(all) {
+			return []*models.Post{}, nil
+		}
+		endIdx = min(startIdx+int(limit), len(all))
 	}
 
-	if startIdx >= len(all) {
-		return []*models.Post{}, nil
-	}
-
-	endIdx := min(startIdx+int(limit), len(all))
-
 	result := make([]*models.Post, endIdx-startIdx)
 	for i := startIdx; i < endIdx; i++ {
 		clone := *all[i]
@@ -47,9 +54,44 @@ func (r *post) Get(ctx context.Context, afterCreatedAt *string, afterID int64, l
 	return result, nil
 }
 
-func (r *post) TotalCount(ctx context.Context) (int64, error) {
+func (r *post) TotalCount(ctx context.Context, filter models.PostFilter) (int64, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	return int64(len(r.posts)), nil
+	return int64(len(r.filtered(filter))), nil
+}
+
+// filtered returns alive posts matching the tag filter. Without tags every
+// alive post matches; otherwise the tag index is consulted and a post needs
+// one (ANY) or every (ALL) of the requested tags.
+func (r *post) filtered(filter models.PostFilter) []*models.Post {
+	if len(filter.Tags) == 0 {
+		all := make([]*models.Post, 0, len(r.posts))
+		for _, p := range r.posts {
+			if p.DeletedAt == nil {
+				all = append(all, p)
+			}
+		}
+		return all
+	}
+
+	need := 1
+	if filter.Match == models.TagMatchAll {
+		need = len(filter.Tags)
+	}
+
+	hits := make(map[int64]int)
+	for _, tag := range filter.Tags {
+		for id := range r.tags[tag] {
+			hits[id]++
+		}
+	}
+
+	all := make([]*models.Post, 0, len(hits))
+	for id, n := range hits {
+		if p := r.posts[id]; n >= need && p.DeletedAt == nil {
+			all = append(all, p)
+		}
+	}
+	return all
 }
@@ -2,6 +2,7 @@ package post
 
 import (
 	"context"
+	"slices"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 )
@@ -14,15 +15,26 @@ func (r *post) Save(ctx context.Context, post models.Post) (models.Post, error)
 	id := r.seq
 
 	clone := models.Post{
-		ID:            id,
-		Title:         post.Title,
-		Body:          post.Body,
-		Author:        post.Author,
-		AllowComments: post.AllowComments,
-		CreatedAt:     post.CreatedAt,
+		ID:              id,
+		Title:           post.Title,
+		Body:            post.Body,
+		Author:          post.Author,
+		CommentPolicy:   post.CommentPolicy,
+		CommentsCloseAt: post.CommentsCloseAt,
+		CreatedAt:       post.CreatedAt,
+		Tags:            slices.Clone(post.Tags),
+		MaxReplyDepth:   post.MaxReplyDepth,
 	}
 
 	r.posts[id] = &clone
+	r.index.Add(id, clone.Title+" "+clone.Body)
+
+	for _, tag := range clone.Tags {
+		if r.tags[tag] == nil {
+			r.tags[tag] = make(map[int64]struct{})
+		}
+		r.tags[tag][id] = struct{}{}
+	}
 
 	return clone, nil
 }
@@ -0,0 +1,52 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/index"
+)
+
+func (r *post) Search(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32) ([]*models.SearchHit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	hits := make([]*models.SearchHit, 0, limit)
+	for _, match := range r.index.Search(query) {
+		if len(hits) == int(limit) {
+			break
+		}
+
+		post := r.posts[match.ID]
+		if post.DeletedAt != nil {
+			continue
+		}
+
+		if afterRank != nil && (match.Score > *afterRank || match.Score == *afterRank && match.ID >= afterID) {
+			continue
+		}
+
+		clone := *post
+		hits = append(hits, &models.SearchHit{
+			Rank:    match.Score,
+			Snippet: index.Snippet(post.Body, query),
+			Post:    &clone,
+		})
+	}
+
+	return hits, nil
+}
+
+func (r *post) SearchCount(ctx context.Context, query string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, match := range r.index.Search(query) {
+		if r.posts[match.ID].DeletedAt == nil {
+			count++
+		}
+	}
+
+	return count, nil
+}
@@ -6,16 +6,17 @@ import (
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 )
 
-func (r *post) SetCommentsAllowed(ctx context.Context, postID int64, allow bool) (*models.Post, error) {
+func (r *post) SetCommentPolicy(ctx context.Context, postID int64, policy models.CommentPolicy, closeAt *string) (*models.Post, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	post, ok := r.posts[postID]
-	if !ok {
+	if !ok || post.DeletedAt != nil {
 		return nil, ErrPostNotFound
 	}
 
-	post.AllowComments = allow
+	post.CommentPolicy = policy
+	post.CommentsCloseAt = closeAt
 	clone := *post
 
 	return &clone, nil
@@ -0,0 +1,37 @@
+package post
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+func (r *post) Tags(ctx context.Context, prefix string, limit int32) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]string, 0)
+	for tag, ids := range r.tags {
+		if !strings.HasPrefix(tag, prefix) || !r.hasAlivePost(ids) {
+			continue
+		}
+		out = append(out, tag)
+	}
+
+	sort.Strings(out)
+
+	if len(out) > int(limit) {
+		out = out[:limit]
+	}
+
+	return out, nil
+}
+
+func (r *post) hasAlivePost(ids map[int64]struct{}) bool {
+	for id := range ids {
+		if r.posts[id].DeletedAt == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *post) Update(ctx context.Context, postID int64, title, body *string) (*models.Post, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	post, ok := r.posts[postID]
+	if !ok || post.DeletedAt != nil {
+		return nil, ErrPostNotFound
+	}
+
+	if title != nil {
+		post.Title = *title
+	}
+
+	if body != nil {
+		post.Body = *body
+	}
+
+	r.index.Add(post.ID, post.Title+" "+post.Body)
+
+	clone := *post
+
+	return &clone, nil
+}
@@ -0,0 +1,67 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockvoteCounter is an autogenerated mock type for the voteCounter type
+type MockvoteCounter struct {
+	mock.Mock
+}
+
+type MockvoteCounter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockvoteCounter) EXPECT() *MockvoteCounter_Expecter {
+	return &MockvoteCounter_Expecter{mock: &_m.Mock}
+}
+
+// SetVotes provides a mock function with given fields: commentID, upvotes, downvotes
+func (_m *MockvoteCounter) SetVotes(commentID int64, upvotes int32, downvotes int32) {
+	_m.Called(commentID, upvotes, downvotes)
+}
+
+// MockvoteCounter_SetVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVotes'
+type MockvoteCounter_SetVotes_Call struct {
+	*mock.Call
+}
+
+// SetVotes is a helper method to define mock.On call
+//   - commentID int64
+//   - upvotes int32
+//   - downvotes int32
+func (_e *MockvoteCounter_Expecter) SetVotes(commentID interface{}, upvotes interface{}, downvotes interface{}) *MockvoteCounter_SetVotes_Call {
+	return &MockvoteCounter_SetVotes_Call{Call: _e.mock.On("SetVotes", commentID, upvotes, downvotes)}
+}
+
+func (_c *MockvoteCounter_SetVotes_Call) Run(run func(commentID int64, upvotes int32, downvotes int32)) *MockvoteCounter_SetVotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int32), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockvoteCounter_SetVotes_Call) Return() *MockvoteCounter_SetVotes_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockvoteCounter_SetVotes_Call) RunAndReturn(run func(int64, int32, int32)) *MockvoteCounter_SetVotes_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockvoteCounter creates a new instance of MockvoteCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockvoteCounter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockvoteCounter {
+	mock := &MockvoteCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
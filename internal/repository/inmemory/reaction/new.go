@@ -0,0 +1,49 @@
+package reaction
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+var (
+	ErrPostNotFound     = errors.New("post not found")
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrReactionNotFound = errors.New("reaction not found")
+	ErrUnknownTarget    = errors.New("unknown reaction target")
+)
+
+// byAuthor keeps a single reaction per author, so reacting again replaces it.
+type byAuthor map[string]*models.Reaction
+
+// voteCounter is implemented by the in-memory comment repository, which keeps
+// the vote tallies its TOP and CONTROVERSIAL orderings sort by.
+type voteCounter interface {
+	SetVotes(commentID int64, upvotes, downvotes int32)
+}
+
+type reaction struct {
+	mu        sync.RWMutex
+	reactions map[models.ReactionTarget]map[int64]byAuthor
+
+	repoPost    repository.PostUC
+	repoComment repository.CommentUC
+	votes       voteCounter
+}
+
+func New(repoPost repository.PostUC, repoComment repository.CommentUC) repository.ReactionUC {
+	votes, _ := repoComment.(voteCounter)
+
+	return &reaction{
+		reactions: map[models.ReactionTarget]map[int64]byAuthor{
+			models.ReactionTargetPost:    make(map[int64]byAuthor),
+			models.ReactionTargetComment: make(map[int64]byAuthor),
+		},
+		repoPost:    repoPost,
+		repoComment: repoComment,
+		votes:       votes,
+	}
+}
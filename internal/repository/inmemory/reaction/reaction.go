@@ -0,0 +1,122 @@
+package reaction
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *reaction) Set(ctx context.Context, reaction models.Reaction) error {
+	if err := r.checkTarget(ctx, reaction.Target, reaction.TargetID); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	targets := r.reactions[reaction.Target]
+	if targets[reaction.TargetID] == nil {
+		targets[reaction.TargetID] = make(byAuthor)
+	}
+
+	clone := reaction
+	targets[reaction.TargetID][reaction.Author] = &clone
+	r.syncVotes(reaction.Target, reaction.TargetID)
+
+	return nil
+}
+
+func (r *reaction) Remove(ctx context.Context, target models.ReactionTarget, targetID int64, author string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	targets, ok := r.reactions[target]
+	if !ok {
+		return ErrUnknownTarget
+	}
+
+	if _, ok := targets[targetID][author]; !ok {
+		return ErrReactionNotFound
+	}
+
+	delete(targets[targetID], author)
+	if len(targets[targetID]) == 0 {
+		delete(targets, targetID)
+	}
+	r.syncVotes(target, targetID)
+
+	return nil
+}
+
+func (r *reaction) CountBatch(ctx context.Context, target models.ReactionTarget, targetIDs []int64) ([]*models.ReactionCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	targets, ok := r.reactions[target]
+	if !ok {
+		return nil, ErrUnknownTarget
+	}
+
+	var counts []*models.ReactionCount
+	for _, id := range targetIDs {
+		perKind := make(map[string]int32)
+		for _, reaction := range targets[id] {
+			perKind[reaction.Kind]++
+		}
+
+		kinds := make([]string, 0, len(perKind))
+		for kind := range perKind {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+
+		for _, kind := range kinds {
+			counts = append(counts, &models.ReactionCount{
+				TargetID: id,
+				Kind:     kind,
+				Count:    perKind[kind],
+			})
+		}
+	}
+
+	return counts, nil
+}
+
+// checkTarget rejects reactions to missing, soft deleted or tombstoned targets.
+func (r *reaction) checkTarget(ctx context.Context, target models.ReactionTarget, targetID int64) error {
+	switch target {
+	case models.ReactionTargetPost:
+		if _, err := r.repoPost.GetByID(ctx, targetID); err != nil {
+			return ErrPostNotFound
+		}
+	case models.ReactionTargetComment:
+		if _, err := r.repoComment.CheckParentExists(ctx, targetID); err != nil {
+			return ErrCommentNotFound
+		}
+	default:
+		return ErrUnknownTarget
+	}
+
+	return nil
+}
+
+// syncVotes pushes the vote tallies of a comment to the comment repository.
+// The caller must hold r.mu.
+func (r *reaction) syncVotes(target models.ReactionTarget, targetID int64) {
+	if target != models.ReactionTargetComment || r.votes == nil {
+		return
+	}
+
+	var up, down int32
+	for _, reaction := range r.reactions[target][targetID] {
+		switch reaction.Kind {
+		case models.ReactionUpvote:
+			up++
+		case models.ReactionDownvote:
+			down++
+		}
+	}
+
+	r.votes.SetVotes(targetID, up, down)
+}
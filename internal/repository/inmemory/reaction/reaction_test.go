@@ -0,0 +1,226 @@
+package reaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/comment"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/post"
+)
+
+func TestReactionRepo_Set(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("react_to_post", func(t *testing.T) {
+		repo, postRepo, _ := setupReactionRepo(t)
+		postID := createTestPost(t, postRepo)
+
+		err := repo.Set(ctx, newReaction(models.ReactionTargetPost, postID, "Alice", models.ReactionUpvote))
+		require.NoError(t, err)
+
+		counts, err := repo.CountBatch(ctx, models.ReactionTargetPost, []int64{postID})
+		require.NoError(t, err)
+		require.Len(t, counts, 1)
+		assert.Equal(t, models.ReactionCount{TargetID: postID, Kind: models.ReactionUpvote, Count: 1}, *counts[0])
+	})
+
+	t.Run("react_again_replaces_kind", func(t *testing.T) {
+		repo, postRepo, _ := setupReactionRepo(t)
+		postID := createTestPost(t, postRepo)
+
+		require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetPost, postID, "Alice", models.ReactionUpvote)))
+		require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetPost, postID, "Alice", models.ReactionDownvote)))
+
+		counts, err := repo.CountBatch(ctx, models.ReactionTargetPost, []int64{postID})
+		require.NoError(t, err)
+		require.Len(t, counts, 1)
+		assert.Equal(t, models.ReactionDownvote, counts[0].Kind)
+		assert.Equal(t, int32(1), counts[0].Count)
+	})
+
+	t.Run("react_to_comment", func(t *testing.T) {
+		repo, postRepo, commentRepo := setupReactionRepo(t)
+		postID := createTestPost(t, postRepo)
+		c := addComment(t, commentRepo, postID)
+
+		err := repo.Set(ctx, newReaction(models.ReactionTargetComment, c.ID, "Bob", "like"))
+		require.NoError(t, err)
+
+		counts, err := repo.CountBatch(ctx, models.ReactionTargetComment, []int64{c.ID})
+		require.NoError(t, err)
+		require.Len(t, counts, 1)
+		assert.Equal(t, "like", counts[0].Kind)
+	})
+
+	t.Run("comment_votes_drive_top_order", func(t *testing.T) {
+		repo, postRepo, commentRepo := setupReactionRepo(t)
+		postID := createTestPost(t, postRepo)
+		first := addComment(t, commentRepo, postID)
+		second := addComment(t, commentRepo, postID)
+
+		require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetComment, first.ID, "Alice", models.ReactionUpvote)))
+		require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetComment, first.ID, "Bob", models.ReactionUpvote)))
+		require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetComment, second.ID, "Alice", models.ReactionDownvote)))
+
+		roots, err := commentRepo.GetRootByPost(ctx, postID, models.CommentOrderTop, nil, false, 10)
+		require.NoError(t, err)
+		require.Len(t, roots, 2)
+		assert.Equal(t, first.ID, roots[0].ID)
+		assert.Equal(t, int32(2), roots[0].Upvotes)
+		assert.Equal(t, int32(1), roots[1].Downvotes)
+
+		require.NoError(t, repo.Remove(ctx, models.ReactionTargetComment, first.ID, "Alice"))
+		require.NoError(t, repo.Remove(ctx, models.ReactionTargetComment, first.ID, "Bob"))
+
+		roots, err = commentRepo.GetRootByPost(ctx, postID, models.CommentOrderTop, nil, false, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), roots[0].Upvotes)
+	})
+
+	t.Run("post_not_found", func(t *testing.T) {
+		repo, _, _ := setupReactionRepo(t)
+
+		err := repo.Set(ctx, newReaction(models.ReactionTargetPost, 999, "Alice", models.ReactionUpvote))
+		assert.ErrorIs(t, err, ErrPostNotFound)
+	})
+
+	t.Run("deleted_post", func(t *testing.T) {
+		repo, postRepo, _ := setupReactionRepo(t)
+		postID := createTestPost(t, postRepo)
+		_, err := postRepo.Delete(ctx, postID, time.Now().UTC().Format(time.RFC3339))
+		require.NoError(t, err)
+
+		err = repo.Set(ctx, newReaction(models.ReactionTargetPost, postID, "Alice", models.ReactionUpvote))
+		assert.ErrorIs(t, err, ErrPostNotFound)
+	})
+
+	t.Run("comment_not_found", func(t *testing.T) {
+		repo, _, _ := setupReactionRepo(t)
+
+		err := repo.Set(ctx, newReaction(models.ReactionTargetComment, 999, "Alice", models.ReactionUpvote))
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+	})
+
+	t.Run("unknown_target", func(t *testing.T) {
+		repo, _, _ := setupReactionRepo(t)
+
+		err := repo.Set(ctx, newReaction("USER", 1, "Alice", models.ReactionUpvote))
+		assert.ErrorIs(t, err, ErrUnknownTarget)
+	})
+}
+
+func TestReactionRepo_Remove(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("successful_remove", func(t *testing.T) {
+		repo, postRepo, _ := setupReactionRepo(t)
+		postID := createTestPost(t, postRepo)
+		require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetPost, postID, "Alice", models.ReactionUpvote)))
+
+		err := repo.Remove(ctx, models.ReactionTargetPost, postID, "Alice")
+		require.NoError(t, err)
+
+		counts, err := repo.CountBatch(ctx, models.ReactionTargetPost, []int64{postID})
+		require.NoError(t, err)
+		assert.Empty(t, counts)
+	})
+
+	t.Run("reaction_not_found", func(t *testing.T) {
+		repo, postRepo, _ := setupReactionRepo(t)
+		postID := createTestPost(t, postRepo)
+
+		err := repo.Remove(ctx, models.ReactionTargetPost, postID, "Alice")
+		assert.ErrorIs(t, err, ErrReactionNotFound)
+	})
+
+	t.Run("unknown_target", func(t *testing.T) {
+		repo, _, _ := setupReactionRepo(t)
+
+		err := repo.Remove(ctx, "USER", 1, "Alice")
+		assert.ErrorIs(t, err, ErrUnknownTarget)
+	})
+}
+
+func TestReactionRepo_CountBatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo, postRepo, _ := setupReactionRepo(t)
+	first := createTestPost(t, postRepo)
+	second := createTestPost(t, postRepo)
+
+	require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetPost, first, "Alice", models.ReactionUpvote)))
+	require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetPost, first, "Bob", models.ReactionUpvote)))
+	require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetPost, first, "Carol", "like")))
+	require.NoError(t, repo.Set(ctx, newReaction(models.ReactionTargetPost, second, "Alice", models.ReactionDownvote)))
+
+	counts, err := repo.CountBatch(ctx, models.ReactionTargetPost, []int64{first, second, 999})
+	require.NoError(t, err)
+
+	got := make([]models.ReactionCount, len(counts))
+	for i, c := range counts {
+		got[i] = *c
+	}
+
+	assert.Equal(t, []models.ReactionCount{
+		{TargetID: first, Kind: "like", Count: 1},
+		{TargetID: first, Kind: models.ReactionUpvote, Count: 2},
+		{TargetID: second, Kind: models.ReactionDownvote, Count: 1},
+	}, got)
+
+	t.Run("unknown_target", func(t *testing.T) {
+		_, err := repo.CountBatch(ctx, "USER", []int64{first})
+		assert.ErrorIs(t, err, ErrUnknownTarget)
+	})
+}
+
+func setupReactionRepo(t *testing.T) (repository.ReactionUC, repository.PostUC, repository.CommentUC) {
+	t.Helper()
+	postRepo := post.New()
+	commentRepo := comment.New(postRepo)
+	return New(postRepo, commentRepo), postRepo, commentRepo
+}
+
+func createTestPost(t *testing.T, postRepo repository.PostUC) int64 {
+	t.Helper()
+	p, err := postRepo.Save(context.Background(), models.Post{
+		Title:         "Test Post",
+		Body:          "Content",
+		Author:        "Tester",
+		CommentPolicy: models.CommentPolicyOpen,
+		CreatedAt:     time.Now().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+	return p.ID
+}
+
+func addComment(t *testing.T, repo repository.CommentUC, postID int64) *models.Comment {
+	t.Helper()
+	c, err := repo.Add(context.Background(), models.Comment{
+		PostID:    postID,
+		Author:    "Tester",
+		Text:      "Comment",
+		CreatedAt: time.Now().Format(time.RFC3339),
+		Status:    models.CommentStatusPublished,
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func newReaction(target models.ReactionTarget, targetID int64, author, kind string) models.Reaction {
+	return models.Reaction{
+		Target:    target,
+		TargetID:  targetID,
+		Author:    author,
+		Kind:      kind,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,45 @@
+package report
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *report) Log(ctx context.Context, entry models.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.logSeq++
+	stored := entry
+	stored.ID = r.logSeq
+	r.log = append(r.log, &stored)
+
+	return nil
+}
+
+// GetLog returns the entries written before the cursor, newest first.
+func (r *report) GetLog(ctx context.Context, beforeCreatedAt *string, beforeID int64, limit int32) ([]*models.AuditEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]*models.AuditEntry, 0, limit)
+	for i := len(r.log) - 1; i >= 0 && len(entries) < int(limit); i-- {
+		e := r.log[i]
+		if beforeCreatedAt != nil && (e.CreatedAt > *beforeCreatedAt || (e.CreatedAt == *beforeCreatedAt && e.ID >= beforeID)) {
+			continue
+		}
+
+		clone := *e
+		entries = append(entries, &clone)
+	}
+
+	return entries, nil
+}
+
+func (r *report) LogCount(ctx context.Context) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.log)), nil
+}
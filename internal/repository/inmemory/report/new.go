@@ -0,0 +1,41 @@
+package report
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+var (
+	ErrReportNotFound = errors.New("report not found")
+	ErrReportResolved = errors.New("report is already resolved")
+)
+
+// reportKey identifies the one open report a reporter may have on a target.
+type reportKey struct {
+	target   models.ReportTarget
+	targetID int64
+	reporter string
+}
+
+type report struct {
+	mu         sync.RWMutex
+	reports    map[int64]*models.Report
+	byReporter map[reportKey]int64 // open reports only
+	seq        int64
+
+	// log is kept in the order the entries were written, which is also
+	// their createdAt order.
+	log    []*models.AuditEntry
+	logSeq int64
+}
+
+func New() repository.ReportUC {
+	return &report{
+		reports:    make(map[int64]*models.Report),
+		byReporter: make(map[reportKey]int64),
+	}
+}
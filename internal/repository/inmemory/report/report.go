@@ -0,0 +1,124 @@
+package report
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// Add files the report unless the reporter has an open report on the target,
+// in which case that report is returned and nothing is stored.
+func (r *report) Add(ctx context.Context, report models.Report) (*models.Report, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := reportKey{target: report.Target, targetID: report.TargetID, reporter: report.Reporter}
+	if id, ok := r.byReporter[key]; ok {
+		clone := *r.reports[id]
+		return &clone, false, nil
+	}
+
+	r.seq++
+	stored := report
+	stored.ID = r.seq
+	stored.Status = models.ReportStatusOpen
+	stored.Action = nil
+	stored.TargetHidden = false
+	stored.ResolvedAt = nil
+
+	r.reports[stored.ID] = &stored
+	r.byReporter[key] = stored.ID
+
+	clone := stored
+	return &clone, true, nil
+}
+
+func (r *report) GetByID(ctx context.Context, reportID int64) (*models.Report, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	report, ok := r.reports[reportID]
+	if !ok {
+		return nil, ErrReportNotFound
+	}
+
+	clone := *report
+	return &clone, nil
+}
+
+// ReporterCount is the number of distinct reporters with an open report on the
+// target. Add keeps one open report per reporter and target, so every open
+// report is another reporter.
+func (r *report) ReporterCount(ctx context.Context, target models.ReportTarget, targetID int64) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, report := range r.reports {
+		if isOpenOn(report, target, targetID) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *report) CountByReporter(ctx context.Context, reporter, since string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, report := range r.reports {
+		if report.Reporter == reporter && report.CreatedAt >= since {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *report) MarkHidden(ctx context.Context, target models.ReportTarget, targetID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, report := range r.reports {
+		if isOpenOn(report, target, targetID) {
+			report.TargetHidden = true
+		}
+	}
+
+	return nil
+}
+
+// Resolve settles every open report on the target of reportID.
+func (r *report) Resolve(ctx context.Context, reportID int64, action models.ReportAction, resolvedAt string) (*models.Report, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resolved, ok := r.reports[reportID]
+	if !ok || resolved.Status != models.ReportStatusOpen {
+		return nil, ErrReportResolved
+	}
+
+	target, targetID := resolved.Target, resolved.TargetID
+	for _, report := range r.reports {
+		if !isOpenOn(report, target, targetID) {
+			continue
+		}
+
+		a, at := action, resolvedAt
+		report.Status = models.ReportStatusResolved
+		report.Action = &a
+		report.ResolvedAt = &at
+
+		// The reporter may report the target again from now on.
+		delete(r.byReporter, reportKey{target: target, targetID: targetID, reporter: report.Reporter})
+	}
+
+	clone := *resolved
+	return &clone, nil
+}
+
+func isOpenOn(report *models.Report, target models.ReportTarget, targetID int64) bool {
+	return report.Status == models.ReportStatusOpen && report.Target == target && report.TargetID == targetID
+}
@@ -0,0 +1,304 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func TestReportRepo_Add(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("created", func(t *testing.T) {
+		repo := New()
+
+		got, created, err := repo.Add(ctx, newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T10:00:00Z"))
+
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, int64(1), got.ID)
+		assert.Equal(t, models.ReportStatusOpen, got.Status)
+		assert.Nil(t, got.Action)
+		assert.False(t, got.TargetHidden)
+	})
+
+	t.Run("duplicate_returns_first_report", func(t *testing.T) {
+		repo := New()
+		first, _, err := repo.Add(ctx, newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T10:00:00Z"))
+		require.NoError(t, err)
+
+		again := newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T11:00:00Z")
+		again.Reason = models.ReportReasonAbuse
+		got, created, err := repo.Add(ctx, again)
+
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, first, got)
+
+		count, _ := repo.Count(ctx, models.ReportStatusOpen)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("report_again_after_resolve", func(t *testing.T) {
+		repo := New()
+		first, _, err := repo.Add(ctx, newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T10:00:00Z"))
+		require.NoError(t, err)
+		_, err = repo.Resolve(ctx, first.ID, models.ReportActionDismiss, "2026-02-13T11:00:00Z")
+		require.NoError(t, err)
+
+		got, created, err := repo.Add(ctx, newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T12:00:00Z"))
+
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.NotEqual(t, first.ID, got.ID)
+		assert.Equal(t, models.ReportStatusOpen, got.Status)
+	})
+
+	t.Run("same_reporter_other_target", func(t *testing.T) {
+		repo := New()
+		_, _, err := repo.Add(ctx, newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T10:00:00Z"))
+		require.NoError(t, err)
+
+		got, created, err := repo.Add(ctx, newReport(models.ReportTargetComment, 1, "Alice", "2026-02-13T10:00:00Z"))
+
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, int64(2), got.ID)
+	})
+}
+
+func TestReportRepo_GetByID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := New()
+	added, _, err := repo.Add(ctx, newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T10:00:00Z"))
+	require.NoError(t, err)
+
+	got, err := repo.GetByID(ctx, added.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, added, got)
+
+	got, err = repo.GetByID(ctx, 999)
+	assert.ErrorIs(t, err, ErrReportNotFound)
+	assert.Nil(t, got)
+}
+
+func TestReportRepo_Resolve(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("resolves_all_reports_on_target", func(t *testing.T) {
+		repo := New()
+		r1, _, _ := repo.Add(ctx, newReport(models.ReportTargetComment, 7, "Alice", "2026-02-13T10:00:00Z"))
+		r2, _, _ := repo.Add(ctx, newReport(models.ReportTargetComment, 7, "Bob", "2026-02-13T10:01:00Z"))
+		other, _, _ := repo.Add(ctx, newReport(models.ReportTargetComment, 8, "Alice", "2026-02-13T10:02:00Z"))
+		require.NoError(t, repo.MarkHidden(ctx, models.ReportTargetComment, 7))
+
+		got, err := repo.Resolve(ctx, r1.ID, models.ReportActionHide, "2026-02-13T11:00:00Z")
+
+		require.NoError(t, err)
+		assert.Equal(t, models.ReportStatusResolved, got.Status)
+		require.NotNil(t, got.Action)
+		assert.Equal(t, models.ReportActionHide, *got.Action)
+		require.NotNil(t, got.ResolvedAt)
+		assert.Equal(t, "2026-02-13T11:00:00Z", *got.ResolvedAt)
+		assert.True(t, got.TargetHidden)
+
+		sibling, _ := repo.GetByID(ctx, r2.ID)
+		assert.Equal(t, models.ReportStatusResolved, sibling.Status)
+
+		untouched, _ := repo.GetByID(ctx, other.ID)
+		assert.Equal(t, models.ReportStatusOpen, untouched.Status)
+		assert.False(t, untouched.TargetHidden)
+
+		count, _ := repo.ReporterCount(ctx, models.ReportTargetComment, 7)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("already_resolved", func(t *testing.T) {
+		repo := New()
+		r, _, _ := repo.Add(ctx, newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T10:00:00Z"))
+		_, err := repo.Resolve(ctx, r.ID, models.ReportActionDismiss, "2026-02-13T11:00:00Z")
+		require.NoError(t, err)
+
+		got, err := repo.Resolve(ctx, r.ID, models.ReportActionHide, "2026-02-13T12:00:00Z")
+
+		assert.ErrorIs(t, err, ErrReportResolved)
+		assert.Nil(t, got)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		repo := New()
+
+		_, err := repo.Resolve(ctx, 999, models.ReportActionHide, "2026-02-13T12:00:00Z")
+
+		assert.ErrorIs(t, err, ErrReportResolved)
+	})
+}
+
+func TestReportRepo_ReporterCount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := New()
+	add := func(reporter, caller string) {
+		r := newReport(models.ReportTargetPost, 1, reporter, "2026-02-13T10:00:00Z")
+		r.Caller = caller
+		_, _, err := repo.Add(ctx, r)
+		require.NoError(t, err)
+	}
+	add("Alice", "203.0.113.7")
+	add("Alice", "198.51.100.1")
+	add("Bob", "203.0.113.7")
+	add("Carol", "")
+
+	count, err := repo.ReporterCount(ctx, models.ReportTargetPost, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count, "reporters sharing a host count apart and one reporter counts once")
+}
+
+func TestReportRepo_CountByReporter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := New()
+	for i, createdAt := range []string{"2026-02-13T09:00:00Z", "2026-02-13T10:00:00Z", "2026-02-13T10:30:00Z"} {
+		r := newReport(models.ReportTargetPost, int64(i+1), "Alice", createdAt)
+		r.Caller = "203.0.113.7"
+		_, _, err := repo.Add(ctx, r)
+		require.NoError(t, err)
+	}
+
+	count, err := repo.CountByReporter(ctx, "Alice", "2026-02-13T10:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = repo.CountByReporter(ctx, "Bob", "2026-02-13T00:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestReportRepo_Get(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := New()
+	r1, _, _ := repo.Add(ctx, newReport(models.ReportTargetPost, 1, "Alice", "2026-02-13T10:00:00Z"))
+	r2, _, _ := repo.Add(ctx, newReport(models.ReportTargetPost, 2, "Alice", "2026-02-13T09:00:00Z"))
+	r3, _, _ := repo.Add(ctx, newReport(models.ReportTargetPost, 3, "Alice", "2026-02-13T10:00:00Z"))
+	resolved, _, _ := repo.Add(ctx, newReport(models.ReportTargetPost, 4, "Alice", "2026-02-13T08:00:00Z"))
+	_, err := repo.Resolve(ctx, resolved.ID, models.ReportActionDismiss, "2026-02-13T11:00:00Z")
+	require.NoError(t, err)
+
+	t.Run("oldest_first", func(t *testing.T) {
+		got, err := repo.Get(ctx, models.ReportStatusOpen, nil, 0, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{r2.ID, r1.ID, r3.ID}, reportIDs(got))
+	})
+
+	t.Run("after_cursor", func(t *testing.T) {
+		got, err := repo.Get(ctx, models.ReportStatusOpen, &r1.CreatedAt, r1.ID, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{r3.ID}, reportIDs(got))
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		got, err := repo.Get(ctx, models.ReportStatusOpen, nil, 0, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{r2.ID, r1.ID}, reportIDs(got))
+	})
+
+	t.Run("resolved", func(t *testing.T) {
+		got, err := repo.Get(ctx, models.ReportStatusResolved, nil, 0, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{resolved.ID}, reportIDs(got))
+	})
+
+	t.Run("count", func(t *testing.T) {
+		open, _ := repo.Count(ctx, models.ReportStatusOpen)
+		closed, _ := repo.Count(ctx, models.ReportStatusResolved)
+
+		assert.Equal(t, int64(3), open)
+		assert.Equal(t, int64(1), closed)
+	})
+}
+
+func TestReportRepo_AuditLog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := New()
+	for _, createdAt := range []string{"2026-02-13T10:00:00Z", "2026-02-13T10:00:00Z", "2026-02-13T11:00:00Z"} {
+		require.NoError(t, repo.Log(ctx, models.AuditEntry{
+			Actor:     models.AuditActorSystem,
+			Action:    models.AuditActionAutoHide,
+			Target:    models.ReportTargetPost,
+			TargetID:  1,
+			CreatedAt: createdAt,
+		}))
+	}
+
+	t.Run("newest_first", func(t *testing.T) {
+		got, err := repo.GetLog(ctx, nil, 0, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 2, 1}, entryIDs(got))
+	})
+
+	t.Run("before_cursor", func(t *testing.T) {
+		before := "2026-02-13T10:00:00Z"
+
+		got, err := repo.GetLog(ctx, &before, 2, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, entryIDs(got))
+	})
+
+	t.Run("limit_and_count", func(t *testing.T) {
+		got, err := repo.GetLog(ctx, nil, 0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, entryIDs(got))
+
+		count, err := repo.LogCount(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+}
+
+func newReport(target models.ReportTarget, targetID int64, reporter, createdAt string) models.Report {
+	return models.Report{
+		Target:       target,
+		TargetID:     targetID,
+		TargetAuthor: "Author",
+		Reporter:     reporter,
+		Reason:       models.ReportReasonSpam,
+		CreatedAt:    createdAt,
+	}
+}
+
+func reportIDs(reports []*models.Report) []int64 {
+	ids := make([]int64, 0, len(reports))
+	for _, r := range reports {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func entryIDs(entries []*models.AuditEntry) []int64 {
+	ids := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
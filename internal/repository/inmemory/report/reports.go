@@ -0,0 +1,55 @@
+package report
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// Get returns the reports with the given status oldest first.
+func (r *report) Get(ctx context.Context, status models.ReportStatus, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Report, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []*models.Report
+	for _, report := range r.reports {
+		if report.Status != status {
+			continue
+		}
+		if afterCreatedAt == nil || report.CreatedAt > *afterCreatedAt || (report.CreatedAt == *afterCreatedAt && report.ID > afterID) {
+			matched = append(matched, report)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].CreatedAt == matched[j].CreatedAt {
+			return matched[i].ID < matched[j].ID
+		}
+		return matched[i].CreatedAt < matched[j].CreatedAt
+	})
+
+	endIdx := min(int(limit), len(matched))
+
+	result := make([]*models.Report, endIdx)
+	for i := 0; i < endIdx; i++ {
+		clone := *matched[i]
+		result[i] = &clone
+	}
+
+	return result, nil
+}
+
+func (r *report) Count(ctx context.Context, status models.ReportStatus) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, report := range r.reports {
+		if report.Status == status {
+			count++
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,51 @@
+package spam
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+// spam keeps the classifier statistics in memory and, when path is set,
+// rewrites them to that file after every training step so they survive a
+// restart.
+type spam struct {
+	mu    sync.Mutex
+	path  string
+	stats models.SpamStats
+}
+
+// New reads the statistics saved at path, if any. An empty path keeps them in
+// memory only.
+func New(path string) (repository.SpamUC, error) {
+	r := &spam{
+		path:  path,
+		stats: models.SpamStats{Tokens: make(map[string]models.SpamTokenCount)},
+	}
+
+	if path == "" {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "read spam model")
+	}
+
+	if err := json.Unmarshal(data, &r.stats); err != nil {
+		return nil, errors.Wrap(err, "parse spam model")
+	}
+	if r.stats.Tokens == nil {
+		r.stats.Tokens = make(map[string]models.SpamTokenCount)
+	}
+
+	return r, nil
+}
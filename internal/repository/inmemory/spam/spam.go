@@ -0,0 +1,75 @@
+package spam
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (r *spam) LoadStats(ctx context.Context) (*models.SpamStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := r.stats
+	stats.Tokens = maps.Clone(r.stats.Tokens)
+
+	return &stats, nil
+}
+
+func (r *spam) Train(ctx context.Context, tokens []string, isSpam bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if isSpam {
+		r.stats.SpamDocs++
+	} else {
+		r.stats.HamDocs++
+	}
+
+	for _, token := range tokens {
+		count := r.stats.Tokens[token]
+		if isSpam {
+			count.Spam++
+		} else {
+			count.Ham++
+		}
+		r.stats.Tokens[token] = count
+	}
+
+	return r.save()
+}
+
+// save writes the statistics to a temporary file and renames it over path, so
+// a crash never leaves a half-written model behind. The caller holds the lock.
+func (r *spam) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(r.stats)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "save spam model")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "save spam model")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "save spam model")
+	}
+
+	return errors.Wrap(os.Rename(tmp.Name(), r.path), "save spam model")
+}
@@ -0,0 +1,90 @@
+package spam
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func TestSpamRepo_Train(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "spam.json")
+
+	r, err := New(path)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Train(ctx, []string{"buy", "pills"}, true))
+	require.NoError(t, r.Train(ctx, []string{"thanks", "buy"}, false))
+
+	want := &models.SpamStats{
+		SpamDocs: 1,
+		HamDocs:  1,
+		Tokens: map[string]models.SpamTokenCount{
+			"buy":    {Spam: 1, Ham: 1},
+			"pills":  {Spam: 1},
+			"thanks": {Ham: 1},
+		},
+	}
+
+	stats, err := r.LoadStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, stats)
+
+	stats.Tokens["buy"] = models.SpamTokenCount{}
+	again, err := r.LoadStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, again, "LoadStats returns a copy")
+
+	reloaded, err := New(path)
+	require.NoError(t, err)
+	stats, err = reloaded.LoadStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, stats)
+}
+
+func TestSpamRepo_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("memory_only", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := New("")
+		require.NoError(t, err)
+		require.NoError(t, r.Train(ctx, []string{"buy"}, true))
+
+		stats, err := r.LoadStats(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), stats.SpamDocs)
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := New(filepath.Join(t.TempDir(), "spam.json"))
+		require.NoError(t, err)
+
+		stats, err := r.LoadStats(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, stats.SpamDocs+stats.HamDocs)
+		assert.NotNil(t, stats.Tokens)
+	})
+
+	t.Run("invalid_file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "spam.json")
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+
+		_, err := New(path)
+		assert.ErrorContains(t, err, "parse spam model")
+	})
+}
@@ -0,0 +1,20 @@
+package tx
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+type transactor struct{}
+
+func New() repository.Transactor {
+	return transactor{}
+}
+
+// InTx runs fn as it is. Every in-memory repository applies a call under its
+// own lock and keeps no undo log, so a failing fn keeps what it changed
+// before the failure.
+func (transactor) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
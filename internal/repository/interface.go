@@ -6,20 +6,88 @@ import (
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 )
 
+// Transactor runs fn so that the repository calls it makes with the context
+// it is given are committed together or not at all.
+type Transactor interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type CommentUC interface {
 	Add(ctx context.Context, comment models.Comment) (*models.Comment, error)
-	CheckAllowComments(ctx context.Context, postID int64) (bool, error)
+	GetCommentPolicy(ctx context.Context, postID int64) (*models.PostCommentPolicy, error)
 	CheckParentExists(ctx context.Context, parentID int64) (int64, error)
-	GetRootByPost(ctx context.Context, postID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error)
+	GetReplyParent(ctx context.Context, parentID int64) (*models.ReplyParent, error)
+	GetAncestorAt(ctx context.Context, commentID int64, depth int32) (int64, error)
+	GetByID(ctx context.Context, commentID int64) (*models.Comment, error)
+	GetAncestors(ctx context.Context, commentID int64) ([]*models.Comment, error)
+	GetRootByPost(ctx context.Context, postID int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error)
 	TotalCount(ctx context.Context, postID int64) (int64, error)
-	GetChild(ctx context.Context, parentID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error)
-	GetChildBatch(ctx context.Context, parentIDs []int64) ([]*models.Comment, error)
+	GetRootBatch(ctx context.Context, postIDs []int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error)
+	TotalCountBatch(ctx context.Context, postIDs []int64) ([]*models.CommentCount, error)
+	GetChild(ctx context.Context, parentID int64, order models.CommentOrder, after *models.CommentCursor, limit int32) ([]*models.Comment, error)
+	GetChildBatch(ctx context.Context, parentIDs []int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error)
+	ReplyCountBatch(ctx context.Context, parentIDs []int64) ([]*models.ReplyCount, error)
+	GetThread(ctx context.Context, postID int64, rootID *int64, maxDepth, maxPerLevel int32) ([]*models.ThreadComment, error)
+	GetPublishedSeq(ctx context.Context, postID int64, createdAt string, commentID int64) (int64, error)
+	GetAddedAfter(ctx context.Context, postID int64, afterSeq int64, limit int32) ([]*models.Comment, error)
+	Edit(ctx context.Context, commentID int64, text string, editedAt string) (*models.Comment, error)
+	GetRevisions(ctx context.Context, commentID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.CommentRevision, error)
+	RevisionCount(ctx context.Context, commentID int64) (int64, error)
+	Delete(ctx context.Context, commentID int64) (*models.Comment, error)
+	Purge(ctx context.Context, commentID int64) ([]*models.Comment, error)
+	Search(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32) ([]*models.SearchHit, error)
+	SearchCount(ctx context.Context, query string) (int64, error)
+	GetPending(ctx context.Context, postID *int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error)
+	PendingCount(ctx context.Context, postID *int64) (int64, error)
+	Approve(ctx context.Context, commentID int64) (*models.Comment, error)
+	Reject(ctx context.Context, commentID int64, reason *string) (*models.Comment, error)
+	Hold(ctx context.Context, commentID int64) (*models.Comment, error)
+	GetByIDAnyStatus(ctx context.Context, commentID int64) (*models.Comment, error)
+	GetSpamScore(ctx context.Context, commentID int64) (*float64, error)
 }
 
 type PostUC interface {
 	Save(ctx context.Context, post models.Post) (models.Post, error)
-	SetCommentsAllowed(ctx context.Context, postID int64, allow bool) (*models.Post, error)
+	SetCommentPolicy(ctx context.Context, postID int64, policy models.CommentPolicy, closeAt *string) (*models.Post, error)
+	Update(ctx context.Context, postID int64, title, body *string) (*models.Post, error)
+	Delete(ctx context.Context, postID int64, deletedAt string) (*models.Post, error)
+	Hide(ctx context.Context, postID int64, hiddenAt string) (*models.Post, error)
+	Restore(ctx context.Context, postID int64, unhide bool) (*models.Post, error)
 	GetByID(ctx context.Context, postID int64) (*models.Post, error)
-	Get(ctx context.Context, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Post, error)
-	TotalCount(ctx context.Context) (int64, error)
+	GetByIDs(ctx context.Context, postIDs []int64) ([]*models.Post, error)
+	Get(ctx context.Context, filter models.PostFilter, cursorCreatedAt *string, cursorID int64, backward bool, limit int32) ([]*models.Post, error)
+	TotalCount(ctx context.Context, filter models.PostFilter) (int64, error)
+	Tags(ctx context.Context, prefix string, limit int32) ([]string, error)
+	Search(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32) ([]*models.SearchHit, error)
+	SearchCount(ctx context.Context, query string) (int64, error)
+}
+
+type ReactionUC interface {
+	Set(ctx context.Context, reaction models.Reaction) error
+	Remove(ctx context.Context, target models.ReactionTarget, targetID int64, author string) error
+	CountBatch(ctx context.Context, target models.ReactionTarget, targetIDs []int64) ([]*models.ReactionCount, error)
+}
+
+type SpamUC interface {
+	LoadStats(ctx context.Context) (*models.SpamStats, error)
+	Train(ctx context.Context, tokens []string, spam bool) error
+}
+
+type ReportUC interface {
+	Add(ctx context.Context, report models.Report) (*models.Report, bool, error)
+	GetByID(ctx context.Context, reportID int64) (*models.Report, error)
+	ReporterCount(ctx context.Context, target models.ReportTarget, targetID int64) (int64, error)
+	CountByReporter(ctx context.Context, reporter, since string) (int64, error)
+	MarkHidden(ctx context.Context, target models.ReportTarget, targetID int64) error
+	Resolve(ctx context.Context, reportID int64, action models.ReportAction, resolvedAt string) (*models.Report, error)
+	Get(ctx context.Context, status models.ReportStatus, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Report, error)
+	Count(ctx context.Context, status models.ReportStatus) (int64, error)
+	Log(ctx context.Context, entry models.AuditEntry) error
+	GetLog(ctx context.Context, beforeCreatedAt *string, beforeID int64, limit int32) ([]*models.AuditEntry, error)
+	LogCount(ctx context.Context) (int64, error)
+}
+
+type BanUC interface {
+	Ban(ctx context.Context, author, createdAt string) error
+	IsBanned(ctx context.Context, author string) (bool, error)
 }
@@ -0,0 +1,141 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockBanUC is an autogenerated mock type for the BanUC type
+type MockBanUC struct {
+	mock.Mock
+}
+
+type MockBanUC_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBanUC) EXPECT() *MockBanUC_Expecter {
+	return &MockBanUC_Expecter{mock: &_m.Mock}
+}
+
+// Ban provides a mock function with given fields: ctx, author, createdAt
+func (_m *MockBanUC) Ban(ctx context.Context, author string, createdAt string) error {
+	ret := _m.Called(ctx, author, createdAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ban")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, author, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBanUC_Ban_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ban'
+type MockBanUC_Ban_Call struct {
+	*mock.Call
+}
+
+// Ban is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - createdAt string
+func (_e *MockBanUC_Expecter) Ban(ctx interface{}, author interface{}, createdAt interface{}) *MockBanUC_Ban_Call {
+	return &MockBanUC_Ban_Call{Call: _e.mock.On("Ban", ctx, author, createdAt)}
+}
+
+func (_c *MockBanUC_Ban_Call) Run(run func(ctx context.Context, author string, createdAt string)) *MockBanUC_Ban_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockBanUC_Ban_Call) Return(_a0 error) *MockBanUC_Ban_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBanUC_Ban_Call) RunAndReturn(run func(context.Context, string, string) error) *MockBanUC_Ban_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBanned provides a mock function with given fields: ctx, author
+func (_m *MockBanUC) IsBanned(ctx context.Context, author string) (bool, error) {
+	ret := _m.Called(ctx, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBanned")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, author)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, author)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBanUC_IsBanned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBanned'
+type MockBanUC_IsBanned_Call struct {
+	*mock.Call
+}
+
+// IsBanned is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+func (_e *MockBanUC_Expecter) IsBanned(ctx interface{}, author interface{}) *MockBanUC_IsBanned_Call {
+	return &MockBanUC_IsBanned_Call{Call: _e.mock.On("IsBanned", ctx, author)}
+}
+
+func (_c *MockBanUC_IsBanned_Call) Run(run func(ctx context.Context, author string)) *MockBanUC_IsBanned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockBanUC_IsBanned_Call) Return(_a0 bool, _a1 error) *MockBanUC_IsBanned_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBanUC_IsBanned_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockBanUC_IsBanned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBanUC creates a new instance of MockBanUC. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBanUC(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBanUC {
+	mock := &MockBanUC{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -81,27 +81,1234 @@ func (_c *MockCommentUC_Add_Call) RunAndReturn(run func(context.Context, models.
 	return _c
 }
 
-// CheckAllowComments provides a mock function with given fields: ctx, postID
-func (_m *MockCommentUC) CheckAllowComments(ctx context.Context, postID int64) (bool, error) {
+// Approve provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) Approve(ctx context.Context, commentID int64) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Approve")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_Approve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Approve'
+type MockCommentUC_Approve_Call struct {
+	*mock.Call
+}
+
+// Approve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) Approve(ctx interface{}, commentID interface{}) *MockCommentUC_Approve_Call {
+	return &MockCommentUC_Approve_Call{Call: _e.mock.On("Approve", ctx, commentID)}
+}
+
+func (_c *MockCommentUC_Approve_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_Approve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_Approve_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentUC_Approve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_Approve_Call) RunAndReturn(run func(context.Context, int64) (*models.Comment, error)) *MockCommentUC_Approve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckParentExists provides a mock function with given fields: ctx, parentID
+func (_m *MockCommentUC) CheckParentExists(ctx context.Context, parentID int64) (int64, error) {
+	ret := _m.Called(ctx, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckParentExists")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, parentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, parentID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_CheckParentExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckParentExists'
+type MockCommentUC_CheckParentExists_Call struct {
+	*mock.Call
+}
+
+// CheckParentExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID int64
+func (_e *MockCommentUC_Expecter) CheckParentExists(ctx interface{}, parentID interface{}) *MockCommentUC_CheckParentExists_Call {
+	return &MockCommentUC_CheckParentExists_Call{Call: _e.mock.On("CheckParentExists", ctx, parentID)}
+}
+
+func (_c *MockCommentUC_CheckParentExists_Call) Run(run func(ctx context.Context, parentID int64)) *MockCommentUC_CheckParentExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_CheckParentExists_Call) Return(_a0 int64, _a1 error) *MockCommentUC_CheckParentExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_CheckParentExists_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockCommentUC_CheckParentExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) Delete(ctx context.Context, commentID int64) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCommentUC_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) Delete(ctx interface{}, commentID interface{}) *MockCommentUC_Delete_Call {
+	return &MockCommentUC_Delete_Call{Call: _e.mock.On("Delete", ctx, commentID)}
+}
+
+func (_c *MockCommentUC_Delete_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_Delete_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentUC_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_Delete_Call) RunAndReturn(run func(context.Context, int64) (*models.Comment, error)) *MockCommentUC_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Edit provides a mock function with given fields: ctx, commentID, text, editedAt
+func (_m *MockCommentUC) Edit(ctx context.Context, commentID int64, text string, editedAt string) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID, text, editedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Edit")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (*models.Comment, error)); ok {
+		return rf(ctx, commentID, text, editedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) *models.Comment); ok {
+		r0 = rf(ctx, commentID, text, editedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, commentID, text, editedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_Edit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Edit'
+type MockCommentUC_Edit_Call struct {
+	*mock.Call
+}
+
+// Edit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+//   - text string
+//   - editedAt string
+func (_e *MockCommentUC_Expecter) Edit(ctx interface{}, commentID interface{}, text interface{}, editedAt interface{}) *MockCommentUC_Edit_Call {
+	return &MockCommentUC_Edit_Call{Call: _e.mock.On("Edit", ctx, commentID, text, editedAt)}
+}
+
+func (_c *MockCommentUC_Edit_Call) Run(run func(ctx context.Context, commentID int64, text string, editedAt string)) *MockCommentUC_Edit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_Edit_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentUC_Edit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_Edit_Call) RunAndReturn(run func(context.Context, int64, string, string) (*models.Comment, error)) *MockCommentUC_Edit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAddedAfter provides a mock function with given fields: ctx, postID, afterSeq, limit
+func (_m *MockCommentUC) GetAddedAfter(ctx context.Context, postID int64, afterSeq int64, limit int32) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, postID, afterSeq, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAddedAfter")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32) ([]*models.Comment, error)); ok {
+		return rf(ctx, postID, afterSeq, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32) []*models.Comment); ok {
+		r0 = rf(ctx, postID, afterSeq, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int32) error); ok {
+		r1 = rf(ctx, postID, afterSeq, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetAddedAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAddedAfter'
+type MockCommentUC_GetAddedAfter_Call struct {
+	*mock.Call
+}
+
+// GetAddedAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - afterSeq int64
+//   - limit int32
+func (_e *MockCommentUC_Expecter) GetAddedAfter(ctx interface{}, postID interface{}, afterSeq interface{}, limit interface{}) *MockCommentUC_GetAddedAfter_Call {
+	return &MockCommentUC_GetAddedAfter_Call{Call: _e.mock.On("GetAddedAfter", ctx, postID, afterSeq, limit)}
+}
+
+func (_c *MockCommentUC_GetAddedAfter_Call) Run(run func(ctx context.Context, postID int64, afterSeq int64, limit int32)) *MockCommentUC_GetAddedAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetAddedAfter_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetAddedAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetAddedAfter_Call) RunAndReturn(run func(context.Context, int64, int64, int32) ([]*models.Comment, error)) *MockCommentUC_GetAddedAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAncestorAt provides a mock function with given fields: ctx, commentID, depth
+func (_m *MockCommentUC) GetAncestorAt(ctx context.Context, commentID int64, depth int32) (int64, error) {
+	ret := _m.Called(ctx, commentID, depth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAncestorAt")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32) (int64, error)); ok {
+		return rf(ctx, commentID, depth)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32) int64); ok {
+		r0 = rf(ctx, commentID, depth)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32) error); ok {
+		r1 = rf(ctx, commentID, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetAncestorAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAncestorAt'
+type MockCommentUC_GetAncestorAt_Call struct {
+	*mock.Call
+}
+
+// GetAncestorAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+//   - depth int32
+func (_e *MockCommentUC_Expecter) GetAncestorAt(ctx interface{}, commentID interface{}, depth interface{}) *MockCommentUC_GetAncestorAt_Call {
+	return &MockCommentUC_GetAncestorAt_Call{Call: _e.mock.On("GetAncestorAt", ctx, commentID, depth)}
+}
+
+func (_c *MockCommentUC_GetAncestorAt_Call) Run(run func(ctx context.Context, commentID int64, depth int32)) *MockCommentUC_GetAncestorAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetAncestorAt_Call) Return(_a0 int64, _a1 error) *MockCommentUC_GetAncestorAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetAncestorAt_Call) RunAndReturn(run func(context.Context, int64, int32) (int64, error)) *MockCommentUC_GetAncestorAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAncestors provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) GetAncestors(ctx context.Context, commentID int64) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAncestors")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetAncestors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAncestors'
+type MockCommentUC_GetAncestors_Call struct {
+	*mock.Call
+}
+
+// GetAncestors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) GetAncestors(ctx interface{}, commentID interface{}) *MockCommentUC_GetAncestors_Call {
+	return &MockCommentUC_GetAncestors_Call{Call: _e.mock.On("GetAncestors", ctx, commentID)}
+}
+
+func (_c *MockCommentUC_GetAncestors_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_GetAncestors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetAncestors_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetAncestors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetAncestors_Call) RunAndReturn(run func(context.Context, int64) ([]*models.Comment, error)) *MockCommentUC_GetAncestors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) GetByID(ctx context.Context, commentID int64) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCommentUC_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) GetByID(ctx interface{}, commentID interface{}) *MockCommentUC_GetByID_Call {
+	return &MockCommentUC_GetByID_Call{Call: _e.mock.On("GetByID", ctx, commentID)}
+}
+
+func (_c *MockCommentUC_GetByID_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetByID_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentUC_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetByID_Call) RunAndReturn(run func(context.Context, int64) (*models.Comment, error)) *MockCommentUC_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDAnyStatus provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) GetByIDAnyStatus(ctx context.Context, commentID int64) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDAnyStatus")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetByIDAnyStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDAnyStatus'
+type MockCommentUC_GetByIDAnyStatus_Call struct {
+	*mock.Call
+}
+
+// GetByIDAnyStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) GetByIDAnyStatus(ctx interface{}, commentID interface{}) *MockCommentUC_GetByIDAnyStatus_Call {
+	return &MockCommentUC_GetByIDAnyStatus_Call{Call: _e.mock.On("GetByIDAnyStatus", ctx, commentID)}
+}
+
+func (_c *MockCommentUC_GetByIDAnyStatus_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_GetByIDAnyStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetByIDAnyStatus_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentUC_GetByIDAnyStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetByIDAnyStatus_Call) RunAndReturn(run func(context.Context, int64) (*models.Comment, error)) *MockCommentUC_GetByIDAnyStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChild provides a mock function with given fields: ctx, parentID, order, after, limit
+func (_m *MockCommentUC) GetChild(ctx context.Context, parentID int64, order models.CommentOrder, after *models.CommentCursor, limit int32) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, parentID, order, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChild")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.CommentOrder, *models.CommentCursor, int32) ([]*models.Comment, error)); ok {
+		return rf(ctx, parentID, order, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.CommentOrder, *models.CommentCursor, int32) []*models.Comment); ok {
+		r0 = rf(ctx, parentID, order, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, models.CommentOrder, *models.CommentCursor, int32) error); ok {
+		r1 = rf(ctx, parentID, order, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetChild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChild'
+type MockCommentUC_GetChild_Call struct {
+	*mock.Call
+}
+
+// GetChild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID int64
+//   - order models.CommentOrder
+//   - after *models.CommentCursor
+//   - limit int32
+func (_e *MockCommentUC_Expecter) GetChild(ctx interface{}, parentID interface{}, order interface{}, after interface{}, limit interface{}) *MockCommentUC_GetChild_Call {
+	return &MockCommentUC_GetChild_Call{Call: _e.mock.On("GetChild", ctx, parentID, order, after, limit)}
+}
+
+func (_c *MockCommentUC_GetChild_Call) Run(run func(ctx context.Context, parentID int64, order models.CommentOrder, after *models.CommentCursor, limit int32)) *MockCommentUC_GetChild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(models.CommentOrder), args[3].(*models.CommentCursor), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetChild_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetChild_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetChild_Call) RunAndReturn(run func(context.Context, int64, models.CommentOrder, *models.CommentCursor, int32) ([]*models.Comment, error)) *MockCommentUC_GetChild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChildBatch provides a mock function with given fields: ctx, parentIDs, order, cursor, backward, limit
+func (_m *MockCommentUC) GetChildBatch(ctx context.Context, parentIDs []int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, parentIDs, order, cursor, backward, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChildBatch")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, models.CommentOrder, *models.CommentCursor, bool, int32) ([]*models.Comment, error)); ok {
+		return rf(ctx, parentIDs, order, cursor, backward, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, models.CommentOrder, *models.CommentCursor, bool, int32) []*models.Comment); ok {
+		r0 = rf(ctx, parentIDs, order, cursor, backward, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, models.CommentOrder, *models.CommentCursor, bool, int32) error); ok {
+		r1 = rf(ctx, parentIDs, order, cursor, backward, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetChildBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChildBatch'
+type MockCommentUC_GetChildBatch_Call struct {
+	*mock.Call
+}
+
+// GetChildBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentIDs []int64
+//   - order models.CommentOrder
+//   - cursor *models.CommentCursor
+//   - backward bool
+//   - limit int32
+func (_e *MockCommentUC_Expecter) GetChildBatch(ctx interface{}, parentIDs interface{}, order interface{}, cursor interface{}, backward interface{}, limit interface{}) *MockCommentUC_GetChildBatch_Call {
+	return &MockCommentUC_GetChildBatch_Call{Call: _e.mock.On("GetChildBatch", ctx, parentIDs, order, cursor, backward, limit)}
+}
+
+func (_c *MockCommentUC_GetChildBatch_Call) Run(run func(ctx context.Context, parentIDs []int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32)) *MockCommentUC_GetChildBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64), args[2].(models.CommentOrder), args[3].(*models.CommentCursor), args[4].(bool), args[5].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetChildBatch_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetChildBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetChildBatch_Call) RunAndReturn(run func(context.Context, []int64, models.CommentOrder, *models.CommentCursor, bool, int32) ([]*models.Comment, error)) *MockCommentUC_GetChildBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommentPolicy provides a mock function with given fields: ctx, postID
+func (_m *MockCommentUC) GetCommentPolicy(ctx context.Context, postID int64) (*models.PostCommentPolicy, error) {
 	ret := _m.Called(ctx, postID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CheckAllowComments")
+		panic("no return value specified for GetCommentPolicy")
+	}
+
+	var r0 *models.PostCommentPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.PostCommentPolicy, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.PostCommentPolicy); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PostCommentPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetCommentPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentPolicy'
+type MockCommentUC_GetCommentPolicy_Call struct {
+	*mock.Call
+}
+
+// GetCommentPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+func (_e *MockCommentUC_Expecter) GetCommentPolicy(ctx interface{}, postID interface{}) *MockCommentUC_GetCommentPolicy_Call {
+	return &MockCommentUC_GetCommentPolicy_Call{Call: _e.mock.On("GetCommentPolicy", ctx, postID)}
+}
+
+func (_c *MockCommentUC_GetCommentPolicy_Call) Run(run func(ctx context.Context, postID int64)) *MockCommentUC_GetCommentPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetCommentPolicy_Call) Return(_a0 *models.PostCommentPolicy, _a1 error) *MockCommentUC_GetCommentPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetCommentPolicy_Call) RunAndReturn(run func(context.Context, int64) (*models.PostCommentPolicy, error)) *MockCommentUC_GetCommentPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPending provides a mock function with given fields: ctx, postID, afterCreatedAt, afterID, limit
+func (_m *MockCommentUC) GetPending(ctx context.Context, postID *int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, postID, afterCreatedAt, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPending")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int64, *string, int64, int32) ([]*models.Comment, error)); ok {
+		return rf(ctx, postID, afterCreatedAt, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int64, *string, int64, int32) []*models.Comment); ok {
+		r0 = rf(ctx, postID, afterCreatedAt, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int64, *string, int64, int32) error); ok {
+		r1 = rf(ctx, postID, afterCreatedAt, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPending'
+type MockCommentUC_GetPending_Call struct {
+	*mock.Call
+}
+
+// GetPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID *int64
+//   - afterCreatedAt *string
+//   - afterID int64
+//   - limit int32
+func (_e *MockCommentUC_Expecter) GetPending(ctx interface{}, postID interface{}, afterCreatedAt interface{}, afterID interface{}, limit interface{}) *MockCommentUC_GetPending_Call {
+	return &MockCommentUC_GetPending_Call{Call: _e.mock.On("GetPending", ctx, postID, afterCreatedAt, afterID, limit)}
+}
+
+func (_c *MockCommentUC_GetPending_Call) Run(run func(ctx context.Context, postID *int64, afterCreatedAt *string, afterID int64, limit int32)) *MockCommentUC_GetPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*int64), args[2].(*string), args[3].(int64), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetPending_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetPending_Call) RunAndReturn(run func(context.Context, *int64, *string, int64, int32) ([]*models.Comment, error)) *MockCommentUC_GetPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPublishedSeq provides a mock function with given fields: ctx, postID, createdAt, commentID
+func (_m *MockCommentUC) GetPublishedSeq(ctx context.Context, postID int64, createdAt string, commentID int64) (int64, error) {
+	ret := _m.Called(ctx, postID, createdAt, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublishedSeq")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) (int64, error)); ok {
+		return rf(ctx, postID, createdAt, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) int64); ok {
+		r0 = rf(ctx, postID, createdAt, commentID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int64) error); ok {
+		r1 = rf(ctx, postID, createdAt, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetPublishedSeq_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublishedSeq'
+type MockCommentUC_GetPublishedSeq_Call struct {
+	*mock.Call
+}
+
+// GetPublishedSeq is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - createdAt string
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) GetPublishedSeq(ctx interface{}, postID interface{}, createdAt interface{}, commentID interface{}) *MockCommentUC_GetPublishedSeq_Call {
+	return &MockCommentUC_GetPublishedSeq_Call{Call: _e.mock.On("GetPublishedSeq", ctx, postID, createdAt, commentID)}
+}
+
+func (_c *MockCommentUC_GetPublishedSeq_Call) Run(run func(ctx context.Context, postID int64, createdAt string, commentID int64)) *MockCommentUC_GetPublishedSeq_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetPublishedSeq_Call) Return(_a0 int64, _a1 error) *MockCommentUC_GetPublishedSeq_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetPublishedSeq_Call) RunAndReturn(run func(context.Context, int64, string, int64) (int64, error)) *MockCommentUC_GetPublishedSeq_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplyParent provides a mock function with given fields: ctx, parentID
+func (_m *MockCommentUC) GetReplyParent(ctx context.Context, parentID int64) (*models.ReplyParent, error) {
+	ret := _m.Called(ctx, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplyParent")
+	}
+
+	var r0 *models.ReplyParent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.ReplyParent, error)); ok {
+		return rf(ctx, parentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.ReplyParent); ok {
+		r0 = rf(ctx, parentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReplyParent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetReplyParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplyParent'
+type MockCommentUC_GetReplyParent_Call struct {
+	*mock.Call
+}
+
+// GetReplyParent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID int64
+func (_e *MockCommentUC_Expecter) GetReplyParent(ctx interface{}, parentID interface{}) *MockCommentUC_GetReplyParent_Call {
+	return &MockCommentUC_GetReplyParent_Call{Call: _e.mock.On("GetReplyParent", ctx, parentID)}
+}
+
+func (_c *MockCommentUC_GetReplyParent_Call) Run(run func(ctx context.Context, parentID int64)) *MockCommentUC_GetReplyParent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetReplyParent_Call) Return(_a0 *models.ReplyParent, _a1 error) *MockCommentUC_GetReplyParent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetReplyParent_Call) RunAndReturn(run func(context.Context, int64) (*models.ReplyParent, error)) *MockCommentUC_GetReplyParent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisions provides a mock function with given fields: ctx, commentID, afterCreatedAt, afterID, limit
+func (_m *MockCommentUC) GetRevisions(ctx context.Context, commentID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.CommentRevision, error) {
+	ret := _m.Called(ctx, commentID, afterCreatedAt, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 []*models.CommentRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *string, int64, int32) ([]*models.CommentRevision, error)); ok {
+		return rf(ctx, commentID, afterCreatedAt, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *string, int64, int32) []*models.CommentRevision); ok {
+		r0 = rf(ctx, commentID, afterCreatedAt, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CommentRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *string, int64, int32) error); ok {
+		r1 = rf(ctx, commentID, afterCreatedAt, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type MockCommentUC_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+//   - afterCreatedAt *string
+//   - afterID int64
+//   - limit int32
+func (_e *MockCommentUC_Expecter) GetRevisions(ctx interface{}, commentID interface{}, afterCreatedAt interface{}, afterID interface{}, limit interface{}) *MockCommentUC_GetRevisions_Call {
+	return &MockCommentUC_GetRevisions_Call{Call: _e.mock.On("GetRevisions", ctx, commentID, afterCreatedAt, afterID, limit)}
+}
+
+func (_c *MockCommentUC_GetRevisions_Call) Run(run func(ctx context.Context, commentID int64, afterCreatedAt *string, afterID int64, limit int32)) *MockCommentUC_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*string), args[3].(int64), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetRevisions_Call) Return(_a0 []*models.CommentRevision, _a1 error) *MockCommentUC_GetRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetRevisions_Call) RunAndReturn(run func(context.Context, int64, *string, int64, int32) ([]*models.CommentRevision, error)) *MockCommentUC_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRootBatch provides a mock function with given fields: ctx, postIDs, order, cursor, backward, limit
+func (_m *MockCommentUC) GetRootBatch(ctx context.Context, postIDs []int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, postIDs, order, cursor, backward, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRootBatch")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, models.CommentOrder, *models.CommentCursor, bool, int32) ([]*models.Comment, error)); ok {
+		return rf(ctx, postIDs, order, cursor, backward, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, models.CommentOrder, *models.CommentCursor, bool, int32) []*models.Comment); ok {
+		r0 = rf(ctx, postIDs, order, cursor, backward, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, models.CommentOrder, *models.CommentCursor, bool, int32) error); ok {
+		r1 = rf(ctx, postIDs, order, cursor, backward, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetRootBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRootBatch'
+type MockCommentUC_GetRootBatch_Call struct {
+	*mock.Call
+}
+
+// GetRootBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postIDs []int64
+//   - order models.CommentOrder
+//   - cursor *models.CommentCursor
+//   - backward bool
+//   - limit int32
+func (_e *MockCommentUC_Expecter) GetRootBatch(ctx interface{}, postIDs interface{}, order interface{}, cursor interface{}, backward interface{}, limit interface{}) *MockCommentUC_GetRootBatch_Call {
+	return &MockCommentUC_GetRootBatch_Call{Call: _e.mock.On("GetRootBatch", ctx, postIDs, order, cursor, backward, limit)}
+}
+
+func (_c *MockCommentUC_GetRootBatch_Call) Run(run func(ctx context.Context, postIDs []int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32)) *MockCommentUC_GetRootBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64), args[2].(models.CommentOrder), args[3].(*models.CommentCursor), args[4].(bool), args[5].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetRootBatch_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetRootBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetRootBatch_Call) RunAndReturn(run func(context.Context, []int64, models.CommentOrder, *models.CommentCursor, bool, int32) ([]*models.Comment, error)) *MockCommentUC_GetRootBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRootByPost provides a mock function with given fields: ctx, postID, order, cursor, backward, limit
+func (_m *MockCommentUC) GetRootByPost(ctx context.Context, postID int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, postID, order, cursor, backward, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRootByPost")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.CommentOrder, *models.CommentCursor, bool, int32) ([]*models.Comment, error)); ok {
+		return rf(ctx, postID, order, cursor, backward, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.CommentOrder, *models.CommentCursor, bool, int32) []*models.Comment); ok {
+		r0 = rf(ctx, postID, order, cursor, backward, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, models.CommentOrder, *models.CommentCursor, bool, int32) error); ok {
+		r1 = rf(ctx, postID, order, cursor, backward, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetRootByPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRootByPost'
+type MockCommentUC_GetRootByPost_Call struct {
+	*mock.Call
+}
+
+// GetRootByPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - order models.CommentOrder
+//   - cursor *models.CommentCursor
+//   - backward bool
+//   - limit int32
+func (_e *MockCommentUC_Expecter) GetRootByPost(ctx interface{}, postID interface{}, order interface{}, cursor interface{}, backward interface{}, limit interface{}) *MockCommentUC_GetRootByPost_Call {
+	return &MockCommentUC_GetRootByPost_Call{Call: _e.mock.On("GetRootByPost", ctx, postID, order, cursor, backward, limit)}
+}
+
+func (_c *MockCommentUC_GetRootByPost_Call) Run(run func(ctx context.Context, postID int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32)) *MockCommentUC_GetRootByPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(models.CommentOrder), args[3].(*models.CommentCursor), args[4].(bool), args[5].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetRootByPost_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetRootByPost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetRootByPost_Call) RunAndReturn(run func(context.Context, int64, models.CommentOrder, *models.CommentCursor, bool, int32) ([]*models.Comment, error)) *MockCommentUC_GetRootByPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpamScore provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) GetSpamScore(ctx context.Context, commentID int64) (*float64, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpamScore")
+	}
+
+	var r0 *float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*float64, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *float64); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*float64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetSpamScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpamScore'
+type MockCommentUC_GetSpamScore_Call struct {
+	*mock.Call
+}
+
+// GetSpamScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) GetSpamScore(ctx interface{}, commentID interface{}) *MockCommentUC_GetSpamScore_Call {
+	return &MockCommentUC_GetSpamScore_Call{Call: _e.mock.On("GetSpamScore", ctx, commentID)}
+}
+
+func (_c *MockCommentUC_GetSpamScore_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_GetSpamScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetSpamScore_Call) Return(_a0 *float64, _a1 error) *MockCommentUC_GetSpamScore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetSpamScore_Call) RunAndReturn(run func(context.Context, int64) (*float64, error)) *MockCommentUC_GetSpamScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetThread provides a mock function with given fields: ctx, postID, rootID, maxDepth, maxPerLevel
+func (_m *MockCommentUC) GetThread(ctx context.Context, postID int64, rootID *int64, maxDepth int32, maxPerLevel int32) ([]*models.ThreadComment, error) {
+	ret := _m.Called(ctx, postID, rootID, maxDepth, maxPerLevel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetThread")
+	}
+
+	var r0 []*models.ThreadComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int64, int32, int32) ([]*models.ThreadComment, error)); ok {
+		return rf(ctx, postID, rootID, maxDepth, maxPerLevel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int64, int32, int32) []*models.ThreadComment); ok {
+		r0 = rf(ctx, postID, rootID, maxDepth, maxPerLevel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ThreadComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *int64, int32, int32) error); ok {
+		r1 = rf(ctx, postID, rootID, maxDepth, maxPerLevel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_GetThread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetThread'
+type MockCommentUC_GetThread_Call struct {
+	*mock.Call
+}
+
+// GetThread is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - rootID *int64
+//   - maxDepth int32
+//   - maxPerLevel int32
+func (_e *MockCommentUC_Expecter) GetThread(ctx interface{}, postID interface{}, rootID interface{}, maxDepth interface{}, maxPerLevel interface{}) *MockCommentUC_GetThread_Call {
+	return &MockCommentUC_GetThread_Call{Call: _e.mock.On("GetThread", ctx, postID, rootID, maxDepth, maxPerLevel)}
+}
+
+func (_c *MockCommentUC_GetThread_Call) Run(run func(ctx context.Context, postID int64, rootID *int64, maxDepth int32, maxPerLevel int32)) *MockCommentUC_GetThread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*int64), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_GetThread_Call) Return(_a0 []*models.ThreadComment, _a1 error) *MockCommentUC_GetThread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_GetThread_Call) RunAndReturn(run func(context.Context, int64, *int64, int32, int32) ([]*models.ThreadComment, error)) *MockCommentUC_GetThread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hold provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) Hold(ctx context.Context, commentID int64) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hold")
 	}
 
-	var r0 bool
+	var r0 *models.Comment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
-		return rf(ctx, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.Comment, error)); ok {
+		return rf(ctx, commentID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
-		r0 = rf(ctx, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.Comment); ok {
+		r0 = rf(ctx, commentID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, postID)
+		r1 = rf(ctx, commentID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -109,56 +1316,56 @@ func (_m *MockCommentUC) CheckAllowComments(ctx context.Context, postID int64) (
 	return r0, r1
 }
 
-// MockCommentUC_CheckAllowComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckAllowComments'
-type MockCommentUC_CheckAllowComments_Call struct {
+// MockCommentUC_Hold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hold'
+type MockCommentUC_Hold_Call struct {
 	*mock.Call
 }
 
-// CheckAllowComments is a helper method to define mock.On call
+// Hold is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID int64
-func (_e *MockCommentUC_Expecter) CheckAllowComments(ctx interface{}, postID interface{}) *MockCommentUC_CheckAllowComments_Call {
-	return &MockCommentUC_CheckAllowComments_Call{Call: _e.mock.On("CheckAllowComments", ctx, postID)}
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) Hold(ctx interface{}, commentID interface{}) *MockCommentUC_Hold_Call {
+	return &MockCommentUC_Hold_Call{Call: _e.mock.On("Hold", ctx, commentID)}
 }
 
-func (_c *MockCommentUC_CheckAllowComments_Call) Run(run func(ctx context.Context, postID int64)) *MockCommentUC_CheckAllowComments_Call {
+func (_c *MockCommentUC_Hold_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_Hold_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockCommentUC_CheckAllowComments_Call) Return(_a0 bool, _a1 error) *MockCommentUC_CheckAllowComments_Call {
+func (_c *MockCommentUC_Hold_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentUC_Hold_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentUC_CheckAllowComments_Call) RunAndReturn(run func(context.Context, int64) (bool, error)) *MockCommentUC_CheckAllowComments_Call {
+func (_c *MockCommentUC_Hold_Call) RunAndReturn(run func(context.Context, int64) (*models.Comment, error)) *MockCommentUC_Hold_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CheckParentExists provides a mock function with given fields: ctx, parentID
-func (_m *MockCommentUC) CheckParentExists(ctx context.Context, parentID int64) (int64, error) {
-	ret := _m.Called(ctx, parentID)
+// PendingCount provides a mock function with given fields: ctx, postID
+func (_m *MockCommentUC) PendingCount(ctx context.Context, postID *int64) (int64, error) {
+	ret := _m.Called(ctx, postID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CheckParentExists")
+		panic("no return value specified for PendingCount")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
-		return rf(ctx, parentID)
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) (int64, error)); ok {
+		return rf(ctx, postID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
-		r0 = rf(ctx, parentID)
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) int64); ok {
+		r0 = rf(ctx, postID)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, parentID)
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, postID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,58 +1373,58 @@ func (_m *MockCommentUC) CheckParentExists(ctx context.Context, parentID int64)
 	return r0, r1
 }
 
-// MockCommentUC_CheckParentExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckParentExists'
-type MockCommentUC_CheckParentExists_Call struct {
+// MockCommentUC_PendingCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingCount'
+type MockCommentUC_PendingCount_Call struct {
 	*mock.Call
 }
 
-// CheckParentExists is a helper method to define mock.On call
+// PendingCount is a helper method to define mock.On call
 //   - ctx context.Context
-//   - parentID int64
-func (_e *MockCommentUC_Expecter) CheckParentExists(ctx interface{}, parentID interface{}) *MockCommentUC_CheckParentExists_Call {
-	return &MockCommentUC_CheckParentExists_Call{Call: _e.mock.On("CheckParentExists", ctx, parentID)}
+//   - postID *int64
+func (_e *MockCommentUC_Expecter) PendingCount(ctx interface{}, postID interface{}) *MockCommentUC_PendingCount_Call {
+	return &MockCommentUC_PendingCount_Call{Call: _e.mock.On("PendingCount", ctx, postID)}
 }
 
-func (_c *MockCommentUC_CheckParentExists_Call) Run(run func(ctx context.Context, parentID int64)) *MockCommentUC_CheckParentExists_Call {
+func (_c *MockCommentUC_PendingCount_Call) Run(run func(ctx context.Context, postID *int64)) *MockCommentUC_PendingCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(*int64))
 	})
 	return _c
 }
 
-func (_c *MockCommentUC_CheckParentExists_Call) Return(_a0 int64, _a1 error) *MockCommentUC_CheckParentExists_Call {
+func (_c *MockCommentUC_PendingCount_Call) Return(_a0 int64, _a1 error) *MockCommentUC_PendingCount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentUC_CheckParentExists_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockCommentUC_CheckParentExists_Call {
+func (_c *MockCommentUC_PendingCount_Call) RunAndReturn(run func(context.Context, *int64) (int64, error)) *MockCommentUC_PendingCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetChild provides a mock function with given fields: ctx, parentID, afterCreatedAt, afterID, limit
-func (_m *MockCommentUC) GetChild(ctx context.Context, parentID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
-	ret := _m.Called(ctx, parentID, afterCreatedAt, afterID, limit)
+// Purge provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) Purge(ctx context.Context, commentID int64) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetChild")
+		panic("no return value specified for Purge")
 	}
 
 	var r0 []*models.Comment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *string, int64, int32) ([]*models.Comment, error)); ok {
-		return rf(ctx, parentID, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*models.Comment, error)); ok {
+		return rf(ctx, commentID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *string, int64, int32) []*models.Comment); ok {
-		r0 = rf(ctx, parentID, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*models.Comment); ok {
+		r0 = rf(ctx, commentID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Comment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, *string, int64, int32) error); ok {
-		r1 = rf(ctx, parentID, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -225,56 +1432,113 @@ func (_m *MockCommentUC) GetChild(ctx context.Context, parentID int64, afterCrea
 	return r0, r1
 }
 
-// MockCommentUC_GetChild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChild'
-type MockCommentUC_GetChild_Call struct {
+// MockCommentUC_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockCommentUC_Purge_Call struct {
 	*mock.Call
 }
 
-// GetChild is a helper method to define mock.On call
+// Purge is a helper method to define mock.On call
 //   - ctx context.Context
-//   - parentID int64
-//   - afterCreatedAt *string
-//   - afterID int64
-//   - limit int32
-func (_e *MockCommentUC_Expecter) GetChild(ctx interface{}, parentID interface{}, afterCreatedAt interface{}, afterID interface{}, limit interface{}) *MockCommentUC_GetChild_Call {
-	return &MockCommentUC_GetChild_Call{Call: _e.mock.On("GetChild", ctx, parentID, afterCreatedAt, afterID, limit)}
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) Purge(ctx interface{}, commentID interface{}) *MockCommentUC_Purge_Call {
+	return &MockCommentUC_Purge_Call{Call: _e.mock.On("Purge", ctx, commentID)}
 }
 
-func (_c *MockCommentUC_GetChild_Call) Run(run func(ctx context.Context, parentID int64, afterCreatedAt *string, afterID int64, limit int32)) *MockCommentUC_GetChild_Call {
+func (_c *MockCommentUC_Purge_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_Purge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(*string), args[3].(int64), args[4].(int32))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockCommentUC_GetChild_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetChild_Call {
+func (_c *MockCommentUC_Purge_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_Purge_Call) RunAndReturn(run func(context.Context, int64) ([]*models.Comment, error)) *MockCommentUC_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reject provides a mock function with given fields: ctx, commentID, reason
+func (_m *MockCommentUC) Reject(ctx context.Context, commentID int64, reason *string) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reject")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *string) (*models.Comment, error)); ok {
+		return rf(ctx, commentID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *string) *models.Comment); ok {
+		r0 = rf(ctx, commentID, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *string) error); ok {
+		r1 = rf(ctx, commentID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_Reject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reject'
+type MockCommentUC_Reject_Call struct {
+	*mock.Call
+}
+
+// Reject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+//   - reason *string
+func (_e *MockCommentUC_Expecter) Reject(ctx interface{}, commentID interface{}, reason interface{}) *MockCommentUC_Reject_Call {
+	return &MockCommentUC_Reject_Call{Call: _e.mock.On("Reject", ctx, commentID, reason)}
+}
+
+func (_c *MockCommentUC_Reject_Call) Run(run func(ctx context.Context, commentID int64, reason *string)) *MockCommentUC_Reject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_Reject_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentUC_Reject_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentUC_GetChild_Call) RunAndReturn(run func(context.Context, int64, *string, int64, int32) ([]*models.Comment, error)) *MockCommentUC_GetChild_Call {
+func (_c *MockCommentUC_Reject_Call) RunAndReturn(run func(context.Context, int64, *string) (*models.Comment, error)) *MockCommentUC_Reject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetChildBatch provides a mock function with given fields: ctx, parentIDs
-func (_m *MockCommentUC) GetChildBatch(ctx context.Context, parentIDs []int64) ([]*models.Comment, error) {
+// ReplyCountBatch provides a mock function with given fields: ctx, parentIDs
+func (_m *MockCommentUC) ReplyCountBatch(ctx context.Context, parentIDs []int64) ([]*models.ReplyCount, error) {
 	ret := _m.Called(ctx, parentIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetChildBatch")
+		panic("no return value specified for ReplyCountBatch")
 	}
 
-	var r0 []*models.Comment
+	var r0 []*models.ReplyCount
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]*models.Comment, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]*models.ReplyCount, error)); ok {
 		return rf(ctx, parentIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []int64) []*models.Comment); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []*models.ReplyCount); ok {
 		r0 = rf(ctx, parentIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.Comment)
+			r0 = ret.Get(0).([]*models.ReplyCount)
 		}
 	}
 
@@ -287,58 +1551,115 @@ func (_m *MockCommentUC) GetChildBatch(ctx context.Context, parentIDs []int64) (
 	return r0, r1
 }
 
-// MockCommentUC_GetChildBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChildBatch'
-type MockCommentUC_GetChildBatch_Call struct {
+// MockCommentUC_ReplyCountBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplyCountBatch'
+type MockCommentUC_ReplyCountBatch_Call struct {
 	*mock.Call
 }
 
-// GetChildBatch is a helper method to define mock.On call
+// ReplyCountBatch is a helper method to define mock.On call
 //   - ctx context.Context
 //   - parentIDs []int64
-func (_e *MockCommentUC_Expecter) GetChildBatch(ctx interface{}, parentIDs interface{}) *MockCommentUC_GetChildBatch_Call {
-	return &MockCommentUC_GetChildBatch_Call{Call: _e.mock.On("GetChildBatch", ctx, parentIDs)}
+func (_e *MockCommentUC_Expecter) ReplyCountBatch(ctx interface{}, parentIDs interface{}) *MockCommentUC_ReplyCountBatch_Call {
+	return &MockCommentUC_ReplyCountBatch_Call{Call: _e.mock.On("ReplyCountBatch", ctx, parentIDs)}
 }
 
-func (_c *MockCommentUC_GetChildBatch_Call) Run(run func(ctx context.Context, parentIDs []int64)) *MockCommentUC_GetChildBatch_Call {
+func (_c *MockCommentUC_ReplyCountBatch_Call) Run(run func(ctx context.Context, parentIDs []int64)) *MockCommentUC_ReplyCountBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].([]int64))
 	})
 	return _c
 }
 
-func (_c *MockCommentUC_GetChildBatch_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetChildBatch_Call {
+func (_c *MockCommentUC_ReplyCountBatch_Call) Return(_a0 []*models.ReplyCount, _a1 error) *MockCommentUC_ReplyCountBatch_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentUC_GetChildBatch_Call) RunAndReturn(run func(context.Context, []int64) ([]*models.Comment, error)) *MockCommentUC_GetChildBatch_Call {
+func (_c *MockCommentUC_ReplyCountBatch_Call) RunAndReturn(run func(context.Context, []int64) ([]*models.ReplyCount, error)) *MockCommentUC_ReplyCountBatch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRootByPost provides a mock function with given fields: ctx, postID, afterCreatedAt, afterID, limit
-func (_m *MockCommentUC) GetRootByPost(ctx context.Context, postID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
-	ret := _m.Called(ctx, postID, afterCreatedAt, afterID, limit)
+// RevisionCount provides a mock function with given fields: ctx, commentID
+func (_m *MockCommentUC) RevisionCount(ctx context.Context, commentID int64) (int64, error) {
+	ret := _m.Called(ctx, commentID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRootByPost")
+		panic("no return value specified for RevisionCount")
 	}
 
-	var r0 []*models.Comment
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *string, int64, int32) ([]*models.Comment, error)); ok {
-		return rf(ctx, postID, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, commentID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *string, int64, int32) []*models.Comment); ok {
-		r0 = rf(ctx, postID, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_RevisionCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevisionCount'
+type MockCommentUC_RevisionCount_Call struct {
+	*mock.Call
+}
+
+// RevisionCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockCommentUC_Expecter) RevisionCount(ctx interface{}, commentID interface{}) *MockCommentUC_RevisionCount_Call {
+	return &MockCommentUC_RevisionCount_Call{Call: _e.mock.On("RevisionCount", ctx, commentID)}
+}
+
+func (_c *MockCommentUC_RevisionCount_Call) Run(run func(ctx context.Context, commentID int64)) *MockCommentUC_RevisionCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_RevisionCount_Call) Return(_a0 int64, _a1 error) *MockCommentUC_RevisionCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_RevisionCount_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockCommentUC_RevisionCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, query, afterRank, afterID, limit
+func (_m *MockCommentUC) Search(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32) ([]*models.SearchHit, error) {
+	ret := _m.Called(ctx, query, afterRank, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*models.SearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *float64, int64, int32) ([]*models.SearchHit, error)); ok {
+		return rf(ctx, query, afterRank, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *float64, int64, int32) []*models.SearchHit); ok {
+		r0 = rf(ctx, query, afterRank, afterID, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.Comment)
+			r0 = ret.Get(0).([]*models.SearchHit)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, *string, int64, int32) error); ok {
-		r1 = rf(ctx, postID, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *float64, int64, int32) error); ok {
+		r1 = rf(ctx, query, afterRank, afterID, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -346,34 +1667,91 @@ func (_m *MockCommentUC) GetRootByPost(ctx context.Context, postID int64, afterC
 	return r0, r1
 }
 
-// MockCommentUC_GetRootByPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRootByPost'
-type MockCommentUC_GetRootByPost_Call struct {
+// MockCommentUC_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockCommentUC_Search_Call struct {
 	*mock.Call
 }
 
-// GetRootByPost is a helper method to define mock.On call
+// Search is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID int64
-//   - afterCreatedAt *string
+//   - query string
+//   - afterRank *float64
 //   - afterID int64
 //   - limit int32
-func (_e *MockCommentUC_Expecter) GetRootByPost(ctx interface{}, postID interface{}, afterCreatedAt interface{}, afterID interface{}, limit interface{}) *MockCommentUC_GetRootByPost_Call {
-	return &MockCommentUC_GetRootByPost_Call{Call: _e.mock.On("GetRootByPost", ctx, postID, afterCreatedAt, afterID, limit)}
+func (_e *MockCommentUC_Expecter) Search(ctx interface{}, query interface{}, afterRank interface{}, afterID interface{}, limit interface{}) *MockCommentUC_Search_Call {
+	return &MockCommentUC_Search_Call{Call: _e.mock.On("Search", ctx, query, afterRank, afterID, limit)}
 }
 
-func (_c *MockCommentUC_GetRootByPost_Call) Run(run func(ctx context.Context, postID int64, afterCreatedAt *string, afterID int64, limit int32)) *MockCommentUC_GetRootByPost_Call {
+func (_c *MockCommentUC_Search_Call) Run(run func(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32)) *MockCommentUC_Search_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(*string), args[3].(int64), args[4].(int32))
+		run(args[0].(context.Context), args[1].(string), args[2].(*float64), args[3].(int64), args[4].(int32))
 	})
 	return _c
 }
 
-func (_c *MockCommentUC_GetRootByPost_Call) Return(_a0 []*models.Comment, _a1 error) *MockCommentUC_GetRootByPost_Call {
+func (_c *MockCommentUC_Search_Call) Return(_a0 []*models.SearchHit, _a1 error) *MockCommentUC_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_Search_Call) RunAndReturn(run func(context.Context, string, *float64, int64, int32) ([]*models.SearchHit, error)) *MockCommentUC_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchCount provides a mock function with given fields: ctx, query
+func (_m *MockCommentUC) SearchCount(ctx context.Context, query string) (int64, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, query)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_SearchCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCount'
+type MockCommentUC_SearchCount_Call struct {
+	*mock.Call
+}
+
+// SearchCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+func (_e *MockCommentUC_Expecter) SearchCount(ctx interface{}, query interface{}) *MockCommentUC_SearchCount_Call {
+	return &MockCommentUC_SearchCount_Call{Call: _e.mock.On("SearchCount", ctx, query)}
+}
+
+func (_c *MockCommentUC_SearchCount_Call) Run(run func(ctx context.Context, query string)) *MockCommentUC_SearchCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_SearchCount_Call) Return(_a0 int64, _a1 error) *MockCommentUC_SearchCount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentUC_GetRootByPost_Call) RunAndReturn(run func(context.Context, int64, *string, int64, int32) ([]*models.Comment, error)) *MockCommentUC_GetRootByPost_Call {
+func (_c *MockCommentUC_SearchCount_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockCommentUC_SearchCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -435,6 +1813,65 @@ func (_c *MockCommentUC_TotalCount_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// TotalCountBatch provides a mock function with given fields: ctx, postIDs
+func (_m *MockCommentUC) TotalCountBatch(ctx context.Context, postIDs []int64) ([]*models.CommentCount, error) {
+	ret := _m.Called(ctx, postIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TotalCountBatch")
+	}
+
+	var r0 []*models.CommentCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]*models.CommentCount, error)); ok {
+		return rf(ctx, postIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []*models.CommentCount); ok {
+		r0 = rf(ctx, postIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CommentCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, postIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUC_TotalCountBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TotalCountBatch'
+type MockCommentUC_TotalCountBatch_Call struct {
+	*mock.Call
+}
+
+// TotalCountBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postIDs []int64
+func (_e *MockCommentUC_Expecter) TotalCountBatch(ctx interface{}, postIDs interface{}) *MockCommentUC_TotalCountBatch_Call {
+	return &MockCommentUC_TotalCountBatch_Call{Call: _e.mock.On("TotalCountBatch", ctx, postIDs)}
+}
+
+func (_c *MockCommentUC_TotalCountBatch_Call) Run(run func(ctx context.Context, postIDs []int64)) *MockCommentUC_TotalCountBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUC_TotalCountBatch_Call) Return(_a0 []*models.CommentCount, _a1 error) *MockCommentUC_TotalCountBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUC_TotalCountBatch_Call) RunAndReturn(run func(context.Context, []int64) ([]*models.CommentCount, error)) *MockCommentUC_TotalCountBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCommentUC creates a new instance of MockCommentUC. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCommentUC(t interface {
@@ -22,9 +22,69 @@ func (_m *MockPostUC) EXPECT() *MockPostUC_Expecter {
 	return &MockPostUC_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function with given fields: ctx, afterCreatedAt, afterID, limit
-func (_m *MockPostUC) Get(ctx context.Context, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Post, error) {
-	ret := _m.Called(ctx, afterCreatedAt, afterID, limit)
+// Delete provides a mock function with given fields: ctx, postID, deletedAt
+func (_m *MockPostUC) Delete(ctx context.Context, postID int64, deletedAt string) (*models.Post, error) {
+	ret := _m.Called(ctx, postID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*models.Post, error)); ok {
+		return rf(ctx, postID, deletedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *models.Post); ok {
+		r0 = rf(ctx, postID, deletedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, postID, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostUC_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockPostUC_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - deletedAt string
+func (_e *MockPostUC_Expecter) Delete(ctx interface{}, postID interface{}, deletedAt interface{}) *MockPostUC_Delete_Call {
+	return &MockPostUC_Delete_Call{Call: _e.mock.On("Delete", ctx, postID, deletedAt)}
+}
+
+func (_c *MockPostUC_Delete_Call) Run(run func(ctx context.Context, postID int64, deletedAt string)) *MockPostUC_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPostUC_Delete_Call) Return(_a0 *models.Post, _a1 error) *MockPostUC_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostUC_Delete_Call) RunAndReturn(run func(context.Context, int64, string) (*models.Post, error)) *MockPostUC_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, filter, cursorCreatedAt, cursorID, backward, limit
+func (_m *MockPostUC) Get(ctx context.Context, filter models.PostFilter, cursorCreatedAt *string, cursorID int64, backward bool, limit int32) ([]*models.Post, error) {
+	ret := _m.Called(ctx, filter, cursorCreatedAt, cursorID, backward, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
@@ -32,19 +92,19 @@ func (_m *MockPostUC) Get(ctx context.Context, afterCreatedAt *string, afterID i
 
 	var r0 []*models.Post
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *string, int64, int32) ([]*models.Post, error)); ok {
-		return rf(ctx, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, models.PostFilter, *string, int64, bool, int32) ([]*models.Post, error)); ok {
+		return rf(ctx, filter, cursorCreatedAt, cursorID, backward, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *string, int64, int32) []*models.Post); ok {
-		r0 = rf(ctx, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, models.PostFilter, *string, int64, bool, int32) []*models.Post); ok {
+		r0 = rf(ctx, filter, cursorCreatedAt, cursorID, backward, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Post)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *string, int64, int32) error); ok {
-		r1 = rf(ctx, afterCreatedAt, afterID, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, models.PostFilter, *string, int64, bool, int32) error); ok {
+		r1 = rf(ctx, filter, cursorCreatedAt, cursorID, backward, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,16 +119,18 @@ type MockPostUC_Get_Call struct {
 
 // Get is a helper method to define mock.On call
 //   - ctx context.Context
-//   - afterCreatedAt *string
-//   - afterID int64
+//   - filter models.PostFilter
+//   - cursorCreatedAt *string
+//   - cursorID int64
+//   - backward bool
 //   - limit int32
-func (_e *MockPostUC_Expecter) Get(ctx interface{}, afterCreatedAt interface{}, afterID interface{}, limit interface{}) *MockPostUC_Get_Call {
-	return &MockPostUC_Get_Call{Call: _e.mock.On("Get", ctx, afterCreatedAt, afterID, limit)}
+func (_e *MockPostUC_Expecter) Get(ctx interface{}, filter interface{}, cursorCreatedAt interface{}, cursorID interface{}, backward interface{}, limit interface{}) *MockPostUC_Get_Call {
+	return &MockPostUC_Get_Call{Call: _e.mock.On("Get", ctx, filter, cursorCreatedAt, cursorID, backward, limit)}
 }
 
-func (_c *MockPostUC_Get_Call) Run(run func(ctx context.Context, afterCreatedAt *string, afterID int64, limit int32)) *MockPostUC_Get_Call {
+func (_c *MockPostUC_Get_Call) Run(run func(ctx context.Context, filter models.PostFilter, cursorCreatedAt *string, cursorID int64, backward bool, limit int32)) *MockPostUC_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*string), args[2].(int64), args[3].(int32))
+		run(args[0].(context.Context), args[1].(models.PostFilter), args[2].(*string), args[3].(int64), args[4].(bool), args[5].(int32))
 	})
 	return _c
 }
@@ -78,7 +140,7 @@ func (_c *MockPostUC_Get_Call) Return(_a0 []*models.Post, _a1 error) *MockPostUC
 	return _c
 }
 
-func (_c *MockPostUC_Get_Call) RunAndReturn(run func(context.Context, *string, int64, int32) ([]*models.Post, error)) *MockPostUC_Get_Call {
+func (_c *MockPostUC_Get_Call) RunAndReturn(run func(context.Context, models.PostFilter, *string, int64, bool, int32) ([]*models.Post, error)) *MockPostUC_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -142,6 +204,185 @@ func (_c *MockPostUC_GetByID_Call) RunAndReturn(run func(context.Context, int64)
 	return _c
 }
 
+// GetByIDs provides a mock function with given fields: ctx, postIDs
+func (_m *MockPostUC) GetByIDs(ctx context.Context, postIDs []int64) ([]*models.Post, error) {
+	ret := _m.Called(ctx, postIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]*models.Post, error)); ok {
+		return rf(ctx, postIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []*models.Post); ok {
+		r0 = rf(ctx, postIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, postIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostUC_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockPostUC_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postIDs []int64
+func (_e *MockPostUC_Expecter) GetByIDs(ctx interface{}, postIDs interface{}) *MockPostUC_GetByIDs_Call {
+	return &MockPostUC_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, postIDs)}
+}
+
+func (_c *MockPostUC_GetByIDs_Call) Run(run func(ctx context.Context, postIDs []int64)) *MockPostUC_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockPostUC_GetByIDs_Call) Return(_a0 []*models.Post, _a1 error) *MockPostUC_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostUC_GetByIDs_Call) RunAndReturn(run func(context.Context, []int64) ([]*models.Post, error)) *MockPostUC_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hide provides a mock function with given fields: ctx, postID, hiddenAt
+func (_m *MockPostUC) Hide(ctx context.Context, postID int64, hiddenAt string) (*models.Post, error) {
+	ret := _m.Called(ctx, postID, hiddenAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hide")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*models.Post, error)); ok {
+		return rf(ctx, postID, hiddenAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *models.Post); ok {
+		r0 = rf(ctx, postID, hiddenAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, postID, hiddenAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostUC_Hide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hide'
+type MockPostUC_Hide_Call struct {
+	*mock.Call
+}
+
+// Hide is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - hiddenAt string
+func (_e *MockPostUC_Expecter) Hide(ctx interface{}, postID interface{}, hiddenAt interface{}) *MockPostUC_Hide_Call {
+	return &MockPostUC_Hide_Call{Call: _e.mock.On("Hide", ctx, postID, hiddenAt)}
+}
+
+func (_c *MockPostUC_Hide_Call) Run(run func(ctx context.Context, postID int64, hiddenAt string)) *MockPostUC_Hide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPostUC_Hide_Call) Return(_a0 *models.Post, _a1 error) *MockPostUC_Hide_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostUC_Hide_Call) RunAndReturn(run func(context.Context, int64, string) (*models.Post, error)) *MockPostUC_Hide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, postID, unhide
+func (_m *MockPostUC) Restore(ctx context.Context, postID int64, unhide bool) (*models.Post, error) {
+	ret := _m.Called(ctx, postID, unhide)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) (*models.Post, error)); ok {
+		return rf(ctx, postID, unhide)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) *models.Post); ok {
+		r0 = rf(ctx, postID, unhide)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, bool) error); ok {
+		r1 = rf(ctx, postID, unhide)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostUC_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockPostUC_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - unhide bool
+func (_e *MockPostUC_Expecter) Restore(ctx interface{}, postID interface{}, unhide interface{}) *MockPostUC_Restore_Call {
+	return &MockPostUC_Restore_Call{Call: _e.mock.On("Restore", ctx, postID, unhide)}
+}
+
+func (_c *MockPostUC_Restore_Call) Run(run func(ctx context.Context, postID int64, unhide bool)) *MockPostUC_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockPostUC_Restore_Call) Return(_a0 *models.Post, _a1 error) *MockPostUC_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostUC_Restore_Call) RunAndReturn(run func(context.Context, int64, bool) (*models.Post, error)) *MockPostUC_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, post
 func (_m *MockPostUC) Save(ctx context.Context, post models.Post) (models.Post, error) {
 	ret := _m.Called(ctx, post)
@@ -199,29 +440,148 @@ func (_c *MockPostUC_Save_Call) RunAndReturn(run func(context.Context, models.Po
 	return _c
 }
 
-// SetCommentsAllowed provides a mock function with given fields: ctx, postID, allow
-func (_m *MockPostUC) SetCommentsAllowed(ctx context.Context, postID int64, allow bool) (*models.Post, error) {
-	ret := _m.Called(ctx, postID, allow)
+// Search provides a mock function with given fields: ctx, query, afterRank, afterID, limit
+func (_m *MockPostUC) Search(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32) ([]*models.SearchHit, error) {
+	ret := _m.Called(ctx, query, afterRank, afterID, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetCommentsAllowed")
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*models.SearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *float64, int64, int32) ([]*models.SearchHit, error)); ok {
+		return rf(ctx, query, afterRank, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *float64, int64, int32) []*models.SearchHit); ok {
+		r0 = rf(ctx, query, afterRank, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *float64, int64, int32) error); ok {
+		r1 = rf(ctx, query, afterRank, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostUC_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockPostUC_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - afterRank *float64
+//   - afterID int64
+//   - limit int32
+func (_e *MockPostUC_Expecter) Search(ctx interface{}, query interface{}, afterRank interface{}, afterID interface{}, limit interface{}) *MockPostUC_Search_Call {
+	return &MockPostUC_Search_Call{Call: _e.mock.On("Search", ctx, query, afterRank, afterID, limit)}
+}
+
+func (_c *MockPostUC_Search_Call) Run(run func(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32)) *MockPostUC_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*float64), args[3].(int64), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockPostUC_Search_Call) Return(_a0 []*models.SearchHit, _a1 error) *MockPostUC_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostUC_Search_Call) RunAndReturn(run func(context.Context, string, *float64, int64, int32) ([]*models.SearchHit, error)) *MockPostUC_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchCount provides a mock function with given fields: ctx, query
+func (_m *MockPostUC) SearchCount(ctx context.Context, query string) (int64, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, query)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostUC_SearchCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCount'
+type MockPostUC_SearchCount_Call struct {
+	*mock.Call
+}
+
+// SearchCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+func (_e *MockPostUC_Expecter) SearchCount(ctx interface{}, query interface{}) *MockPostUC_SearchCount_Call {
+	return &MockPostUC_SearchCount_Call{Call: _e.mock.On("SearchCount", ctx, query)}
+}
+
+func (_c *MockPostUC_SearchCount_Call) Run(run func(ctx context.Context, query string)) *MockPostUC_SearchCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPostUC_SearchCount_Call) Return(_a0 int64, _a1 error) *MockPostUC_SearchCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostUC_SearchCount_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockPostUC_SearchCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCommentPolicy provides a mock function with given fields: ctx, postID, policy, closeAt
+func (_m *MockPostUC) SetCommentPolicy(ctx context.Context, postID int64, policy models.CommentPolicy, closeAt *string) (*models.Post, error) {
+	ret := _m.Called(ctx, postID, policy, closeAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCommentPolicy")
 	}
 
 	var r0 *models.Post
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) (*models.Post, error)); ok {
-		return rf(ctx, postID, allow)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.CommentPolicy, *string) (*models.Post, error)); ok {
+		return rf(ctx, postID, policy, closeAt)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) *models.Post); ok {
-		r0 = rf(ctx, postID, allow)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.CommentPolicy, *string) *models.Post); ok {
+		r0 = rf(ctx, postID, policy, closeAt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Post)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, bool) error); ok {
-		r1 = rf(ctx, postID, allow)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, models.CommentPolicy, *string) error); ok {
+		r1 = rf(ctx, postID, policy, closeAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -229,39 +589,100 @@ func (_m *MockPostUC) SetCommentsAllowed(ctx context.Context, postID int64, allo
 	return r0, r1
 }
 
-// MockPostUC_SetCommentsAllowed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCommentsAllowed'
-type MockPostUC_SetCommentsAllowed_Call struct {
+// MockPostUC_SetCommentPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCommentPolicy'
+type MockPostUC_SetCommentPolicy_Call struct {
 	*mock.Call
 }
 
-// SetCommentsAllowed is a helper method to define mock.On call
+// SetCommentPolicy is a helper method to define mock.On call
 //   - ctx context.Context
 //   - postID int64
-//   - allow bool
-func (_e *MockPostUC_Expecter) SetCommentsAllowed(ctx interface{}, postID interface{}, allow interface{}) *MockPostUC_SetCommentsAllowed_Call {
-	return &MockPostUC_SetCommentsAllowed_Call{Call: _e.mock.On("SetCommentsAllowed", ctx, postID, allow)}
+//   - policy models.CommentPolicy
+//   - closeAt *string
+func (_e *MockPostUC_Expecter) SetCommentPolicy(ctx interface{}, postID interface{}, policy interface{}, closeAt interface{}) *MockPostUC_SetCommentPolicy_Call {
+	return &MockPostUC_SetCommentPolicy_Call{Call: _e.mock.On("SetCommentPolicy", ctx, postID, policy, closeAt)}
 }
 
-func (_c *MockPostUC_SetCommentsAllowed_Call) Run(run func(ctx context.Context, postID int64, allow bool)) *MockPostUC_SetCommentsAllowed_Call {
+func (_c *MockPostUC_SetCommentPolicy_Call) Run(run func(ctx context.Context, postID int64, policy models.CommentPolicy, closeAt *string)) *MockPostUC_SetCommentPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(bool))
+		run(args[0].(context.Context), args[1].(int64), args[2].(models.CommentPolicy), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockPostUC_SetCommentPolicy_Call) Return(_a0 *models.Post, _a1 error) *MockPostUC_SetCommentPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostUC_SetCommentPolicy_Call) RunAndReturn(run func(context.Context, int64, models.CommentPolicy, *string) (*models.Post, error)) *MockPostUC_SetCommentPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tags provides a mock function with given fields: ctx, prefix, limit
+func (_m *MockPostUC) Tags(ctx context.Context, prefix string, limit int32) ([]string, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) ([]string, error)); ok {
+		return rf(ctx, prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) []string); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostUC_Tags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tags'
+type MockPostUC_Tags_Call struct {
+	*mock.Call
+}
+
+// Tags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - limit int32
+func (_e *MockPostUC_Expecter) Tags(ctx interface{}, prefix interface{}, limit interface{}) *MockPostUC_Tags_Call {
+	return &MockPostUC_Tags_Call{Call: _e.mock.On("Tags", ctx, prefix, limit)}
+}
+
+func (_c *MockPostUC_Tags_Call) Run(run func(ctx context.Context, prefix string, limit int32)) *MockPostUC_Tags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
 	})
 	return _c
 }
 
-func (_c *MockPostUC_SetCommentsAllowed_Call) Return(_a0 *models.Post, _a1 error) *MockPostUC_SetCommentsAllowed_Call {
+func (_c *MockPostUC_Tags_Call) Return(_a0 []string, _a1 error) *MockPostUC_Tags_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockPostUC_SetCommentsAllowed_Call) RunAndReturn(run func(context.Context, int64, bool) (*models.Post, error)) *MockPostUC_SetCommentsAllowed_Call {
+func (_c *MockPostUC_Tags_Call) RunAndReturn(run func(context.Context, string, int32) ([]string, error)) *MockPostUC_Tags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TotalCount provides a mock function with given fields: ctx
-func (_m *MockPostUC) TotalCount(ctx context.Context) (int64, error) {
-	ret := _m.Called(ctx)
+// TotalCount provides a mock function with given fields: ctx, filter
+func (_m *MockPostUC) TotalCount(ctx context.Context, filter models.PostFilter) (int64, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TotalCount")
@@ -269,17 +690,17 @@ func (_m *MockPostUC) TotalCount(ctx context.Context) (int64, error) {
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, models.PostFilter) (int64, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, models.PostFilter) int64); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, models.PostFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -294,13 +715,14 @@ type MockPostUC_TotalCount_Call struct {
 
 // TotalCount is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockPostUC_Expecter) TotalCount(ctx interface{}) *MockPostUC_TotalCount_Call {
-	return &MockPostUC_TotalCount_Call{Call: _e.mock.On("TotalCount", ctx)}
+//   - filter models.PostFilter
+func (_e *MockPostUC_Expecter) TotalCount(ctx interface{}, filter interface{}) *MockPostUC_TotalCount_Call {
+	return &MockPostUC_TotalCount_Call{Call: _e.mock.On("TotalCount", ctx, filter)}
 }
 
-func (_c *MockPostUC_TotalCount_Call) Run(run func(ctx context.Context)) *MockPostUC_TotalCount_Call {
+func (_c *MockPostUC_TotalCount_Call) Run(run func(ctx context.Context, filter models.PostFilter)) *MockPostUC_TotalCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(models.PostFilter))
 	})
 	return _c
 }
@@ -310,7 +732,68 @@ func (_c *MockPostUC_TotalCount_Call) Return(_a0 int64, _a1 error) *MockPostUC_T
 	return _c
 }
 
-func (_c *MockPostUC_TotalCount_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockPostUC_TotalCount_Call {
+func (_c *MockPostUC_TotalCount_Call) RunAndReturn(run func(context.Context, models.PostFilter) (int64, error)) *MockPostUC_TotalCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, postID, title, body
+func (_m *MockPostUC) Update(ctx context.Context, postID int64, title *string, body *string) (*models.Post, error) {
+	ret := _m.Called(ctx, postID, title, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *string, *string) (*models.Post, error)); ok {
+		return rf(ctx, postID, title, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *string, *string) *models.Post); ok {
+		r0 = rf(ctx, postID, title, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *string, *string) error); ok {
+		r1 = rf(ctx, postID, title, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostUC_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockPostUC_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - title *string
+//   - body *string
+func (_e *MockPostUC_Expecter) Update(ctx interface{}, postID interface{}, title interface{}, body interface{}) *MockPostUC_Update_Call {
+	return &MockPostUC_Update_Call{Call: _e.mock.On("Update", ctx, postID, title, body)}
+}
+
+func (_c *MockPostUC_Update_Call) Run(run func(ctx context.Context, postID int64, title *string, body *string)) *MockPostUC_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*string), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockPostUC_Update_Call) Return(_a0 *models.Post, _a1 error) *MockPostUC_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostUC_Update_Call) RunAndReturn(run func(context.Context, int64, *string, *string) (*models.Post, error)) *MockPostUC_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,193 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/Saracomethstein/ozon-test-task/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReactionUC is an autogenerated mock type for the ReactionUC type
+type MockReactionUC struct {
+	mock.Mock
+}
+
+type MockReactionUC_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReactionUC) EXPECT() *MockReactionUC_Expecter {
+	return &MockReactionUC_Expecter{mock: &_m.Mock}
+}
+
+// CountBatch provides a mock function with given fields: ctx, target, targetIDs
+func (_m *MockReactionUC) CountBatch(ctx context.Context, target models.ReactionTarget, targetIDs []int64) ([]*models.ReactionCount, error) {
+	ret := _m.Called(ctx, target, targetIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBatch")
+	}
+
+	var r0 []*models.ReactionCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, []int64) ([]*models.ReactionCount, error)); ok {
+		return rf(ctx, target, targetIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, []int64) []*models.ReactionCount); ok {
+		r0 = rf(ctx, target, targetIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ReactionCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ReactionTarget, []int64) error); ok {
+		r1 = rf(ctx, target, targetIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReactionUC_CountBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBatch'
+type MockReactionUC_CountBatch_Call struct {
+	*mock.Call
+}
+
+// CountBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ReactionTarget
+//   - targetIDs []int64
+func (_e *MockReactionUC_Expecter) CountBatch(ctx interface{}, target interface{}, targetIDs interface{}) *MockReactionUC_CountBatch_Call {
+	return &MockReactionUC_CountBatch_Call{Call: _e.mock.On("CountBatch", ctx, target, targetIDs)}
+}
+
+func (_c *MockReactionUC_CountBatch_Call) Run(run func(ctx context.Context, target models.ReactionTarget, targetIDs []int64)) *MockReactionUC_CountBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReactionTarget), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockReactionUC_CountBatch_Call) Return(_a0 []*models.ReactionCount, _a1 error) *MockReactionUC_CountBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReactionUC_CountBatch_Call) RunAndReturn(run func(context.Context, models.ReactionTarget, []int64) ([]*models.ReactionCount, error)) *MockReactionUC_CountBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: ctx, target, targetID, author
+func (_m *MockReactionUC) Remove(ctx context.Context, target models.ReactionTarget, targetID int64, author string) error {
+	ret := _m.Called(ctx, target, targetID, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, int64, string) error); ok {
+		r0 = rf(ctx, target, targetID, author)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReactionUC_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockReactionUC_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ReactionTarget
+//   - targetID int64
+//   - author string
+func (_e *MockReactionUC_Expecter) Remove(ctx interface{}, target interface{}, targetID interface{}, author interface{}) *MockReactionUC_Remove_Call {
+	return &MockReactionUC_Remove_Call{Call: _e.mock.On("Remove", ctx, target, targetID, author)}
+}
+
+func (_c *MockReactionUC_Remove_Call) Run(run func(ctx context.Context, target models.ReactionTarget, targetID int64, author string)) *MockReactionUC_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReactionTarget), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockReactionUC_Remove_Call) Return(_a0 error) *MockReactionUC_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReactionUC_Remove_Call) RunAndReturn(run func(context.Context, models.ReactionTarget, int64, string) error) *MockReactionUC_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: ctx, reaction
+func (_m *MockReactionUC) Set(ctx context.Context, reaction models.Reaction) error {
+	ret := _m.Called(ctx, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Reaction) error); ok {
+		r0 = rf(ctx, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReactionUC_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockReactionUC_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reaction models.Reaction
+func (_e *MockReactionUC_Expecter) Set(ctx interface{}, reaction interface{}) *MockReactionUC_Set_Call {
+	return &MockReactionUC_Set_Call{Call: _e.mock.On("Set", ctx, reaction)}
+}
+
+func (_c *MockReactionUC_Set_Call) Run(run func(ctx context.Context, reaction models.Reaction)) *MockReactionUC_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Reaction))
+	})
+	return _c
+}
+
+func (_c *MockReactionUC_Set_Call) Return(_a0 error) *MockReactionUC_Set_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReactionUC_Set_Call) RunAndReturn(run func(context.Context, models.Reaction) error) *MockReactionUC_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReactionUC creates a new instance of MockReactionUC. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReactionUC(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReactionUC {
+	mock := &MockReactionUC{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,670 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/Saracomethstein/ozon-test-task/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReportUC is an autogenerated mock type for the ReportUC type
+type MockReportUC struct {
+	mock.Mock
+}
+
+type MockReportUC_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReportUC) EXPECT() *MockReportUC_Expecter {
+	return &MockReportUC_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, report
+func (_m *MockReportUC) Add(ctx context.Context, report models.Report) (*models.Report, bool, error) {
+	ret := _m.Called(ctx, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 *models.Report
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Report) (*models.Report, bool, error)); ok {
+		return rf(ctx, report)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Report) *models.Report); ok {
+		r0 = rf(ctx, report)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Report) bool); ok {
+		r1 = rf(ctx, report)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.Report) error); ok {
+		r2 = rf(ctx, report)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockReportUC_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type MockReportUC_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - report models.Report
+func (_e *MockReportUC_Expecter) Add(ctx interface{}, report interface{}) *MockReportUC_Add_Call {
+	return &MockReportUC_Add_Call{Call: _e.mock.On("Add", ctx, report)}
+}
+
+func (_c *MockReportUC_Add_Call) Run(run func(ctx context.Context, report models.Report)) *MockReportUC_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Report))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_Add_Call) Return(_a0 *models.Report, _a1 bool, _a2 error) *MockReportUC_Add_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockReportUC_Add_Call) RunAndReturn(run func(context.Context, models.Report) (*models.Report, bool, error)) *MockReportUC_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Count provides a mock function with given fields: ctx, status
+func (_m *MockReportUC) Count(ctx context.Context, status models.ReportStatus) (int64, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReportStatus) (int64, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReportStatus) int64); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ReportStatus) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportUC_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockReportUC_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status models.ReportStatus
+func (_e *MockReportUC_Expecter) Count(ctx interface{}, status interface{}) *MockReportUC_Count_Call {
+	return &MockReportUC_Count_Call{Call: _e.mock.On("Count", ctx, status)}
+}
+
+func (_c *MockReportUC_Count_Call) Run(run func(ctx context.Context, status models.ReportStatus)) *MockReportUC_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReportStatus))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_Count_Call) Return(_a0 int64, _a1 error) *MockReportUC_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportUC_Count_Call) RunAndReturn(run func(context.Context, models.ReportStatus) (int64, error)) *MockReportUC_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByReporter provides a mock function with given fields: ctx, reporter, since
+func (_m *MockReportUC) CountByReporter(ctx context.Context, reporter string, since string) (int64, error) {
+	ret := _m.Called(ctx, reporter, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByReporter")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, reporter, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, reporter, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, reporter, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportUC_CountByReporter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByReporter'
+type MockReportUC_CountByReporter_Call struct {
+	*mock.Call
+}
+
+// CountByReporter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reporter string
+//   - since string
+func (_e *MockReportUC_Expecter) CountByReporter(ctx interface{}, reporter interface{}, since interface{}) *MockReportUC_CountByReporter_Call {
+	return &MockReportUC_CountByReporter_Call{Call: _e.mock.On("CountByReporter", ctx, reporter, since)}
+}
+
+func (_c *MockReportUC_CountByReporter_Call) Run(run func(ctx context.Context, reporter string, since string)) *MockReportUC_CountByReporter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_CountByReporter_Call) Return(_a0 int64, _a1 error) *MockReportUC_CountByReporter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportUC_CountByReporter_Call) RunAndReturn(run func(context.Context, string, string) (int64, error)) *MockReportUC_CountByReporter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, status, afterCreatedAt, afterID, limit
+func (_m *MockReportUC) Get(ctx context.Context, status models.ReportStatus, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Report, error) {
+	ret := _m.Called(ctx, status, afterCreatedAt, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []*models.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReportStatus, *string, int64, int32) ([]*models.Report, error)); ok {
+		return rf(ctx, status, afterCreatedAt, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReportStatus, *string, int64, int32) []*models.Report); ok {
+		r0 = rf(ctx, status, afterCreatedAt, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ReportStatus, *string, int64, int32) error); ok {
+		r1 = rf(ctx, status, afterCreatedAt, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportUC_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockReportUC_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status models.ReportStatus
+//   - afterCreatedAt *string
+//   - afterID int64
+//   - limit int32
+func (_e *MockReportUC_Expecter) Get(ctx interface{}, status interface{}, afterCreatedAt interface{}, afterID interface{}, limit interface{}) *MockReportUC_Get_Call {
+	return &MockReportUC_Get_Call{Call: _e.mock.On("Get", ctx, status, afterCreatedAt, afterID, limit)}
+}
+
+func (_c *MockReportUC_Get_Call) Run(run func(ctx context.Context, status models.ReportStatus, afterCreatedAt *string, afterID int64, limit int32)) *MockReportUC_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReportStatus), args[2].(*string), args[3].(int64), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_Get_Call) Return(_a0 []*models.Report, _a1 error) *MockReportUC_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportUC_Get_Call) RunAndReturn(run func(context.Context, models.ReportStatus, *string, int64, int32) ([]*models.Report, error)) *MockReportUC_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, reportID
+func (_m *MockReportUC) GetByID(ctx context.Context, reportID int64) (*models.Report, error) {
+	ret := _m.Called(ctx, reportID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.Report, error)); ok {
+		return rf(ctx, reportID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.Report); ok {
+		r0 = rf(ctx, reportID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, reportID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportUC_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockReportUC_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportID int64
+func (_e *MockReportUC_Expecter) GetByID(ctx interface{}, reportID interface{}) *MockReportUC_GetByID_Call {
+	return &MockReportUC_GetByID_Call{Call: _e.mock.On("GetByID", ctx, reportID)}
+}
+
+func (_c *MockReportUC_GetByID_Call) Run(run func(ctx context.Context, reportID int64)) *MockReportUC_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_GetByID_Call) Return(_a0 *models.Report, _a1 error) *MockReportUC_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportUC_GetByID_Call) RunAndReturn(run func(context.Context, int64) (*models.Report, error)) *MockReportUC_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLog provides a mock function with given fields: ctx, beforeCreatedAt, beforeID, limit
+func (_m *MockReportUC) GetLog(ctx context.Context, beforeCreatedAt *string, beforeID int64, limit int32) ([]*models.AuditEntry, error) {
+	ret := _m.Called(ctx, beforeCreatedAt, beforeID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLog")
+	}
+
+	var r0 []*models.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, int64, int32) ([]*models.AuditEntry, error)); ok {
+		return rf(ctx, beforeCreatedAt, beforeID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, int64, int32) []*models.AuditEntry); ok {
+		r0 = rf(ctx, beforeCreatedAt, beforeID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, int64, int32) error); ok {
+		r1 = rf(ctx, beforeCreatedAt, beforeID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportUC_GetLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLog'
+type MockReportUC_GetLog_Call struct {
+	*mock.Call
+}
+
+// GetLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - beforeCreatedAt *string
+//   - beforeID int64
+//   - limit int32
+func (_e *MockReportUC_Expecter) GetLog(ctx interface{}, beforeCreatedAt interface{}, beforeID interface{}, limit interface{}) *MockReportUC_GetLog_Call {
+	return &MockReportUC_GetLog_Call{Call: _e.mock.On("GetLog", ctx, beforeCreatedAt, beforeID, limit)}
+}
+
+func (_c *MockReportUC_GetLog_Call) Run(run func(ctx context.Context, beforeCreatedAt *string, beforeID int64, limit int32)) *MockReportUC_GetLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string), args[2].(int64), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_GetLog_Call) Return(_a0 []*models.AuditEntry, _a1 error) *MockReportUC_GetLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportUC_GetLog_Call) RunAndReturn(run func(context.Context, *string, int64, int32) ([]*models.AuditEntry, error)) *MockReportUC_GetLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Log provides a mock function with given fields: ctx, entry
+func (_m *MockReportUC) Log(ctx context.Context, entry models.AuditEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Log")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportUC_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
+type MockReportUC_Log_Call struct {
+	*mock.Call
+}
+
+// Log is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry models.AuditEntry
+func (_e *MockReportUC_Expecter) Log(ctx interface{}, entry interface{}) *MockReportUC_Log_Call {
+	return &MockReportUC_Log_Call{Call: _e.mock.On("Log", ctx, entry)}
+}
+
+func (_c *MockReportUC_Log_Call) Run(run func(ctx context.Context, entry models.AuditEntry)) *MockReportUC_Log_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.AuditEntry))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_Log_Call) Return(_a0 error) *MockReportUC_Log_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportUC_Log_Call) RunAndReturn(run func(context.Context, models.AuditEntry) error) *MockReportUC_Log_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogCount provides a mock function with given fields: ctx
+func (_m *MockReportUC) LogCount(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportUC_LogCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogCount'
+type MockReportUC_LogCount_Call struct {
+	*mock.Call
+}
+
+// LogCount is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReportUC_Expecter) LogCount(ctx interface{}) *MockReportUC_LogCount_Call {
+	return &MockReportUC_LogCount_Call{Call: _e.mock.On("LogCount", ctx)}
+}
+
+func (_c *MockReportUC_LogCount_Call) Run(run func(ctx context.Context)) *MockReportUC_LogCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_LogCount_Call) Return(_a0 int64, _a1 error) *MockReportUC_LogCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportUC_LogCount_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockReportUC_LogCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkHidden provides a mock function with given fields: ctx, target, targetID
+func (_m *MockReportUC) MarkHidden(ctx context.Context, target models.ReportTarget, targetID int64) error {
+	ret := _m.Called(ctx, target, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkHidden")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReportTarget, int64) error); ok {
+		r0 = rf(ctx, target, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportUC_MarkHidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkHidden'
+type MockReportUC_MarkHidden_Call struct {
+	*mock.Call
+}
+
+// MarkHidden is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ReportTarget
+//   - targetID int64
+func (_e *MockReportUC_Expecter) MarkHidden(ctx interface{}, target interface{}, targetID interface{}) *MockReportUC_MarkHidden_Call {
+	return &MockReportUC_MarkHidden_Call{Call: _e.mock.On("MarkHidden", ctx, target, targetID)}
+}
+
+func (_c *MockReportUC_MarkHidden_Call) Run(run func(ctx context.Context, target models.ReportTarget, targetID int64)) *MockReportUC_MarkHidden_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReportTarget), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_MarkHidden_Call) Return(_a0 error) *MockReportUC_MarkHidden_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportUC_MarkHidden_Call) RunAndReturn(run func(context.Context, models.ReportTarget, int64) error) *MockReportUC_MarkHidden_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReporterCount provides a mock function with given fields: ctx, target, targetID
+func (_m *MockReportUC) ReporterCount(ctx context.Context, target models.ReportTarget, targetID int64) (int64, error) {
+	ret := _m.Called(ctx, target, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReporterCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReportTarget, int64) (int64, error)); ok {
+		return rf(ctx, target, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReportTarget, int64) int64); ok {
+		r0 = rf(ctx, target, targetID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ReportTarget, int64) error); ok {
+		r1 = rf(ctx, target, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportUC_ReporterCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReporterCount'
+type MockReportUC_ReporterCount_Call struct {
+	*mock.Call
+}
+
+// ReporterCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ReportTarget
+//   - targetID int64
+func (_e *MockReportUC_Expecter) ReporterCount(ctx interface{}, target interface{}, targetID interface{}) *MockReportUC_ReporterCount_Call {
+	return &MockReportUC_ReporterCount_Call{Call: _e.mock.On("ReporterCount", ctx, target, targetID)}
+}
+
+func (_c *MockReportUC_ReporterCount_Call) Run(run func(ctx context.Context, target models.ReportTarget, targetID int64)) *MockReportUC_ReporterCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReportTarget), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_ReporterCount_Call) Return(_a0 int64, _a1 error) *MockReportUC_ReporterCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportUC_ReporterCount_Call) RunAndReturn(run func(context.Context, models.ReportTarget, int64) (int64, error)) *MockReportUC_ReporterCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: ctx, reportID, action, resolvedAt
+func (_m *MockReportUC) Resolve(ctx context.Context, reportID int64, action models.ReportAction, resolvedAt string) (*models.Report, error) {
+	ret := _m.Called(ctx, reportID, action, resolvedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 *models.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.ReportAction, string) (*models.Report, error)); ok {
+		return rf(ctx, reportID, action, resolvedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.ReportAction, string) *models.Report); ok {
+		r0 = rf(ctx, reportID, action, resolvedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, models.ReportAction, string) error); ok {
+		r1 = rf(ctx, reportID, action, resolvedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportUC_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockReportUC_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportID int64
+//   - action models.ReportAction
+//   - resolvedAt string
+func (_e *MockReportUC_Expecter) Resolve(ctx interface{}, reportID interface{}, action interface{}, resolvedAt interface{}) *MockReportUC_Resolve_Call {
+	return &MockReportUC_Resolve_Call{Call: _e.mock.On("Resolve", ctx, reportID, action, resolvedAt)}
+}
+
+func (_c *MockReportUC_Resolve_Call) Run(run func(ctx context.Context, reportID int64, action models.ReportAction, resolvedAt string)) *MockReportUC_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(models.ReportAction), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockReportUC_Resolve_Call) Return(_a0 *models.Report, _a1 error) *MockReportUC_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportUC_Resolve_Call) RunAndReturn(run func(context.Context, int64, models.ReportAction, string) (*models.Report, error)) *MockReportUC_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReportUC creates a new instance of MockReportUC. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReportUC(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReportUC {
+	mock := &MockReportUC{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/Saracomethstein/ozon-test-task/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSpamUC is an autogenerated mock type for the SpamUC type
+type MockSpamUC struct {
+	mock.Mock
+}
+
+type MockSpamUC_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSpamUC) EXPECT() *MockSpamUC_Expecter {
+	return &MockSpamUC_Expecter{mock: &_m.Mock}
+}
+
+// LoadStats provides a mock function with given fields: ctx
+func (_m *MockSpamUC) LoadStats(ctx context.Context) (*models.SpamStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadStats")
+	}
+
+	var r0 *models.SpamStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.SpamStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.SpamStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SpamStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSpamUC_LoadStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadStats'
+type MockSpamUC_LoadStats_Call struct {
+	*mock.Call
+}
+
+// LoadStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSpamUC_Expecter) LoadStats(ctx interface{}) *MockSpamUC_LoadStats_Call {
+	return &MockSpamUC_LoadStats_Call{Call: _e.mock.On("LoadStats", ctx)}
+}
+
+func (_c *MockSpamUC_LoadStats_Call) Run(run func(ctx context.Context)) *MockSpamUC_LoadStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSpamUC_LoadStats_Call) Return(_a0 *models.SpamStats, _a1 error) *MockSpamUC_LoadStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSpamUC_LoadStats_Call) RunAndReturn(run func(context.Context) (*models.SpamStats, error)) *MockSpamUC_LoadStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Train provides a mock function with given fields: ctx, tokens, spam
+func (_m *MockSpamUC) Train(ctx context.Context, tokens []string, spam bool) error {
+	ret := _m.Called(ctx, tokens, spam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Train")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, bool) error); ok {
+		r0 = rf(ctx, tokens, spam)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSpamUC_Train_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Train'
+type MockSpamUC_Train_Call struct {
+	*mock.Call
+}
+
+// Train is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokens []string
+//   - spam bool
+func (_e *MockSpamUC_Expecter) Train(ctx interface{}, tokens interface{}, spam interface{}) *MockSpamUC_Train_Call {
+	return &MockSpamUC_Train_Call{Call: _e.mock.On("Train", ctx, tokens, spam)}
+}
+
+func (_c *MockSpamUC_Train_Call) Run(run func(ctx context.Context, tokens []string, spam bool)) *MockSpamUC_Train_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockSpamUC_Train_Call) Return(_a0 error) *MockSpamUC_Train_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSpamUC_Train_Call) RunAndReturn(run func(context.Context, []string, bool) error) *MockSpamUC_Train_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSpamUC creates a new instance of MockSpamUC. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSpamUC(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSpamUC {
+	mock := &MockSpamUC{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
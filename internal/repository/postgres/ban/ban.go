@@ -0,0 +1,29 @@
+package ban
+
+import (
+	"context"
+)
+
+const (
+	// Banning an author twice keeps the first ban.
+	banAuthorQuery = `
+		insert into banned_authors (author, created_at)
+		values ($1, $2)
+		on conflict (author) do nothing
+	`
+
+	isBannedQuery = `select exists (select 1 from banned_authors where author = $1)`
+)
+
+func (r *ban) Ban(ctx context.Context, author, createdAt string) error {
+	_, err := r.db.Exec(ctx, banAuthorQuery, author, createdAt)
+	return err
+}
+
+func (r *ban) IsBanned(ctx context.Context, author string) (bool, error) {
+	var banned bool
+
+	err := r.db.QueryRow(ctx, isBannedQuery, author).Scan(&banned)
+
+	return banned, err
+}
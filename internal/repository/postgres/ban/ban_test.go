@@ -0,0 +1,90 @@
+package ban
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/pashagolub/pgxmock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBan(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec(`insert into banned_authors \(author, created_at\) values \(\$1, \$2\) on conflict \(author\) do nothing`).
+		WithArgs("Bob", "2026-02-13T10:00:00Z").
+		WillReturnResult(pgconn.CommandTag("INSERT 0 1"))
+
+	r := New(mock)
+
+	assert.NoError(t, r.Ban(context.Background(), "Bob", "2026-02-13T10:00:00Z"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsBanned(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setupMock func(mock pgxmock.PgxPoolIface)
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name: "banned",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select exists \(select 1 from banned_authors where author = \$1\)`).
+					WithArgs("Bob").
+					WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			want: true,
+		},
+		{
+			name: "not_banned",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select exists`).
+					WithArgs("Bob").
+					WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select exists`).
+					WithArgs("Bob").
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.IsBanned(context.Background(), "Bob")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
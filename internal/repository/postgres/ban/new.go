@@ -0,0 +1,25 @@
+package ban
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+type ban struct {
+	db DB
+}
+
+func New(db DB) repository.BanUC {
+	return &ban{
+		db: db,
+	}
+}
+
+type DB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
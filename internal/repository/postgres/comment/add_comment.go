@@ -2,37 +2,80 @@ package comment
 
 import (
 	"context"
-	"database/sql"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 )
 
 const (
+	// The counters are bumped by the same statement as the insert, so they
+	// are committed or rolled back together with the new row. A pending
+	// comment is only counted, and given a publish sequence, once it is
+	// approved.
 	addCommentQuery = `
-		insert into comments (post_id, parent_id, author, body, created_at)
-		values ($1, $2, $3, $4, $5)
-		returning id
+		with inserted as (
+			insert into comments (post_id, parent_id, author, body, created_at, depth, status, spam_score, published_seq)
+			values ($1, $2, $3, $4, $5, $6, $7, $8,
+				case when $7::text = 'PUBLISHED' then nextval('comments_published_seq') end)
+			returning id, post_id, parent_id, status, published_seq
+		), post_counts as (
+			update posts p
+			set comment_count = p.comment_count + 1,
+				root_comment_count = p.root_comment_count + case when i.parent_id is null then 1 else 0 end
+			from inserted i
+			where p.id = i.post_id and i.status = 'PUBLISHED'
+		), reply_counts as (
+			update comments c
+			set reply_count = c.reply_count + 1
+			from inserted i
+			where c.id = i.parent_id and i.status = 'PUBLISHED'
+		)
+		select id, coalesce(published_seq, 0) from inserted
 	`
 
-	checkPostAllowsCommentsQuery = `
-		select allow_comments from posts where id = $1
+	getCommentPolicyQuery = `
+		select comment_policy, comments_close_at, author from posts where id = $1 and deleted_at is null
 	`
 
 	checkParentCommentQuery = `
-		select post_id from comments where id = $1
+		select post_id from comments where id = $1 and not is_deleted and status = 'PUBLISHED'
+	`
+
+	getReplyParentQuery = `
+		select c.post_id, c.depth, p.max_reply_depth
+		from comments c
+		join posts p on p.id = c.post_id
+		where c.id = $1 and not c.is_deleted and c.status = 'PUBLISHED'
+	`
+
+	// getAncestorAtQuery climbs the parent chain only as far as the requested
+	// depth, so it reads at most (start depth - $2) rows.
+	getAncestorAtQuery = `
+		with recursive chain as (
+			select id, parent_id, depth from comments where id = $1
+			union all
+			select c.id, c.parent_id, c.depth
+			from comments c
+			join chain on c.id = chain.parent_id
+			where chain.depth > $2
+		)
+		select id from chain where depth = $2
 	`
 )
 
 func (r *comment) Add(ctx context.Context, comment models.Comment) (*models.Comment, error) {
-	err := r.db.QueryRow(ctx, addCommentQuery,
+	err := r.conn(ctx).QueryRow(ctx, addCommentQuery,
 		comment.PostID,
 		comment.ParentID,
 		comment.Author,
 		comment.Text,
 		comment.CreatedAt,
-	).Scan(&comment.ID)
+		comment.Depth,
+		comment.Status,
+		comment.SpamScore,
+	).Scan(&comment.ID, &comment.PublishedSeq)
 	if err != nil {
 		return nil, err
 	}
@@ -40,26 +83,30 @@ func (r *comment) Add(ctx context.Context, comment models.Comment) (*models.Comm
 	return &comment, nil
 }
 
-func (r *comment) CheckAllowComments(ctx context.Context, postID int64) (bool, error) {
-	var allow bool
+func (r *comment) GetCommentPolicy(ctx context.Context, postID int64) (*models.PostCommentPolicy, error) {
+	var out models.PostCommentPolicy
 
-	err := r.db.QueryRow(ctx, checkPostAllowsCommentsQuery, postID).Scan(&allow)
+	err := r.conn(ctx).QueryRow(ctx, getCommentPolicyQuery, postID).Scan(
+		&out.Policy,
+		&out.CloseAt,
+		&out.Author,
+	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return false, errors.New("post not found")
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("post not found")
 		}
-		return false, err
+		return nil, err
 	}
 
-	return allow, nil
+	return &out, nil
 }
 
 func (r *comment) CheckParentExists(ctx context.Context, parentID int64) (int64, error) {
 	var postID int64
 
-	err := r.db.QueryRow(ctx, checkParentCommentQuery, parentID).Scan(&postID)
+	err := r.conn(ctx).QueryRow(ctx, checkParentCommentQuery, parentID).Scan(&postID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return 0, errors.New("parent comment not found")
 		}
 		return 0, err
@@ -67,3 +114,35 @@ func (r *comment) CheckParentExists(ctx context.Context, parentID int64) (int64,
 
 	return postID, nil
 }
+
+func (r *comment) GetReplyParent(ctx context.Context, parentID int64) (*models.ReplyParent, error) {
+	var out models.ReplyParent
+
+	err := r.conn(ctx).QueryRow(ctx, getReplyParentQuery, parentID).Scan(
+		&out.PostID,
+		&out.Depth,
+		&out.MaxReplyDepth,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("parent comment not found")
+		}
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (r *comment) GetAncestorAt(ctx context.Context, commentID int64, depth int32) (int64, error) {
+	var id int64
+
+	err := r.conn(ctx).QueryRow(ctx, getAncestorAtQuery, commentID, depth).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrCommentNotFound
+		}
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,76 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	// A cursor names the last comment a subscriber received. Its publish
+	// sequence is kept through a later hold, so it is looked up regardless of
+	// status; a purged comment falls back to the newest comment published at
+	// or before its position.
+	getPublishedSeqQuery = `
+		select coalesce(
+			(select published_seq from comments where id = $2 and post_id = $1),
+			(select max(published_seq) from comments
+				where post_id = $1 and status = 'PUBLISHED'
+					and (created_at, id) <= ($3::text, $2::bigint)),
+			0
+		)
+	`
+
+	getCommentsAddedAfterQuery = `
+		select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, status, published_seq
+		from comments
+		where post_id = $1 and status = 'PUBLISHED' and published_seq > $2
+		order by published_seq
+		limit $3
+	`
+)
+
+func (r *comment) GetPublishedSeq(ctx context.Context, postID int64, createdAt string, commentID int64) (int64, error) {
+	var seq int64
+
+	err := r.conn(ctx).QueryRow(ctx, getPublishedSeqQuery, postID, commentID, createdAt).Scan(&seq)
+
+	return seq, err
+}
+
+func (r *comment) GetAddedAfter(ctx context.Context, postID int64, afterSeq int64, limit int32) ([]*models.Comment, error) {
+	rows, err := r.conn(ctx).Query(ctx, getCommentsAddedAfterQuery,
+		postID,
+		afterSeq,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]*models.Comment, 0, limit)
+	for rows.Next() {
+		c := models.Comment{}
+
+		err := rows.Scan(
+			&c.ID,
+			&c.PostID,
+			&c.ParentID,
+			&c.Author,
+			&c.Text,
+			&c.CreatedAt,
+			&c.EditedAt,
+			&c.IsDeleted,
+			&c.Status,
+			&c.PublishedSeq,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, &c)
+	}
+
+	return comments, rows.Err()
+}
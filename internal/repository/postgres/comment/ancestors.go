@@ -0,0 +1,71 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	getCommentByIdQuery = `select ` + commentListColumns + ` from comments where id = $1 and status = 'PUBLISHED'`
+
+	// getAncestorsQuery follows parent_id up from the comment; hops counts the
+	// distance, so ordering by it descending puts the thread root first. An
+	// ancestor that is held or rejected keeps its place in the chain but not
+	// its author and text, like a tombstone.
+	getAncestorsQuery = `
+		with recursive chain as (
+			select p.id, p.post_id, p.parent_id, p.author, p.body, p.created_at, p.edited_at, p.is_deleted, p.upvotes, p.downvotes, p.status, 1 as hops
+			from comments c
+			join comments p on p.id = c.parent_id
+			where c.id = $1
+			union all
+			select p.id, p.post_id, p.parent_id, p.author, p.body, p.created_at, p.edited_at, p.is_deleted, p.upvotes, p.downvotes, p.status, chain.hops + 1
+			from chain
+			join comments p on p.id = chain.parent_id
+		)
+		select id, post_id, parent_id,
+			case when status = 'PUBLISHED' then author else '' end,
+			case when status = 'PUBLISHED' then body else '' end,
+			created_at, edited_at, is_deleted, upvotes, downvotes, status
+		from chain
+		order by hops desc
+	`
+)
+
+func (r *comment) GetByID(ctx context.Context, commentID int64) (*models.Comment, error) {
+	c, err := scanListedComment(r.conn(ctx).QueryRow(ctx, getCommentByIdQuery, commentID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// GetAncestors returns the parent chain of commentID root first, without the
+// comment itself. A root comment has no ancestors.
+func (r *comment) GetAncestors(ctx context.Context, commentID int64) ([]*models.Comment, error) {
+	rows, err := r.conn(ctx).Query(ctx, getAncestorsQuery, commentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ancestors := make([]*models.Comment, 0)
+	for rows.Next() {
+		c, err := scanListedComment(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		ancestors = append(ancestors, c)
+	}
+
+	return ancestors, rows.Err()
+}
@@ -2,10 +2,10 @@ package comment
 
 import (
 	"context"
-	"database/sql"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/pashagolub/pgxmock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -17,12 +17,16 @@ import (
 func TestAdd(t *testing.T) {
 	t.Parallel()
 
+	spamScore := 0.12
 	baseComment := models.Comment{
 		PostID:    1,
 		ParentID:  nil,
 		Author:    "John Doe",
 		Text:      "Test comment",
 		CreatedAt: time.Now().Format(time.RFC3339),
+		Depth:     2,
+		Status:    models.CommentStatusPublished,
+		SpamScore: &spamScore,
 	}
 
 	tests := []struct {
@@ -30,6 +34,7 @@ func TestAdd(t *testing.T) {
 		comment     models.Comment
 		setupMock   func(mock pgxmock.PgxPoolIface)
 		wantID      int64
+		wantSeq     int64
 		wantErr     bool
 		expectedErr error
 	}{
@@ -37,11 +42,12 @@ func TestAdd(t *testing.T) {
 			name:    "success",
 			comment: baseComment,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`insert into comments`).
-					WithArgs(baseComment.PostID, baseComment.ParentID, baseComment.Author, baseComment.Text, baseComment.CreatedAt).
-					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(123)))
+				mock.ExpectQuery(`insert into comments .* update posts p set comment_count = p.comment_count \+ 1, root_comment_count = p.root_comment_count \+ case when i.parent_id is null then 1 else 0 end from inserted i where p.id = i.post_id and i.status = 'PUBLISHED' .* update comments c set reply_count = c.reply_count \+ 1 from inserted i where c.id = i.parent_id and i.status = 'PUBLISHED' \) select id, coalesce\(published_seq, 0\) from inserted`).
+					WithArgs(baseComment.PostID, baseComment.ParentID, baseComment.Author, baseComment.Text, baseComment.CreatedAt, baseComment.Depth, baseComment.Status, baseComment.SpamScore).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "published_seq"}).AddRow(int64(123), int64(17)))
 			},
 			wantID:  123,
+			wantSeq: 17,
 			wantErr: false,
 		},
 		{
@@ -49,7 +55,7 @@ func TestAdd(t *testing.T) {
 			comment: baseComment,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery(`insert into comments`).
-					WithArgs(baseComment.PostID, baseComment.ParentID, baseComment.Author, baseComment.Text, baseComment.CreatedAt).
+					WithArgs(baseComment.PostID, baseComment.ParentID, baseComment.Author, baseComment.Text, baseComment.CreatedAt, baseComment.Depth, baseComment.Status, baseComment.SpamScore).
 					WillReturnError(errors.New("insert failed"))
 			},
 			wantErr: true,
@@ -76,69 +82,62 @@ func TestAdd(t *testing.T) {
 				assert.NoError(t, err)
 				require.NotNil(t, got)
 				assert.Equal(t, tt.wantID, got.ID)
+				assert.Equal(t, tt.wantSeq, got.PublishedSeq)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestCheckAllowComments(t *testing.T) {
+func TestGetReplyParent(t *testing.T) {
 	t.Parallel()
 
-	postID := int64(1)
+	parentID := int64(100)
+	maxReplyDepth := int32(4)
 
 	tests := []struct {
 		name        string
-		postID      int64
 		setupMock   func(mock pgxmock.PgxPoolIface)
-		wantAllow   bool
+		want        *models.ReplyParent
 		wantErr     bool
 		expectedErr error
 	}{
 		{
-			name:   "comments_allowed",
-			postID: postID,
+			name: "post_limit",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select allow_comments from posts`).
-					WithArgs(postID).
-					WillReturnRows(pgxmock.NewRows([]string{"allow_comments"}).AddRow(true))
+				mock.ExpectQuery(`select c.post_id, c.depth, p.max_reply_depth from comments c join posts p on p.id = c.post_id where c.id = \$1 and not c.is_deleted`).
+					WithArgs(parentID).
+					WillReturnRows(pgxmock.NewRows([]string{"post_id", "depth", "max_reply_depth"}).AddRow(int64(1), int32(2), &maxReplyDepth))
 			},
-			wantAllow: true,
-			wantErr:   false,
+			want: &models.ReplyParent{PostID: 1, Depth: 2, MaxReplyDepth: &maxReplyDepth},
 		},
 		{
-			name:   "comments_not_allowed",
-			postID: postID,
+			name: "default_limit",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select allow_comments from posts`).
-					WithArgs(postID).
-					WillReturnRows(pgxmock.NewRows([]string{"allow_comments"}).AddRow(false))
+				mock.ExpectQuery(`select c.post_id, c.depth, p.max_reply_depth`).
+					WithArgs(parentID).
+					WillReturnRows(pgxmock.NewRows([]string{"post_id", "depth", "max_reply_depth"}).AddRow(int64(1), int32(0), nil))
 			},
-			wantAllow: false,
-			wantErr:   false,
+			want: &models.ReplyParent{PostID: 1},
 		},
 		{
-			name:   "post_not_found",
-			postID: postID,
+			name: "parent_not_found",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select allow_comments from posts`).
-					WithArgs(postID).
-					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`select c.post_id, c.depth, p.max_reply_depth`).
+					WithArgs(parentID).
+					WillReturnError(pgx.ErrNoRows)
 			},
-			wantAllow:   false,
 			wantErr:     true,
-			expectedErr: errors.New("post not found"),
+			expectedErr: errors.New("parent comment not found"),
 		},
 		{
-			name:   "db_error",
-			postID: postID,
+			name: "db_error",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select allow_comments from posts`).
-					WithArgs(postID).
-					WillReturnError(errors.New("connection error"))
+				mock.ExpectQuery(`select c.post_id, c.depth, p.max_reply_depth`).
+					WithArgs(parentID).
+					WillReturnError(errors.New("some db error"))
 			},
-			wantAllow: false,
-			wantErr:   true,
+			wantErr: true,
 		},
 	}
 
@@ -153,70 +152,63 @@ func TestCheckAllowComments(t *testing.T) {
 			tt.setupMock(mock)
 
 			r := New(mock)
-			got, err := r.CheckAllowComments(context.Background(), tt.postID)
+			got, err := r.GetReplyParent(context.Background(), parentID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
-				assert.False(t, got)
+				assert.Nil(t, got)
 				if tt.expectedErr != nil {
 					assert.EqualError(t, err, tt.expectedErr.Error())
 				}
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.wantAllow, got)
+				assert.Equal(t, tt.want, got)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestCheckParentExists(t *testing.T) {
+func TestGetAncestorAt(t *testing.T) {
 	t.Parallel()
 
-	parentID := int64(100)
-	expectedPostID := int64(1)
+	commentID := int64(50)
+	depth := int32(2)
 
 	tests := []struct {
 		name        string
-		parentID    int64
 		setupMock   func(mock pgxmock.PgxPoolIface)
-		wantPostID  int64
+		want        int64
 		wantErr     bool
 		expectedErr error
 	}{
 		{
-			name:     "parent_exists",
-			parentID: parentID,
+			name: "found",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select post_id from comments`).
-					WithArgs(parentID).
-					WillReturnRows(pgxmock.NewRows([]string{"post_id"}).AddRow(expectedPostID))
+				mock.ExpectQuery(`with recursive chain as \( select id, parent_id, depth from comments where id = \$1 union all select c.id, c.parent_id, c.depth from comments c join chain on c.id = chain.parent_id where chain.depth > \$2 \) select id from chain where depth = \$2`).
+					WithArgs(commentID, depth).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
 			},
-			wantPostID: expectedPostID,
-			wantErr:    false,
+			want: 7,
 		},
 		{
-			name:     "parent_not_found",
-			parentID: parentID,
+			name: "not_found",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select post_id from comments`).
-					WithArgs(parentID).
-					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`with recursive chain`).
+					WithArgs(commentID, depth).
+					WillReturnError(pgx.ErrNoRows)
 			},
-			wantPostID:  0,
 			wantErr:     true,
-			expectedErr: errors.New("parent comment not found"),
+			expectedErr: ErrCommentNotFound,
 		},
 		{
-			name:     "db_error",
-			parentID: parentID,
+			name: "db_error",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select post_id from comments`).
-					WithArgs(parentID).
+				mock.ExpectQuery(`with recursive chain`).
+					WithArgs(commentID, depth).
 					WillReturnError(errors.New("some db error"))
 			},
-			wantPostID: 0,
-			wantErr:    true,
+			wantErr: true,
 		},
 	}
 
@@ -231,106 +223,64 @@ func TestCheckParentExists(t *testing.T) {
 			tt.setupMock(mock)
 
 			r := New(mock)
-			got, err := r.CheckParentExists(context.Background(), tt.parentID)
+			got, err := r.GetAncestorAt(context.Background(), commentID, depth)
 
 			if tt.wantErr {
 				assert.Error(t, err)
-				assert.Equal(t, int64(0), got)
 				if tt.expectedErr != nil {
-					assert.EqualError(t, err, tt.expectedErr.Error())
+					assert.ErrorIs(t, err, tt.expectedErr)
 				}
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.wantPostID, got)
+				assert.Equal(t, tt.want, got)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestGetRootByPost(t *testing.T) {
+func TestGetByID(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now().UTC()
-	postID := int64(1)
-	afterCreatedAt := "2023-01-01T00:00:00Z"
-	afterID := int64(10)
-	limit := int32(5)
-
-	comment1 := testComment(1, postID, nil, "Alice", "First", now.Format(time.RFC3339))
-	comment2 := testComment(2, postID, nil, "Bob", "Second", now.Add(-time.Hour).Format(time.RFC3339))
+	columns := []string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}
+	parentID := int64(2)
 
 	tests := []struct {
-		name      string
-		postID    int64
-		after     *string
-		afterID   int64
-		limit     int32
-		setupMock func(pgxmock.PgxPoolIface)
-		want      []*models.Comment
-		wantErr   bool
+		name        string
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		want        *models.Comment
+		expectedErr error
 	}{
 		{
-			name:    "success_with_results",
-			postID:  postID,
-			after:   &afterCreatedAt,
-			afterID: afterID,
-			limit:   limit,
-			setupMock: func(mock pgxmock.PgxPoolIface) {
-				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at"}).
-					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt).
-					AddRow(comment2.ID, comment2.PostID, comment2.ParentID, comment2.Author, comment2.Text, comment2.CreatedAt)
-				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at from comments where post_id = \$1 and parent_id is null and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
-					WithArgs(postID, &afterCreatedAt, afterID, limit).
-					WillReturnRows(rows)
-			},
-			want: []*models.Comment{&comment1, &comment2},
-		},
-		{
-			name:    "success_with_nil_after",
-			postID:  postID,
-			after:   nil,
-			afterID: 0,
-			limit:   limit,
+			name: "found",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at"}).
-					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt)
-				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at from comments where post_id = \$1 and parent_id is null and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
-					WithArgs(postID, (*string)(nil), int64(0), limit).
-					WillReturnRows(rows)
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where id = \$1 and status = 'PUBLISHED'`).
+					WithArgs(int64(5)).
+					WillReturnRows(pgxmock.NewRows(columns).AddRow(int64(5), int64(1), &parentID, "Bob", "deep", "2026-02-12T19:00:00Z", nil, false, int32(1), int32(0), models.CommentStatusPublished))
 			},
-			want: []*models.Comment{&comment1},
+			want: &models.Comment{ID: 5, PostID: 1, ParentID: &parentID, Author: "Bob", Text: "deep", CreatedAt: "2026-02-12T19:00:00Z", Upvotes: 1, Status: models.CommentStatusPublished},
 		},
 		{
-			name:    "no_rows",
-			postID:  postID,
-			after:   &afterCreatedAt,
-			afterID: afterID,
-			limit:   limit,
+			name: "not_found",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at from comments where post_id = \$1 and parent_id is null and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
-					WithArgs(postID, &afterCreatedAt, afterID, limit).
-					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at"}))
+				mock.ExpectQuery(`from comments where id = \$1`).
+					WithArgs(int64(5)).
+					WillReturnError(pgx.ErrNoRows)
 			},
-			want: []*models.Comment{},
+			expectedErr: ErrCommentNotFound,
 		},
 		{
-			name:    "db_error",
-			postID:  postID,
-			after:   &afterCreatedAt,
-			afterID: afterID,
-			limit:   limit,
+			name: "db_error",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at from comments where post_id = \$1 and parent_id is null and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
-					WithArgs(postID, &afterCreatedAt, afterID, limit).
-					WillReturnError(errors.New("query failed"))
+				mock.ExpectQuery(`from comments where id = \$1`).
+					WithArgs(int64(5)).
+					WillReturnError(errors.New("db error"))
 			},
-			wantErr: true,
+			expectedErr: errors.New("db error"),
 		},
 	}
 
 	for _, tt := range tests {
-		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
@@ -341,10 +291,10 @@ func TestGetRootByPost(t *testing.T) {
 			tt.setupMock(mock)
 
 			r := New(mock)
-			got, err := r.GetRootByPost(context.Background(), tt.postID, tt.after, tt.afterID, tt.limit)
+			got, err := r.GetByID(context.Background(), 5)
 
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
 				assert.Nil(t, got)
 			} else {
 				assert.NoError(t, err)
@@ -354,68 +304,48 @@ func TestGetRootByPost(t *testing.T) {
 		})
 	}
 }
-func TestGetChild(t *testing.T) {
-	t.Parallel()
 
-	parentID := int64(100)
-	afterCreatedAt := "2026-02-12T19:57:26Z"
-	afterID := int64(10)
-	limit := int32(5)
+func TestGetAncestors(t *testing.T) {
+	t.Parallel()
 
-	comment1 := testComment(1, 1, &parentID, "Alice", "Child 1", "2026-02-12T19:57:26Z")
-	comment2 := testComment(2, 1, &parentID, "Bob", "Child 2", "2026-02-12T18:57:26Z")
+	columns := []string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}
+	rootID := int64(1)
 
 	tests := []struct {
-		name      string
-		parentID  int64
-		after     *string
-		afterID   int64
-		limit     int32
-		setupMock func(pgxmock.PgxPoolIface)
-		want      []*models.Comment
-		wantErr   bool
+		name        string
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		wantIDs     []int64
+		expectedErr error
 	}{
 		{
-			name:     "success_with_results",
-			parentID: parentID,
-			after:    &afterCreatedAt,
-			afterID:  afterID,
-			limit:    limit,
+			name: "root_first",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at"}).
-					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt).
-					AddRow(comment2.ID, comment2.PostID, comment2.ParentID, comment2.Author, comment2.Text, comment2.CreatedAt)
-				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at from comments where parent_id = \$1 and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
-					WithArgs(parentID, &afterCreatedAt, afterID, limit).
+				rows := pgxmock.NewRows(columns).
+					AddRow(int64(1), int64(1), nil, "A", "root", "2026-02-12T19:00:00Z", nil, false, int32(0), int32(0), models.CommentStatusPublished).
+					AddRow(int64(2), int64(1), &rootID, "B", "mid", "2026-02-12T19:01:00Z", nil, false, int32(0), int32(0), models.CommentStatusPublished)
+				mock.ExpectQuery(`with recursive chain as \( .* where c.id = \$1 union all .* join comments p on p.id = chain.parent_id \) select id, post_id, parent_id, case when status = 'PUBLISHED' then author else '' end, case when status = 'PUBLISHED' then body else '' end, created_at, edited_at, is_deleted, upvotes, downvotes, status from chain order by hops desc`).
+					WithArgs(int64(3)).
 					WillReturnRows(rows)
 			},
-			want: []*models.Comment{&comment1, &comment2},
+			wantIDs: []int64{1, 2},
 		},
 		{
-			name:     "no_rows",
-			parentID: parentID,
-			after:    &afterCreatedAt,
-			afterID:  afterID,
-			limit:    limit,
+			name: "no_ancestors",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at from comments where parent_id = \$1 and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
-					WithArgs(parentID, &afterCreatedAt, afterID, limit).
-					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at"}))
+				mock.ExpectQuery(`from chain order by hops desc`).
+					WithArgs(int64(3)).
+					WillReturnRows(pgxmock.NewRows(columns))
 			},
-			want: []*models.Comment{},
+			wantIDs: []int64{},
 		},
 		{
-			name:     "db_error",
-			parentID: parentID,
-			after:    &afterCreatedAt,
-			afterID:  afterID,
-			limit:    limit,
+			name: "db_error",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at from comments where parent_id = \$1 and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
-					WithArgs(parentID, &afterCreatedAt, afterID, limit).
-					WillReturnError(errors.New("query failed"))
+				mock.ExpectQuery(`from chain order by hops desc`).
+					WithArgs(int64(3)).
+					WillReturnError(errors.New("db error"))
 			},
-			wantErr: true,
+			expectedErr: errors.New("db error"),
 		},
 	}
 
@@ -430,91 +360,84 @@ func TestGetChild(t *testing.T) {
 			tt.setupMock(mock)
 
 			r := New(mock)
-			got, err := r.GetChild(context.Background(), tt.parentID, tt.after, tt.afterID, tt.limit)
+			got, err := r.GetAncestors(context.Background(), 3)
 
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
 				assert.Nil(t, got)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.want, got)
+				ids := make([]int64, len(got))
+				for i, c := range got {
+					ids[i] = c.ID
+				}
+				assert.Equal(t, tt.wantIDs, ids)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestGetChildBatch(t *testing.T) {
+func TestGetRootBatch(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now().UTC()
-	parentIDs := []int64{100, 200}
-
-	comment1 := models.Comment{
-		ID:        1,
-		PostID:    1,
-		ParentID:  &parentIDs[0],
-		Author:    "Alice",
-		Text:      "Child of 100",
-		CreatedAt: now.Format(time.RFC3339),
-	}
-	comment2 := models.Comment{
-		ID:        2,
-		PostID:    1,
-		ParentID:  &parentIDs[0],
-		Author:    "Bob",
-		Text:      "Another child of 100",
-		CreatedAt: now.Add(-1 * time.Hour).Format(time.RFC3339),
-	}
-	comment3 := models.Comment{
-		ID:        3,
-		PostID:    2,
-		ParentID:  &parentIDs[1],
-		Author:    "Charlie",
-		Text:      "Child of 200",
-		CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339),
-	}
+	columns := []string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}
+	postIDs := []int64{1, 2}
+	after := &models.CommentCursor{Score: 3, ID: 9}
 
 	tests := []struct {
-		name      string
-		parentIDs []int64
-		setupMock func(pgxmock.PgxPoolIface)
-		want      []*models.Comment
-		wantErr   bool
+		name        string
+		order       models.CommentOrder
+		after       *models.CommentCursor
+		backward    bool
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		wantIDs     []int64
+		expectedErr error
 	}{
 		{
-			name:      "success_with_results",
-			parentIDs: parentIDs,
+			name:  "newest_first_page",
+			order: models.CommentOrderNewest,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				rows := pgxmock.NewRows([]string{"parent_id", "id", "post_id", "author", "body", "created_at"}).
-					AddRow(comment1.ParentID, comment1.ID, comment1.PostID, comment1.Author, comment1.Text, comment1.CreatedAt).
-					AddRow(comment2.ParentID, comment2.ID, comment2.PostID, comment2.Author, comment2.Text, comment2.CreatedAt).
-					AddRow(comment3.ParentID, comment3.ID, comment3.PostID, comment3.Author, comment3.Text, comment3.CreatedAt)
-				mock.ExpectQuery(`select parent_id, id, post_id, author, body, created_at from comments where parent_id = any\(\$1\) order by parent_id, created_at desc, id desc`).
-					WithArgs(parentIDs).
+				rows := pgxmock.NewRows(columns).
+					AddRow(int64(3), int64(1), nil, "A", "a", "2026-02-12T19:02:00Z", nil, false, int32(0), int32(0), models.CommentStatusPublished).
+					AddRow(int64(4), int64(2), nil, "B", "b", "2026-02-12T19:01:00Z", nil, false, int32(0), int32(0), models.CommentStatusPublished)
+				mock.ExpectQuery(`select c.\* from unnest\(\$1::bigint\[\]\) as p\(pid\) cross join lateral \( select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where post_id = p.pid and parent_id is null and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4 \) c order by c.post_id, created_at desc, id desc`).
+					WithArgs(postIDs, (*string)(nil), int64(0), int32(3)).
 					WillReturnRows(rows)
 			},
-			want: []*models.Comment{&comment1, &comment2, &comment3},
+			wantIDs: []int64{3, 4},
 		},
 		{
-			name:      "no_rows",
-			parentIDs: parentIDs,
+			name:  "top_after_cursor",
+			order: models.CommentOrderTop,
+			after: after,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select parent_id, id, post_id, author, body, created_at from comments where parent_id = any\(\$1\) order by parent_id, created_at desc, id desc`).
-					WithArgs(parentIDs).
-					WillReturnRows(pgxmock.NewRows([]string{"parent_id", "id", "post_id", "author", "body", "created_at"}))
+				mock.ExpectQuery(`\(\$2::bigint is null or \(upvotes - downvotes, id\) < \(\$2::bigint, \$3::bigint\)\) order by upvotes - downvotes desc, id desc limit \$4 \) c order by c.post_id, upvotes - downvotes desc, id desc`).
+					WithArgs(postIDs, &after.Score, after.ID, int32(3)).
+					WillReturnRows(pgxmock.NewRows(columns))
 			},
-			want: []*models.Comment{},
+			wantIDs: []int64{},
 		},
 		{
-			name:      "db_error",
-			parentIDs: parentIDs,
+			name:     "newest_backward",
+			order:    models.CommentOrderNewest,
+			backward: true,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select parent_id, id, post_id, author, body, created_at from comments where parent_id = any\(\$1\) order by parent_id, created_at desc, id desc`).
-					WithArgs(parentIDs).
-					WillReturnError(errors.New("batch query failed"))
+				mock.ExpectQuery(`where post_id = p.pid and parent_id is null and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) > \(\$2::text, \$3::bigint\)\) order by created_at, id limit \$4 \) c order by c.post_id, created_at desc, id desc`).
+					WithArgs(postIDs, (*string)(nil), int64(0), int32(3)).
+					WillReturnRows(pgxmock.NewRows(columns))
 			},
-			wantErr: true,
+			wantIDs: []int64{},
+		},
+		{
+			name:  "db_error",
+			order: models.CommentOrderNewest,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`cross join lateral`).
+					WithArgs(postIDs, (*string)(nil), int64(0), int32(3)).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
 		},
 	}
 
@@ -529,10 +452,70 @@ func TestGetChildBatch(t *testing.T) {
 			tt.setupMock(mock)
 
 			r := New(mock)
-			got, err := r.GetChildBatch(context.Background(), tt.parentIDs)
+			got, err := r.GetRootBatch(context.Background(), postIDs, tt.order, tt.after, tt.backward, 3)
 
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				ids := make([]int64, len(got))
+				for i, c := range got {
+					ids[i] = c.ID
+				}
+				assert.Equal(t, tt.wantIDs, ids)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTotalCountBatch(t *testing.T) {
+	t.Parallel()
+
+	postIDs := []int64{1, 2}
+
+	tests := []struct {
+		name        string
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		want        []*models.CommentCount
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, comment_count from posts where id = any\(\$1\) and comment_count > 0`).
+					WithArgs(postIDs).
+					WillReturnRows(pgxmock.NewRows([]string{"post_id", "count"}).AddRow(int64(1), int64(4)))
+			},
+			want: []*models.CommentCount{{PostID: 1, Count: 4}},
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`from posts where id = any`).
+					WithArgs(postIDs).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.TotalCountBatch(context.Background(), postIDs)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
 				assert.Nil(t, got)
 			} else {
 				assert.NoError(t, err)
@@ -543,46 +526,66 @@ func TestGetChildBatch(t *testing.T) {
 	}
 }
 
-func TestTotalCount(t *testing.T) {
+func TestGetCommentPolicy(t *testing.T) {
 	t.Parallel()
 
 	postID := int64(1)
+	closeAt := "2026-03-01T00:00:00Z"
 
 	tests := []struct {
 		name        string
 		postID      int64
-		setupMock   func(pgxmock.PgxPoolIface)
-		wantCount   int64
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		want        *models.PostCommentPolicy
 		wantErr     bool
 		expectedErr error
 	}{
 		{
-			name:   "success",
+			name:   "open",
 			postID: postID,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select count\(\*\) from comments where post_id = \$1`).
+				mock.ExpectQuery(`select comment_policy, comments_close_at, author from posts where id = \$1 and deleted_at is null`).
 					WithArgs(postID).
-					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(42)))
+					WillReturnRows(pgxmock.NewRows([]string{"comment_policy", "comments_close_at", "author"}).
+						AddRow(models.CommentPolicyOpen, nil, "Adel"))
 			},
-			wantCount: 42,
+			want:    &models.PostCommentPolicy{Policy: models.CommentPolicyOpen, Author: "Adel"},
+			wantErr: false,
 		},
 		{
-			name:   "zero_count",
+			name:   "author_only_with_close_time",
 			postID: postID,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select count\(\*\) from comments where post_id = \$1`).
+				mock.ExpectQuery(`select comment_policy, comments_close_at, author from posts where id = \$1 and deleted_at is null`).
 					WithArgs(postID).
-					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(0)))
+					WillReturnRows(pgxmock.NewRows([]string{"comment_policy", "comments_close_at", "author"}).
+						AddRow(models.CommentPolicyAuthorOnly, &closeAt, "Adel"))
 			},
-			wantCount: 0,
+			want: &models.PostCommentPolicy{
+				Policy:  models.CommentPolicyAuthorOnly,
+				CloseAt: &closeAt,
+				Author:  "Adel",
+			},
+			wantErr: false,
+		},
+		{
+			name:   "post_not_found",
+			postID: postID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select comment_policy, comments_close_at, author from posts where id = \$1 and deleted_at is null`).
+					WithArgs(postID).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr:     true,
+			expectedErr: errors.New("post not found"),
 		},
 		{
 			name:   "db_error",
 			postID: postID,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select count\(\*\) from comments where post_id = \$1`).
+				mock.ExpectQuery(`select comment_policy, comments_close_at, author from posts where id = \$1 and deleted_at is null`).
 					WithArgs(postID).
-					WillReturnError(errors.New("count failed"))
+					WillReturnError(errors.New("connection error"))
 			},
 			wantErr: true,
 		},
@@ -599,27 +602,1665 @@ func TestTotalCount(t *testing.T) {
 			tt.setupMock(mock)
 
 			r := New(mock)
-			got, err := r.TotalCount(context.Background(), tt.postID)
+			got, err := r.GetCommentPolicy(context.Background(), tt.postID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
-				assert.Equal(t, int64(0), got)
+				assert.Nil(t, got)
+				if tt.expectedErr != nil {
+					assert.EqualError(t, err, tt.expectedErr.Error())
+				}
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.wantCount, got)
+				assert.Equal(t, tt.want, got)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func testComment(id, postID int64, parentID *int64, author, text string, createdAt string) models.Comment {
-	return models.Comment{
-		ID:        id,
-		PostID:    postID,
-		ParentID:  parentID,
-		Author:    author,
-		Text:      text,
+func TestCheckParentExists(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(100)
+	expectedPostID := int64(1)
+
+	tests := []struct {
+		name        string
+		parentID    int64
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		wantPostID  int64
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:     "parent_exists",
+			parentID: parentID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select post_id from comments where id = \$1 and not is_deleted`).
+					WithArgs(parentID).
+					WillReturnRows(pgxmock.NewRows([]string{"post_id"}).AddRow(expectedPostID))
+			},
+			wantPostID: expectedPostID,
+			wantErr:    false,
+		},
+		{
+			name:     "parent_not_found",
+			parentID: parentID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select post_id from comments where id = \$1 and not is_deleted`).
+					WithArgs(parentID).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantPostID:  0,
+			wantErr:     true,
+			expectedErr: errors.New("parent comment not found"),
+		},
+		{
+			name:     "db_error",
+			parentID: parentID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select post_id from comments where id = \$1 and not is_deleted`).
+					WithArgs(parentID).
+					WillReturnError(errors.New("some db error"))
+			},
+			wantPostID: 0,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.CheckParentExists(context.Background(), tt.parentID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, int64(0), got)
+				if tt.expectedErr != nil {
+					assert.EqualError(t, err, tt.expectedErr.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantPostID, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetRootByPost(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	postID := int64(1)
+	afterCreatedAt := "2023-01-01T00:00:00Z"
+	afterID := int64(10)
+	limit := int32(5)
+
+	comment1 := testComment(1, postID, nil, "Alice", "First", now.Format(time.RFC3339))
+	comment2 := testComment(2, postID, nil, "Bob", "Second", now.Add(-time.Hour).Format(time.RFC3339))
+	voted := testComment(3, postID, nil, "Carol", "Third", now.Format(time.RFC3339))
+	voted.Upvotes = 3
+	voted.Downvotes = 1
+	score := int64(4)
+
+	tests := []struct {
+		name      string
+		postID    int64
+		order     models.CommentOrder
+		after     *models.CommentCursor
+		backward  bool
+		limit     int32
+		setupMock func(pgxmock.PgxPoolIface)
+		want      []*models.Comment
+		wantErr   bool
+	}{
+		{
+			name:   "success_with_results",
+			postID: postID,
+			after:  &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID},
+			limit:  limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}).
+					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt, comment1.EditedAt, comment1.IsDeleted, comment1.Upvotes, comment1.Downvotes, comment1.Status).
+					AddRow(comment2.ID, comment2.PostID, comment2.ParentID, comment2.Author, comment2.Text, comment2.CreatedAt, comment2.EditedAt, comment2.IsDeleted, comment2.Upvotes, comment2.Downvotes, comment2.Status)
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where post_id = \$1 and parent_id is null and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
+					WithArgs(postID, &afterCreatedAt, afterID, limit).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{&comment1, &comment2},
+		},
+		{
+			name:   "success_with_nil_after",
+			postID: postID,
+			after:  nil,
+			limit:  limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}).
+					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt, comment1.EditedAt, comment1.IsDeleted, comment1.Upvotes, comment1.Downvotes, comment1.Status)
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where post_id = \$1 and parent_id is null and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
+					WithArgs(postID, (*string)(nil), int64(0), limit).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{&comment1},
+		},
+		{
+			name:     "backward_before_cursor",
+			postID:   postID,
+			after:    &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID},
+			backward: true,
+			limit:    limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}).
+					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt, comment1.EditedAt, comment1.IsDeleted, comment1.Upvotes, comment1.Downvotes, comment1.Status)
+				mock.ExpectQuery(`select \* from \( select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where post_id = \$1 and parent_id is null and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) > \(\$2::text, \$3::bigint\)\) order by created_at, id limit \$4 \) page order by created_at desc, id desc`).
+					WithArgs(postID, &afterCreatedAt, afterID, limit).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{&comment1},
+		},
+		{
+			name:   "oldest_order",
+			postID: postID,
+			order:  models.CommentOrderOldest,
+			after:  &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID},
+			limit:  limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`from comments where post_id = \$1 and parent_id is null and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) > \(\$2::text, \$3::bigint\)\) order by created_at, id limit \$4`).
+					WithArgs(postID, &afterCreatedAt, afterID, limit).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}))
+			},
+			want: []*models.Comment{},
+		},
+		{
+			name:   "top_order",
+			postID: postID,
+			order:  models.CommentOrderTop,
+			after:  &models.CommentCursor{Score: 4, ID: afterID},
+			limit:  limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}).
+					AddRow(voted.ID, voted.PostID, voted.ParentID, voted.Author, voted.Text, voted.CreatedAt, voted.EditedAt, voted.IsDeleted, voted.Upvotes, voted.Downvotes, voted.Status)
+				mock.ExpectQuery(`from comments where post_id = \$1 and parent_id is null and status = 'PUBLISHED' and \(\$2::bigint is null or \(upvotes - downvotes, id\) < \(\$2::bigint, \$3::bigint\)\) order by upvotes - downvotes desc, id desc limit \$4`).
+					WithArgs(postID, &score, afterID, limit).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{&voted},
+		},
+		{
+			name:   "controversial_order_first_page",
+			postID: postID,
+			order:  models.CommentOrderControversial,
+			limit:  limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`\(\$2::bigint is null or \(least\(upvotes, downvotes\), id\) < \(\$2::bigint, \$3::bigint\)\) order by least\(upvotes, downvotes\) desc, id desc limit \$4`).
+					WithArgs(postID, (*int64)(nil), int64(0), limit).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}))
+			},
+			want: []*models.Comment{},
+		},
+		{
+			name:   "no_rows",
+			postID: postID,
+			after:  &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID},
+			limit:  limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where post_id = \$1 and parent_id is null and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
+					WithArgs(postID, &afterCreatedAt, afterID, limit).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}))
+			},
+			want: []*models.Comment{},
+		},
+		{
+			name:   "db_error",
+			postID: postID,
+			after:  &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID},
+			limit:  limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where post_id = \$1 and parent_id is null and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
+					WithArgs(postID, &afterCreatedAt, afterID, limit).
+					WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.GetRootByPost(context.Background(), tt.postID, tt.order, tt.after, tt.backward, tt.limit)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+func TestGetChild(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(100)
+	afterCreatedAt := "2026-02-12T19:57:26Z"
+	afterID := int64(10)
+	limit := int32(5)
+
+	comment1 := testComment(1, 1, &parentID, "Alice", "Child 1", "2026-02-12T19:57:26Z")
+	comment2 := testComment(2, 1, &parentID, "Bob", "Child 2", "2026-02-12T18:57:26Z")
+
+	tests := []struct {
+		name      string
+		parentID  int64
+		order     models.CommentOrder
+		after     *models.CommentCursor
+		limit     int32
+		setupMock func(pgxmock.PgxPoolIface)
+		want      []*models.Comment
+		wantErr   bool
+	}{
+		{
+			name:     "success_with_results",
+			parentID: parentID,
+			after:    &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID},
+			limit:    limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}).
+					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt, comment1.EditedAt, comment1.IsDeleted, comment1.Upvotes, comment1.Downvotes, comment1.Status).
+					AddRow(comment2.ID, comment2.PostID, comment2.ParentID, comment2.Author, comment2.Text, comment2.CreatedAt, comment2.EditedAt, comment2.IsDeleted, comment2.Upvotes, comment2.Downvotes, comment2.Status)
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where parent_id = \$1 and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
+					WithArgs(parentID, &afterCreatedAt, afterID, limit).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{&comment1, &comment2},
+		},
+		{
+			name:     "no_rows",
+			parentID: parentID,
+			after:    &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID},
+			limit:    limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where parent_id = \$1 and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
+					WithArgs(parentID, &afterCreatedAt, afterID, limit).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}))
+			},
+			want: []*models.Comment{},
+		},
+		{
+			name:     "db_error",
+			parentID: parentID,
+			after:    &models.CommentCursor{CreatedAt: afterCreatedAt, ID: afterID},
+			limit:    limit,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where parent_id = \$1 and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
+					WithArgs(parentID, &afterCreatedAt, afterID, limit).
+					WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.GetChild(context.Background(), tt.parentID, tt.order, tt.after, tt.limit)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetChildBatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	parentIDs := []int64{100, 200}
+	after := &models.CommentCursor{Score: 3, ID: 9}
+
+	comment1 := models.Comment{
+		ID:        1,
+		PostID:    1,
+		ParentID:  &parentIDs[0],
+		Author:    "Alice",
+		Text:      "Child of 100",
+		CreatedAt: now.Format(time.RFC3339),
+		Status:    models.CommentStatusPublished,
+	}
+	comment2 := models.Comment{
+		ID:        2,
+		PostID:    1,
+		ParentID:  &parentIDs[0],
+		Author:    "Bob",
+		Text:      "Another child of 100",
+		CreatedAt: now.Add(-1 * time.Hour).Format(time.RFC3339),
+		Status:    models.CommentStatusPublished,
+	}
+	comment3 := models.Comment{
+		ID:        3,
+		PostID:    2,
+		ParentID:  &parentIDs[1],
+		Author:    "Charlie",
+		Text:      "Child of 200",
+		CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339),
+		Status:    models.CommentStatusPublished,
+	}
+
+	tests := []struct {
+		name      string
+		order     models.CommentOrder
+		after     *models.CommentCursor
+		backward  bool
+		setupMock func(pgxmock.PgxPoolIface)
+		want      []*models.Comment
+		wantErr   bool
+	}{
+		{
+			name: "success_with_results",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}).
+					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt, comment1.EditedAt, comment1.IsDeleted, comment1.Upvotes, comment1.Downvotes, comment1.Status).
+					AddRow(comment2.ID, comment2.PostID, comment2.ParentID, comment2.Author, comment2.Text, comment2.CreatedAt, comment2.EditedAt, comment2.IsDeleted, comment2.Upvotes, comment2.Downvotes, comment2.Status).
+					AddRow(comment3.ID, comment3.PostID, comment3.ParentID, comment3.Author, comment3.Text, comment3.CreatedAt, comment3.EditedAt, comment3.IsDeleted, comment3.Upvotes, comment3.Downvotes, comment3.Status)
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from \( select c.\*, row_number\(\) over \(partition by c.parent_id order by created_at desc, id desc\) as rn from unnest\(\$1::bigint\[\]\) as p\(pid\) cross join lateral \( select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where parent_id = p.pid and status = 'PUBLISHED' and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4 \) c \) ranked order by parent_id, rn`).
+					WithArgs(parentIDs, (*string)(nil), int64(0), int32(3)).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{&comment1, &comment2, &comment3},
+		},
+		{
+			name:  "top_after_cursor",
+			order: models.CommentOrderTop,
+			after: after,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`row_number\(\) over \(partition by c.parent_id order by upvotes - downvotes desc, id desc\) .* where parent_id = p.pid and status = 'PUBLISHED' and \(\$2::bigint is null or \(upvotes - downvotes, id\) < \(\$2::bigint, \$3::bigint\)\) order by upvotes - downvotes desc, id desc limit \$4`).
+					WithArgs(parentIDs, &after.Score, after.ID, int32(3)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}))
+			},
+			want: []*models.Comment{},
+		},
+		{
+			name:     "backward_top_before_cursor",
+			order:    models.CommentOrderTop,
+			after:    after,
+			backward: true,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`row_number\(\) over \(partition by c.parent_id order by upvotes - downvotes desc, id desc\) .* where parent_id = p.pid and status = 'PUBLISHED' and \(\$2::bigint is null or \(upvotes - downvotes, id\) > \(\$2::bigint, \$3::bigint\)\) order by upvotes - downvotes, id limit \$4 \) c \) ranked order by parent_id, rn`).
+					WithArgs(parentIDs, &after.Score, after.ID, int32(3)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}))
+			},
+			want: []*models.Comment{},
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`cross join lateral`).
+					WithArgs(parentIDs, (*string)(nil), int64(0), int32(3)).
+					WillReturnError(errors.New("batch query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.GetChildBatch(context.Background(), parentIDs, tt.order, tt.after, tt.backward, 3)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReplyCountBatch(t *testing.T) {
+	t.Parallel()
+
+	parentIDs := []int64{100, 200}
+
+	tests := []struct {
+		name        string
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		want        []*models.ReplyCount
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, reply_count from comments where id = any\(\$1\) and reply_count > 0`).
+					WithArgs(parentIDs).
+					WillReturnRows(pgxmock.NewRows([]string{"parent_id", "count"}).AddRow(int64(100), int64(2)))
+			},
+			want: []*models.ReplyCount{{ParentID: 100, Count: 2}},
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, reply_count`).
+					WithArgs(parentIDs).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.ReplyCountBatch(context.Background(), parentIDs)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTotalCount(t *testing.T) {
+	t.Parallel()
+
+	postID := int64(1)
+
+	tests := []struct {
+		name        string
+		postID      int64
+		setupMock   func(pgxmock.PgxPoolIface)
+		wantCount   int64
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			postID: postID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select coalesce\(\(select root_comment_count from posts where id = \$1\), 0\)`).
+					WithArgs(postID).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(42)))
+			},
+			wantCount: 42,
+		},
+		{
+			name:   "zero_count",
+			postID: postID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select coalesce\(\(select root_comment_count from posts where id = \$1\), 0\)`).
+					WithArgs(postID).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(0)))
+			},
+			wantCount: 0,
+		},
+		{
+			name:   "db_error",
+			postID: postID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select coalesce\(\(select root_comment_count from posts where id = \$1\), 0\)`).
+					WithArgs(postID).
+					WillReturnError(errors.New("count failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.TotalCount(context.Background(), tt.postID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, int64(0), got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCount, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetPublishedSeq(t *testing.T) {
+	t.Parallel()
+
+	postID := int64(1)
+	createdAt := "2026-02-12T19:00:00Z"
+	commentID := int64(5)
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      int64
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select coalesce\( \(select published_seq from comments where id = \$2 and post_id = \$1\), \(select max\(published_seq\) from comments where post_id = \$1 and status = 'PUBLISHED' and \(created_at, id\) <= \(\$3::text, \$2::bigint\)\), 0 \)`).
+					WithArgs(postID, commentID, createdAt).
+					WillReturnRows(pgxmock.NewRows([]string{"coalesce"}).AddRow(int64(42)))
+			},
+			want: 42,
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select coalesce`).
+					WithArgs(postID, commentID, createdAt).
+					WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.GetPublishedSeq(context.Background(), postID, createdAt, commentID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetAddedAfter(t *testing.T) {
+	t.Parallel()
+
+	postID := int64(1)
+	afterSeq := int64(5)
+	parentID := int64(6)
+
+	comment1 := testComment(6, postID, nil, "Alice", "Root", "2026-02-12T19:30:00Z")
+	comment1.PublishedSeq = 6
+	comment2 := testComment(7, postID, &parentID, "Bob", "Reply", "2026-02-12T20:00:00Z")
+	comment2.PublishedSeq = 7
+
+	columns := []string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "status", "published_seq"}
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      []*models.Comment
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(comment1.ID, comment1.PostID, comment1.ParentID, comment1.Author, comment1.Text, comment1.CreatedAt, comment1.EditedAt, comment1.IsDeleted, comment1.Status, comment1.PublishedSeq).
+					AddRow(comment2.ID, comment2.PostID, comment2.ParentID, comment2.Author, comment2.Text, comment2.CreatedAt, comment2.EditedAt, comment2.IsDeleted, comment2.Status, comment2.PublishedSeq)
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, status, published_seq from comments where post_id = \$1 and status = 'PUBLISHED' and published_seq > \$2 order by published_seq limit \$3`).
+					WithArgs(postID, afterSeq, int32(10)).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{&comment1, &comment2},
+		},
+		{
+			name: "no_rows",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, status, published_seq from comments`).
+					WithArgs(postID, afterSeq, int32(10)).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			want: []*models.Comment{},
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, status, published_seq from comments`).
+					WithArgs(postID, afterSeq, int32(10)).
+					WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.GetAddedAfter(context.Background(), postID, afterSeq, 10)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEdit(t *testing.T) {
+	t.Parallel()
+
+	editedAt := "2026-02-13T10:00:00Z"
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      *models.Comment
+		wantErr   string
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "status"}).
+					AddRow(int64(5), int64(1), nil, "Alice", "Fixed", "2026-02-12T19:00:00Z", &editedAt, false, models.CommentStatusPublished)
+				mock.ExpectQuery(`with prev as \(\s*select id, body from comments where id = \$1 and not is_deleted and status = 'PUBLISHED' for update\s*\), revision as \(\s*insert into comment_revisions`).
+					WithArgs(int64(5), "Fixed", editedAt).
+					WillReturnRows(rows)
+			},
+			want: &models.Comment{
+				ID:        5,
+				PostID:    1,
+				Author:    "Alice",
+				Text:      "Fixed",
+				CreatedAt: "2026-02-12T19:00:00Z",
+				EditedAt:  &editedAt,
+				Status:    models.CommentStatusPublished,
+			},
+		},
+		{
+			name: "not_found",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with prev as`).
+					WithArgs(int64(5), "Fixed", editedAt).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: "comment not found",
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with prev as`).
+					WithArgs(int64(5), "Fixed", editedAt).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.Edit(context.Background(), 5, "Fixed", editedAt)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetRevisions(t *testing.T) {
+	t.Parallel()
+
+	afterCreatedAt := "2026-02-13T10:00:00Z"
+
+	tests := []struct {
+		name           string
+		afterCreatedAt *string
+		afterID        int64
+		setupMock      func(pgxmock.PgxPoolIface)
+		want           []*models.CommentRevision
+		wantErr        bool
+	}{
+		{
+			name: "first_page",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "comment_id", "body", "created_at"}).
+					AddRow(int64(2), int64(5), "Second", "2026-02-13T11:00:00Z").
+					AddRow(int64(1), int64(5), "First", "2026-02-13T10:00:00Z")
+				mock.ExpectQuery(`select id, comment_id, body, created_at from comment_revisions where comment_id = \$1 and \(\$2::text is null or \(created_at, id\) < \(\$2::text, \$3::bigint\)\) order by created_at desc, id desc limit \$4`).
+					WithArgs(int64(5), (*string)(nil), int64(0), int32(10)).
+					WillReturnRows(rows)
+			},
+			want: []*models.CommentRevision{
+				{ID: 2, CommentID: 5, Text: "Second", CreatedAt: "2026-02-13T11:00:00Z"},
+				{ID: 1, CommentID: 5, Text: "First", CreatedAt: "2026-02-13T10:00:00Z"},
+			},
+		},
+		{
+			name:           "after_cursor",
+			afterCreatedAt: &afterCreatedAt,
+			afterID:        1,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, comment_id, body, created_at from comment_revisions`).
+					WithArgs(int64(5), &afterCreatedAt, int64(1), int32(10)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "comment_id", "body", "created_at"}))
+			},
+			want: []*models.CommentRevision{},
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select id, comment_id, body, created_at from comment_revisions`).
+					WithArgs(int64(5), (*string)(nil), int64(0), int32(10)).
+					WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.GetRevisions(context.Background(), 5, tt.afterCreatedAt, tt.afterID, 10)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRevisionCount(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`select count\(\*\) from comment_revisions where comment_id = \$1`).
+		WithArgs(int64(5)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(3)))
+
+	r := New(mock)
+	got, err := r.RevisionCount(context.Background(), 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(1)
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      *models.Comment
+		wantErr   string
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "status"}).
+					AddRow(int64(5), int64(1), &parentID, "", "", "2026-02-12T19:00:00Z", nil, true, models.CommentStatusPublished)
+				mock.ExpectQuery(`with tombstone as \(\s*update comments set author = '', body = '', is_deleted = true where id = \$1 and not is_deleted .*delete from comment_revisions`).
+					WithArgs(int64(5)).
+					WillReturnRows(rows)
+			},
+			want: &models.Comment{
+				ID:        5,
+				PostID:    1,
+				ParentID:  &parentID,
+				CreatedAt: "2026-02-12T19:00:00Z",
+				IsDeleted: true,
+				Status:    models.CommentStatusPublished,
+			},
+		},
+		{
+			name: "not_found",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with tombstone as`).
+					WithArgs(int64(5)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: "comment not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.Delete(context.Background(), 5)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPurge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      []*models.Comment
+		wantErr   string
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "status"}).
+					AddRow(int64(5), int64(1), models.CommentStatusPublished).
+					AddRow(int64(6), int64(1), models.CommentStatusPublished)
+				mock.ExpectQuery(`with recursive subtree as \(\s*select id from comments where id = \$1 union all select c.id from comments c join subtree s on c.parent_id = s.id\s*\), purged as \( delete from comments where id in \(select id from subtree\) returning id, post_id, parent_id, status \), post_counts as \( update posts p .* where p.id = \(select post_id from purged where id = \$1\) \), reply_counts as \( update comments c set reply_count = c.reply_count - 1 from purged d where d.id = \$1 and c.id = d.parent_id and d.status = 'PUBLISHED' \) select id, post_id, status from purged`).
+					WithArgs(int64(5)).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{{ID: 5, PostID: 1, Status: models.CommentStatusPublished}, {ID: 6, PostID: 1, Status: models.CommentStatusPublished}},
+		},
+		{
+			name: "not_found",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with recursive subtree`).
+					WithArgs(int64(5)).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "post_id", "status"}))
+			},
+			wantErr: "comment not found",
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with recursive subtree`).
+					WithArgs(int64(5)).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.Purge(context.Background(), 5)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func testComment(id, postID int64, parentID *int64, author, text string, createdAt string) models.Comment {
+	return models.Comment{
+		ID:        id,
+		PostID:    postID,
+		ParentID:  parentID,
+		Author:    author,
+		Text:      text,
 		CreatedAt: createdAt,
+		Status:    models.CommentStatusPublished,
+	}
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	rank := 0.0607927
+	parentID := int64(2)
+
+	tests := []struct {
+		name      string
+		afterRank *float64
+		afterID   int64
+		setupMock func(mock pgxmock.PgxPoolIface)
+		want      []*models.SearchHit
+		wantErr   string
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "status", "rank", "snippet",
+				}).AddRow(int64(5), int64(1), &parentID, "Alice", "hello <world> & co", "2026-02-12T19:00:00Z", nil, false, models.CommentStatusPublished, rank, "\x02hello\x03 <world> & co")
+
+				mock.ExpectQuery(`ts_rank\(c.search_vector, q.query\) as rank, ts_headline\('simple', translate\(c.body, chr\(2\) \|\| chr\(3\), ''\), q.query, 'StartSel=' \|\| chr\(2\) \|\| ', StopSel=' \|\| chr\(3\) \|\| ', MaxWords=24, MinWords=12'\) as snippet`).
+					WithArgs("hello", (*float64)(nil), int64(0), int32(10)).
+					WillReturnRows(rows)
+			},
+			want: []*models.SearchHit{
+				{
+					Rank:    rank,
+					Snippet: "<b>hello</b> &lt;world&gt; &amp; co",
+					Comment: &models.Comment{
+						ID:        5,
+						PostID:    1,
+						ParentID:  &parentID,
+						Author:    "Alice",
+						Text:      "hello <world> & co",
+						CreatedAt: "2026-02-12T19:00:00Z",
+						Status:    models.CommentStatusPublished,
+					},
+				},
+			},
+		},
+		{
+			name:      "with_cursor",
+			afterRank: &rank,
+			afterID:   5,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`\(ts_rank\(c.search_vector, q.query\), c.id\) < \(\$2::real, \$3::bigint\)`).
+					WithArgs("hello", &rank, int64(5), int32(10)).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "status", "rank", "snippet",
+					}))
+			},
+			want: []*models.SearchHit{},
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`from comments c`).
+					WithArgs("hello", (*float64)(nil), int64(0), int32(10)).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.Search(context.Background(), "hello", tt.afterRank, tt.afterID, 10)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSearchCount(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`select count\(\*\) from comments c join posts p on p.id = c.post_id and p.deleted_at is null where not c.is_deleted`).
+		WithArgs("hello").
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(3)))
+
+	r := New(mock)
+	got, err := r.SearchCount(context.Background(), "hello")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetThread(t *testing.T) {
+	t.Parallel()
+
+	postID := int64(1)
+	rootID := int64(5)
+	createdAt := "2023-01-01T00:00:00Z"
+
+	root := testComment(5, postID, nil, "Alice", "Root", createdAt)
+	reply := testComment(8, postID, &rootID, "Bob", "Reply", createdAt)
+	columns := []string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status", "depth", "path"}
+
+	tests := []struct {
+		name        string
+		rootID      *int64
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		want        []*models.ThreadComment
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			rootID: &rootID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(root.ID, root.PostID, root.ParentID, root.Author, root.Text, root.CreatedAt, root.EditedAt, root.IsDeleted, root.Upvotes, root.Downvotes, root.Status, int32(0), []int64{5}).
+					AddRow(reply.ID, reply.PostID, reply.ParentID, reply.Author, reply.Text, reply.CreatedAt, reply.EditedAt, reply.IsDeleted, reply.Upvotes, reply.Downvotes, reply.Status, int32(1), []int64{5, 8})
+				mock.ExpectQuery(`with recursive thread as .* union all .* from thread t cross join lateral \( select \* from comments where parent_id = t.id and status = 'PUBLISHED' order by id limit \$4 \) c where t.depth < \$3 \) .* order by path`).
+					WithArgs(postID, &rootID, int32(3), int32(10)).
+					WillReturnRows(rows)
+			},
+			want: []*models.ThreadComment{
+				{Comment: &root, Depth: 0, Path: []int64{5}},
+				{Comment: &reply, Depth: 1, Path: []int64{5, 8}},
+			},
+		},
+		{
+			name:   "empty_post",
+			rootID: nil,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with recursive thread`).
+					WithArgs(postID, (*int64)(nil), int32(3), int32(10)).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			want: []*models.ThreadComment{},
+		},
+		{
+			name:   "root_not_found",
+			rootID: &rootID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with recursive thread`).
+					WithArgs(postID, &rootID, int32(3), int32(10)).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			wantErr:     true,
+			expectedErr: ErrCommentNotFound,
+		},
+		{
+			name:   "db_error",
+			rootID: nil,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with recursive thread`).
+					WithArgs(postID, (*int64)(nil), int32(3), int32(10)).
+					WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.GetThread(context.Background(), postID, tt.rootID, 3, 10)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetPending(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}
+	postID := int64(1)
+	afterCreatedAt := "2026-02-13T10:00:00Z"
+
+	tests := []struct {
+		name           string
+		postID         *int64
+		afterCreatedAt *string
+		afterID        int64
+		setupMock      func(pgxmock.PgxPoolIface)
+		want           []*models.Comment
+		wantErr        bool
+	}{
+		{
+			name: "all_posts",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(int64(5), int64(1), nil, "Alice", "Held", "2026-02-13T10:00:00Z", nil, false, int32(0), int32(0), models.CommentStatusPending)
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where status = 'PENDING' and \(\$1::bigint is null or post_id = \$1::bigint\) and \(\$2::text is null or \(created_at, id\) > \(\$2::text, \$3::bigint\)\) order by created_at, id limit \$4`).
+					WithArgs((*int64)(nil), (*string)(nil), int64(0), int32(10)).
+					WillReturnRows(rows)
+			},
+			want: []*models.Comment{
+				{ID: 5, PostID: 1, Author: "Alice", Text: "Held", CreatedAt: "2026-02-13T10:00:00Z", Status: models.CommentStatusPending},
+			},
+		},
+		{
+			name:           "one_post_after_cursor",
+			postID:         &postID,
+			afterCreatedAt: &afterCreatedAt,
+			afterID:        5,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`from comments where status = 'PENDING'`).
+					WithArgs(&postID, &afterCreatedAt, int64(5), int32(10)).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			want: []*models.Comment{},
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`from comments where status = 'PENDING'`).
+					WithArgs((*int64)(nil), (*string)(nil), int64(0), int32(10)).
+					WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.GetPending(context.Background(), tt.postID, tt.afterCreatedAt, tt.afterID, 10)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPendingCount(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`select count\(\*\) from comments where status = 'PENDING' and \(\$1::bigint is null or post_id = \$1::bigint\)`).
+		WithArgs((*int64)(nil)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(2)))
+
+	r := New(mock)
+	got, err := r.PendingCount(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApprove(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(2)
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      *models.Comment
+		wantErr   error
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status", "published_seq"}).
+					AddRow(int64(5), int64(1), &parentID, "Alice", "Held", "2026-02-13T10:00:00Z", nil, false, int32(0), int32(0), models.CommentStatusPublished, int64(9))
+				mock.ExpectQuery(`with approved as \( update comments set status = 'PUBLISHED', published_seq = nextval\('comments_published_seq'\) where id = \$1 and status = 'PENDING' returning .* \), post_counts as \( update posts p set comment_count = p.comment_count \+ 1, .* from approved a where p.id = a.post_id \), reply_counts as \( update comments c set reply_count = c.reply_count \+ 1 from approved a where c.id = a.parent_id \) select .* from approved`).
+					WithArgs(int64(5)).
+					WillReturnRows(rows)
+			},
+			want: &models.Comment{
+				ID:           5,
+				PostID:       1,
+				ParentID:     &parentID,
+				Author:       "Alice",
+				Text:         "Held",
+				CreatedAt:    "2026-02-13T10:00:00Z",
+				Status:       models.CommentStatusPublished,
+				PublishedSeq: 9,
+			},
+		},
+		{
+			name: "not_pending",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with approved as`).
+					WithArgs(int64(5)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: ErrCommentNotPending,
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with approved as`).
+					WithArgs(int64(5)).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.Approve(context.Background(), 5)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReject(t *testing.T) {
+	t.Parallel()
+
+	reason := "spam"
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      *models.Comment
+		wantErr   error
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status", "rejection_reason"}).
+					AddRow(int64(5), int64(1), nil, "Alice", "Held", "2026-02-13T10:00:00Z", nil, false, int32(0), int32(0), models.CommentStatusRejected, &reason)
+				mock.ExpectQuery(`update comments set status = 'REJECTED', rejection_reason = \$2 where id = \$1 and status = 'PENDING' returning .*, rejection_reason`).
+					WithArgs(int64(5), &reason).
+					WillReturnRows(rows)
+			},
+			want: &models.Comment{
+				ID:              5,
+				PostID:          1,
+				Author:          "Alice",
+				Text:            "Held",
+				CreatedAt:       "2026-02-13T10:00:00Z",
+				Status:          models.CommentStatusRejected,
+				RejectionReason: &reason,
+			},
+		},
+		{
+			name: "not_pending",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`update comments set status = 'REJECTED'`).
+					WithArgs(int64(5), &reason).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: ErrCommentNotPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.Reject(context.Background(), 5, &reason)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      *models.Comment
+		wantErr   error
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}).
+					AddRow(int64(5), int64(1), nil, "Alice", "Reported", "2026-02-13T10:00:00Z", nil, false, int32(0), int32(0), models.CommentStatusPending)
+				mock.ExpectQuery(`with held as \( update comments set status = 'PENDING' where id = \$1 and status = 'PUBLISHED' and not is_deleted returning .* \), post_counts as \( update posts p set comment_count = p.comment_count - 1, .* from held h where p.id = h.post_id \), reply_counts as \( update comments c set reply_count = c.reply_count - 1 from held h where c.id = h.parent_id \) select .* from held`).
+					WithArgs(int64(5)).
+					WillReturnRows(rows)
+			},
+			want: &models.Comment{
+				ID:        5,
+				PostID:    1,
+				Author:    "Alice",
+				Text:      "Reported",
+				CreatedAt: "2026-02-13T10:00:00Z",
+				Status:    models.CommentStatusPending,
+			},
+		},
+		{
+			name: "not_published",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with held as`).
+					WithArgs(int64(5)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: ErrCommentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.Hold(context.Background(), 5)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetByIDAnyStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      *models.Comment
+		wantErr   error
+	}{
+		{
+			name: "rejected",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "post_id", "parent_id", "author", "body", "created_at", "edited_at", "is_deleted", "upvotes", "downvotes", "status"}).
+					AddRow(int64(5), int64(1), nil, "Alice", "Reported", "2026-02-13T10:00:00Z", nil, false, int32(0), int32(0), models.CommentStatusRejected)
+				mock.ExpectQuery(`select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status from comments where id = \$1$`).
+					WithArgs(int64(5)).
+					WillReturnRows(rows)
+			},
+			want: &models.Comment{
+				ID:        5,
+				PostID:    1,
+				Author:    "Alice",
+				Text:      "Reported",
+				CreatedAt: "2026-02-13T10:00:00Z",
+				Status:    models.CommentStatusRejected,
+			},
+		},
+		{
+			name: "not_found",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`from comments where id = \$1`).
+					WithArgs(int64(5)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: ErrCommentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			got, err := New(mock).GetByIDAnyStatus(context.Background(), 5)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetSpamScore(t *testing.T) {
+	t.Parallel()
+
+	score := 0.97
+
+	tests := []struct {
+		name      string
+		setupMock func(pgxmock.PgxPoolIface)
+		want      *float64
+		wantErr   error
+	}{
+		{
+			name: "scored",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select spam_score from comments where id = \$1`).
+					WithArgs(int64(5)).
+					WillReturnRows(pgxmock.NewRows([]string{"spam_score"}).AddRow(&score))
+			},
+			want: &score,
+		},
+		{
+			name: "unscored",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select spam_score from comments where id = \$1`).
+					WithArgs(int64(5)).
+					WillReturnRows(pgxmock.NewRows([]string{"spam_score"}).AddRow(nil))
+			},
+		},
+		{
+			name: "not_found",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select spam_score from comments`).
+					WithArgs(int64(5)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: ErrCommentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			got, err := New(mock).GetSpamScore(context.Background(), 5)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
 	}
 }
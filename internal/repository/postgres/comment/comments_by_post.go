@@ -2,51 +2,165 @@ package comment
 
 import (
 	"context"
-	"database/sql"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 )
 
 const (
-	getRootCommentsByPostQuery = `
-		select id, post_id, parent_id, author, body, created_at
+	commentListColumns = `id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status`
+
+	countRootCommentsQuery = `select coalesce((select root_comment_count from posts where id = $1), 0)`
+
+	countRepliesBatchQuery = `
+		select id, reply_count
 		from comments
-		where post_id = $1 and parent_id is null
-			and ($2::text is null or (created_at, id) < ($2::text, $3::bigint))
-		order by created_at desc, id desc
-		limit $4
+		where id = any($1) and reply_count > 0
 	`
+)
+
+// commentOrdering is the keyset condition and sort of one comment ordering.
+// $2 is the createdAt or score of the cursor and $3 its id, $2 is null on the
+// first page. The conditions match cursor.Less in the in-memory repository.
+// keyset and orderBy read the rows after the cursor, before and reverse read
+// the rows before it for backward pages.
+type commentOrdering struct {
+	keyset  string
+	orderBy string
+	before  string
+	reverse string
+}
+
+var commentOrderings = map[models.CommentOrder]commentOrdering{
+	models.CommentOrderNewest: {
+		keyset:  `($2::text is null or (created_at, id) < ($2::text, $3::bigint))`,
+		orderBy: `created_at desc, id desc`,
+		before:  `($2::text is null or (created_at, id) > ($2::text, $3::bigint))`,
+		reverse: `created_at, id`,
+	},
+	models.CommentOrderOldest: {
+		keyset:  `($2::text is null or (created_at, id) > ($2::text, $3::bigint))`,
+		orderBy: `created_at, id`,
+		before:  `($2::text is null or (created_at, id) < ($2::text, $3::bigint))`,
+		reverse: `created_at desc, id desc`,
+	},
+	models.CommentOrderTop: {
+		keyset:  `($2::bigint is null or (upvotes - downvotes, id) < ($2::bigint, $3::bigint))`,
+		orderBy: `upvotes - downvotes desc, id desc`,
+		before:  `($2::bigint is null or (upvotes - downvotes, id) > ($2::bigint, $3::bigint))`,
+		reverse: `upvotes - downvotes, id`,
+	},
+	models.CommentOrderControversial: {
+		keyset:  `($2::bigint is null or (least(upvotes, downvotes), id) < ($2::bigint, $3::bigint))`,
+		orderBy: `least(upvotes, downvotes) desc, id desc`,
+		before:  `($2::bigint is null or (least(upvotes, downvotes), id) > ($2::bigint, $3::bigint))`,
+		reverse: `least(upvotes, downvotes), id`,
+	},
+}
+
+// orderingOf falls back to NEWEST for an empty order, like cursor.Less does.
+func orderingOf(order models.CommentOrder) commentOrdering {
+	o, ok := commentOrderings[order]
+	if !ok {
+		return commentOrderings[models.CommentOrderNewest]
+	}
+	return o
+}
+
+// seek returns the keyset condition and the sort a page is read with. A
+// backward page reads the rows nearest before the cursor first, so the limit
+// keeps the right ones; the queries put them back into orderBy afterwards.
+func (o commentOrdering) seek(backward bool) (keyset, orderBy string) {
+	if backward {
+		return o.before, o.reverse
+	}
+	return o.keyset, o.orderBy
+}
+
+func getRootCommentsByPostQuery(o commentOrdering, backward bool) string {
+	keyset, seek := o.seek(backward)
 
-	getChildCommentsQuery = `
-		select id, post_id, parent_id, author, body, created_at
+	query := `
+		select ` + commentListColumns + `
 		from comments
-		where parent_id = $1
-	  		and ($2::text is null or (created_at, id) < ($2::text, $3::bigint))
-		order by created_at desc, id desc
+		where post_id = $1 and parent_id is null and status = 'PUBLISHED'
+			and ` + keyset + `
+		order by ` + seek + `
 		limit $4
 	`
+	if !backward {
+		return query
+	}
+
+	return `select * from (` + query + `) page order by ` + o.orderBy
+}
 
-	getChildCommentsBatchQuery = `
-		select parent_id, id, post_id, author, body, created_at
+func getChildCommentsQuery(o commentOrdering) string {
+	return `
+		select ` + commentListColumns + `
 		from comments
-		where parent_id = any($1)
-		order by parent_id, created_at desc, id desc
+		where parent_id = $1 and status = 'PUBLISHED'
+			and ` + o.keyset + `
+		order by ` + o.orderBy + `
+		limit $4
 	`
+}
 
-	countCommentsByPostQuery = `select count(*) from comments where post_id = $1`
-)
+// getChildCommentsBatchQuery fetches one page of replies per parent: the
+// lateral join runs the single parent page query, so at most $4 rows are read
+// per parent however many replies it has, and row_number keeps every page in
+// its ordering once the pages are merged, backward pages included.
+func getChildCommentsBatchQuery(o commentOrdering, backward bool) string {
+	keyset, seek := o.seek(backward)
+
+	return `
+		select ` + commentListColumns + `
+		from (
+			select c.*, row_number() over (partition by c.parent_id order by ` + o.orderBy + `) as rn
+			from unnest($1::bigint[]) as p(pid)
+			cross join lateral (
+				select ` + commentListColumns + `
+				from comments
+				where parent_id = p.pid and status = 'PUBLISHED'
+					and ` + keyset + `
+				order by ` + seek + `
+				limit $4
+			) c
+		) ranked
+		order by parent_id, rn
+	`
+}
+
+// keysetArgs returns the sort key and id of the cursor typed for the keyset
+// condition of the ordering; the key is a nil pointer without a cursor.
+func keysetArgs(order models.CommentOrder, cursor *models.CommentCursor) (any, int64) {
+	switch order {
+	case models.CommentOrderTop, models.CommentOrderControversial:
+		if cursor == nil {
+			return (*int64)(nil), 0
+		}
+		return &cursor.Score, cursor.ID
+	default:
+		if cursor == nil {
+			return (*string)(nil), 0
+		}
+		return &cursor.CreatedAt, cursor.ID
+	}
+}
 
-func (r *comment) GetRootByPost(ctx context.Context, postID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
-	rows, err := r.db.Query(ctx, getRootCommentsByPostQuery,
+func (r *comment) GetRootByPost(ctx context.Context, postID int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
+	cursorKey, cursorID := keysetArgs(order, cursor)
+
+	rows, err := r.conn(ctx).Query(ctx, getRootCommentsByPostQuery(orderingOf(order), backward),
 		postID,
-		afterCreatedAt,
-		afterID,
+		cursorKey,
+		cursorID,
 		limit,
 	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return []*models.Comment{}, nil
 		}
 		return nil, err
@@ -55,35 +169,28 @@ func (r *comment) GetRootByPost(ctx context.Context, postID int64, afterCreatedA
 
 	comments := make([]*models.Comment, 0, limit)
 	for rows.Next() {
-		c := models.Comment{}
-
-		err := rows.Scan(
-			&c.ID,
-			&c.PostID,
-			&c.ParentID,
-			&c.Author,
-			&c.Text,
-			&c.CreatedAt,
-		)
+		c, err := scanListedComment(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		comments = append(comments, &c)
+		comments = append(comments, c)
 	}
 
 	return comments, rows.Err()
 }
 
-func (r *comment) GetChild(ctx context.Context, parentID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
-	rows, err := r.db.Query(ctx, getChildCommentsQuery,
+func (r *comment) GetChild(ctx context.Context, parentID int64, order models.CommentOrder, after *models.CommentCursor, limit int32) ([]*models.Comment, error) {
+	afterKey, afterID := keysetArgs(order, after)
+
+	rows, err := r.conn(ctx).Query(ctx, getChildCommentsQuery(orderingOf(order)),
 		parentID,
-		afterCreatedAt,
+		afterKey,
 		afterID,
 		limit,
 	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return []*models.Comment{}, nil
 		}
 		return nil, err
@@ -92,61 +199,94 @@ func (r *comment) GetChild(ctx context.Context, parentID int64, afterCreatedAt *
 
 	comments := make([]*models.Comment, 0, limit)
 	for rows.Next() {
-		c := models.Comment{}
-
-		err := rows.Scan(
-			&c.ID,
-			&c.PostID,
-			&c.ParentID,
-			&c.Author,
-			&c.Text,
-			&c.CreatedAt,
-		)
+		c, err := scanListedComment(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		comments = append(comments, &c)
+		comments = append(comments, c)
 	}
 	return comments, rows.Err()
 }
 
-func (r *comment) GetChildBatch(ctx context.Context, parentIDs []int64) ([]*models.Comment, error) {
-	rows, err := r.db.Query(ctx, getChildCommentsBatchQuery, parentIDs)
+func (r *comment) GetChildBatch(ctx context.Context, parentIDs []int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
+	cursorKey, cursorID := keysetArgs(order, cursor)
+
+	rows, err := r.conn(ctx).Query(ctx, getChildCommentsBatchQuery(orderingOf(order), backward),
+		parentIDs,
+		cursorKey,
+		cursorID,
+		limit,
+	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return []*models.Comment{}, nil
-		}
 		return nil, err
 	}
 	defer rows.Close()
 
 	comments := make([]*models.Comment, 0)
 	for rows.Next() {
-		c := models.Comment{}
-
-		err := rows.Scan(
-			&c.ParentID,
-			&c.ID,
-			&c.PostID,
-			&c.Author,
-			&c.Text,
-			&c.CreatedAt,
-		)
+		c, err := scanListedComment(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		comments = append(comments, &c)
+		comments = append(comments, c)
 	}
 
 	return comments, rows.Err()
 }
 
+// ReplyCountBatch returns the number of direct replies of every comment.
+// Comments without replies are left out.
+func (r *comment) ReplyCountBatch(ctx context.Context, parentIDs []int64) ([]*models.ReplyCount, error) {
+	rows, err := r.conn(ctx).Query(ctx, countRepliesBatchQuery, parentIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]*models.ReplyCount, 0, len(parentIDs))
+	for rows.Next() {
+		var c models.ReplyCount
+		if err := rows.Scan(&c.ParentID, &c.Count); err != nil {
+			return nil, err
+		}
+
+		counts = append(counts, &c)
+	}
+
+	return counts, rows.Err()
+}
+
+func scanListedComment(row pgx.Row) (*models.Comment, error) {
+	c := models.Comment{}
+
+	err := row.Scan(
+		&c.ID,
+		&c.PostID,
+		&c.ParentID,
+		&c.Author,
+		&c.Text,
+		&c.CreatedAt,
+		&c.EditedAt,
+		&c.IsDeleted,
+		&c.Upvotes,
+		&c.Downvotes,
+		&c.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// TotalCount returns the number of root comments on a post, replies are
+// counted by their parents.
 func (r *comment) TotalCount(ctx context.Context, postID int64) (int64, error) {
 	var count int64
 
-	err := r.db.QueryRow(ctx, countCommentsByPostQuery, postID).Scan(&count)
+	err := r.conn(ctx).QueryRow(ctx, countRootCommentsQuery, postID).Scan(&count)
 
 	return count, err
 }
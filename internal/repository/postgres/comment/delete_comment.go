@@ -0,0 +1,107 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	// A tombstone keeps the row, so replies stay attached to the thread, but
+	// drops everything the author wrote including the edit history.
+	deleteCommentQuery = `
+		with tombstone as (
+			update comments
+			set author = '', body = '', is_deleted = true
+			where id = $1 and not is_deleted and status = 'PUBLISHED'
+			returning id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, status
+		), revisions as (
+			delete from comment_revisions
+			where comment_id in (select id from tombstone)
+		)
+		select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, status
+		from tombstone
+	`
+
+	// Purging takes the whole subtree off the post counters and the purged
+	// comment off its parent's reply count in the same statement. Comments
+	// that were never published were never counted.
+	purgeCommentQuery = `
+		with recursive subtree as (
+			select id from comments where id = $1
+			union all
+			select c.id from comments c join subtree s on c.parent_id = s.id
+		), purged as (
+			delete from comments
+			where id in (select id from subtree)
+			returning id, post_id, parent_id, status
+		), post_counts as (
+			update posts p
+			set comment_count = p.comment_count - (select count(*) from purged where status = 'PUBLISHED'),
+				root_comment_count = p.root_comment_count - (select count(*) from purged where parent_id is null and status = 'PUBLISHED')
+			where p.id = (select post_id from purged where id = $1)
+		), reply_counts as (
+			update comments c
+			set reply_count = c.reply_count - 1
+			from purged d
+			where d.id = $1 and c.id = d.parent_id and d.status = 'PUBLISHED'
+		)
+		select id, post_id, status from purged
+	`
+)
+
+func (r *comment) Delete(ctx context.Context, commentID int64) (*models.Comment, error) {
+	var out models.Comment
+
+	err := r.conn(ctx).QueryRow(ctx, deleteCommentQuery, commentID).Scan(
+		&out.ID,
+		&out.PostID,
+		&out.ParentID,
+		&out.Author,
+		&out.Text,
+		&out.CreatedAt,
+		&out.EditedAt,
+		&out.IsDeleted,
+		&out.Status,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (r *comment) Purge(ctx context.Context, commentID int64) ([]*models.Comment, error) {
+	rows, err := r.conn(ctx).Query(ctx, purgeCommentQuery, commentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	purged := make([]*models.Comment, 0)
+	for rows.Next() {
+		c := models.Comment{}
+
+		if err := rows.Scan(&c.ID, &c.PostID, &c.Status); err != nil {
+			return nil, err
+		}
+
+		purged = append(purged, &c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(purged) == 0 {
+		return nil, ErrCommentNotFound
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,103 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	// The previous body is archived and replaced in one statement, so a
+	// revision is never lost or recorded twice under concurrent edits.
+	editCommentQuery = `
+		with prev as (
+			select id, body from comments where id = $1 and not is_deleted and status = 'PUBLISHED' for update
+		), revision as (
+			insert into comment_revisions (comment_id, body, created_at)
+			select id, body, $3 from prev
+		)
+		update comments c
+		set body = $2, edited_at = $3
+		from prev
+		where c.id = prev.id
+		returning c.id, c.post_id, c.parent_id, c.author, c.body, c.created_at, c.edited_at, c.is_deleted, c.status
+	`
+
+	getCommentRevisionsQuery = `
+		select id, comment_id, body, created_at
+		from comment_revisions
+		where comment_id = $1
+			and ($2::text is null or (created_at, id) < ($2::text, $3::bigint))
+		order by created_at desc, id desc
+		limit $4
+	`
+
+	countCommentRevisionsQuery = `select count(*) from comment_revisions where comment_id = $1`
+)
+
+func (r *comment) Edit(ctx context.Context, commentID int64, text string, editedAt string) (*models.Comment, error) {
+	var out models.Comment
+
+	err := r.conn(ctx).QueryRow(ctx, editCommentQuery, commentID, text, editedAt).Scan(
+		&out.ID,
+		&out.PostID,
+		&out.ParentID,
+		&out.Author,
+		&out.Text,
+		&out.CreatedAt,
+		&out.EditedAt,
+		&out.IsDeleted,
+		&out.Status,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (r *comment) GetRevisions(ctx context.Context, commentID int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.CommentRevision, error) {
+	rows, err := r.conn(ctx).Query(ctx, getCommentRevisionsQuery,
+		commentID,
+		afterCreatedAt,
+		afterID,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]*models.CommentRevision, 0, limit)
+	for rows.Next() {
+		rev := models.CommentRevision{}
+
+		err := rows.Scan(
+			&rev.ID,
+			&rev.CommentID,
+			&rev.Text,
+			&rev.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, &rev)
+	}
+
+	return revisions, rows.Err()
+}
+
+func (r *comment) RevisionCount(ctx context.Context, commentID int64) (int64, error) {
+	var count int64
+
+	err := r.conn(ctx).QueryRow(ctx, countCommentRevisionsQuery, commentID).Scan(&count)
+
+	return count, err
+}
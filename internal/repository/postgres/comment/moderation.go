@@ -0,0 +1,219 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	getCommentAnyStatusQuery = `select ` + commentListColumns + ` from comments where id = $1`
+
+	getSpamScoreQuery = `select spam_score from comments where id = $1`
+
+	// The queue is served oldest first, so comments are reviewed in the order
+	// they were written.
+	getPendingCommentsQuery = `
+		select ` + commentListColumns + `
+		from comments
+		where status = 'PENDING'
+			and ($1::bigint is null or post_id = $1::bigint)
+			and ($2::text is null or (created_at, id) > ($2::text, $3::bigint))
+		order by created_at, id
+		limit $4
+	`
+
+	countPendingCommentsQuery = `
+		select count(*)
+		from comments
+		where status = 'PENDING' and ($1::bigint is null or post_id = $1::bigint)
+	`
+
+	// Approving publishes the comment and counts it in the same statement,
+	// like adding a published comment does. The comment takes the next publish
+	// sequence, so resumed subscriptions replay it even though it was written
+	// before comments they already received.
+	approveCommentQuery = `
+		with approved as (
+			update comments
+			set status = 'PUBLISHED', published_seq = nextval('comments_published_seq')
+			where id = $1 and status = 'PENDING'
+			returning ` + commentListColumns + `, published_seq
+		), post_counts as (
+			update posts p
+			set comment_count = p.comment_count + 1,
+				root_comment_count = p.root_comment_count + case when a.parent_id is null then 1 else 0 end
+			from approved a
+			where p.id = a.post_id
+		), reply_counts as (
+			update comments c
+			set reply_count = c.reply_count + 1
+			from approved a
+			where c.id = a.parent_id
+		)
+		select ` + commentListColumns + `, published_seq from approved
+	`
+
+	rejectCommentQuery = `
+		update comments
+		set status = 'REJECTED', rejection_reason = $2
+		where id = $1 and status = 'PENDING'
+		returning ` + commentListColumns + `, rejection_reason
+	`
+
+	// Holding takes a published comment back into the queue and off the
+	// counters, undoing what approving it did.
+	holdCommentQuery = `
+		with held as (
+			update comments
+			set status = 'PENDING'
+			where id = $1 and status = 'PUBLISHED' and not is_deleted
+			returning ` + commentListColumns + `
+		), post_counts as (
+			update posts p
+			set comment_count = p.comment_count - 1,
+				root_comment_count = p.root_comment_count - case when h.parent_id is null then 1 else 0 end
+			from held h
+			where p.id = h.post_id
+		), reply_counts as (
+			update comments c
+			set reply_count = c.reply_count - 1
+			from held h
+			where c.id = h.parent_id
+		)
+		select ` + commentListColumns + ` from held
+	`
+)
+
+// GetPending returns the comments waiting for a moderator, on one post or on
+// all of them when postID is nil.
+func (r *comment) GetPending(ctx context.Context, postID *int64, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Comment, error) {
+	rows, err := r.conn(ctx).Query(ctx, getPendingCommentsQuery,
+		postID,
+		afterCreatedAt,
+		afterID,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]*models.Comment, 0, limit)
+	for rows.Next() {
+		c, err := scanListedComment(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+func (r *comment) PendingCount(ctx context.Context, postID *int64) (int64, error) {
+	var count int64
+
+	err := r.conn(ctx).QueryRow(ctx, countPendingCommentsQuery, postID).Scan(&count)
+
+	return count, err
+}
+
+func (r *comment) Approve(ctx context.Context, commentID int64) (*models.Comment, error) {
+	var c models.Comment
+
+	err := r.conn(ctx).QueryRow(ctx, approveCommentQuery, commentID).Scan(
+		&c.ID,
+		&c.PostID,
+		&c.ParentID,
+		&c.Author,
+		&c.Text,
+		&c.CreatedAt,
+		&c.EditedAt,
+		&c.IsDeleted,
+		&c.Upvotes,
+		&c.Downvotes,
+		&c.Status,
+		&c.PublishedSeq,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotPending
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *comment) Reject(ctx context.Context, commentID int64, reason *string) (*models.Comment, error) {
+	var c models.Comment
+
+	err := r.conn(ctx).QueryRow(ctx, rejectCommentQuery, commentID, reason).Scan(
+		&c.ID,
+		&c.PostID,
+		&c.ParentID,
+		&c.Author,
+		&c.Text,
+		&c.CreatedAt,
+		&c.EditedAt,
+		&c.IsDeleted,
+		&c.Upvotes,
+		&c.Downvotes,
+		&c.Status,
+		&c.RejectionReason,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotPending
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *comment) Hold(ctx context.Context, commentID int64) (*models.Comment, error) {
+	c, err := scanListedComment(r.conn(ctx).QueryRow(ctx, holdCommentQuery, commentID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// GetByIDAnyStatus returns a comment whatever its moderation status, so
+// moderation can settle a comment by the state it is in now.
+func (r *comment) GetByIDAnyStatus(ctx context.Context, commentID int64) (*models.Comment, error) {
+	c, err := scanListedComment(r.conn(ctx).QueryRow(ctx, getCommentAnyStatusQuery, commentID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// GetSpamScore returns the spam score stored with a comment when it was added.
+func (r *comment) GetSpamScore(ctx context.Context, commentID int64) (*float64, error) {
+	var score *float64
+
+	err := r.conn(ctx).QueryRow(ctx, getSpamScoreQuery, commentID).Scan(&score)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return score, nil
+}
@@ -2,10 +2,18 @@ package comment
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/tx"
+)
+
+var (
+	ErrCommentNotFound   = fmt.Errorf("comment %w", repository.ErrNotFound)
+	ErrCommentNotPending = errors.New("comment is not awaiting moderation")
 )
 
 type comment struct {
@@ -22,3 +30,8 @@ type DB interface {
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 }
+
+// conn is the transaction ctx runs in, or the pool outside of one.
+func (r *comment) conn(ctx context.Context) DB {
+	return tx.Conn(ctx, r.db)
+}
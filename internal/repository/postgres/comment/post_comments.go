@@ -0,0 +1,84 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	countCommentsByPostsQuery = `
+		select id, comment_count
+		from posts
+		where id = any($1) and comment_count > 0
+	`
+)
+
+// getRootCommentsBatchQuery runs the single post page query once per post
+// through a lateral join, so every post gets its own limit and keyset.
+func getRootCommentsBatchQuery(o commentOrdering, backward bool) string {
+	keyset, seek := o.seek(backward)
+
+	return `
+		select c.*
+		from unnest($1::bigint[]) as p(pid)
+		cross join lateral (
+			select ` + commentListColumns + `
+			from comments
+			where post_id = p.pid and parent_id is null and status = 'PUBLISHED'
+				and ` + keyset + `
+			order by ` + seek + `
+			limit $4
+		) c
+		order by c.post_id, ` + o.orderBy + `
+	`
+}
+
+func (r *comment) GetRootBatch(ctx context.Context, postIDs []int64, order models.CommentOrder, cursor *models.CommentCursor, backward bool, limit int32) ([]*models.Comment, error) {
+	cursorKey, cursorID := keysetArgs(order, cursor)
+
+	rows, err := r.conn(ctx).Query(ctx, getRootCommentsBatchQuery(orderingOf(order), backward),
+		postIDs,
+		cursorKey,
+		cursorID,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]*models.Comment, 0)
+	for rows.Next() {
+		c, err := scanListedComment(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+// TotalCountBatch returns the number of comments on every post, replies at
+// any depth included. Posts without comments are left out.
+func (r *comment) TotalCountBatch(ctx context.Context, postIDs []int64) ([]*models.CommentCount, error) {
+	rows, err := r.conn(ctx).Query(ctx, countCommentsByPostsQuery, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]*models.CommentCount, 0, len(postIDs))
+	for rows.Next() {
+		var c models.CommentCount
+		if err := rows.Scan(&c.PostID, &c.Count); err != nil {
+			return nil, err
+		}
+
+		counts = append(counts, &c)
+	}
+
+	return counts, rows.Err()
+}
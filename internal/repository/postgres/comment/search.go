@@ -0,0 +1,88 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/highlight"
+)
+
+const (
+	// The snippet marks matches with control characters the body is stripped
+	// of; Search escapes it and turns them into <b> tags.
+	// Tombstones have an empty body and never match; comments of soft deleted
+	// posts are hidden the same way the posts themselves are.
+	searchCommentsQuery = `
+		select c.id, c.post_id, c.parent_id, c.author, c.body, c.created_at, c.edited_at, c.is_deleted, c.status,
+			ts_rank(c.search_vector, q.query) as rank,
+			ts_headline('simple', translate(c.body, chr(2) || chr(3), ''), q.query,
+				'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxWords=24, MinWords=12') as snippet
+		from comments c
+			join posts p on p.id = c.post_id and p.deleted_at is null,
+			websearch_to_tsquery('simple', $1) as q(query)
+		where not c.is_deleted and c.status = 'PUBLISHED'
+			and c.search_vector @@ q.query
+			and ($2::real is null or (ts_rank(c.search_vector, q.query), c.id) < ($2::real, $3::bigint))
+		order by rank desc, c.id desc
+		limit $4
+	`
+
+	searchCommentsCountQuery = `
+		select count(*)
+		from comments c
+			join posts p on p.id = c.post_id and p.deleted_at is null
+		where not c.is_deleted and c.status = 'PUBLISHED'
+			and c.search_vector @@ websearch_to_tsquery('simple', $1)
+	`
+)
+
+func (r *comment) Search(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32) ([]*models.SearchHit, error) {
+	rows, err := r.conn(ctx).Query(ctx, searchCommentsQuery, query, afterRank, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]*models.SearchHit, 0, limit)
+	for rows.Next() {
+		var (
+			c   models.Comment
+			hit models.SearchHit
+		)
+
+		err := rows.Scan(
+			&c.ID,
+			&c.PostID,
+			&c.ParentID,
+			&c.Author,
+			&c.Text,
+			&c.CreatedAt,
+			&c.EditedAt,
+			&c.IsDeleted,
+			&c.Status,
+			&hit.Rank,
+			&hit.Snippet,
+		)
+		if err != nil {
+			return nil, err
+		}
+		hit.Snippet = highlight.HTML(hit.Snippet)
+
+		hit.Comment = &c
+		hits = append(hits, &hit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+func (r *comment) SearchCount(ctx context.Context, query string) (int64, error) {
+	var count int64
+
+	err := r.conn(ctx).QueryRow(ctx, searchCommentsCountQuery, query).Scan(&count)
+
+	return count, err
+}
@@ -0,0 +1,85 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// getThreadQuery starts from the requested root, or from the first root
+// comments of the post when $2 is null, and descends through parent_id until
+// depth $3. The lateral subquery keeps the first $4 replies of every comment;
+// ordering by the id path lists the thread depth first.
+const getThreadQuery = `
+	with recursive thread as (
+		(
+			select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status,
+				0 as depth, array[id] as path
+			from comments
+			where post_id = $1 and status = 'PUBLISHED'
+				and (($2::bigint is null and parent_id is null) or id = $2::bigint)
+			order by id
+			limit $4
+		)
+		union all
+		select c.id, c.post_id, c.parent_id, c.author, c.body, c.created_at, c.edited_at, c.is_deleted, c.upvotes, c.downvotes, c.status,
+			t.depth + 1, t.path || c.id
+		from thread t
+		cross join lateral (
+			select *
+			from comments
+			where parent_id = t.id and status = 'PUBLISHED'
+			order by id
+			limit $4
+		) c
+		where t.depth < $3
+	)
+	select id, post_id, parent_id, author, body, created_at, edited_at, is_deleted, upvotes, downvotes, status, depth, path
+	from thread
+	order by path
+`
+
+func (r *comment) GetThread(ctx context.Context, postID int64, rootID *int64, maxDepth, maxPerLevel int32) ([]*models.ThreadComment, error) {
+	rows, err := r.conn(ctx).Query(ctx, getThreadQuery, postID, rootID, maxDepth, maxPerLevel)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	thread := make([]*models.ThreadComment, 0)
+	for rows.Next() {
+		c := models.Comment{}
+		entry := models.ThreadComment{Comment: &c}
+
+		err := rows.Scan(
+			&c.ID,
+			&c.PostID,
+			&c.ParentID,
+			&c.Author,
+			&c.Text,
+			&c.CreatedAt,
+			&c.EditedAt,
+			&c.IsDeleted,
+			&c.Upvotes,
+			&c.Downvotes,
+			&c.Status,
+			&entry.Depth,
+			&entry.Path,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		thread = append(thread, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if rootID != nil && len(thread) == 0 {
+		return nil, ErrCommentNotFound
+	}
+
+	return thread, nil
+}
@@ -0,0 +1,65 @@
+package post
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	deletePostQuery = `
+		update posts
+		set deleted_at = $2
+		where id = $1 and deleted_at is null
+		returning id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, ` + postTagsColumn + `
+	`
+	// A moderator may hide a post its author already deleted, so that the
+	// author cannot restore it.
+	hidePostQuery = `
+		update posts
+		set deleted_at = coalesce(deleted_at, $2), moderator_hidden = true
+		where id = $1
+		returning id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, ` + postTagsColumn + `
+	`
+	restorePostQuery = `
+		update posts
+		set deleted_at = null, moderator_hidden = false
+		where id = $1 and deleted_at is not null and (not moderator_hidden or $2::boolean)
+		returning id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, ` + postTagsColumn + `
+	`
+	getPostDeleteStateQuery = `select deleted_at is not null, moderator_hidden from posts where id = $1`
+)
+
+func (r *post) Delete(ctx context.Context, postID int64, deletedAt string) (*models.Post, error) {
+	return r.scanPost(r.conn(ctx).QueryRow(ctx, deletePostQuery, postID, deletedAt))
+}
+
+func (r *post) Hide(ctx context.Context, postID int64, hiddenAt string) (*models.Post, error) {
+	return r.scanPost(r.conn(ctx).QueryRow(ctx, hidePostQuery, postID, hiddenAt))
+}
+
+// Restore brings back a deleted post. A post a moderator hid stays hidden
+// unless unhide is set.
+func (r *post) Restore(ctx context.Context, postID int64, unhide bool) (*models.Post, error) {
+	out, err := r.scanPost(r.conn(ctx).QueryRow(ctx, restorePostQuery, postID, unhide))
+	if !errors.Is(err, ErrPostNotFound) {
+		return out, err
+	}
+
+	// Nothing was restored; tell the caller why.
+	var deleted, hidden bool
+	err = r.conn(ctx).QueryRow(ctx, getPostDeleteStateQuery, postID).Scan(&deleted, &hidden)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, ErrPostNotFound
+	case err != nil:
+		return nil, err
+	case !deleted:
+		return nil, ErrPostNotDeleted
+	default:
+		return nil, ErrPostHidden
+	}
+}
@@ -2,15 +2,19 @@ package post
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/tx"
 )
 
 var (
-	ErrPostNotFound = errors.New("post not found")
+	ErrPostNotFound   = fmt.Errorf("post %w", repository.ErrNotFound)
+	ErrPostNotDeleted = errors.New("post is not deleted")
+	ErrPostHidden     = errors.New("post was hidden by a moderator")
 )
 
 type post struct {
@@ -27,3 +31,8 @@ type DB interface {
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 }
+
+// conn is the transaction ctx runs in, or the pool outside of one.
+func (r *post) conn(ctx context.Context) DB {
+	return tx.Conn(ctx, r.db)
+}
@@ -3,37 +3,44 @@ package post
 import (
 	"context"
 
-	"github.com/jackc/pgx/v4"
-	"github.com/pkg/errors"
-
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 )
 
 const (
 	getPostByIdQuery = `
-		select id, title, body, author, allow_comments, created_at
+		select id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, ` + postTagsColumn + `
 		from posts
-		where id = $1
+		where id = $1 and deleted_at is null
+	`
+
+	getPostsByIdsQuery = `
+		select id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, ` + postTagsColumn + `
+		from posts
+		where id = any($1) and deleted_at is null
 	`
 )
 
 func (r *post) GetByID(ctx context.Context, postID int64) (*models.Post, error) {
-	var out models.Post
-
-	err := r.db.QueryRow(ctx, getPostByIdQuery, postID).Scan(
-		&out.ID,
-		&out.Title,
-		&out.Body,
-		&out.Author,
-		&out.AllowComments,
-		&out.CreatedAt,
-	)
+	return r.scanPost(r.conn(ctx).QueryRow(ctx, getPostByIdQuery, postID))
+}
+
+// GetByIDs returns the live posts among postIDs in no particular order,
+// deleted and unknown ids are skipped.
+func (r *post) GetByIDs(ctx context.Context, postIDs []int64) ([]*models.Post, error) {
+	rows, err := r.conn(ctx).Query(ctx, getPostsByIdsQuery, postIDs)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrPostNotFound
-		}
 		return nil, err
 	}
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0, len(postIDs))
+	for rows.Next() {
+		p, err := r.scanPost(rows)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, p)
+	}
 
-	return &out, nil
+	return posts, rows.Err()
 }
@@ -27,17 +27,20 @@ func TestPostRepository_GetByID(t *testing.T) {
 			postID: 123,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "author", "allow_comments", "created_at",
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
 				}).AddRow(
 					int64(123),
 					"Test Title",
 					"Test Body",
 					"Test Author",
-					true,
+					models.CommentPolicyOpen,
+					nil,
 					"2026-02-12T19:57:26Z",
+					nil,
+					[]string{"go", "graphql"},
 				)
 
-				mock.ExpectQuery(`select id, title, body, author, allow_comments, created_at from posts where id = \$1`).
+				mock.ExpectQuery(`select id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, coalesce\(.*\) as tags from posts where id = \$1 and deleted_at is null`).
 					WithArgs(int64(123)).
 					WillReturnRows(rows)
 			},
@@ -46,8 +49,9 @@ func TestPostRepository_GetByID(t *testing.T) {
 				Title:         "Test Title",
 				Body:          "Test Body",
 				Author:        "Test Author",
-				AllowComments: true,
+				CommentPolicy: models.CommentPolicyOpen,
 				CreatedAt:     "2026-02-12T19:57:26Z",
+				Tags:          []string{"go", "graphql"},
 			},
 			expectedError: nil,
 		},
@@ -55,7 +59,7 @@ func TestPostRepository_GetByID(t *testing.T) {
 			name:   "post_not_found",
 			postID: 999,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select id, title, body, author, allow_comments, created_at from posts where id = \$1`).
+				mock.ExpectQuery(`select id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, coalesce\(.*\) as tags from posts where id = \$1 and deleted_at is null`).
 					WithArgs(int64(999)).
 					WillReturnError(pgx.ErrNoRows)
 			},
@@ -66,7 +70,7 @@ func TestPostRepository_GetByID(t *testing.T) {
 			name:   "db_error",
 			postID: 500,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select id, title, body, author, allow_comments, created_at from posts where id = \$1`).
+				mock.ExpectQuery(`select id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, coalesce\(.*\) as tags from posts where id = \$1 and deleted_at is null`).
 					WithArgs(int64(500)).
 					WillReturnError(errors.New("db error"))
 			},
@@ -103,13 +107,83 @@ func TestPostRepository_GetByID(t *testing.T) {
 	}
 }
 
+func TestPostRepository_GetByIDs(t *testing.T) {
+	t.Parallel()
+
+	ids := []int64{1, 2}
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock pgxmock.PgxPoolIface)
+		expectedPosts []*models.Post
+		expectedError error
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
+				}).AddRow(
+					int64(2), "Second", "Body", "Author", models.CommentPolicyOpen, nil, "2026-02-12T19:57:26Z", nil, []string{},
+				)
+
+				mock.ExpectQuery(`select id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, coalesce\(.*\) as tags from posts where id = any\(\$1\) and deleted_at is null`).
+					WithArgs(ids).
+					WillReturnRows(rows)
+			},
+			expectedPosts: []*models.Post{
+				{ID: 2, Title: "Second", Body: "Body", Author: "Author", CommentPolicy: models.CommentPolicyOpen, CreatedAt: "2026-02-12T19:57:26Z", Tags: []string{}},
+			},
+		},
+		{
+			name: "db_error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`where id = any\(\$1\) and deleted_at is null`).
+					WithArgs(ids).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			repo := New(mock)
+
+			tt.mockSetup(mock)
+
+			result, err := repo.GetByIDs(context.Background(), ids)
+
+			if tt.expectedError != nil {
+				require.EqualError(t, err, tt.expectedError.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPosts, result)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestPostRepository_Get(t *testing.T) {
 	t.Parallel()
 
+	cursorCreated := "2026-02-12T19:00:00Z"
+
 	tests := []struct {
 		name          string
+		filter        models.PostFilter
 		afterCreated  *string
 		afterID       int64
+		backward      bool
 		limit         int32
 		mockSetup     func(mock pgxmock.PgxPoolIface)
 		expectedPosts []*models.Post
@@ -122,13 +196,13 @@ func TestPostRepository_Get(t *testing.T) {
 			limit:        2,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "author", "allow_comments", "created_at",
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "tags",
 				}).
-					AddRow(int64(2), "Title2", "Body2", "Author2", true, "2026-02-12T20:00:00Z").
-					AddRow(int64(1), "Title1", "Body1", "Author1", false, "2026-02-12T19:00:00Z")
+					AddRow(int64(2), "Title2", "Body2", "Author2", models.CommentPolicyOpen, nil, "2026-02-12T20:00:00Z", []string{"go"}).
+					AddRow(int64(1), "Title1", "Body1", "Author1", models.CommentPolicyClosed, nil, "2026-02-12T19:00:00Z", []string{})
 
-				mock.ExpectQuery(`select id, title, body, author, allow_comments, created_at from posts`).
-					WithArgs(pgxmock.AnyArg(), int64(0), int32(2)).
+				mock.ExpectQuery(`select id, title, body, author, comment_policy, comments_close_at, created_at, coalesce\(.*\) as tags from posts where deleted_at is null`).
+					WithArgs([]string(nil), false, pgxmock.AnyArg(), int64(0), int32(2)).
 					WillReturnRows(rows)
 			},
 			expectedPosts: []*models.Post{
@@ -137,16 +211,75 @@ func TestPostRepository_Get(t *testing.T) {
 					Title:         "Title2",
 					Body:          "Body2",
 					Author:        "Author2",
-					AllowComments: true,
+					CommentPolicy: models.CommentPolicyOpen,
 					CreatedAt:     "2026-02-12T20:00:00Z",
+					Tags:          []string{"go"},
 				},
 				{
 					ID:            1,
 					Title:         "Title1",
 					Body:          "Body1",
 					Author:        "Author1",
-					AllowComments: false,
+					CommentPolicy: models.CommentPolicyClosed,
 					CreatedAt:     "2026-02-12T19:00:00Z",
+					Tags:          []string{},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name:         "backward_before_cursor",
+			afterCreated: &cursorCreated,
+			afterID:      1,
+			backward:     true,
+			limit:        1,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "tags",
+				}).
+					AddRow(int64(2), "Title2", "Body2", "Author2", models.CommentPolicyOpen, nil, "2026-02-12T20:00:00Z", []string{})
+
+				mock.ExpectQuery(`select \* from \( select id, title, body, author, comment_policy, comments_close_at, created_at, .* and \(\$3::text is null or \(created_at, id\) > \(\$3::text, \$4::bigint\)\) order by created_at, id limit \$5 \) page order by created_at desc, id desc`).
+					WithArgs([]string(nil), false, &cursorCreated, int64(1), int32(1)).
+					WillReturnRows(rows)
+			},
+			expectedPosts: []*models.Post{
+				{
+					ID:            2,
+					Title:         "Title2",
+					Body:          "Body2",
+					Author:        "Author2",
+					CommentPolicy: models.CommentPolicyOpen,
+					CreatedAt:     "2026-02-12T20:00:00Z",
+					Tags:          []string{},
+				},
+			},
+		},
+		{
+			name:         "filter_by_all_tags",
+			filter:       models.PostFilter{Tags: []string{"go", "graphql"}, Match: models.TagMatchAll},
+			afterCreated: nil,
+			afterID:      0,
+			limit:        2,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "tags",
+				}).
+					AddRow(int64(3), "Title3", "Body3", "Author3", models.CommentPolicyOpen, nil, "2026-02-12T21:00:00Z", []string{"go", "graphql"})
+
+				mock.ExpectQuery(`where pt.post_id = posts.id and t.name = any\(\$1::text\[\]\) \) >= case when \$2::boolean then cardinality\(\$1::text\[\]\) else 1 end\) and \(\$3::text is null or \(created_at, id\) < \(\$3::text, \$4::bigint\)\) order by created_at desc, id desc limit \$5`).
+					WithArgs([]string{"go", "graphql"}, true, pgxmock.AnyArg(), int64(0), int32(2)).
+					WillReturnRows(rows)
+			},
+			expectedPosts: []*models.Post{
+				{
+					ID:            3,
+					Title:         "Title3",
+					Body:          "Body3",
+					Author:        "Author3",
+					CommentPolicy: models.CommentPolicyOpen,
+					CreatedAt:     "2026-02-12T21:00:00Z",
+					Tags:          []string{"go", "graphql"},
 				},
 			},
 			expectError: false,
@@ -158,11 +291,11 @@ func TestPostRepository_Get(t *testing.T) {
 			limit:        5,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "author", "allow_comments", "created_at",
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "tags",
 				})
 
-				mock.ExpectQuery(`select id, title, body, author, allow_comments, created_at from posts`).
-					WithArgs(pgxmock.AnyArg(), int64(0), int32(5)).
+				mock.ExpectQuery(`select id, title, body, author, comment_policy, comments_close_at, created_at, coalesce\(.*\) as tags from posts where deleted_at is null`).
+					WithArgs([]string(nil), false, pgxmock.AnyArg(), int64(0), int32(5)).
 					WillReturnRows(rows)
 			},
 			expectedPosts: []*models.Post{},
@@ -174,8 +307,8 @@ func TestPostRepository_Get(t *testing.T) {
 			afterID:      0,
 			limit:        5,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select id, title, body, author, allow_comments, created_at from posts`).
-					WithArgs(pgxmock.AnyArg(), int64(0), int32(5)).
+				mock.ExpectQuery(`select id, title, body, author, comment_policy, comments_close_at, created_at, coalesce\(.*\) as tags from posts where deleted_at is null`).
+					WithArgs([]string(nil), false, pgxmock.AnyArg(), int64(0), int32(5)).
 					WillReturnError(errors.New("db error"))
 			},
 			expectedPosts: nil,
@@ -188,11 +321,11 @@ func TestPostRepository_Get(t *testing.T) {
 			limit:        1,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "author", "allow_comments", "created_at",
-				}).AddRow("wrong_type", "Title", "Body", "Author", true, "2026-02-12T20:00:00Z")
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "tags",
+				}).AddRow("wrong_type", "Title", "Body", "Author", models.CommentPolicyOpen, nil, "2026-02-12T20:00:00Z", []string{})
 
-				mock.ExpectQuery(`select id, title, body, author, allow_comments, created_at from posts`).
-					WithArgs(pgxmock.AnyArg(), int64(0), int32(1)).
+				mock.ExpectQuery(`select id, title, body, author, comment_policy, comments_close_at, created_at, coalesce\(.*\) as tags from posts where deleted_at is null`).
+					WithArgs([]string(nil), false, pgxmock.AnyArg(), int64(0), int32(1)).
 					WillReturnRows(rows)
 			},
 			expectedPosts: nil,
@@ -212,7 +345,7 @@ func TestPostRepository_Get(t *testing.T) {
 
 			tt.mockSetup(mock)
 
-			result, err := repo.Get(context.Background(), tt.afterCreated, tt.afterID, tt.limit)
+			result, err := repo.Get(context.Background(), tt.filter, tt.afterCreated, tt.afterID, tt.backward, tt.limit)
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -242,7 +375,8 @@ func TestPostRepository_TotalCount(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"count"}).
 					AddRow(int64(42))
 
-				mock.ExpectQuery(`select count\(\*\) from posts`).
+				mock.ExpectQuery(`select count\(\*\) from posts where deleted_at is null`).
+					WithArgs([]string(nil), false).
 					WillReturnRows(rows)
 			},
 			expectedCount: 42,
@@ -251,7 +385,8 @@ func TestPostRepository_TotalCount(t *testing.T) {
 		{
 			name: "query_error",
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`select count\(\*\) from posts`).
+				mock.ExpectQuery(`select count\(\*\) from posts where deleted_at is null`).
+					WithArgs([]string(nil), false).
 					WillReturnError(errors.New("db error"))
 			},
 			expectedCount: 0,
@@ -271,7 +406,7 @@ func TestPostRepository_TotalCount(t *testing.T) {
 
 			tt.mockSetup(mock)
 
-			count, err := repo.TotalCount(context.Background())
+			count, err := repo.TotalCount(context.Background(), models.PostFilter{})
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -289,6 +424,8 @@ func TestPostRepository_TotalCount(t *testing.T) {
 func TestPostRepository_Save(t *testing.T) {
 	t.Parallel()
 
+	maxReplyDepth := int32(5)
+
 	tests := []struct {
 		name        string
 		inputPost   models.Post
@@ -301,19 +438,24 @@ func TestPostRepository_Save(t *testing.T) {
 				Title:         "Test Title",
 				Body:          "Test Body",
 				Author:        "Adel",
-				AllowComments: true,
+				CommentPolicy: models.CommentPolicyOpen,
 				CreatedAt:     "2026-02-12T21:00:00Z",
+				Tags:          []string{"go", "graphql"},
+				MaxReplyDepth: &maxReplyDepth,
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, input models.Post) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "author", "allow_comments", "created_at",
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "max_reply_depth", "tags",
 				}).AddRow(
 					int64(100),
 					input.Title,
 					input.Body,
 					input.Author,
-					input.AllowComments,
+					input.CommentPolicy,
+					input.CommentsCloseAt,
 					input.CreatedAt,
+					input.MaxReplyDepth,
+					input.Tags,
 				)
 
 				mock.ExpectQuery(`insert into posts`).
@@ -321,8 +463,11 @@ func TestPostRepository_Save(t *testing.T) {
 						input.Title,
 						input.Body,
 						input.Author,
-						input.AllowComments,
+						input.CommentPolicy,
+						input.CommentsCloseAt,
 						input.CreatedAt,
+						input.Tags,
+						input.MaxReplyDepth,
 					).
 					WillReturnRows(rows)
 			},
@@ -334,8 +479,9 @@ func TestPostRepository_Save(t *testing.T) {
 				Title:         "Test Title",
 				Body:          "Test Body",
 				Author:        "Adel",
-				AllowComments: true,
+				CommentPolicy: models.CommentPolicyOpen,
 				CreatedAt:     "2026-02-12T21:00:00Z",
+				Tags:          []string{"go", "graphql"},
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, input models.Post) {
 				mock.ExpectQuery(`insert into posts`).
@@ -343,8 +489,11 @@ func TestPostRepository_Save(t *testing.T) {
 						input.Title,
 						input.Body,
 						input.Author,
-						input.AllowComments,
+						input.CommentPolicy,
+						input.CommentsCloseAt,
 						input.CreatedAt,
+						input.Tags,
+						input.MaxReplyDepth,
 					).
 					WillReturnError(errors.New("insert error"))
 			},
@@ -356,19 +505,23 @@ func TestPostRepository_Save(t *testing.T) {
 				Title:         "Test Title",
 				Body:          "Test Body",
 				Author:        "Adel",
-				AllowComments: true,
+				CommentPolicy: models.CommentPolicyOpen,
 				CreatedAt:     "2026-02-12T21:00:00Z",
+				Tags:          []string{"go", "graphql"},
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface, input models.Post) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "author", "allow_comments", "created_at",
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "max_reply_depth", "tags",
 				}).AddRow(
 					"wrong_id_type",
 					input.Title,
 					input.Body,
 					input.Author,
-					input.AllowComments,
+					input.CommentPolicy,
+					input.CommentsCloseAt,
 					input.CreatedAt,
+					input.MaxReplyDepth,
+					input.Tags,
 				)
 
 				mock.ExpectQuery(`insert into posts`).
@@ -376,8 +529,11 @@ func TestPostRepository_Save(t *testing.T) {
 						input.Title,
 						input.Body,
 						input.Author,
-						input.AllowComments,
+						input.CommentPolicy,
+						input.CommentsCloseAt,
 						input.CreatedAt,
+						input.Tags,
+						input.MaxReplyDepth,
 					).
 					WillReturnRows(rows)
 			},
@@ -408,8 +564,10 @@ func TestPostRepository_Save(t *testing.T) {
 				require.Equal(t, tt.inputPost.Title, result.Title)
 				require.Equal(t, tt.inputPost.Body, result.Body)
 				require.Equal(t, tt.inputPost.Author, result.Author)
-				require.Equal(t, tt.inputPost.AllowComments, result.AllowComments)
+				require.Equal(t, tt.inputPost.CommentPolicy, result.CommentPolicy)
 				require.Equal(t, tt.inputPost.CreatedAt, result.CreatedAt)
+				require.Equal(t, tt.inputPost.Tags, result.Tags)
+				require.Equal(t, tt.inputPost.MaxReplyDepth, result.MaxReplyDepth)
 
 				require.NotZero(t, result.ID)
 			}
@@ -419,13 +577,14 @@ func TestPostRepository_Save(t *testing.T) {
 	}
 }
 
-func TestPostRepository_SetCommentsAllowed(t *testing.T) {
+func TestPostRepository_SetCommentPolicy(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name        string
 		postID      int64
-		allow       bool
+		policy      models.CommentPolicy
+		closeAt     *string
 		mockSetup   func(mock pgxmock.PgxPoolIface)
 		expected    *models.Post
 		expectError bool
@@ -433,21 +592,24 @@ func TestPostRepository_SetCommentsAllowed(t *testing.T) {
 		{
 			name:   "success",
 			postID: 10,
-			allow:  true,
+			policy: models.CommentPolicyOpen,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "author", "allow_comments", "created_at",
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
 				}).AddRow(
 					int64(10),
 					"Updated Title",
 					"Body",
 					"Adel",
-					true,
+					models.CommentPolicyOpen,
+					nil,
 					"2026-02-12T22:00:00Z",
+					nil,
+					[]string{},
 				)
 
 				mock.ExpectQuery(`update posts`).
-					WithArgs(int64(10), true).
+					WithArgs(int64(10), models.CommentPolicyOpen, (*string)(nil)).
 					WillReturnRows(rows)
 			},
 			expected: &models.Post{
@@ -455,18 +617,55 @@ func TestPostRepository_SetCommentsAllowed(t *testing.T) {
 				Title:         "Updated Title",
 				Body:          "Body",
 				Author:        "Adel",
-				AllowComments: true,
+				CommentPolicy: models.CommentPolicyOpen,
 				CreatedAt:     "2026-02-12T22:00:00Z",
+				Tags:          []string{},
+			},
+			expectError: false,
+		},
+		{
+			name:    "author_only_with_close_time",
+			postID:  11,
+			policy:  models.CommentPolicyAuthorOnly,
+			closeAt: strPtr("2026-03-01T00:00:00Z"),
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
+				}).AddRow(
+					int64(11),
+					"Title",
+					"Body",
+					"Adel",
+					models.CommentPolicyAuthorOnly,
+					strPtr("2026-03-01T00:00:00Z"),
+					"2026-02-12T22:00:00Z",
+					nil,
+					[]string{},
+				)
+
+				mock.ExpectQuery(`update posts`).
+					WithArgs(int64(11), models.CommentPolicyAuthorOnly, strPtr("2026-03-01T00:00:00Z")).
+					WillReturnRows(rows)
+			},
+			expected: &models.Post{
+				ID:              11,
+				Title:           "Title",
+				Body:            "Body",
+				Author:          "Adel",
+				CommentPolicy:   models.CommentPolicyAuthorOnly,
+				CommentsCloseAt: strPtr("2026-03-01T00:00:00Z"),
+				CreatedAt:       "2026-02-12T22:00:00Z",
+				Tags:            []string{},
 			},
 			expectError: false,
 		},
 		{
 			name:   "post_not_found",
 			postID: 999,
-			allow:  false,
+			policy: models.CommentPolicyClosed,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery(`update posts`).
-					WithArgs(int64(999), false).
+					WithArgs(int64(999), models.CommentPolicyClosed, (*string)(nil)).
 					WillReturnError(pgx.ErrNoRows)
 			},
 			expected:    nil,
@@ -475,10 +674,10 @@ func TestPostRepository_SetCommentsAllowed(t *testing.T) {
 		{
 			name:   "db_error",
 			postID: 15,
-			allow:  false,
+			policy: models.CommentPolicyClosed,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery(`update posts`).
-					WithArgs(int64(15), false).
+					WithArgs(int64(15), models.CommentPolicyClosed, (*string)(nil)).
 					WillReturnError(errors.New("db error"))
 			},
 			expected:    nil,
@@ -487,21 +686,24 @@ func TestPostRepository_SetCommentsAllowed(t *testing.T) {
 		{
 			name:   "scan_error",
 			postID: 20,
-			allow:  true,
+			policy: models.CommentPolicyOpen,
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "title", "body", "author", "allow_comments", "created_at",
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
 				}).AddRow(
 					"wrong_id_type",
 					"Title",
 					"Body",
 					"Author",
-					true,
+					models.CommentPolicyOpen,
+					nil,
 					"2026-02-12T22:00:00Z",
+					nil,
+					[]string{},
 				)
 
 				mock.ExpectQuery(`update posts`).
-					WithArgs(int64(20), true).
+					WithArgs(int64(20), models.CommentPolicyOpen, (*string)(nil)).
 					WillReturnRows(rows)
 			},
 			expected:    nil,
@@ -521,10 +723,11 @@ func TestPostRepository_SetCommentsAllowed(t *testing.T) {
 
 			tt.mockSetup(mock)
 
-			result, err := repo.SetCommentsAllowed(
+			result, err := repo.SetCommentPolicy(
 				context.Background(),
 				tt.postID,
-				tt.allow,
+				tt.policy,
+				tt.closeAt,
 			)
 
 			if tt.expectError {
@@ -539,3 +742,480 @@ func TestPostRepository_SetCommentsAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestPostRepository_Update(t *testing.T) {
+	t.Parallel()
+
+	title := "New Title"
+
+	tests := []struct {
+		name        string
+		postID      int64
+		title       *string
+		body        *string
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expected    *models.Post
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			postID: 10,
+			title:  &title,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
+				}).AddRow(int64(10), "New Title", "Body", "Adel", models.CommentPolicyOpen, nil, "2026-02-12T22:00:00Z", nil, []string{"go"})
+
+				mock.ExpectQuery(`update posts set title = coalesce\(\$2, title\), body = coalesce\(\$3, body\) where id = \$1 and deleted_at is null`).
+					WithArgs(int64(10), &title, (*string)(nil)).
+					WillReturnRows(rows)
+			},
+			expected: &models.Post{
+				ID:            10,
+				Title:         "New Title",
+				Body:          "Body",
+				Author:        "Adel",
+				CommentPolicy: models.CommentPolicyOpen,
+				CreatedAt:     "2026-02-12T22:00:00Z",
+				Tags:          []string{"go"},
+			},
+		},
+		{
+			name:   "post_not_found",
+			postID: 999,
+			title:  &title,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`update posts`).
+					WithArgs(int64(999), &title, (*string)(nil)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: errors.New("post not found"),
+		},
+		{
+			name:   "db_error",
+			postID: 15,
+			title:  &title,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`update posts`).
+					WithArgs(int64(15), &title, (*string)(nil)).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			repo := New(mock)
+
+			tt.mockSetup(mock)
+
+			result, err := repo.Update(context.Background(), tt.postID, tt.title, tt.body)
+
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := "2026-02-13T10:00:00Z"
+
+	tests := []struct {
+		name        string
+		postID      int64
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expected    *models.Post
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			postID: 10,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
+				}).AddRow(int64(10), "Title", "Body", "Adel", models.CommentPolicyOpen, nil, "2026-02-12T22:00:00Z", &deletedAt, []string{"go"})
+
+				mock.ExpectQuery(`update posts set deleted_at = \$2 where id = \$1 and deleted_at is null`).
+					WithArgs(int64(10), deletedAt).
+					WillReturnRows(rows)
+			},
+			expected: &models.Post{
+				ID:            10,
+				Title:         "Title",
+				Body:          "Body",
+				Author:        "Adel",
+				CommentPolicy: models.CommentPolicyOpen,
+				CreatedAt:     "2026-02-12T22:00:00Z",
+				Tags:          []string{"go"},
+				DeletedAt:     &deletedAt,
+			},
+		},
+		{
+			name:   "already_deleted",
+			postID: 10,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`update posts set deleted_at`).
+					WithArgs(int64(10), deletedAt).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: errors.New("post not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			repo := New(mock)
+
+			tt.mockSetup(mock)
+
+			result, err := repo.Delete(context.Background(), tt.postID, deletedAt)
+
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostRepository_Restore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		postID      int64
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expected    *models.Post
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			postID: 10,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
+				}).AddRow(int64(10), "Title", "Body", "Adel", models.CommentPolicyOpen, nil, "2026-02-12T22:00:00Z", nil, []string{"go"})
+
+				mock.ExpectQuery(`update posts set deleted_at = null, moderator_hidden = false where id = \$1 and deleted_at is not null and \(not moderator_hidden or \$2::boolean\)`).
+					WithArgs(int64(10), false).
+					WillReturnRows(rows)
+			},
+			expected: &models.Post{
+				ID:            10,
+				Title:         "Title",
+				Body:          "Body",
+				Author:        "Adel",
+				CommentPolicy: models.CommentPolicyOpen,
+				CreatedAt:     "2026-02-12T22:00:00Z",
+				Tags:          []string{"go"},
+			},
+		},
+		{
+			name:   "post_not_found",
+			postID: 999,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`update posts set deleted_at = null`).
+					WithArgs(int64(999), false).
+					WillReturnError(pgx.ErrNoRows)
+				mock.ExpectQuery(`select deleted_at is not null, moderator_hidden from posts where id = \$1`).
+					WithArgs(int64(999)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: errors.New("post not found"),
+		},
+		{
+			name:   "post_not_deleted",
+			postID: 10,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`update posts set deleted_at = null`).
+					WithArgs(int64(10), false).
+					WillReturnError(pgx.ErrNoRows)
+				mock.ExpectQuery(`select deleted_at is not null, moderator_hidden from posts`).
+					WithArgs(int64(10)).
+					WillReturnRows(pgxmock.NewRows([]string{"deleted", "moderator_hidden"}).AddRow(false, false))
+			},
+			expectedErr: errors.New("post is not deleted"),
+		},
+		{
+			name:   "hidden_by_moderator",
+			postID: 10,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`update posts set deleted_at = null`).
+					WithArgs(int64(10), false).
+					WillReturnError(pgx.ErrNoRows)
+				mock.ExpectQuery(`select deleted_at is not null, moderator_hidden from posts`).
+					WithArgs(int64(10)).
+					WillReturnRows(pgxmock.NewRows([]string{"deleted", "moderator_hidden"}).AddRow(true, true))
+			},
+			expectedErr: errors.New("post was hidden by a moderator"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			repo := New(mock)
+
+			tt.mockSetup(mock)
+
+			result, err := repo.Restore(context.Background(), tt.postID, false)
+
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostRepository_Hide(t *testing.T) {
+	t.Parallel()
+
+	hiddenAt := "2026-02-13T10:00:00Z"
+	deletedAt := "2026-02-12T23:00:00Z"
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	rows := pgxmock.NewRows([]string{
+		"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "deleted_at", "tags",
+	}).AddRow(int64(10), "Title", "Body", "Adel", models.CommentPolicyOpen, nil, "2026-02-12T22:00:00Z", &deletedAt, []string{})
+
+	mock.ExpectQuery(`update posts set deleted_at = coalesce\(deleted_at, \$2\), moderator_hidden = true where id = \$1`).
+		WithArgs(int64(10), hiddenAt).
+		WillReturnRows(rows)
+
+	result, err := New(mock).Hide(context.Background(), 10, hiddenAt)
+
+	require.NoError(t, err)
+	require.NotNil(t, result.DeletedAt)
+	require.Equal(t, deletedAt, *result.DeletedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepository_Tags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		prefix      string
+		limit       int32
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expected    []string
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			prefix: "g",
+			limit:  10,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"name"}).
+					AddRow("go").
+					AddRow("graphql")
+
+				mock.ExpectQuery(`select t.name from tags t where t.name like \$1 escape '\\'`).
+					WithArgs("g%", int32(10)).
+					WillReturnRows(rows)
+			},
+			expected: []string{"go", "graphql"},
+		},
+		{
+			name:   "escapes_like_wildcards",
+			prefix: `c_%\`,
+			limit:  5,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select t.name from tags t`).
+					WithArgs(`c\_\%\\%`, int32(5)).
+					WillReturnRows(pgxmock.NewRows([]string{"name"}))
+			},
+			expected: []string{},
+		},
+		{
+			name:   "db_error",
+			prefix: "g",
+			limit:  10,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select t.name from tags t`).
+					WithArgs("g%", int32(10)).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			repo := New(mock)
+
+			tt.mockSetup(mock)
+
+			result, err := repo.Tags(context.Background(), tt.prefix, tt.limit)
+
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostRepository_Search(t *testing.T) {
+	t.Parallel()
+
+	rank := 0.0991032
+
+	tests := []struct {
+		name        string
+		afterRank   *float64
+		afterID     int64
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expected    []*models.SearchHit
+		expectedErr error
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{
+					"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "tags", "rank", "snippet",
+				}).AddRow(int64(7), "Postgres", "Full text search <script>", "Adel", models.CommentPolicyOpen, nil, "2026-02-12T22:00:00Z", []string{"db"}, rank, "Full \x02text\x03 search <script>")
+
+				mock.ExpectQuery(`ts_headline\('simple', translate\(body, chr\(2\) \|\| chr\(3\), ''\), q.query, 'StartSel=' \|\| chr\(2\) \|\| ', StopSel=' \|\| chr\(3\) \|\| ', MaxWords=24, MinWords=12'\) as snippet from posts, websearch_to_tsquery\('simple', \$1\) as q\(query\) where deleted_at is null and search_vector @@ q.query`).
+					WithArgs("text", (*float64)(nil), int64(0), int32(10)).
+					WillReturnRows(rows)
+			},
+			expected: []*models.SearchHit{
+				{
+					Rank:    rank,
+					Snippet: "Full <b>text</b> search &lt;script&gt;",
+					Post: &models.Post{
+						ID:            7,
+						Title:         "Postgres",
+						Body:          "Full text search <script>",
+						Author:        "Adel",
+						CommentPolicy: models.CommentPolicyOpen,
+						CreatedAt:     "2026-02-12T22:00:00Z",
+						Tags:          []string{"db"},
+					},
+				},
+			},
+		},
+		{
+			name:      "with_cursor",
+			afterRank: &rank,
+			afterID:   7,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`\(ts_rank\(search_vector, q.query\), id\) < \(\$2::real, \$3::bigint\)\) order by rank desc, id desc limit \$4`).
+					WithArgs("text", &rank, int64(7), int32(10)).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "title", "body", "author", "comment_policy", "comments_close_at", "created_at", "tags", "rank", "snippet",
+					}))
+			},
+			expected: []*models.SearchHit{},
+		},
+		{
+			name: "db_error",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`from posts`).
+					WithArgs("text", (*float64)(nil), int64(0), int32(10)).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			repo := New(mock)
+
+			tt.mockSetup(mock)
+
+			result, err := repo.Search(context.Background(), "text", tt.afterRank, tt.afterID, 10)
+
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostRepository_SearchCount(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`select count\(\*\) from posts where deleted_at is null and search_vector @@ websearch_to_tsquery\('simple', \$1\)`).
+		WithArgs("text").
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(4)))
+
+	repo := New(mock)
+	count, err := repo.SearchCount(context.Background(), "text")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(4), count)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func strPtr(v string) *string { return &v }
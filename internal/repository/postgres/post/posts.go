@@ -10,19 +10,60 @@ import (
 )
 
 const (
+	// postTagFilter keeps posts carrying at least one ($2 = false) or all
+	// ($2 = true) of the tags in $1; an empty $1 disables the filter.
+	postTagFilter = `(coalesce(cardinality($1::text[]), 0) = 0 or (
+			select count(*)
+			from post_tags pt join tags t on t.id = pt.tag_id
+			where pt.post_id = posts.id and t.name = any($1::text[])
+		) >= case when $2::boolean then cardinality($1::text[]) else 1 end)`
+
 	getPostsQuery = `
-		select id, title, body, author, allow_comments, created_at
+		select id, title, body, author, comment_policy, comments_close_at, created_at, ` + postTagsColumn + `
 		from posts
-		where ($1::text is null or (created_at, id) < ($1::text, $2::bigint))
+		where deleted_at is null
+			and ` + postTagFilter + `
+			and ($3::text is null or (created_at, id) < ($3::text, $4::bigint))
+		order by created_at desc, id desc
+		limit $5
+	`
+
+	// getPostsBeforeQuery reads the posts nearest before the cursor first,
+	// so the limit keeps the right ones, and puts the page back in order.
+	getPostsBeforeQuery = `
+		select * from (
+			select id, title, body, author, comment_policy, comments_close_at, created_at, ` + postTagsColumn + `
+			from posts
+			where deleted_at is null
+				and ` + postTagFilter + `
+				and ($3::text is null or (created_at, id) > ($3::text, $4::bigint))
+			order by created_at, id
+			limit $5
+		) page
 		order by created_at desc, id desc
-		limit $3
 	`
 
-	totalCountQuery = `select count(*) from posts`
+	totalCountQuery = `
+		select count(*)
+		from posts
+		where deleted_at is null
+			and ` + postTagFilter + `
+	`
 )
 
-func (r *post) Get(ctx context.Context, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Post, error) {
-	rows, err := r.db.Query(ctx, getPostsQuery, afterCreatedAt, afterID, limit)
+func (r *post) Get(ctx context.Context, filter models.PostFilter, cursorCreatedAt *string, cursorID int64, backward bool, limit int32) ([]*models.Post, error) {
+	query := getPostsQuery
+	if backward {
+		query = getPostsBeforeQuery
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, query,
+		filter.Tags,
+		filter.Match == models.TagMatchAll,
+		cursorCreatedAt,
+		cursorID,
+		limit,
+	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return []*models.Post{}, nil
@@ -40,8 +81,10 @@ func (r *post) Get(ctx context.Context, afterCreatedAt *string, afterID int64, l
 			&p.Title,
 			&p.Body,
 			&p.Author,
-			&p.AllowComments,
+			&p.CommentPolicy,
+			&p.CommentsCloseAt,
 			&p.CreatedAt,
+			&p.Tags,
 		)
 		if err != nil {
 			return nil, err
@@ -57,10 +100,10 @@ func (r *post) Get(ctx context.Context, afterCreatedAt *string, afterID int64, l
 	return posts, nil
 }
 
-func (r *post) TotalCount(ctx context.Context) (int64, error) {
+func (r *post) TotalCount(ctx context.Context, filter models.PostFilter) (int64, error) {
 	var count int64
 
-	err := r.db.QueryRow(ctx, totalCountQuery).Scan(&count)
+	err := r.conn(ctx).QueryRow(ctx, totalCountQuery, filter.Tags, filter.Match == models.TagMatchAll).Scan(&count)
 
 	return count, err
 }
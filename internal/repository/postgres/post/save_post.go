@@ -7,29 +7,51 @@ import (
 )
 
 const (
+	// savePostQuery inserts the post together with its tags in one statement:
+	// unknown tag names are created, and the no-op "do update" makes existing
+	// ones show up in the returning clause so they can be linked as well.
 	savePostQuery = `
-		insert into posts (title, body, author, allow_comments, created_at) 
-		values ($1, $2, $3, $4, $5)
-		returning id, title, body, author, allow_comments, created_at
+		with p as (
+			insert into posts (title, body, author, comment_policy, comments_close_at, created_at, max_reply_depth)
+			values ($1, $2, $3, $4, $5, $6, $8)
+			returning id, title, body, author, comment_policy, comments_close_at, created_at, max_reply_depth
+		), t as (
+			insert into tags (name)
+			select unnest($7::text[])
+			on conflict (name) do update set name = excluded.name
+			returning id, name
+		), pt as (
+			insert into post_tags (post_id, tag_id)
+			select p.id, t.id from p, t
+		)
+		select p.id, p.title, p.body, p.author, p.comment_policy, p.comments_close_at, p.created_at, p.max_reply_depth,
+			coalesce((select array_agg(t.name order by t.name) from t), '{}')
+		from p
 	`
 )
 
 func (r *post) Save(ctx context.Context, post models.Post) (models.Post, error) {
 	out := models.Post{}
 
-	err := r.db.QueryRow(ctx, savePostQuery,
+	err := r.conn(ctx).QueryRow(ctx, savePostQuery,
 		post.Title,
 		post.Body,
 		post.Author,
-		post.AllowComments,
+		post.CommentPolicy,
+		post.CommentsCloseAt,
 		post.CreatedAt,
+		post.Tags,
+		post.MaxReplyDepth,
 	).Scan(
 		&out.ID,
 		&out.Title,
 		&out.Body,
 		&out.Author,
-		&out.AllowComments,
+		&out.CommentPolicy,
+		&out.CommentsCloseAt,
 		&out.CreatedAt,
+		&out.MaxReplyDepth,
+		&out.Tags,
 	)
 	if err != nil {
 		return models.Post{}, err
@@ -0,0 +1,83 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/highlight"
+)
+
+const (
+	// The snippet marks matches with control characters the body is stripped
+	// of; Search escapes it and turns them into <b> tags.
+	// Ranks are compared as real, the type ts_rank returns, so a rank read
+	// from a cursor matches the row it was taken from exactly.
+	searchPostsQuery = `
+		select id, title, body, author, comment_policy, comments_close_at, created_at, ` + postTagsColumn + `,
+			ts_rank(search_vector, q.query) as rank,
+			ts_headline('simple', translate(body, chr(2) || chr(3), ''), q.query,
+				'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxWords=24, MinWords=12') as snippet
+		from posts, websearch_to_tsquery('simple', $1) as q(query)
+		where deleted_at is null
+			and search_vector @@ q.query
+			and ($2::real is null or (ts_rank(search_vector, q.query), id) < ($2::real, $3::bigint))
+		order by rank desc, id desc
+		limit $4
+	`
+
+	searchPostsCountQuery = `
+		select count(*)
+		from posts
+		where deleted_at is null and search_vector @@ websearch_to_tsquery('simple', $1)
+	`
+)
+
+func (r *post) Search(ctx context.Context, query string, afterRank *float64, afterID int64, limit int32) ([]*models.SearchHit, error) {
+	rows, err := r.conn(ctx).Query(ctx, searchPostsQuery, query, afterRank, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]*models.SearchHit, 0, limit)
+	for rows.Next() {
+		var (
+			p   models.Post
+			hit models.SearchHit
+		)
+
+		err := rows.Scan(
+			&p.ID,
+			&p.Title,
+			&p.Body,
+			&p.Author,
+			&p.CommentPolicy,
+			&p.CommentsCloseAt,
+			&p.CreatedAt,
+			&p.Tags,
+			&hit.Rank,
+			&hit.Snippet,
+		)
+		if err != nil {
+			return nil, err
+		}
+		hit.Snippet = highlight.HTML(hit.Snippet)
+
+		hit.Post = &p
+		hits = append(hits, &hit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+func (r *post) SearchCount(ctx context.Context, query string) (int64, error) {
+	var count int64
+
+	err := r.conn(ctx).QueryRow(ctx, searchPostsCountQuery, query).Scan(&count)
+
+	return count, err
+}
@@ -0,0 +1,20 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	setPostCommentPolicyQuery = `
+		update posts
+		set comment_policy = $2, comments_close_at = $3
+		where id = $1 and deleted_at is null
+		returning id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, ` + postTagsColumn + `
+	`
+)
+
+func (r *post) SetCommentPolicy(ctx context.Context, postID int64, policy models.CommentPolicy, closeAt *string) (*models.Post, error) {
+	return r.scanPost(r.conn(ctx).QueryRow(ctx, setPostCommentPolicyQuery, postID, policy, closeAt))
+}
@@ -0,0 +1,53 @@
+package post
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	// postTagsColumn aggregates the sorted tag names of the current posts row,
+	// so it can be appended to both select lists and returning clauses.
+	postTagsColumn = `coalesce((
+			select array_agg(t.name order by t.name)
+			from post_tags pt join tags t on t.id = pt.tag_id
+			where pt.post_id = posts.id
+		), '{}') as tags`
+
+	getTagsQuery = `
+		select t.name
+		from tags t
+		where t.name like $1 escape '\'
+			and exists (
+				select 1 from post_tags pt join posts p on p.id = pt.post_id
+				where pt.tag_id = t.id and p.deleted_at is null
+			)
+		order by t.name
+		limit $2
+	`
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *post) Tags(ctx context.Context, prefix string, limit int32) ([]string, error) {
+	rows, err := r.conn(ctx).Query(ctx, getTagsQuery, likeEscaper.Replace(prefix)+"%", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]string, 0, limit)
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,47 @@
+package post
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	updatePostQuery = `
+		update posts
+		set title = coalesce($2, title), body = coalesce($3, body)
+		where id = $1 and deleted_at is null
+		returning id, title, body, author, comment_policy, comments_close_at, created_at, deleted_at, ` + postTagsColumn + `
+	`
+)
+
+func (r *post) Update(ctx context.Context, postID int64, title, body *string) (*models.Post, error) {
+	return r.scanPost(r.conn(ctx).QueryRow(ctx, updatePostQuery, postID, title, body))
+}
+
+func (r *post) scanPost(row pgx.Row) (*models.Post, error) {
+	var out models.Post
+
+	err := row.Scan(
+		&out.ID,
+		&out.Title,
+		&out.Body,
+		&out.Author,
+		&out.CommentPolicy,
+		&out.CommentsCloseAt,
+		&out.CreatedAt,
+		&out.DeletedAt,
+		&out.Tags,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,32 @@
+package reaction
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+var (
+	ErrPostNotFound     = errors.New("post not found")
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrReactionNotFound = errors.New("reaction not found")
+	ErrUnknownTarget    = errors.New("unknown reaction target")
+)
+
+type reaction struct {
+	db DB
+}
+
+func New(db DB) repository.ReactionUC {
+	return &reaction{
+		db: db,
+	}
+}
+
+type DB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
@@ -0,0 +1,142 @@
+package reaction
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// Posts and comments keep reactions in separate tables so each can reference
+// its target with a cascading foreign key. Set inserts through a select on the
+// target, which yields no row for missing, soft deleted or tombstoned ones.
+const (
+	setPostReactionQuery = `
+		insert into post_reactions (post_id, author, kind, created_at)
+		select id, $2, $3, $4 from posts where id = $1 and deleted_at is null
+		on conflict (post_id, author) do update set kind = excluded.kind, created_at = excluded.created_at
+		returning post_id
+	`
+
+	setCommentReactionQuery = `
+		insert into comment_reactions (comment_id, author, kind, created_at)
+		select id, $2, $3, $4 from comments where id = $1 and not is_deleted and status = 'PUBLISHED'
+		on conflict (comment_id, author) do update set kind = excluded.kind, created_at = excluded.created_at
+		returning comment_id
+	`
+
+	removePostReactionQuery = `
+		delete from post_reactions where post_id = $1 and author = $2 returning post_id
+	`
+
+	removeCommentReactionQuery = `
+		delete from comment_reactions where comment_id = $1 and author = $2 returning comment_id
+	`
+
+	countPostReactionsQuery = `
+		select post_id, kind, count(*)
+		from post_reactions
+		where post_id = any($1)
+		group by post_id, kind
+		order by post_id, kind
+	`
+
+	countCommentReactionsQuery = `
+		select comment_id, kind, count(*)
+		from comment_reactions
+		where comment_id = any($1)
+		group by comment_id, kind
+		order by comment_id, kind
+	`
+)
+
+type queries struct {
+	set      string
+	remove   string
+	count    string
+	notFound error
+}
+
+var targetQueries = map[models.ReactionTarget]queries{
+	models.ReactionTargetPost: {
+		set:      setPostReactionQuery,
+		remove:   removePostReactionQuery,
+		count:    countPostReactionsQuery,
+		notFound: ErrPostNotFound,
+	},
+	models.ReactionTargetComment: {
+		set:      setCommentReactionQuery,
+		remove:   removeCommentReactionQuery,
+		count:    countCommentReactionsQuery,
+		notFound: ErrCommentNotFound,
+	},
+}
+
+func (r *reaction) Set(ctx context.Context, reaction models.Reaction) error {
+	q, ok := targetQueries[reaction.Target]
+	if !ok {
+		return ErrUnknownTarget
+	}
+
+	var id int64
+	err := r.db.QueryRow(ctx, q.set,
+		reaction.TargetID,
+		reaction.Author,
+		reaction.Kind,
+		reaction.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return q.notFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *reaction) Remove(ctx context.Context, target models.ReactionTarget, targetID int64, author string) error {
+	q, ok := targetQueries[target]
+	if !ok {
+		return ErrUnknownTarget
+	}
+
+	var id int64
+	err := r.db.QueryRow(ctx, q.remove, targetID, author).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrReactionNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *reaction) CountBatch(ctx context.Context, target models.ReactionTarget, targetIDs []int64) ([]*models.ReactionCount, error) {
+	q, ok := targetQueries[target]
+	if !ok {
+		return nil, ErrUnknownTarget
+	}
+
+	rows, err := r.db.Query(ctx, q.count, targetIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []*models.ReactionCount
+	for rows.Next() {
+		var c models.ReactionCount
+
+		if err := rows.Scan(&c.TargetID, &c.Kind, &c.Count); err != nil {
+			return nil, err
+		}
+
+		counts = append(counts, &c)
+	}
+
+	return counts, rows.Err()
+}
@@ -0,0 +1,256 @@
+package reaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pashagolub/pgxmock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	postReaction := models.Reaction{
+		Target:    models.ReactionTargetPost,
+		TargetID:  1,
+		Author:    "Alice",
+		Kind:      models.ReactionUpvote,
+		CreatedAt: now,
+	}
+	commentReaction := models.Reaction{
+		Target:    models.ReactionTargetComment,
+		TargetID:  7,
+		Author:    "Bob",
+		Kind:      "like",
+		CreatedAt: now,
+	}
+
+	tests := []struct {
+		name        string
+		reaction    models.Reaction
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		expectedErr error
+	}{
+		{
+			name:     "post_success",
+			reaction: postReaction,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`insert into post_reactions .* from posts where id = \$1 and deleted_at is null on conflict \(post_id, author\) do update`).
+					WithArgs(postReaction.TargetID, postReaction.Author, postReaction.Kind, postReaction.CreatedAt).
+					WillReturnRows(pgxmock.NewRows([]string{"post_id"}).AddRow(int64(1)))
+			},
+		},
+		{
+			name:     "comment_success",
+			reaction: commentReaction,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`insert into comment_reactions .* from comments where id = \$1 and not is_deleted`).
+					WithArgs(commentReaction.TargetID, commentReaction.Author, commentReaction.Kind, commentReaction.CreatedAt).
+					WillReturnRows(pgxmock.NewRows([]string{"comment_id"}).AddRow(int64(7)))
+			},
+		},
+		{
+			name:     "post_not_found",
+			reaction: postReaction,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`insert into post_reactions`).
+					WithArgs(postReaction.TargetID, postReaction.Author, postReaction.Kind, postReaction.CreatedAt).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: ErrPostNotFound,
+		},
+		{
+			name:     "comment_not_found",
+			reaction: commentReaction,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`insert into comment_reactions`).
+					WithArgs(commentReaction.TargetID, commentReaction.Author, commentReaction.Kind, commentReaction.CreatedAt).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: ErrCommentNotFound,
+		},
+		{
+			name:        "unknown_target",
+			reaction:    models.Reaction{Target: "USER", TargetID: 1},
+			setupMock:   func(mock pgxmock.PgxPoolIface) {},
+			expectedErr: ErrUnknownTarget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			err = r.Set(context.Background(), tt.reaction)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		target      models.ReactionTarget
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			target: models.ReactionTargetPost,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`delete from post_reactions where post_id = \$1 and author = \$2`).
+					WithArgs(int64(1), "Alice").
+					WillReturnRows(pgxmock.NewRows([]string{"post_id"}).AddRow(int64(1)))
+			},
+		},
+		{
+			name:   "not_found",
+			target: models.ReactionTargetComment,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`delete from comment_reactions where comment_id = \$1 and author = \$2`).
+					WithArgs(int64(1), "Alice").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr:     true,
+			expectedErr: ErrReactionNotFound,
+		},
+		{
+			name:   "db_error",
+			target: models.ReactionTargetPost,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`delete from post_reactions`).
+					WithArgs(int64(1), "Alice").
+					WillReturnError(errors.New("delete failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name:        "unknown_target",
+			target:      "USER",
+			setupMock:   func(mock pgxmock.PgxPoolIface) {},
+			wantErr:     true,
+			expectedErr: ErrUnknownTarget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			err = r.Remove(context.Background(), tt.target, 1, "Alice")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCountBatch(t *testing.T) {
+	t.Parallel()
+
+	ids := []int64{1, 2}
+
+	tests := []struct {
+		name      string
+		target    models.ReactionTarget
+		setupMock func(mock pgxmock.PgxPoolIface)
+		want      []*models.ReactionCount
+		wantErr   bool
+	}{
+		{
+			name:   "success",
+			target: models.ReactionTargetComment,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select comment_id, kind, count\(\*\) from comment_reactions where comment_id = any\(\$1\) group by comment_id, kind`).
+					WithArgs(ids).
+					WillReturnRows(pgxmock.NewRows([]string{"comment_id", "kind", "count"}).
+						AddRow(int64(1), "like", int32(3)).
+						AddRow(int64(1), models.ReactionUpvote, int32(2)).
+						AddRow(int64(2), models.ReactionDownvote, int32(1)))
+			},
+			want: []*models.ReactionCount{
+				{TargetID: 1, Kind: "like", Count: 3},
+				{TargetID: 1, Kind: models.ReactionUpvote, Count: 2},
+				{TargetID: 2, Kind: models.ReactionDownvote, Count: 1},
+			},
+		},
+		{
+			name:   "db_error",
+			target: models.ReactionTargetPost,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select post_id, kind, count\(\*\) from post_reactions`).
+					WithArgs(ids).
+					WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "unknown_target",
+			target:    "USER",
+			setupMock: func(mock pgxmock.PgxPoolIface) {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.CountBatch(context.Background(), tt.target, ids)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package report
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	addAuditEntryQuery = `
+		insert into moderation_log (actor, action, target_type, target_id, report_id, details, created_at)
+		values ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	// The log is read newest first, so the cursor points backwards in time.
+	getAuditLogQuery = `
+		select id, actor, action, target_type, target_id, report_id, details, created_at
+		from moderation_log
+		where $1::text is null or (created_at, id) < ($1::text, $2::bigint)
+		order by created_at desc, id desc
+		limit $3
+	`
+
+	countAuditLogQuery = `select count(*) from moderation_log`
+)
+
+func (r *report) Log(ctx context.Context, entry models.AuditEntry) error {
+	_, err := r.conn(ctx).Exec(ctx, addAuditEntryQuery,
+		entry.Actor,
+		entry.Action,
+		entry.Target,
+		entry.TargetID,
+		entry.ReportID,
+		entry.Details,
+		entry.CreatedAt,
+	)
+
+	return err
+}
+
+func (r *report) GetLog(ctx context.Context, beforeCreatedAt *string, beforeID int64, limit int32) ([]*models.AuditEntry, error) {
+	rows, err := r.conn(ctx).Query(ctx, getAuditLogQuery, beforeCreatedAt, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*models.AuditEntry, 0, limit)
+	for rows.Next() {
+		var e models.AuditEntry
+
+		err := rows.Scan(
+			&e.ID,
+			&e.Actor,
+			&e.Action,
+			&e.Target,
+			&e.TargetID,
+			&e.ReportID,
+			&e.Details,
+			&e.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &e)
+	}
+
+	return entries, rows.Err()
+}
+
+func (r *report) LogCount(ctx context.Context) (int64, error) {
+	var count int64
+
+	err := r.conn(ctx).QueryRow(ctx, countAuditLogQuery).Scan(&count)
+
+	return count, err
+}
@@ -0,0 +1,38 @@
+package report
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/postgres/tx"
+)
+
+var (
+	ErrReportNotFound = errors.New("report not found")
+	ErrReportResolved = errors.New("report is already resolved")
+)
+
+type report struct {
+	db DB
+}
+
+func New(db DB) repository.ReportUC {
+	return &report{
+		db: db,
+	}
+}
+
+type DB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// conn is the transaction ctx runs in, or the pool outside of one.
+func (r *report) conn(ctx context.Context) DB {
+	return tx.Conn(ctx, r.db)
+}
@@ -0,0 +1,173 @@
+package report
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	reportColumns = `id, target_type, target_id, target_author, reporter, caller, reason, details, status, action, target_hidden, created_at, resolved_at`
+
+	// A repeated report by the same reporter inserts nothing while the first
+	// one is open; the original is read back instead.
+	addReportQuery = `
+		insert into reports (target_type, target_id, target_author, reporter, caller, reason, details, created_at)
+		values ($1, $2, $3, $4, $5, $6, $7, $8)
+		on conflict (target_type, target_id, reporter) where status = 'OPEN' do nothing
+		returning ` + reportColumns + `
+	`
+
+	getReportByReporterQuery = `
+		select ` + reportColumns + `
+		from reports
+		where target_type = $1 and target_id = $2 and reporter = $3 and status = 'OPEN'
+	`
+
+	getReportByIdQuery = `
+		select ` + reportColumns + `
+		from reports
+		where id = $1
+	`
+
+	// A reporter has at most one open report on a target, so counting the
+	// open reports counts the distinct reporters.
+	countOpenReportersQuery = `
+		select count(*)
+		from reports
+		where target_type = $1 and target_id = $2 and status = 'OPEN'
+	`
+
+	countReporterReportsQuery = `
+		select count(*)
+		from reports
+		where reporter = $1 and created_at >= $2
+	`
+
+	markTargetHiddenQuery = `
+		update reports
+		set target_hidden = true
+		where target_type = $1 and target_id = $2 and status = 'OPEN'
+	`
+
+	// Resolving a report settles every open report on the same target, as the
+	// action was taken on the target and not on one complaint.
+	resolveReportQuery = `
+		with target as (
+			select target_type, target_id
+			from reports
+			where id = $1 and status = 'OPEN'
+		), resolved as (
+			update reports r
+			set status = 'RESOLVED', action = $2, resolved_at = $3
+			from target t
+			where r.target_type = t.target_type and r.target_id = t.target_id and r.status = 'OPEN'
+			returning r.id, r.target_type, r.target_id, r.target_author, r.reporter, r.caller, r.reason, r.details,
+				r.status, r.action, r.target_hidden, r.created_at, r.resolved_at
+		)
+		select ` + reportColumns + ` from resolved where id = $1
+	`
+)
+
+func (r *report) Add(ctx context.Context, report models.Report) (*models.Report, bool, error) {
+	out, err := scanReport(r.conn(ctx).QueryRow(ctx, addReportQuery,
+		report.Target,
+		report.TargetID,
+		report.TargetAuthor,
+		report.Reporter,
+		report.Caller,
+		report.Reason,
+		report.Details,
+		report.CreatedAt,
+	))
+	if err == nil {
+		return out, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+
+	out, err = scanReport(r.conn(ctx).QueryRow(ctx, getReportByReporterQuery, report.Target, report.TargetID, report.Reporter))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return out, false, nil
+}
+
+func (r *report) GetByID(ctx context.Context, reportID int64) (*models.Report, error) {
+	out, err := scanReport(r.conn(ctx).QueryRow(ctx, getReportByIdQuery, reportID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReportNotFound
+		}
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ReporterCount is the number of distinct reporters with an open report on the
+// target.
+func (r *report) ReporterCount(ctx context.Context, target models.ReportTarget, targetID int64) (int64, error) {
+	var count int64
+
+	err := r.conn(ctx).QueryRow(ctx, countOpenReportersQuery, target, targetID).Scan(&count)
+
+	return count, err
+}
+
+// CountByReporter is the number of reports the reporter filed since the given
+// time.
+func (r *report) CountByReporter(ctx context.Context, reporter, since string) (int64, error) {
+	var count int64
+
+	err := r.conn(ctx).QueryRow(ctx, countReporterReportsQuery, reporter, since).Scan(&count)
+
+	return count, err
+}
+
+func (r *report) MarkHidden(ctx context.Context, target models.ReportTarget, targetID int64) error {
+	_, err := r.conn(ctx).Exec(ctx, markTargetHiddenQuery, target, targetID)
+	return err
+}
+
+func (r *report) Resolve(ctx context.Context, reportID int64, action models.ReportAction, resolvedAt string) (*models.Report, error) {
+	out, err := scanReport(r.conn(ctx).QueryRow(ctx, resolveReportQuery, reportID, action, resolvedAt))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReportResolved
+		}
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func scanReport(row pgx.Row) (*models.Report, error) {
+	var out models.Report
+
+	err := row.Scan(
+		&out.ID,
+		&out.Target,
+		&out.TargetID,
+		&out.TargetAuthor,
+		&out.Reporter,
+		&out.Caller,
+		&out.Reason,
+		&out.Details,
+		&out.Status,
+		&out.Action,
+		&out.TargetHidden,
+		&out.CreatedAt,
+		&out.ResolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,321 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pashagolub/pgxmock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+var reportRowColumns = []string{"id", "target_type", "target_id", "target_author", "reporter", "caller", "reason", "details", "status", "action", "target_hidden", "created_at", "resolved_at"}
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	details := "Links to a casino"
+	in := models.Report{
+		Target:       models.ReportTargetComment,
+		TargetID:     7,
+		TargetAuthor: "Bob",
+		Reporter:     "Alice",
+		Caller:       "203.0.113.7",
+		Reason:       models.ReportReasonSpam,
+		Details:      &details,
+		CreatedAt:    "2026-02-13T10:00:00Z",
+	}
+	stored := models.Report{
+		ID:           3,
+		Target:       models.ReportTargetComment,
+		TargetID:     7,
+		TargetAuthor: "Bob",
+		Reporter:     "Alice",
+		Caller:       "203.0.113.7",
+		Reason:       models.ReportReasonSpam,
+		Details:      &details,
+		Status:       models.ReportStatusOpen,
+		CreatedAt:    "2026-02-13T10:00:00Z",
+	}
+	row := func() *pgxmock.Rows {
+		return pgxmock.NewRows(reportRowColumns).
+			AddRow(int64(3), models.ReportTargetComment, int64(7), "Bob", "Alice", "203.0.113.7", models.ReportReasonSpam, &details, models.ReportStatusOpen, nil, false, "2026-02-13T10:00:00Z", nil)
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		want        *models.Report
+		wantCreated bool
+		wantErr     bool
+	}{
+		{
+			name: "created",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`insert into reports \(target_type, target_id, target_author, reporter, caller, reason, details, created_at\) values .* on conflict \(target_type, target_id, reporter\) where status = 'OPEN' do nothing returning`).
+					WithArgs(in.Target, in.TargetID, in.TargetAuthor, in.Reporter, in.Caller, in.Reason, in.Details, in.CreatedAt).
+					WillReturnRows(row())
+			},
+			want:        &stored,
+			wantCreated: true,
+		},
+		{
+			name: "duplicate",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`insert into reports`).
+					WithArgs(in.Target, in.TargetID, in.TargetAuthor, in.Reporter, in.Caller, in.Reason, in.Details, in.CreatedAt).
+					WillReturnError(pgx.ErrNoRows)
+				mock.ExpectQuery(`select .* from reports where target_type = \$1 and target_id = \$2 and reporter = \$3 and status = 'OPEN'`).
+					WithArgs(in.Target, in.TargetID, in.Reporter).
+					WillReturnRows(row())
+			},
+			want: &stored,
+		},
+		{
+			name: "db_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`insert into reports`).
+					WithArgs(in.Target, in.TargetID, in.TargetAuthor, in.Reporter, in.Caller, in.Reason, in.Details, in.CreatedAt).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, created, err := r.Add(context.Background(), in)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantCreated, created)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetByID(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`select .* from reports where id = \$1`).
+		WithArgs(int64(3)).
+		WillReturnError(pgx.ErrNoRows)
+
+	r := New(mock)
+	got, err := r.GetByID(context.Background(), 3)
+
+	assert.ErrorIs(t, err, ErrReportNotFound)
+	assert.Nil(t, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReporterCountAndMarkHidden(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`select count\(\*\) from reports where target_type = \$1 and target_id = \$2 and status = 'OPEN'`).
+		WithArgs(models.ReportTargetPost, int64(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(4)))
+	mock.ExpectExec(`update reports set target_hidden = true where target_type = \$1 and target_id = \$2 and status = 'OPEN'`).
+		WithArgs(models.ReportTargetPost, int64(1)).
+		WillReturnResult(pgconn.CommandTag("UPDATE 4"))
+
+	r := New(mock)
+
+	count, err := r.ReporterCount(context.Background(), models.ReportTargetPost, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	assert.NoError(t, r.MarkHidden(context.Background(), models.ReportTargetPost, 1))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountByReporter(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`select count\(\*\) from reports where reporter = \$1 and created_at >= \$2`).
+		WithArgs("Alice", "2026-02-13T09:00:00Z").
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(3)))
+
+	count, err := New(mock).CountByReporter(context.Background(), "Alice", "2026-02-13T09:00:00Z")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	action := models.ReportActionHide
+	resolvedAt := "2026-02-13T11:00:00Z"
+
+	tests := []struct {
+		name      string
+		setupMock func(mock pgxmock.PgxPoolIface)
+		want      *models.Report
+		wantErr   error
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with target as \( select target_type, target_id from reports where id = \$1 and status = 'OPEN' \), resolved as \( update reports r set status = 'RESOLVED', action = \$2, resolved_at = \$3 from target t where r.target_type = t.target_type and r.target_id = t.target_id and r.status = 'OPEN' returning .* \) select .* from resolved where id = \$1`).
+					WithArgs(int64(3), action, resolvedAt).
+					WillReturnRows(pgxmock.NewRows(reportRowColumns).
+						AddRow(int64(3), models.ReportTargetPost, int64(1), "Bob", "Alice", "203.0.113.7", models.ReportReasonAbuse, nil, models.ReportStatusResolved, &action, true, "2026-02-13T10:00:00Z", &resolvedAt))
+			},
+			want: &models.Report{
+				ID:           3,
+				Target:       models.ReportTargetPost,
+				TargetID:     1,
+				TargetAuthor: "Bob",
+				Reporter:     "Alice",
+				Caller:       "203.0.113.7",
+				Reason:       models.ReportReasonAbuse,
+				Status:       models.ReportStatusResolved,
+				Action:       &action,
+				TargetHidden: true,
+				CreatedAt:    "2026-02-13T10:00:00Z",
+				ResolvedAt:   &resolvedAt,
+			},
+		},
+		{
+			name: "already_resolved",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with target as`).
+					WithArgs(int64(3), action, resolvedAt).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: ErrReportResolved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.Resolve(context.Background(), 3, action, resolvedAt)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	afterCreatedAt := "2026-02-13T09:00:00Z"
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`select .* from reports where status = \$1 and \(\$2::text is null or \(created_at, id\) > \(\$2::text, \$3::bigint\)\) order by created_at, id limit \$4`).
+		WithArgs(models.ReportStatusOpen, &afterCreatedAt, int64(2), int32(3)).
+		WillReturnRows(pgxmock.NewRows(reportRowColumns).
+			AddRow(int64(3), models.ReportTargetPost, int64(1), "Bob", "Alice", "203.0.113.7", models.ReportReasonOther, nil, models.ReportStatusOpen, nil, false, "2026-02-13T10:00:00Z", nil))
+	mock.ExpectQuery(`select count\(\*\) from reports where status = \$1`).
+		WithArgs(models.ReportStatusOpen).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(2)))
+
+	r := New(mock)
+
+	got, err := r.Get(context.Background(), models.ReportStatusOpen, &afterCreatedAt, 2, 3)
+	assert.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, int64(3), got[0].ID)
+
+	count, err := r.Count(context.Background(), models.ReportStatusOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+
+	reportID := int64(3)
+	details := "SPAM"
+	entry := models.AuditEntry{
+		Actor:     "Alice",
+		Action:    models.AuditActionReport,
+		Target:    models.ReportTargetComment,
+		TargetID:  7,
+		ReportID:  &reportID,
+		Details:   &details,
+		CreatedAt: "2026-02-13T10:00:00Z",
+	}
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec(`insert into moderation_log \(actor, action, target_type, target_id, report_id, details, created_at\) values`).
+		WithArgs(entry.Actor, entry.Action, entry.Target, entry.TargetID, entry.ReportID, entry.Details, entry.CreatedAt).
+		WillReturnResult(pgconn.CommandTag("INSERT 0 1"))
+	mock.ExpectQuery(`select id, actor, action, target_type, target_id, report_id, details, created_at from moderation_log where \$1::text is null or \(created_at, id\) < \(\$1::text, \$2::bigint\) order by created_at desc, id desc limit \$3`).
+		WithArgs((*string)(nil), int64(0), int32(10)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "actor", "action", "target_type", "target_id", "report_id", "details", "created_at"}).
+			AddRow(int64(1), entry.Actor, entry.Action, entry.Target, entry.TargetID, entry.ReportID, entry.Details, entry.CreatedAt))
+	mock.ExpectQuery(`select count\(\*\) from moderation_log`).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(1)))
+
+	r := New(mock)
+
+	require.NoError(t, r.Log(context.Background(), entry))
+
+	got, err := r.GetLog(context.Background(), nil, 0, 10)
+	assert.NoError(t, err)
+	want := entry
+	want.ID = 1
+	assert.Equal(t, []*models.AuditEntry{&want}, got)
+
+	count, err := r.LogCount(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
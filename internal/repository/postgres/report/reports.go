@@ -0,0 +1,58 @@
+package report
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	// Reports are triaged oldest first, like the moderation queue.
+	getReportsQuery = `
+		select ` + reportColumns + `
+		from reports
+		where status = $1
+			and ($2::text is null or (created_at, id) > ($2::text, $3::bigint))
+		order by created_at, id
+		limit $4
+	`
+
+	countReportsQuery = `
+		select count(*)
+		from reports
+		where status = $1
+	`
+)
+
+func (r *report) Get(ctx context.Context, status models.ReportStatus, afterCreatedAt *string, afterID int64, limit int32) ([]*models.Report, error) {
+	rows, err := r.conn(ctx).Query(ctx, getReportsQuery,
+		status,
+		afterCreatedAt,
+		afterID,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports := make([]*models.Report, 0, limit)
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
+
+func (r *report) Count(ctx context.Context, status models.ReportStatus) (int64, error) {
+	var count int64
+
+	err := r.conn(ctx).QueryRow(ctx, countReportsQuery, status).Scan(&count)
+
+	return count, err
+}
@@ -0,0 +1,24 @@
+package spam
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+type spam struct {
+	db DB
+}
+
+func New(db DB) repository.SpamUC {
+	return &spam{
+		db: db,
+	}
+}
+
+type DB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
@@ -0,0 +1,77 @@
+package spam
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	getSpamTotalsQuery = `
+		select spam_docs, ham_docs
+		from spam_totals
+	`
+
+	getSpamTokensQuery = `
+		select token, spam_count, ham_count
+		from spam_tokens
+	`
+
+	// One comment bumps the document total of its class and, once each, the
+	// counters of its tokens.
+	trainSpamQuery = `
+		with totals as (
+			update spam_totals
+			set spam_docs = spam_docs + case when $2 then 1 else 0 end,
+				ham_docs = ham_docs + case when $2 then 0 else 1 end
+			returning spam_docs
+		), tokens as (
+			insert into spam_tokens (token, spam_count, ham_count)
+			select t, case when $2 then 1 else 0 end, case when $2 then 0 else 1 end
+			from unnest($1::text[]) as t
+			on conflict (token) do update
+			set spam_count = spam_tokens.spam_count + excluded.spam_count,
+				ham_count = spam_tokens.ham_count + excluded.ham_count
+		)
+		select spam_docs from totals
+	`
+)
+
+func (r *spam) LoadStats(ctx context.Context) (*models.SpamStats, error) {
+	stats := &models.SpamStats{Tokens: make(map[string]models.SpamTokenCount)}
+
+	err := r.db.QueryRow(ctx, getSpamTotalsQuery).Scan(&stats.SpamDocs, &stats.HamDocs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, getSpamTokensQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			token string
+			count models.SpamTokenCount
+		)
+		if err := rows.Scan(&token, &count.Spam, &count.Ham); err != nil {
+			return nil, err
+		}
+
+		stats.Tokens[token] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (r *spam) Train(ctx context.Context, tokens []string, isSpam bool) error {
+	var spamDocs int64
+
+	return r.db.QueryRow(ctx, trainSpamQuery, tokens, isSpam).Scan(&spamDocs)
+}
@@ -0,0 +1,150 @@
+package spam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func TestLoadStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setupMock func(mock pgxmock.PgxPoolIface)
+		want      *models.SpamStats
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select spam_docs, ham_docs from spam_totals`).
+					WillReturnRows(pgxmock.NewRows([]string{"spam_docs", "ham_docs"}).AddRow(int64(3), int64(5)))
+				mock.ExpectQuery(`select token, spam_count, ham_count from spam_tokens`).
+					WillReturnRows(pgxmock.NewRows([]string{"token", "spam_count", "ham_count"}).
+						AddRow("casino", int64(3), int64(0)).
+						AddRow("thanks", int64(0), int64(4)))
+			},
+			want: &models.SpamStats{
+				SpamDocs: 3,
+				HamDocs:  5,
+				Tokens: map[string]models.SpamTokenCount{
+					"casino": {Spam: 3},
+					"thanks": {Ham: 4},
+				},
+			},
+		},
+		{
+			name: "totals_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select spam_docs, ham_docs from spam_totals`).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "tokens_error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`select spam_docs, ham_docs from spam_totals`).
+					WillReturnRows(pgxmock.NewRows([]string{"spam_docs", "ham_docs"}).AddRow(int64(0), int64(0)))
+				mock.ExpectQuery(`select token, spam_count, ham_count from spam_tokens`).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			got, err := r.LoadStats(context.Background())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTrain(t *testing.T) {
+	t.Parallel()
+
+	tokens := []string{"buy", "pills"}
+
+	tests := []struct {
+		name      string
+		spam      bool
+		setupMock func(mock pgxmock.PgxPoolIface)
+		wantErr   bool
+	}{
+		{
+			name: "spam",
+			spam: true,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with totals as \( update spam_totals .* insert into spam_tokens .* from unnest\(\$1::text\[\]\) as t on conflict \(token\) do update .* select spam_docs from totals`).
+					WithArgs(tokens, true).
+					WillReturnRows(pgxmock.NewRows([]string{"spam_docs"}).AddRow(int64(1)))
+			},
+		},
+		{
+			name: "ham",
+			spam: false,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with totals as \( update spam_totals`).
+					WithArgs(tokens, false).
+					WillReturnRows(pgxmock.NewRows([]string{"spam_docs"}).AddRow(int64(0)))
+			},
+		},
+		{
+			name: "db_error",
+			spam: true,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`with totals as \( update spam_totals`).
+					WithArgs(tokens, true).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setupMock(mock)
+
+			r := New(mock)
+			err = r.Train(context.Background(), tokens, tt.spam)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
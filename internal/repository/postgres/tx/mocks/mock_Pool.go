@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgx "github.com/jackc/pgx/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPool is an autogenerated mock type for the Pool type
+type MockPool struct {
+	mock.Mock
+}
+
+type MockPool_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPool) EXPECT() *MockPool_Expecter {
+	return &MockPool_Expecter{mock: &_m.Mock}
+}
+
+// Begin provides a mock function with given fields: ctx
+func (_m *MockPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 pgx.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (pgx.Tx, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) pgx.Tx); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgx.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPool_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type MockPool_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPool_Expecter) Begin(ctx interface{}) *MockPool_Begin_Call {
+	return &MockPool_Begin_Call{Call: _e.mock.On("Begin", ctx)}
+}
+
+func (_c *MockPool_Begin_Call) Run(run func(ctx context.Context)) *MockPool_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPool_Begin_Call) Return(_a0 pgx.Tx, _a1 error) *MockPool_Begin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPool_Begin_Call) RunAndReturn(run func(context.Context) (pgx.Tx, error)) *MockPool_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPool creates a new instance of MockPool. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPool(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPool {
+	mock := &MockPool{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
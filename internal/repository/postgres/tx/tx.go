@@ -0,0 +1,59 @@
+package tx
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+type txKey struct{}
+
+type transactor struct {
+	pool Pool
+}
+
+// New returns a Transactor that runs its callbacks in transactions on pool.
+// Repositories take part by reading their connection through Conn.
+func New(pool Pool) repository.Transactor {
+	return &transactor{
+		pool: pool,
+	}
+}
+
+type Pool interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// InTx runs fn in a transaction it commits when fn succeeds and rolls back
+// otherwise. A call inside fn joins the transaction already running.
+func (t *transactor) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	// Rolling back a committed transaction does nothing.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Conn returns the transaction InTx runs ctx in, or db outside of one.
+func Conn[DB any](ctx context.Context, db DB) DB {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		if conn, ok := any(tx).(DB); ok {
+			return conn
+		}
+	}
+
+	return db
+}
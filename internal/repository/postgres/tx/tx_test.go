@@ -0,0 +1,90 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/pashagolub/pgxmock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func TestInTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("commits", func(t *testing.T) {
+		t.Parallel()
+
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`update reports`).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		err = New(mock).InTx(context.Background(), func(ctx context.Context) error {
+			conn := Conn[execer](ctx, mock)
+			assert.NotEqual(t, mock, conn, "calls inside InTx go through the transaction")
+
+			_, err := conn.Exec(ctx, "update reports set target_hidden = true")
+			return err
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls_back_on_error", func(t *testing.T) {
+		t.Parallel()
+
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err = New(mock).InTx(context.Background(), func(ctx context.Context) error {
+			return errors.New("hide failed")
+		})
+
+		assert.EqualError(t, err, "hide failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("nested_call_joins", func(t *testing.T) {
+		t.Parallel()
+
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		transactor := New(mock)
+		err = transactor.InTx(context.Background(), func(ctx context.Context) error {
+			return transactor.InTx(ctx, func(ctx context.Context) error { return nil })
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestConn_OutsideTx(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	assert.Equal(t, mock, Conn[execer](context.Background(), mock))
+}
@@ -1,16 +1,31 @@
 package repository
 
 type Container struct {
-	Post    PostUC
-	Comment CommentUC
+	Post     PostUC
+	Comment  CommentUC
+	Reaction ReactionUC
+	Spam     SpamUC
+	Report   ReportUC
+	Ban      BanUC
+	Tx       Transactor
 }
 
 func New(
 	postRepo PostUC,
 	commentRepo CommentUC,
+	reactionRepo ReactionUC,
+	spamRepo SpamUC,
+	reportRepo ReportUC,
+	banRepo BanUC,
+	tx Transactor,
 ) *Container {
 	return &Container{
-		Post:    postRepo,
-		Comment: commentRepo,
+		Post:     postRepo,
+		Comment:  commentRepo,
+		Reaction: reactionRepo,
+		Spam:     spamRepo,
+		Report:   reportRepo,
+		Ban:      banRepo,
+		Tx:       tx,
 	}
 }
@@ -7,7 +7,9 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 const (
@@ -15,7 +17,7 @@ const (
 )
 
 func (s *Service) AddComment(ctx context.Context, in models.AddCommentInput) (*models.Comment, error) {
-	postID, err := strconv.ParseInt(in.PostID, 10, 64)
+	postID, err := globalid.DecodeAs(globalid.Post, in.PostID)
 	if err != nil {
 		return nil, errors.New("invalid postID format")
 	}
@@ -23,55 +25,147 @@ func (s *Service) AddComment(ctx context.Context, in models.AddCommentInput) (*m
 		return nil, errors.New("postID must be greater 0")
 	}
 
-	if len(in.Text) > MaxCommentLenght {
-		return nil, errors.New("max comment length is 2000 char")
+	if err := checkCommentLength(in.Text); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkBanned(ctx, in.Author); err != nil {
+		return nil, err
 	}
 
-	allow, err := s.repo.CheckAllowComments(ctx, postID)
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	policy, err := s.repo.GetCommentPolicy(ctx, postID)
 	if err != nil {
 		return nil, err
 	}
-	if !allow {
-		return nil, errors.New("comments not allowed for this post")
+
+	isReply := in.ParentID != nil && *in.ParentID != ""
+	if err := checkAllowComments(policy, in.Author, isReply, now); err != nil {
+		return nil, err
 	}
 
-	parentID, err := s.processParent(ctx, in.ParentID, postID)
+	parentID, depth, err := s.processParent(ctx, in.ParentID, postID)
 	if err != nil {
 		return nil, err
 	}
 
-	now := time.Now().UTC().Format(time.RFC3339)
+	decision := s.content.Check(ctx, filter.Content{Author: in.Author, Text: in.Text})
+	if decision.Action == filter.Reject {
+		return nil, &filter.RejectedError{Decision: decision}
+	}
+
+	// The score is stored with the comment, so moderators see the score that
+	// decided whether it was held and not one the model has learned since.
+	var spamScore *float64
+	if score, ok := s.spam.Score(in.Text); ok {
+		spamScore = &score
+	}
+
+	// Comments on a moderated post, or held by the content filter or the spam
+	// classifier, wait until a moderator approves them; subscribers hear about
+	// them only then.
+	status := models.CommentStatusPublished
+	if policy.Policy == models.CommentPolicyModerated || decision.Action == filter.Hold || (spamScore != nil && s.spam.Hold(*spamScore)) {
+		status = models.CommentStatusPending
+	}
+
 	comment, err := s.repo.Add(ctx, models.Comment{
 		PostID:    postID,
 		ParentID:  parentID,
 		Author:    in.Author,
 		Text:      in.Text,
 		CreatedAt: now,
+		Depth:     depth,
+		Status:    status,
+		SpamScore: spamScore,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.content.Accept(ctx, filter.Content{Author: in.Author, Text: in.Text})
+
+	if status == models.CommentStatusPublished {
+		s.publish(ctx, models.EventCommentAdded, comment)
+	}
+
 	return comment, nil
 }
 
-func (s *Service) processParent(ctx context.Context, parentIDStr *string, postID int64) (*int64, error) {
+// checkBanned turns away comments by an author a moderator banned.
+func (s *Service) checkBanned(ctx context.Context, author string) error {
+	if s.bans == nil {
+		return nil
+	}
+
+	banned, err := s.bans.IsBanned(ctx, author)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return errors.New("author is banned")
+	}
+
+	return nil
+}
+
+func checkCommentLength(text string) error {
+	if len(text) > MaxCommentLenght {
+		return errors.New("max comment length is 2000 char")
+	}
+
+	return nil
+}
+
+// ReplyDepthError is returned when a reply would nest deeper than its post
+// allows and the reject policy is configured.
+type ReplyDepthError struct {
+	MaxDepth int32
+}
+
+func (e *ReplyDepthError) Error() string {
+	return "replies cannot be nested deeper than " + strconv.Itoa(int(e.MaxDepth)) + " levels"
+}
+
+// processParent resolves the parent of a new reply and the depth the reply is
+// stored at. Replies beyond the post's limit are rejected or, with the flatten
+// policy, attached to the deepest ancestor that still accepts replies.
+func (s *Service) processParent(ctx context.Context, parentIDStr *string, postID int64) (*int64, int32, error) {
 	if parentIDStr == nil || *parentIDStr == "" {
-		return nil, nil
+		return nil, 0, nil
 	}
 
-	pid, err := strconv.ParseInt(*parentIDStr, 10, 64)
+	pid, err := globalid.DecodeAs(globalid.Comment, *parentIDStr)
 	if err != nil {
-		return nil, errors.New("invalid parentID format")
+		return nil, 0, errors.New("invalid parentID format")
 	}
 
-	parentPostID, err := s.repo.CheckParentExists(ctx, pid)
+	parent, err := s.repo.GetReplyParent(ctx, pid)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	if parent.PostID != postID {
+		return nil, 0, errors.New("parent comment does not belong to this post")
+	}
+
+	depth := parent.Depth + 1
+	maxDepth := s.replyDepth.Max
+	if parent.MaxReplyDepth != nil {
+		maxDepth = *parent.MaxReplyDepth
 	}
-	if parentPostID != postID {
-		return nil, errors.New("parent comment does not belong to this post")
+	if maxDepth <= 0 || depth <= maxDepth {
+		return &pid, depth, nil
+	}
+
+	if s.replyDepth.Policy != models.ReplyDepthFlatten {
+		return nil, 0, &ReplyDepthError{MaxDepth: maxDepth}
+	}
+
+	ancestorID, err := s.repo.GetAncestorAt(ctx, pid, maxDepth-1)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return &pid, nil
+	return &ancestorID, maxDepth, nil
 }
@@ -2,7 +2,6 @@ package comment
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/graph-gophers/dataloader"
 	"github.com/pkg/errors"
@@ -10,15 +9,37 @@ import (
 	myLoader "github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/pagination"
 )
 
-func (s *Service) Children(ctx context.Context, parentID int64, first *int32, after *string) (*models.CommentConnection, error) {
+// Children returns a page of the replies to a comment through the children
+// dataloader, which fetches only the requested page of every parent.
+func (s *Service) Children(ctx context.Context, parentID int64, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error) {
+	order, err := parseCommentOrder(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := pagination.ParseWindow(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := cursor.ParseComment(order, window.Cursor); err != nil {
+		return nil, err
+	}
+
 	loader, ok := ctx.Value(myLoader.Key).(dataloader.Interface)
 	if !ok {
 		return nil, errors.New("dataloader not found in context")
 	}
 
-	thunk := loader.Load(ctx, dataloader.StringKey(strconv.FormatInt(parentID, 10)))
+	var pageCursor string
+	if window.Cursor != nil {
+		pageCursor = *window.Cursor
+	}
+
+	thunk := loader.Load(ctx, myLoader.ChildrenKeyFor(order, window.Limit+1, pageCursor, window.Backward, parentID))
 	result, err := thunk()
 	if err != nil {
 		return nil, err
@@ -29,58 +50,36 @@ func (s *Service) Children(ctx context.Context, parentID int64, first *int32, af
 		return nil, errors.New("unexpected data type from dataloader")
 	}
 
-	limit := s.getLimit(first)
-	var startIdx int
-
-	if after != nil && *after != "" {
-		createdAt, id, err := cursor.Decode(*after)
-		if err != nil {
-			return nil, errors.New("invalid cursor format")
-		}
-		for i, c := range children {
-			if c.CreatedAt == createdAt && c.ID == id {
-				startIdx = i + 1
-				break
-			}
-		}
-	}
+	hasMore, page := pagination.ExtractPage(children, window)
 
-	if startIdx >= len(children) {
-		return &models.CommentConnection{
-			Edges:      []*models.CommentEdge{},
-			PageInfo:   &models.PageInfo{HasNextPage: false},
-			TotalCount: int32(len(children)),
-		}, nil
-	}
+	edges := s.buildEdges(order, page)
 
-	endIdx := startIdx + int(limit)
-	hasNextPage := endIdx < len(children)
-	if endIdx > len(children) {
-		endIdx = len(children)
-		hasNextPage = false
+	totalCount, err := s.ReplyCount(ctx, parentID)
+	if err != nil {
+		return nil, err
 	}
 
-	page := children[startIdx:endIdx]
+	return s.buildConnection(edges, window, hasMore, totalCount), nil
+}
+
+// ReplyCount returns the number of direct replies to a comment through the
+// reply count dataloader.
+func (s *Service) ReplyCount(ctx context.Context, parentID int64) (int64, error) {
+	loader, ok := ctx.Value(myLoader.ReplyCountKey).(dataloader.Interface)
+	if !ok {
+		return 0, errors.New("dataloader not found in context")
+	}
 
-	edges := make([]*models.CommentEdge, len(page))
-	for i, c := range page {
-		edges[i] = &models.CommentEdge{
-			Cursor: cursor.Encode(c.CreatedAt, c.ID),
-			Node:   c,
-		}
+	thunk := loader.Load(ctx, myLoader.ReplyCountKeyFor(parentID))
+	result, err := thunk()
+	if err != nil {
+		return 0, err
 	}
 
-	var endCursor *string
-	if len(edges) > 0 {
-		endCursor = &edges[len(edges)-1].Cursor
+	count, ok := result.(int64)
+	if !ok {
+		return 0, errors.New("unexpected data type from dataloader")
 	}
 
-	return &models.CommentConnection{
-		Edges: edges,
-		PageInfo: &models.PageInfo{
-			EndCursor:   endCursor,
-			HasNextPage: hasNextPage,
-		},
-		TotalCount: int32(len(children)),
-	}, nil
+	return count, nil
 }
@@ -0,0 +1,22 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (s *Service) GetComment(ctx context.Context, commentID string) (*models.Comment, error) {
+	cID, err := parseCommentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, cID)
+}
+
+// Ancestors returns the chain above a comment starting at its thread root, so
+// a permalink can render the context of a deep reply.
+func (s *Service) Ancestors(ctx context.Context, commentID int64) ([]*models.Comment, error) {
+	return s.repo.GetAncestors(ctx, commentID)
+}
@@ -0,0 +1,148 @@
+package comment
+
+import (
+	"context"
+	"log"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+const replayPageLimit = 100
+
+func (s *Service) CommentAdded(ctx context.Context, postID string, after *string) (<-chan *models.Comment, error) {
+	pID, err := globalid.DecodeAs(globalid.Post, postID)
+	if err != nil {
+		return nil, errors.New("invalid postID format")
+	}
+	if pID <= 0 {
+		return nil, errors.New("postID must be greater 0")
+	}
+
+	pos, err := cursor.Parse(after)
+	if err != nil {
+		return nil, err
+	}
+
+	// Subscribe before reading the backlog: anything committed after the
+	// replay query is then guaranteed to arrive on the live channel.
+	subCtx, cancel := context.WithCancel(ctx)
+	live, err := s.broker.Subscribe(subCtx, pID)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The cursor names the last comment the client received. Replay goes by
+	// publish sequence from there, so comments approved after it are replayed
+	// even though they were written before it.
+	var seen int64
+	var page []*models.Comment
+	if pos.CreatedAt != nil {
+		seen, err = s.repo.GetPublishedSeq(ctx, pID, *pos.CreatedAt, pos.ID)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		page, err = s.repo.GetAddedAfter(ctx, pID, seen, replayPageLimit)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	queued := queueAdded(subCtx, live)
+	out := make(chan *models.Comment, 1)
+
+	go func() {
+		defer cancel()
+		defer close(out)
+
+		s.resume(subCtx, pID, page, seen, queued, out)
+	}()
+
+	return out, nil
+}
+
+// resume replays page and the pages after it, then forwards the live
+// comments. Live comments at or before seen, the publish sequence of the last
+// comment the client received, were already sent and are dropped. A comment
+// without a sequence is forwarded rather than risk losing it.
+func (s *Service) resume(ctx context.Context, postID int64, page []*models.Comment, seen int64, queued <-chan *models.Comment, out chan<- *models.Comment) {
+	for len(page) > 0 {
+		for _, c := range page {
+			select {
+			case out <- c:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		seen = page[len(page)-1].PublishedSeq
+
+		if len(page) < replayPageLimit {
+			break
+		}
+
+		var err error
+		page, err = s.repo.GetAddedAfter(ctx, postID, seen, replayPageLimit)
+		if err != nil {
+			log.Printf("failed to replay comments for post %d: %v", postID, err)
+			return
+		}
+	}
+
+	for c := range queued {
+		if c.PublishedSeq != 0 && c.PublishedSeq <= seen {
+			continue
+		}
+
+		select {
+		case out <- c:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// queueAdded reads the added comments from live into a local queue as soon as
+// they arrive. The broker buffers only a few events per subscriber and drops
+// subscribers that fall behind, so live must keep draining while the backlog
+// is still being replayed.
+func queueAdded(ctx context.Context, live <-chan *models.Event) <-chan *models.Comment {
+	queued := make(chan *models.Comment)
+
+	go func() {
+		defer close(queued)
+
+		var pending []*models.Comment
+		for live != nil || len(pending) > 0 {
+			var next chan<- *models.Comment
+			var head *models.Comment
+			if len(pending) > 0 {
+				next, head = queued, pending[0]
+			}
+
+			select {
+			case event, ok := <-live:
+				if !ok {
+					live = nil
+					continue
+				}
+				if event.Type == models.EventCommentAdded {
+					pending = append(pending, event.Comment)
+				}
+			case next <- head:
+				pending = pending[1:]
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return queued
+}
@@ -0,0 +1,68 @@
+package comment
+
+import (
+	"context"
+	"log"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (s *Service) CommentEvents(ctx context.Context, postID string) (<-chan *models.Event, error) {
+	pID, err := globalid.DecodeAs(globalid.Post, postID)
+	if err != nil {
+		return nil, errors.New("invalid postID format")
+	}
+	if pID <= 0 {
+		return nil, errors.New("postID must be greater 0")
+	}
+
+	live, err := s.broker.Subscribe(ctx, pID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *models.Event, 1)
+
+	go func() {
+		defer close(out)
+
+		for event := range live {
+			if !isCommentEvent(event.Type) {
+				continue
+			}
+
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func isCommentEvent(eventType models.EventType) bool {
+	switch eventType {
+	case models.EventCommentAdded, models.EventCommentUpdated, models.EventCommentDeleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// publish notifies subscribers about a change that is already persisted, so a
+// broker failure is only logged and never fails the mutation itself.
+func (s *Service) publish(ctx context.Context, eventType models.EventType, comment *models.Comment) {
+	err := s.broker.Publish(ctx, models.Event{
+		Type:    eventType,
+		PostID:  comment.PostID,
+		Comment: comment,
+	})
+	if err != nil {
+		log.Printf("failed to publish %s event for comment %d: %v", eventType, comment.ID, err)
+	}
+}
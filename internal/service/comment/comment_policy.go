@@ -0,0 +1,59 @@
+package comment
+
+import (
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// CommentPolicyError is returned when the comment policy of a post turns a
+// new comment away. Reason tells clients which rule applied.
+type CommentPolicyError struct {
+	Reason models.CommentDenialReason
+}
+
+func (e *CommentPolicyError) Error() string {
+	switch e.Reason {
+	case models.CommentDeniedAutoClosed:
+		return "comments for this post were closed automatically"
+	case models.CommentDeniedAuthorOnly:
+		return "only the post author can comment on this post"
+	case models.CommentDeniedRepliesOnly:
+		return "this post only accepts replies to existing comments"
+	default:
+		return "comments not allowed for this post"
+	}
+}
+
+// checkAllowComments evaluates the comment policy of a post for a new comment
+// by author at now. A CLOSED policy or a passed auto-close time rejects every
+// comment; policies this service does not know reject as CLOSED. MODERATED
+// accepts the comment, AddComment holds it for review.
+//
+// AUTHOR_ONLY compares the author name the client sent with the post author.
+// The API has no accounts to authenticate that name against, so the policy
+// only keeps out clients that do not know or do not claim the author's name.
+func checkAllowComments(policy *models.PostCommentPolicy, author string, isReply bool, now string) error {
+	if policy.Policy == models.CommentPolicyClosed {
+		return &CommentPolicyError{Reason: models.CommentDeniedClosed}
+	}
+
+	if policy.CloseAt != nil && now >= *policy.CloseAt {
+		return &CommentPolicyError{Reason: models.CommentDeniedAutoClosed}
+	}
+
+	switch policy.Policy {
+	case models.CommentPolicyOpen, models.CommentPolicyModerated:
+		return nil
+	case models.CommentPolicyAuthorOnly:
+		if author != policy.Author {
+			return &CommentPolicyError{Reason: models.CommentDeniedAuthorOnly}
+		}
+		return nil
+	case models.CommentPolicyRepliesOnly:
+		if !isReply {
+			return &CommentPolicyError{Reason: models.CommentDeniedRepliesOnly}
+		}
+		return nil
+	default:
+		return &CommentPolicyError{Reason: models.CommentDeniedClosed}
+	}
+}
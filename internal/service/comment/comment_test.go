@@ -6,13 +6,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/graph-gophers/dataloader"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
+	myLoader "github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	inmemoryPubsub "github.com/Saracomethstein/ozon-test-task/internal/pubsub/inmemory"
+	pubsubMocks "github.com/Saracomethstein/ozon-test-task/internal/pubsub/mocks"
+	inmemoryComment "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/comment"
+	inmemoryPost "github.com/Saracomethstein/ozon-test-task/internal/repository/inmemory/post"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/spam"
 	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func TestService_AddComment(t *testing.T) {
@@ -21,14 +31,17 @@ func TestService_AddComment(t *testing.T) {
 	now := time.Now().UTC().Format(time.RFC3339)
 
 	parentID := int64(10)
-	parentIDStr := "10"
+	parentIDStr := globalid.Encode(globalid.Comment, 10)
 	postID := int64(1)
-	postIDStr := "1"
+	postIDStr := globalid.Encode(globalid.Post, 1)
+	openPolicy := &models.PostCommentPolicy{Policy: models.CommentPolicyOpen}
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
 
 	tests := []struct {
 		name        string
 		input       models.AddCommentInput
-		setupMock   func(repo *mocks.MockCommentUC)
+		setupMock   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker)
 		want        *models.Comment
 		wantErr     bool
 		expectedErr string
@@ -41,10 +54,10 @@ func TestService_AddComment(t *testing.T) {
 				Author:   "Alice",
 				Text:     "Hello",
 			},
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("CheckAllowComments", context.Background(), postID).Return(true, nil)
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", context.Background(), postID).Return(openPolicy, nil)
 				repo.On("Add", mock.Anything, mock.MatchedBy(func(c models.Comment) bool {
-					return c.PostID == postID && c.ParentID == nil && c.Author == "Alice" && c.Text == "Hello" && c.CreatedAt != ""
+					return c.PostID == postID && c.ParentID == nil && c.Author == "Alice" && c.Text == "Hello" && c.CreatedAt != "" && c.Status == models.CommentStatusPublished
 				})).Return(&models.Comment{
 					ID:        1,
 					PostID:    postID,
@@ -53,6 +66,9 @@ func TestService_AddComment(t *testing.T) {
 					Text:      "Hello",
 					CreatedAt: now,
 				}, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentAdded && e.PostID == postID && e.Comment.ID == 1
+				})).Return(nil)
 			},
 			want: &models.Comment{
 				ID:        1,
@@ -71,11 +87,11 @@ func TestService_AddComment(t *testing.T) {
 				Author:   "Bob",
 				Text:     "Reply",
 			},
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("CheckAllowComments", mock.Anything, postID).Return(true, nil)
-				repo.On("CheckParentExists", mock.Anything, parentID).Return(postID, nil)
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+				repo.On("GetReplyParent", mock.Anything, parentID).Return(&models.ReplyParent{PostID: postID, Depth: 0}, nil)
 				repo.On("Add", mock.Anything, mock.MatchedBy(func(c models.Comment) bool {
-					return c.PostID == postID && *c.ParentID == parentID && c.Author == "Bob" && c.Text == "Reply"
+					return c.PostID == postID && *c.ParentID == parentID && c.Author == "Bob" && c.Text == "Reply" && c.Depth == 1
 				})).Return(&models.Comment{
 					ID:        2,
 					PostID:    postID,
@@ -84,6 +100,9 @@ func TestService_AddComment(t *testing.T) {
 					Text:      "Reply",
 					CreatedAt: now,
 				}, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentAdded && e.PostID == postID && e.Comment.ID == 2
+				})).Return(nil)
 			},
 			want: &models.Comment{
 				ID:        2,
@@ -94,35 +113,72 @@ func TestService_AddComment(t *testing.T) {
 				CreatedAt: now,
 			},
 		},
+		{
+			name: "publish_error_does_not_fail",
+			input: models.AddCommentInput{
+				PostID:   postIDStr,
+				ParentID: nil,
+				Author:   "Alice",
+				Text:     "Hello",
+			},
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+				repo.On("Add", mock.Anything, mock.Anything).Return(&models.Comment{
+					ID:        3,
+					PostID:    postID,
+					Author:    "Alice",
+					Text:      "Hello",
+					CreatedAt: now,
+				}, nil)
+				broker.On("Publish", mock.Anything, mock.Anything).Return(errors.New("broker closed"))
+			},
+			want: &models.Comment{
+				ID:        3,
+				PostID:    postID,
+				Author:    "Alice",
+				Text:      "Hello",
+				CreatedAt: now,
+			},
+		},
 		{
 			name: "invalid_postID_format",
 			input: models.AddCommentInput{
 				PostID:   "abc",
 				ParentID: nil,
 			},
-			setupMock:   func(repo *mocks.MockCommentUC) {},
+			setupMock:   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {},
+			wantErr:     true,
+			expectedErr: "invalid postID format",
+		},
+		{
+			name: "comment_id_as_postID",
+			input: models.AddCommentInput{
+				PostID:   globalid.Encode(globalid.Comment, 1),
+				ParentID: nil,
+			},
+			setupMock:   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {},
 			wantErr:     true,
 			expectedErr: "invalid postID format",
 		},
 		{
 			name: "invalid_postID",
 			input: models.AddCommentInput{
-				PostID:   "0",
+				PostID:   globalid.Encode(globalid.Post, 0),
 				ParentID: nil,
 			},
-			setupMock:   func(repo *mocks.MockCommentUC) {},
+			setupMock:   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {},
 			wantErr:     true,
 			expectedErr: "postID must be greater 0",
 		},
 		{
 			name: "max_lenght",
 			input: models.AddCommentInput{
-				PostID:   "1",
+				PostID:   globalid.Encode(globalid.Post, 1),
 				ParentID: nil,
 				Author:   "Shrek",
 				Text:     strings.Repeat("a", 2001),
 			},
-			setupMock:   func(repo *mocks.MockCommentUC) {},
+			setupMock:   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {},
 			wantErr:     true,
 			expectedErr: "max comment length is 2000 char",
 		},
@@ -132,20 +188,131 @@ func TestService_AddComment(t *testing.T) {
 				PostID:   postIDStr,
 				ParentID: nil,
 			},
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("CheckAllowComments", mock.Anything, postID).Return(false, nil)
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{Policy: models.CommentPolicyClosed}, nil)
 			},
 			wantErr:     true,
 			expectedErr: "comments not allowed for this post",
 		},
 		{
-			name: "checkAllowComments_error",
+			name: "comments_closed_automatically",
 			input: models.AddCommentInput{
 				PostID:   postIDStr,
 				ParentID: nil,
 			},
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("CheckAllowComments", mock.Anything, postID).Return(false, errors.New("db error"))
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{
+					Policy:  models.CommentPolicyOpen,
+					CloseAt: &past,
+				}, nil)
+			},
+			wantErr:     true,
+			expectedErr: "comments for this post were closed automatically",
+		},
+		{
+			name: "close_time_not_reached",
+			input: models.AddCommentInput{
+				PostID: postIDStr,
+				Author: "Alice",
+				Text:   "Hello",
+			},
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{
+					Policy:  models.CommentPolicyOpen,
+					CloseAt: &future,
+				}, nil)
+				repo.On("Add", mock.Anything, mock.Anything).Return(&models.Comment{ID: 1, PostID: postID}, nil)
+				broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			},
+			want: &models.Comment{ID: 1, PostID: postID},
+		},
+		{
+			name: "author_only_other_author",
+			input: models.AddCommentInput{
+				PostID: postIDStr,
+				Author: "Bob",
+			},
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{
+					Policy: models.CommentPolicyAuthorOnly,
+					Author: "Alice",
+				}, nil)
+			},
+			wantErr:     true,
+			expectedErr: "only the post author can comment on this post",
+		},
+		{
+			name: "author_only_post_author",
+			input: models.AddCommentInput{
+				PostID: postIDStr,
+				Author: "Alice",
+				Text:   "Hello",
+			},
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{
+					Policy: models.CommentPolicyAuthorOnly,
+					Author: "Alice",
+				}, nil)
+				repo.On("Add", mock.Anything, mock.Anything).Return(&models.Comment{ID: 1, PostID: postID, Author: "Alice"}, nil)
+				broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			},
+			want: &models.Comment{ID: 1, PostID: postID, Author: "Alice"},
+		},
+		{
+			name: "replies_only_root_comment",
+			input: models.AddCommentInput{
+				PostID:   postIDStr,
+				ParentID: nil,
+			},
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{
+					Policy: models.CommentPolicyRepliesOnly,
+				}, nil)
+			},
+			wantErr:     true,
+			expectedErr: "this post only accepts replies to existing comments",
+		},
+		{
+			name: "replies_only_reply",
+			input: models.AddCommentInput{
+				PostID:   postIDStr,
+				ParentID: &parentIDStr,
+				Author:   "Bob",
+				Text:     "Reply",
+			},
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{
+					Policy: models.CommentPolicyRepliesOnly,
+				}, nil)
+				repo.On("GetReplyParent", mock.Anything, parentID).Return(&models.ReplyParent{PostID: postID}, nil)
+				repo.On("Add", mock.Anything, mock.Anything).Return(&models.Comment{ID: 2, PostID: postID, ParentID: &parentID}, nil)
+				broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			},
+			want: &models.Comment{ID: 2, PostID: postID, ParentID: &parentID},
+		},
+		{
+			name: "moderated_post",
+			input: models.AddCommentInput{
+				PostID: postIDStr,
+			},
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{
+					Policy: models.CommentPolicyModerated,
+				}, nil)
+				repo.On("Add", mock.Anything, mock.MatchedBy(func(c models.Comment) bool {
+					return c.Status == models.CommentStatusPending
+				})).Return(&models.Comment{ID: 1, PostID: postID, Status: models.CommentStatusPending}, nil)
+			},
+			want: &models.Comment{ID: 1, PostID: postID, Status: models.CommentStatusPending},
+		},
+		{
+			name: "getCommentPolicy_error",
+			input: models.AddCommentInput{
+				PostID:   postIDStr,
+				ParentID: nil,
+			},
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(nil, errors.New("db error"))
 			},
 			wantErr:     true,
 			expectedErr: "db error",
@@ -156,8 +323,8 @@ func TestService_AddComment(t *testing.T) {
 				PostID:   postIDStr,
 				ParentID: strPtr("abc"),
 			},
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("CheckAllowComments", mock.Anything, postID).Return(true, nil)
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
 			},
 			wantErr:     true,
 			expectedErr: "invalid parentID format",
@@ -168,9 +335,9 @@ func TestService_AddComment(t *testing.T) {
 				PostID:   postIDStr,
 				ParentID: &parentIDStr,
 			},
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("CheckAllowComments", mock.Anything, postID).Return(true, nil)
-				repo.On("CheckParentExists", mock.Anything, parentID).Return(int64(0), errors.New("parent comment not found"))
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+				repo.On("GetReplyParent", mock.Anything, parentID).Return(nil, errors.New("parent comment not found"))
 			},
 			wantErr:     true,
 			expectedErr: "parent comment not found",
@@ -181,9 +348,9 @@ func TestService_AddComment(t *testing.T) {
 				PostID:   postIDStr,
 				ParentID: &parentIDStr,
 			},
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("CheckAllowComments", mock.Anything, postID).Return(true, nil)
-				repo.On("CheckParentExists", mock.Anything, parentID).Return(int64(2), nil)
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+				repo.On("GetReplyParent", mock.Anything, parentID).Return(&models.ReplyParent{PostID: 2}, nil)
 			},
 			wantErr:     true,
 			expectedErr: "parent comment does not belong to this post",
@@ -194,8 +361,8 @@ func TestService_AddComment(t *testing.T) {
 				PostID:   postIDStr,
 				ParentID: nil,
 			},
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("CheckAllowComments", mock.Anything, postID).Return(true, nil)
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
 				repo.On("Add", mock.Anything, mock.Anything).Return(nil, errors.New("insert failed"))
 			},
 			wantErr:     true,
@@ -208,11 +375,12 @@ func TestService_AddComment(t *testing.T) {
 			t.Parallel()
 
 			mockRepo := mocks.NewMockCommentUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
 			if tt.setupMock != nil {
-				tt.setupMock(mockRepo)
+				tt.setupMock(mockRepo, mockBroker)
 			}
 
-			s := New(mockRepo)
+			s := New(mockRepo, mockBroker, ReplyDepth{}, nil, nil, nil)
 			got, err := s.AddComment(ctx, tt.input)
 
 			if tt.wantErr {
@@ -226,6 +394,115 @@ func TestService_AddComment(t *testing.T) {
 				assert.Equal(t, tt.want, got)
 			}
 			mockRepo.AssertExpectations(t)
+			mockBroker.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_AddComment_ReplyDepth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postID := int64(1)
+	parentID := int64(10)
+	parentIDStr := globalid.Encode(globalid.Comment, 10)
+	ancestorID := int64(7)
+	openPolicy := &models.PostCommentPolicy{Policy: models.CommentPolicyOpen}
+
+	tests := []struct {
+		name         string
+		replyDepth   ReplyDepth
+		parent       *models.ReplyParent
+		setupMock    func(repo *mocks.MockCommentUC)
+		wantParentID int64
+		wantDepth    int32
+		wantMaxDepth int32
+	}{
+		{
+			name:         "within_limit",
+			replyDepth:   ReplyDepth{Max: 3, Policy: models.ReplyDepthReject},
+			parent:       &models.ReplyParent{PostID: postID, Depth: 2},
+			wantParentID: parentID,
+			wantDepth:    3,
+		},
+		{
+			name:         "reject_beyond_limit",
+			replyDepth:   ReplyDepth{Max: 3, Policy: models.ReplyDepthReject},
+			parent:       &models.ReplyParent{PostID: postID, Depth: 3},
+			wantMaxDepth: 3,
+		},
+		{
+			name:         "unknown_policy_rejects",
+			replyDepth:   ReplyDepth{Max: 3, Policy: "bogus"},
+			parent:       &models.ReplyParent{PostID: postID, Depth: 3},
+			wantMaxDepth: 3,
+		},
+		{
+			name:         "post_limit_overrides_default",
+			replyDepth:   ReplyDepth{Max: 10, Policy: models.ReplyDepthReject},
+			parent:       &models.ReplyParent{PostID: postID, Depth: 2, MaxReplyDepth: int32Ptr(2)},
+			wantMaxDepth: 2,
+		},
+		{
+			name:         "zero_limit_disables_check",
+			replyDepth:   ReplyDepth{},
+			parent:       &models.ReplyParent{PostID: postID, Depth: 50},
+			wantParentID: parentID,
+			wantDepth:    51,
+		},
+		{
+			name:       "flatten_to_deepest_ancestor",
+			replyDepth: ReplyDepth{Max: 3, Policy: models.ReplyDepthFlatten},
+			parent:     &models.ReplyParent{PostID: postID, Depth: 5},
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetAncestorAt", mock.Anything, parentID, int32(2)).Return(ancestorID, nil)
+			},
+			wantParentID: ancestorID,
+			wantDepth:    3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			mockRepo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+			mockRepo.On("GetReplyParent", mock.Anything, parentID).Return(tt.parent, nil)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+			if tt.wantMaxDepth == 0 {
+				mockRepo.On("Add", mock.Anything, mock.Anything).Return(func(_ context.Context, c models.Comment) (*models.Comment, error) {
+					c.ID = 100
+					return &c, nil
+				})
+				mockBroker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			s := New(mockRepo, mockBroker, tt.replyDepth, nil, nil, nil)
+			got, err := s.AddComment(ctx, models.AddCommentInput{
+				PostID:   globalid.Encode(globalid.Post, 1),
+				ParentID: &parentIDStr,
+				Author:   "Alice",
+				Text:     "deep",
+			})
+
+			if tt.wantMaxDepth != 0 {
+				var depthErr *ReplyDepthError
+				if assert.ErrorAs(t, err, &depthErr) {
+					assert.Equal(t, tt.wantMaxDepth, depthErr.MaxDepth)
+				}
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.NoError(t, err)
+			if assert.NotNil(t, got) && assert.NotNil(t, got.ParentID) {
+				assert.Equal(t, tt.wantParentID, *got.ParentID)
+				assert.Equal(t, tt.wantDepth, got.Depth)
+			}
 		})
 	}
 }
@@ -235,18 +512,22 @@ func TestService_GetRootComments(t *testing.T) {
 	ctx := context.Background()
 
 	postID := int64(1)
-	postIDStr := "1"
+	postIDStr := globalid.Encode(globalid.Post, 1)
 
 	now := time.Now().UTC()
 	comment1 := &models.Comment{ID: 1, PostID: postID, Author: "A", Text: "1", CreatedAt: now.Format(time.RFC3339)}
 	comment2 := &models.Comment{ID: 2, PostID: postID, Author: "B", Text: "2", CreatedAt: now.Add(-time.Hour).Format(time.RFC3339)}
 	comment3 := &models.Comment{ID: 3, PostID: postID, Author: "C", Text: "3", CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339)}
+	voted := &models.Comment{ID: 4, PostID: postID, Author: "D", Text: "4", CreatedAt: now.Format(time.RFC3339), Upvotes: 3, Downvotes: 1}
 
 	tests := []struct {
 		name        string
 		postID      string
 		first       *int32
 		after       *string
+		last        *int32
+		before      *string
+		orderBy     *models.CommentOrder
 		setupMock   func(repo *mocks.MockCommentUC)
 		want        *models.CommentConnection
 		wantErr     bool
@@ -258,7 +539,7 @@ func TestService_GetRootComments(t *testing.T) {
 			first:  nil,
 			after:  nil,
 			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("GetRootByPost", mock.Anything, postID, (*string)(nil), int64(0), int32(21)).
+				repo.On("GetRootByPost", mock.Anything, postID, models.CommentOrderNewest, (*models.CommentCursor)(nil), false, int32(21)).
 					Return([]*models.Comment{comment1, comment2, comment3}, nil)
 				repo.On("TotalCount", mock.Anything, postID).Return(int64(10), nil)
 			},
@@ -269,6 +550,7 @@ func TestService_GetRootComments(t *testing.T) {
 					{Cursor: cursor.Encode(comment3.CreatedAt, comment3.ID), Node: comment3},
 				},
 				PageInfo: &models.PageInfo{
+					StartCursor: strPtr(cursor.Encode(comment1.CreatedAt, comment1.ID)),
 					EndCursor:   strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
 					HasNextPage: false,
 				},
@@ -281,7 +563,7 @@ func TestService_GetRootComments(t *testing.T) {
 			first:  int32Ptr(2),
 			after:  nil,
 			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("GetRootByPost", mock.Anything, postID, (*string)(nil), int64(0), int32(3)).
+				repo.On("GetRootByPost", mock.Anything, postID, models.CommentOrderNewest, (*models.CommentCursor)(nil), false, int32(3)).
 					Return([]*models.Comment{comment1, comment2, comment3}, nil)
 				repo.On("TotalCount", mock.Anything, postID).Return(int64(10), nil)
 			},
@@ -291,6 +573,7 @@ func TestService_GetRootComments(t *testing.T) {
 					{Cursor: cursor.Encode(comment2.CreatedAt, comment2.ID), Node: comment2},
 				},
 				PageInfo: &models.PageInfo{
+					StartCursor: strPtr(cursor.Encode(comment1.CreatedAt, comment1.ID)),
 					EndCursor:   strPtr(cursor.Encode(comment2.CreatedAt, comment2.ID)),
 					HasNextPage: true,
 				},
@@ -303,9 +586,8 @@ func TestService_GetRootComments(t *testing.T) {
 			first:  int32Ptr(2),
 			after:  strPtr(cursor.Encode(comment2.CreatedAt, comment2.ID)),
 			setupMock: func(repo *mocks.MockCommentUC) {
-				afterCreatedAt := comment2.CreatedAt
-				afterID := comment2.ID
-				repo.On("GetRootByPost", mock.Anything, postID, &afterCreatedAt, afterID, int32(3)).
+				after := &models.CommentCursor{CreatedAt: comment2.CreatedAt, ID: comment2.ID}
+				repo.On("GetRootByPost", mock.Anything, postID, models.CommentOrderNewest, after, false, int32(3)).
 					Return([]*models.Comment{comment3}, nil)
 				repo.On("TotalCount", mock.Anything, postID).Return(int64(10), nil)
 			},
@@ -314,6 +596,7 @@ func TestService_GetRootComments(t *testing.T) {
 					{Cursor: cursor.Encode(comment3.CreatedAt, comment3.ID), Node: comment3},
 				},
 				PageInfo: &models.PageInfo{
+					StartCursor: strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
 					EndCursor:   strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
 					HasNextPage: false,
 				},
@@ -326,9 +609,8 @@ func TestService_GetRootComments(t *testing.T) {
 			first:  int32Ptr(2),
 			after:  strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
 			setupMock: func(repo *mocks.MockCommentUC) {
-				afterCreatedAt := comment3.CreatedAt
-				afterID := comment3.ID
-				repo.On("GetRootByPost", mock.Anything, postID, &afterCreatedAt, afterID, int32(3)).
+				after := &models.CommentCursor{CreatedAt: comment3.CreatedAt, ID: comment3.ID}
+				repo.On("GetRootByPost", mock.Anything, postID, models.CommentOrderNewest, after, false, int32(3)).
 					Return([]*models.Comment{}, nil)
 				repo.On("TotalCount", mock.Anything, postID).Return(int64(10), nil)
 			},
@@ -338,6 +620,78 @@ func TestService_GetRootComments(t *testing.T) {
 				TotalCount: 10,
 			},
 		},
+		{
+			name:    "top_order_with_cursor",
+			postID:  postIDStr,
+			first:   int32Ptr(1),
+			after:   strPtr(cursor.EncodeComment(models.CommentOrderTop, &models.Comment{ID: 2, Upvotes: 5, Downvotes: 1})),
+			orderBy: orderPtr(models.CommentOrderTop),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetRootByPost", mock.Anything, postID, models.CommentOrderTop, &models.CommentCursor{Score: 4, ID: 2}, false, int32(2)).
+					Return([]*models.Comment{voted}, nil)
+				repo.On("TotalCount", mock.Anything, postID).Return(int64(10), nil)
+			},
+			want: &models.CommentConnection{
+				Edges: []*models.CommentEdge{
+					{Cursor: cursor.EncodeComment(models.CommentOrderTop, voted), Node: voted},
+				},
+				PageInfo: &models.PageInfo{
+					StartCursor: strPtr(cursor.EncodeComment(models.CommentOrderTop, voted)),
+					EndCursor:   strPtr(cursor.EncodeComment(models.CommentOrderTop, voted)),
+					HasNextPage: false,
+				},
+				TotalCount: 10,
+			},
+		},
+		{
+			name:   "backward_before_cursor",
+			postID: postIDStr,
+			last:   int32Ptr(1),
+			before: strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				before := &models.CommentCursor{CreatedAt: comment3.CreatedAt, ID: comment3.ID}
+				repo.On("GetRootByPost", mock.Anything, postID, models.CommentOrderNewest, before, true, int32(2)).
+					Return([]*models.Comment{comment1, comment2}, nil)
+				repo.On("TotalCount", mock.Anything, postID).Return(int64(10), nil)
+			},
+			want: &models.CommentConnection{
+				Edges: []*models.CommentEdge{
+					{Cursor: cursor.Encode(comment2.CreatedAt, comment2.ID), Node: comment2},
+				},
+				PageInfo: &models.PageInfo{
+					StartCursor:     strPtr(cursor.Encode(comment2.CreatedAt, comment2.ID)),
+					EndCursor:       strPtr(cursor.Encode(comment2.CreatedAt, comment2.ID)),
+					HasPreviousPage: true,
+				},
+				TotalCount: 10,
+			},
+		},
+		{
+			name:        "first_mixed_with_last",
+			postID:      postIDStr,
+			first:       int32Ptr(2),
+			last:        int32Ptr(2),
+			setupMock:   func(repo *mocks.MockCommentUC) {},
+			wantErr:     true,
+			expectedErr: "first and after cannot be combined with last and before",
+		},
+		{
+			name:        "invalid_order",
+			postID:      postIDStr,
+			orderBy:     orderPtr("RANDOM"),
+			setupMock:   func(repo *mocks.MockCommentUC) {},
+			wantErr:     true,
+			expectedErr: "invalid comment order",
+		},
+		{
+			name:        "cursor_of_other_order",
+			postID:      postIDStr,
+			after:       strPtr(cursor.Encode(comment2.CreatedAt, comment2.ID)),
+			orderBy:     orderPtr(models.CommentOrderOldest),
+			setupMock:   func(repo *mocks.MockCommentUC) {},
+			wantErr:     true,
+			expectedErr: "invalid cursor format",
+		},
 		{
 			name:   "invalid_postID",
 			postID: "abc",
@@ -350,7 +704,7 @@ func TestService_GetRootComments(t *testing.T) {
 		},
 		{
 			name:        "invalid_postID",
-			postID:      "0",
+			postID:      globalid.Encode(globalid.Post, 0),
 			first:       nil,
 			after:       nil,
 			wantErr:     true,
@@ -371,7 +725,7 @@ func TestService_GetRootComments(t *testing.T) {
 			first:  int32Ptr(2),
 			after:  nil,
 			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("GetRootByPost", mock.Anything, postID, (*string)(nil), int64(0), int32(3)).
+				repo.On("GetRootByPost", mock.Anything, postID, models.CommentOrderNewest, (*models.CommentCursor)(nil), false, int32(3)).
 					Return(nil, errors.New("db error"))
 			},
 			wantErr:     true,
@@ -383,7 +737,7 @@ func TestService_GetRootComments(t *testing.T) {
 			first:  int32Ptr(2),
 			after:  nil,
 			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("GetRootByPost", mock.Anything, postID, (*string)(nil), int64(0), int32(3)).
+				repo.On("GetRootByPost", mock.Anything, postID, models.CommentOrderNewest, (*models.CommentCursor)(nil), false, int32(3)).
 					Return([]*models.Comment{comment1, comment2}, nil)
 				repo.On("TotalCount", mock.Anything, postID).Return(int64(0), errors.New("count error"))
 			},
@@ -401,8 +755,8 @@ func TestService_GetRootComments(t *testing.T) {
 				tt.setupMock(mockRepo)
 			}
 
-			s := New(mockRepo)
-			got, err := s.GetRootComments(ctx, tt.postID, tt.first, tt.after)
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+			got, err := s.GetRootComments(ctx, tt.postID, tt.first, tt.after, tt.last, tt.before, tt.orderBy)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -419,128 +773,44 @@ func TestService_GetRootComments(t *testing.T) {
 	}
 }
 
-func TestService_GetChildComments(t *testing.T) {
+func TestService_GetComment(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	parentID := int64(10)
-	parentIDStr := "10"
-
-	comment1 := &models.Comment{ID: 1, ParentID: &parentID, Author: "A", Text: "c1", CreatedAt: time.Now().UTC().Format(time.RFC3339)}
-	comment2 := &models.Comment{ID: 2, ParentID: &parentID, Author: "B", Text: "c2", CreatedAt: time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)}
-	comment3 := &models.Comment{ID: 3, ParentID: &parentID, Author: "C", Text: "c3", CreatedAt: time.Now().UTC().Add(-2 * time.Hour).Format(time.RFC3339)}
+	reply := &models.Comment{ID: 5, PostID: 1, ParentID: int64Ptr(2), Author: "Bob", Text: "deep"}
 
 	tests := []struct {
 		name        string
-		parentID    string
-		first       *int32
-		after       *string
+		commentID   string
 		setupMock   func(repo *mocks.MockCommentUC)
-		want        *models.CommentConnection
-		wantErr     bool
+		want        *models.Comment
 		expectedErr string
 	}{
 		{
-			name:     "first_page_without_cursor",
-			parentID: parentIDStr,
-			first:    nil,
-			after:    nil,
+			name:      "found",
+			commentID: globalid.Encode(globalid.Comment, 5),
 			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("GetChild", mock.Anything, parentID, (*string)(nil), int64(0), int32(21)).
-					Return([]*models.Comment{comment1, comment2, comment3}, nil)
-			},
-			want: &models.CommentConnection{
-				Edges: []*models.CommentEdge{
-					{Cursor: cursor.Encode(comment1.CreatedAt, comment1.ID), Node: comment1},
-					{Cursor: cursor.Encode(comment2.CreatedAt, comment2.ID), Node: comment2},
-					{Cursor: cursor.Encode(comment3.CreatedAt, comment3.ID), Node: comment3},
-				},
-				PageInfo: &models.PageInfo{
-					EndCursor:   strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
-					HasNextPage: false,
-				},
-				TotalCount: 3,
-			},
-		},
-		{
-			name:     "with_next_page",
-			parentID: parentIDStr,
-			first:    int32Ptr(2),
-			after:    nil,
-			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("GetChild", mock.Anything, parentID, (*string)(nil), int64(0), int32(3)).
-					Return([]*models.Comment{comment1, comment2, comment3}, nil)
-			},
-			want: &models.CommentConnection{
-				Edges: []*models.CommentEdge{
-					{Cursor: cursor.Encode(comment1.CreatedAt, comment1.ID), Node: comment1},
-					{Cursor: cursor.Encode(comment2.CreatedAt, comment2.ID), Node: comment2},
-				},
-				PageInfo: &models.PageInfo{
-					EndCursor:   strPtr(cursor.Encode(comment2.CreatedAt, comment2.ID)),
-					HasNextPage: true,
-				},
-				TotalCount: 2,
-			},
-		},
-		{
-			name:     "with_cursor",
-			parentID: parentIDStr,
-			first:    int32Ptr(2),
-			after:    strPtr(cursor.Encode(comment2.CreatedAt, comment2.ID)),
-			setupMock: func(repo *mocks.MockCommentUC) {
-				afterCreatedAt := comment2.CreatedAt
-				afterID := comment2.ID
-				repo.On("GetChild", mock.Anything, parentID, &afterCreatedAt, afterID, int32(3)).
-					Return([]*models.Comment{comment3}, nil)
-			},
-			want: &models.CommentConnection{
-				Edges: []*models.CommentEdge{
-					{Cursor: cursor.Encode(comment3.CreatedAt, comment3.ID), Node: comment3},
-				},
-				PageInfo: &models.PageInfo{
-					EndCursor:   strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
-					HasNextPage: false,
-				},
-				TotalCount: 1,
+				repo.On("GetByID", mock.Anything, int64(5)).Return(reply, nil)
 			},
+			want: reply,
 		},
 		{
-			name:        "invalid_parentID",
-			parentID:    "abc",
-			first:       nil,
-			after:       nil,
-			wantErr:     true,
-			expectedErr: "invalid parentID",
-		},
-		{
-			name:        "invalid_parentID",
-			parentID:    "0",
-			first:       nil,
-			after:       nil,
-			wantErr:     true,
-			expectedErr: "invalid parentID",
+			name:        "invalid_commentID",
+			commentID:   "abc",
+			expectedErr: "invalid commentID format",
 		},
 		{
-			name:        "invalid_cursor",
-			parentID:    parentIDStr,
-			first:       int32Ptr(2),
-			after:       strPtr("bad"),
-			setupMock:   func(repo *mocks.MockCommentUC) {},
-			wantErr:     true,
-			expectedErr: "invalid cursor format",
+			name:        "non_positive_commentID",
+			commentID:   globalid.Encode(globalid.Comment, 0),
+			expectedErr: "commentID must be greater 0",
 		},
 		{
-			name:     "repo_GetChild_error",
-			parentID: parentIDStr,
-			first:    int32Ptr(2),
-			after:    nil,
+			name:      "comment_not_found",
+			commentID: globalid.Encode(globalid.Comment, 5),
 			setupMock: func(repo *mocks.MockCommentUC) {
-				repo.On("GetChild", mock.Anything, parentID, (*string)(nil), int64(0), int32(3)).
-					Return(nil, errors.New("db error"))
+				repo.On("GetByID", mock.Anything, int64(5)).Return(nil, errors.New("comment not found"))
 			},
-			wantErr:     true,
-			expectedErr: "db error",
+			expectedErr: "comment not found",
 		},
 	}
 
@@ -553,23 +823,1603 @@ func TestService_GetChildComments(t *testing.T) {
 				tt.setupMock(mockRepo)
 			}
 
-			s := New(mockRepo)
-			got, err := s.GetChildComments(ctx, tt.parentID, tt.first, tt.after)
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+			got, err := s.GetComment(ctx, tt.commentID)
 
-			if tt.wantErr {
-				assert.Error(t, err)
-				if tt.expectedErr != "" {
-					assert.Contains(t, err.Error(), tt.expectedErr)
-				}
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_Ancestors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	chain := []*models.Comment{{ID: 1, PostID: 1}, {ID: 2, PostID: 1, ParentID: int64Ptr(1)}}
+
+	mockRepo := mocks.NewMockCommentUC(t)
+	mockRepo.On("GetAncestors", mock.Anything, int64(3)).Return(chain, nil)
+
+	s := New(mockRepo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+	got, err := s.Ancestors(ctx, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, chain, got)
+}
+
+func TestService_GetThread(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postID := int64(1)
+	rootID := int64(5)
+	thread := []*models.ThreadComment{
+		{Comment: &models.Comment{ID: 5, PostID: postID}, Depth: 0, Path: []int64{5}},
+		{Comment: &models.Comment{ID: 8, PostID: postID, ParentID: &rootID}, Depth: 1, Path: []int64{5, 8}},
+	}
+
+	tests := []struct {
+		name        string
+		postID      string
+		rootID      *string
+		maxDepth    *int32
+		maxPerLevel *int32
+		setupMock   func(repo *mocks.MockCommentUC)
+		want        []*models.ThreadComment
+		expectedErr string
+	}{
+		{
+			name:   "defaults",
+			postID: globalid.Encode(globalid.Post, 1),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetThread", mock.Anything, postID, (*int64)(nil), int32(5), int32(20)).Return(thread, nil)
+			},
+			want: thread,
+		},
+		{
+			name:        "subtree",
+			postID:      globalid.Encode(globalid.Post, 1),
+			rootID:      strPtr(globalid.Encode(globalid.Comment, 5)),
+			maxDepth:    int32Ptr(0),
+			maxPerLevel: int32Ptr(3),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetThread", mock.Anything, postID, &rootID, int32(0), int32(3)).Return(thread[:1], nil)
+			},
+			want: thread[:1],
+		},
+		{
+			name:        "invalid_postID",
+			postID:      "abc",
+			expectedErr: "invalid postID format",
+		},
+		{
+			name:        "invalid_rootID",
+			postID:      globalid.Encode(globalid.Post, 1),
+			rootID:      strPtr(globalid.Encode(globalid.Comment, 0)),
+			expectedErr: "invalid rootID",
+		},
+		{
+			name:        "depth_too_large",
+			postID:      globalid.Encode(globalid.Post, 1),
+			maxDepth:    int32Ptr(21),
+			expectedErr: "maxDepth must be between 0 and 20",
+		},
+		{
+			name:        "per_level_too_small",
+			postID:      globalid.Encode(globalid.Post, 1),
+			maxPerLevel: int32Ptr(0),
+			expectedErr: "maxPerLevel must be between 1 and 100",
+		},
+		{
+			name:   "root_not_found",
+			postID: globalid.Encode(globalid.Post, 1),
+			rootID: strPtr(globalid.Encode(globalid.Comment, 5)),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetThread", mock.Anything, postID, &rootID, int32(5), int32(20)).Return(nil, errors.New("comment not found"))
+			},
+			expectedErr: "comment not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+			got, err := s.GetThread(ctx, tt.postID, tt.rootID, tt.maxDepth, tt.maxPerLevel)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
 				assert.Nil(t, got)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want, got)
 			}
+		})
+	}
+}
+
+func TestService_Children(t *testing.T) {
+	t.Parallel()
+
+	parentID := int64(10)
+	now := time.Now().UTC()
+
+	child1 := &models.Comment{ID: 1, ParentID: &parentID, CreatedAt: now.Format(time.RFC3339), Upvotes: 5}
+	child2 := &models.Comment{ID: 2, ParentID: &parentID, CreatedAt: now.Add(time.Minute).Format(time.RFC3339), Upvotes: 2, Downvotes: 1}
+	child3 := &models.Comment{ID: 3, ParentID: &parentID, CreatedAt: now.Add(2 * time.Minute).Format(time.RFC3339), Downvotes: 2}
+
+	withLoader := func(repo *mocks.MockCommentUC) context.Context {
+		l := myLoader.NewCommentLoader(repo)
+		ctx := context.WithValue(context.Background(), myLoader.Key, dataloader.NewBatchedLoader(l.BatchGetChildren))
+		return context.WithValue(ctx, myLoader.ReplyCountKey, dataloader.NewBatchedLoader(l.BatchCountReplies))
+	}
+
+	t.Run("top_order_pages_by_score", func(t *testing.T) {
+		order := models.CommentOrderTop
+		pos := cursor.Position(order, child1)
+
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("GetChildBatch", mock.Anything, []int64{parentID}, order, (*models.CommentCursor)(nil), false, int32(2)).
+			Return([]*models.Comment{child1, child2}, nil).Once()
+		repo.On("GetChildBatch", mock.Anything, []int64{parentID}, order, &pos, false, int32(3)).
+			Return([]*models.Comment{child2, child3}, nil).Once()
+		repo.On("ReplyCountBatch", mock.Anything, []int64{parentID}).
+			Return([]*models.ReplyCount{{ParentID: parentID, Count: 3}}, nil).Once()
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+		ctx := withLoader(repo)
+
+		first, err := s.Children(ctx, parentID, int32Ptr(1), nil, nil, nil, &order)
+		assert.NoError(t, err)
+		if assert.Len(t, first.Edges, 1) {
+			assert.Equal(t, child1, first.Edges[0].Node)
+		}
+		assert.True(t, first.PageInfo.HasNextPage)
+		assert.Equal(t, int32(3), first.TotalCount)
+
+		second, err := s.Children(ctx, parentID, int32Ptr(2), first.PageInfo.EndCursor, nil, nil, &order)
+		assert.NoError(t, err)
+		if assert.Len(t, second.Edges, 2) {
+			assert.Equal(t, child2, second.Edges[0].Node)
+			assert.Equal(t, child3, second.Edges[1].Node)
+		}
+		assert.False(t, second.PageInfo.HasNextPage)
+		assert.Equal(t, int32(3), second.TotalCount)
+	})
+
+	t.Run("dataloader_missing", func(t *testing.T) {
+		s := New(mocks.NewMockCommentUC(t), pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+
+		_, err := s.Children(context.Background(), parentID, nil, nil, nil, nil, nil)
+		assert.EqualError(t, err, "dataloader not found in context")
+
+		_, err = s.ReplyCount(context.Background(), parentID)
+		assert.EqualError(t, err, "dataloader not found in context")
+	})
+
+	t.Run("cursor_of_other_order", func(t *testing.T) {
+		repo := mocks.NewMockCommentUC(t)
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+		order := models.CommentOrderTop
+
+		_, err := s.Children(withLoader(repo), parentID, nil, strPtr(cursor.Encode(child1.CreatedAt, child1.ID)), nil, nil, &order)
+		assert.EqualError(t, err, "invalid cursor format")
+	})
+
+	t.Run("invalid_order", func(t *testing.T) {
+		repo := mocks.NewMockCommentUC(t)
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+
+		_, err := s.Children(withLoader(repo), parentID, nil, nil, nil, nil, orderPtr("RANDOM"))
+		assert.EqualError(t, err, "invalid comment order")
+	})
+}
+
+func TestService_PostComments(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	a1 := &models.Comment{ID: 1, PostID: 1, CreatedAt: now.Format(time.RFC3339)}
+	a2 := &models.Comment{ID: 2, PostID: 1, CreatedAt: now.Add(time.Minute).Format(time.RFC3339)}
+	b1 := &models.Comment{ID: 3, PostID: 2, CreatedAt: now.Format(time.RFC3339)}
+
+	withLoaders := func(repo *mocks.MockCommentUC) context.Context {
+		l := myLoader.NewCommentLoader(repo)
+		ctx := context.WithValue(context.Background(), myLoader.PostCommentsKey,
+			dataloader.NewBatchedLoader(l.BatchGetPostComments, dataloader.WithWait(10*time.Millisecond)))
+		return context.WithValue(ctx, myLoader.CommentCountKey,
+			dataloader.NewBatchedLoader(l.BatchCountComments, dataloader.WithWait(10*time.Millisecond)))
+	}
+
+	t.Run("batches_posts", func(t *testing.T) {
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("GetRootBatch", mock.Anything, mock.MatchedBy(func(ids []int64) bool {
+			return assert.ElementsMatch(t, []int64{1, 2}, ids)
+		}), models.CommentOrderNewest, (*models.CommentCursor)(nil), false, int32(2)).
+			Return([]*models.Comment{a2, a1, b1}, nil).Once()
+		repo.On("TotalCountBatch", mock.Anything, mock.MatchedBy(func(ids []int64) bool {
+			return assert.ElementsMatch(t, []int64{1, 2}, ids)
+		})).Return([]*models.CommentCount{{PostID: 1, Count: 5}, {PostID: 2, Count: 1}}, nil).Once()
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+		ctx := withLoaders(repo)
+
+		type result struct {
+			conn *models.CommentConnection
+			err  error
+		}
+		other := make(chan result, 1)
+		go func() {
+			conn, err := s.PostComments(ctx, 2, int32Ptr(1), nil, nil, nil, nil)
+			other <- result{conn, err}
+		}()
+
+		conn, err := s.PostComments(ctx, 1, int32Ptr(1), nil, nil, nil, nil)
+		assert.NoError(t, err)
+		if assert.Len(t, conn.Edges, 1) {
+			assert.Equal(t, a2, conn.Edges[0].Node)
+		}
+		assert.True(t, conn.PageInfo.HasNextPage)
+		assert.Equal(t, int32(5), conn.TotalCount)
+
+		r := <-other
+		assert.NoError(t, r.err)
+		if assert.Len(t, r.conn.Edges, 1) {
+			assert.Equal(t, b1, r.conn.Edges[0].Node)
+		}
+		assert.False(t, r.conn.PageInfo.HasNextPage)
+		assert.Equal(t, int32(1), r.conn.TotalCount)
+	})
+
+	t.Run("after_cursor", func(t *testing.T) {
+		order := models.CommentOrderOldest
+		after := cursor.EncodeComment(order, a1)
+		pos := cursor.Position(order, a1)
+
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("GetRootBatch", mock.Anything, []int64{1}, order, &pos, false, int32(21)).
+			Return([]*models.Comment{a2}, nil).Once()
+		repo.On("TotalCountBatch", mock.Anything, []int64{1}).Return([]*models.CommentCount{}, nil).Once()
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+
+		conn, err := s.PostComments(withLoaders(repo), 1, nil, &after, nil, nil, &order)
+		assert.NoError(t, err)
+		if assert.Len(t, conn.Edges, 1) {
+			assert.Equal(t, a2, conn.Edges[0].Node)
+		}
+		assert.Equal(t, int32(0), conn.TotalCount)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		repo := mocks.NewMockCommentUC(t)
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+
+		_, err := s.PostComments(withLoaders(repo), 1, nil, strPtr("???"), nil, nil, nil)
+		assert.EqualError(t, err, "invalid cursor format")
+	})
+
+	t.Run("dataloader_missing", func(t *testing.T) {
+		s := New(mocks.NewMockCommentUC(t), pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+
+		_, err := s.PostComments(context.Background(), 1, nil, nil, nil, nil, nil)
+		assert.EqualError(t, err, "dataloader not found in context")
+
+		_, err = s.CommentCount(context.Background(), 1)
+		assert.EqualError(t, err, "dataloader not found in context")
+	})
+}
+
+func TestService_CommentAdded(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postID := int64(1)
+	now := time.Now().UTC()
+
+	comment1 := &models.Comment{ID: 1, PostID: postID, Author: "A", Text: "1", CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339), PublishedSeq: 1}
+	comment2 := &models.Comment{ID: 2, PostID: postID, Author: "B", Text: "2", CreatedAt: now.Format(time.RFC3339), PublishedSeq: 2}
+	comment3 := &models.Comment{ID: 3, PostID: postID, Author: "C", Text: "3", CreatedAt: now.Format(time.RFC3339), PublishedSeq: 3}
+	// approved was written before the others and published after them.
+	approved := &models.Comment{ID: 4, PostID: postID, Author: "D", Text: "4", CreatedAt: now.Add(-3 * time.Hour).Format(time.RFC3339), PublishedSeq: 4}
+
+	afterCreatedAt := now.Add(-3 * time.Hour).Format(time.RFC3339)
+	after := cursor.Encode(afterCreatedAt, 0)
+
+	tests := []struct {
+		name        string
+		postID      string
+		after       *string
+		setupMock   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker)
+		want        []*models.Comment
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:   "live_only_without_cursor",
+			postID: globalid.Encode(globalid.Post, 1),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(
+					&models.Event{Type: models.EventPostUpdated, PostID: postID, Post: &models.Post{ID: postID}},
+					addedEvent(comment2),
+				), nil)
+			},
+			want: []*models.Comment{comment2},
+		},
+		{
+			name:   "replay_then_live_without_duplicates",
+			postID: globalid.Encode(globalid.Post, 1),
+			after:  &after,
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(addedEvent(comment2), addedEvent(comment3)), nil)
+				repo.On("GetPublishedSeq", mock.Anything, postID, afterCreatedAt, int64(0)).Return(int64(0), nil)
+				repo.On("GetAddedAfter", mock.Anything, postID, int64(0), int32(replayPageLimit)).
+					Return([]*models.Comment{comment1, comment2}, nil)
+			},
+			want: []*models.Comment{comment1, comment2, comment3},
+		},
+		{
+			name:   "drops_old_live_comments_already_replayed",
+			postID: globalid.Encode(globalid.Post, 1),
+			after:  &after,
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(addedEvent(comment1), addedEvent(comment3)), nil)
+				repo.On("GetPublishedSeq", mock.Anything, postID, afterCreatedAt, int64(0)).Return(int64(0), nil)
+				repo.On("GetAddedAfter", mock.Anything, postID, int64(0), int32(replayPageLimit)).
+					Return([]*models.Comment{comment1}, nil)
+			},
+			want: []*models.Comment{comment1, comment3},
+		},
+		{
+			name:   "drops_live_comments_before_cursor",
+			postID: globalid.Encode(globalid.Post, 1),
+			after:  strPtr(cursor.Encode(comment2.CreatedAt, comment2.ID)),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(addedEvent(comment1), addedEvent(comment2), addedEvent(comment3)), nil)
+				repo.On("GetPublishedSeq", mock.Anything, postID, comment2.CreatedAt, comment2.ID).Return(comment2.PublishedSeq, nil)
+				repo.On("GetAddedAfter", mock.Anything, postID, comment2.PublishedSeq, int32(replayPageLimit)).
+					Return([]*models.Comment{}, nil)
+			},
+			want: []*models.Comment{comment3},
+		},
+		{
+			name:   "replays_comment_approved_after_cursor",
+			postID: globalid.Encode(globalid.Post, 1),
+			after:  strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(addedEvent(approved)), nil)
+				repo.On("GetPublishedSeq", mock.Anything, postID, comment3.CreatedAt, comment3.ID).Return(comment3.PublishedSeq, nil)
+				repo.On("GetAddedAfter", mock.Anything, postID, comment3.PublishedSeq, int32(replayPageLimit)).
+					Return([]*models.Comment{approved}, nil)
+			},
+			want: []*models.Comment{approved},
+		},
+		{
+			name:   "delivers_live_comment_approved_after_cursor",
+			postID: globalid.Encode(globalid.Post, 1),
+			after:  strPtr(cursor.Encode(comment3.CreatedAt, comment3.ID)),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(addedEvent(comment3), addedEvent(approved)), nil)
+				repo.On("GetPublishedSeq", mock.Anything, postID, comment3.CreatedAt, comment3.ID).Return(comment3.PublishedSeq, nil)
+				repo.On("GetAddedAfter", mock.Anything, postID, comment3.PublishedSeq, int32(replayPageLimit)).
+					Return([]*models.Comment{}, nil)
+			},
+			want: []*models.Comment{approved},
+		},
+		{
+			name:   "replay_multiple_pages",
+			postID: globalid.Encode(globalid.Post, 1),
+			after:  &after,
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				firstPage := make([]*models.Comment, replayPageLimit)
+				for i := range firstPage {
+					firstPage[i] = comment1
+				}
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(), nil)
+				repo.On("GetPublishedSeq", mock.Anything, postID, afterCreatedAt, int64(0)).Return(int64(0), nil)
+				repo.On("GetAddedAfter", mock.Anything, postID, int64(0), int32(replayPageLimit)).
+					Return(firstPage, nil).Once()
+				repo.On("GetAddedAfter", mock.Anything, postID, comment1.PublishedSeq, int32(replayPageLimit)).
+					Return([]*models.Comment{comment2}, nil).Once()
+			},
+			want: func() []*models.Comment {
+				want := make([]*models.Comment, 0, replayPageLimit+1)
+				for i := 0; i < replayPageLimit; i++ {
+					want = append(want, comment1)
+				}
+				return append(want, comment2)
+			}(),
+		},
+		{
+			name:        "invalid_postID_format",
+			postID:      "abc",
+			wantErr:     true,
+			expectedErr: "invalid postID format",
+		},
+		{
+			name:        "invalid_postID",
+			postID:      globalid.Encode(globalid.Post, 0),
+			wantErr:     true,
+			expectedErr: "postID must be greater 0",
+		},
+		{
+			name:        "invalid_cursor",
+			postID:      globalid.Encode(globalid.Post, 1),
+			after:       strPtr("bad"),
+			wantErr:     true,
+			expectedErr: "invalid cursor format",
+		},
+		{
+			name:   "subscribe_error",
+			postID: globalid.Encode(globalid.Post, 1),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(nil, errors.New("broker closed"))
+			},
+			wantErr:     true,
+			expectedErr: "broker closed",
+		},
+		{
+			name:   "replay_error",
+			postID: globalid.Encode(globalid.Post, 1),
+			after:  &after,
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(), nil)
+				repo.On("GetPublishedSeq", mock.Anything, postID, afterCreatedAt, int64(0)).Return(int64(0), nil)
+				repo.On("GetAddedAfter", mock.Anything, postID, mock.Anything, mock.Anything).
+					Return(nil, errors.New("db error"))
+			},
+			wantErr:     true,
+			expectedErr: "db error",
+		},
+		{
+			name:   "cursor_lookup_error",
+			postID: globalid.Encode(globalid.Post, 1),
+			after:  &after,
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(), nil)
+				repo.On("GetPublishedSeq", mock.Anything, postID, afterCreatedAt, int64(0)).
+					Return(int64(0), errors.New("db error"))
+			},
+			wantErr:     true,
+			expectedErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockBroker)
+			}
+
+			s := New(mockRepo, mockBroker, ReplyDepth{}, nil, nil, nil)
+			got, err := s.CommentAdded(ctx, tt.postID, tt.after)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != "" {
+					assert.Contains(t, err.Error(), tt.expectedErr)
+				}
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+
+				var received []*models.Comment
+				for c := range got {
+					received = append(received, c)
+				}
+				assert.Equal(t, tt.want, received)
+			}
 			mockRepo.AssertExpectations(t)
+			mockBroker.AssertExpectations(t)
 		})
 	}
 }
 
-func strPtr(s string) *string { return &s }
-func int32Ptr(i int32) *int32 { return &i }
+func TestService_CommentAdded_QueuesLiveDuringReplay(t *testing.T) {
+	t.Parallel()
+
+	postID := int64(1)
+	createdAt := time.Now().UTC().Format(time.RFC3339)
+	after := cursor.Encode(createdAt, 0)
+
+	replayed := &models.Comment{ID: 1, PostID: postID, CreatedAt: createdAt, PublishedSeq: 1}
+
+	// live is unbuffered, so every send below blocks unless CommentAdded keeps
+	// reading while the replay query is still running.
+	live := make(chan *models.Event)
+	var want []*models.Comment
+	for id := int64(2); id <= 40; id++ {
+		want = append(want, &models.Comment{ID: id, PostID: postID, CreatedAt: createdAt, PublishedSeq: id})
+	}
+
+	mockRepo := mocks.NewMockCommentUC(t)
+	mockBroker := pubsubMocks.NewMockBroker(t)
+	mockBroker.On("Subscribe", mock.Anything, postID).Return((<-chan *models.Event)(live), nil)
+	mockRepo.On("GetPublishedSeq", mock.Anything, postID, createdAt, int64(0)).Return(int64(0), nil)
+	mockRepo.On("GetAddedAfter", mock.Anything, postID, int64(0), int32(replayPageLimit)).
+		Return([]*models.Comment{replayed}, nil)
+
+	s := New(mockRepo, mockBroker, ReplyDepth{}, nil, nil, nil)
+	got, err := s.CommentAdded(context.Background(), globalid.Encode(globalid.Post, postID), &after)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		live <- addedEvent(replayed)
+		for _, c := range want {
+			live <- addedEvent(c)
+		}
+	}()
+
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("live events were not drained before the replay was read")
+	}
+	close(live)
+
+	var received []*models.Comment
+	for c := range got {
+		received = append(received, c)
+	}
+	assert.Equal(t, append([]*models.Comment{replayed}, want...), received)
+}
+
+func TestService_CommentEvents(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postID := int64(1)
+	comment := &models.Comment{ID: 1, PostID: postID, Author: "A", Text: "1"}
+
+	added := addedEvent(comment)
+	updated := &models.Event{Type: models.EventCommentUpdated, PostID: postID, Comment: comment}
+	deleted := &models.Event{Type: models.EventCommentDeleted, PostID: postID, Comment: comment}
+	postUpdated := &models.Event{Type: models.EventPostUpdated, PostID: postID, Post: &models.Post{ID: postID}}
+
+	tests := []struct {
+		name        string
+		postID      string
+		setupMock   func(broker *pubsubMocks.MockBroker)
+		want        []*models.Event
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:   "filters_comment_events",
+			postID: globalid.Encode(globalid.Post, 1),
+			setupMock: func(broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(liveChannel(added, postUpdated, updated, deleted), nil)
+			},
+			want: []*models.Event{added, updated, deleted},
+		},
+		{
+			name:        "invalid_postID_format",
+			postID:      "abc",
+			wantErr:     true,
+			expectedErr: "invalid postID format",
+		},
+		{
+			name:        "invalid_postID",
+			postID:      globalid.Encode(globalid.Post, 0),
+			wantErr:     true,
+			expectedErr: "postID must be greater 0",
+		},
+		{
+			name:   "subscribe_error",
+			postID: globalid.Encode(globalid.Post, 1),
+			setupMock: func(broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, postID).Return(nil, errors.New("broker closed"))
+			},
+			wantErr:     true,
+			expectedErr: "broker closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockBroker)
+			}
+
+			s := New(mocks.NewMockCommentUC(t), mockBroker, ReplyDepth{}, nil, nil, nil)
+			got, err := s.CommentEvents(ctx, tt.postID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != "" {
+					assert.Contains(t, err.Error(), tt.expectedErr)
+				}
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+
+				var received []*models.Event
+				for e := range got {
+					received = append(received, e)
+				}
+				assert.Equal(t, tt.want, received)
+			}
+			mockBroker.AssertExpectations(t)
+		})
+	}
+}
+
+func liveChannel(events ...*models.Event) <-chan *models.Event {
+	ch := make(chan *models.Event, len(events))
+	for _, e := range events {
+		ch <- e
+	}
+	close(ch)
+
+	return ch
+}
+
+func addedEvent(c *models.Comment) *models.Event {
+	return &models.Event{Type: models.EventCommentAdded, PostID: c.PostID, Comment: c}
+}
+
+func strPtr(s string) *string { return &s }
+func int32Ptr(i int32) *int32 { return &i }
+func int64Ptr(i int64) *int64 { return &i }
+
+func orderPtr(o models.CommentOrder) *models.CommentOrder {
+	return &o
+}
+
+func TestService_ContentFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postID := int64(1)
+	openPolicy := &models.PostCommentPolicy{Policy: models.CommentPolicyOpen}
+	content := filter.New(
+		filter.NewBannedWords(filter.Reject, []string{"casino"}),
+		filter.NewLinkLimit(filter.Hold, 0),
+	)
+
+	t.Run("reject", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, content, nil, nil)
+		got, err := s.AddComment(ctx, models.AddCommentInput{
+			PostID: globalid.Encode(globalid.Post, postID),
+			Author: "Alice",
+			Text:   "Visit my casino",
+		})
+
+		var filterErr *filter.RejectedError
+		assert.ErrorAs(t, err, &filterErr)
+		assert.Equal(t, "banned_words", filterErr.Decision.Rule)
+		assert.Nil(t, got)
+	})
+
+	t.Run("hold_for_moderation", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+		repo.On("Add", mock.Anything, mock.MatchedBy(func(c models.Comment) bool {
+			return c.Status == models.CommentStatusPending
+		})).Return(&models.Comment{ID: 1, PostID: postID, Status: models.CommentStatusPending}, nil)
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, content, nil, nil)
+		got, err := s.AddComment(ctx, models.AddCommentInput{
+			PostID: globalid.Encode(globalid.Post, postID),
+			Author: "Alice",
+			Text:   "See https://example.com",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.CommentStatusPending, got.Status)
+	})
+
+	t.Run("edit_cannot_be_held", func(t *testing.T) {
+		t.Parallel()
+
+		s := New(mocks.NewMockCommentUC(t), pubsubMocks.NewMockBroker(t), ReplyDepth{}, content, nil, nil)
+		got, err := s.EditComment(ctx, globalid.Encode(globalid.Comment, 5), "See https://example.com")
+
+		var filterErr *filter.RejectedError
+		assert.ErrorAs(t, err, &filterErr)
+		assert.Equal(t, filter.Hold, filterErr.Decision.Action)
+		assert.Nil(t, got)
+	})
+}
+
+func TestService_DuplicateText(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postID := int64(1)
+	openPolicy := &models.PostCommentPolicy{Policy: models.CommentPolicyOpen}
+	in := models.AddCommentInput{
+		PostID: globalid.Encode(globalid.Post, postID),
+		Author: "Alice",
+		Text:   "First!",
+	}
+	content := filter.New(filter.NewDuplicateText(filter.Reject, 10*time.Minute))
+
+	repo := mocks.NewMockCommentUC(t)
+	broker := pubsubMocks.NewMockBroker(t)
+	repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+	repo.On("Add", mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+	repo.On("Add", mock.Anything, mock.Anything).
+		Return(&models.Comment{ID: 1, PostID: postID, Status: models.CommentStatusPublished}, nil).Once()
+	broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+	s := New(repo, broker, ReplyDepth{}, content, nil, nil)
+
+	// A comment that was not stored does not count as sent.
+	_, err := s.AddComment(ctx, in)
+	assert.EqualError(t, err, "db error")
+
+	_, err = s.AddComment(ctx, in)
+	assert.NoError(t, err)
+
+	_, err = s.AddComment(ctx, in)
+	var filterErr *filter.RejectedError
+	if assert.ErrorAs(t, err, &filterErr) {
+		assert.Equal(t, "duplicate_text", filterErr.Decision.Rule)
+	}
+}
+
+func TestService_BannedAuthor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postID := int64(1)
+
+	t.Run("banned_author_is_refused", func(t *testing.T) {
+		t.Parallel()
+
+		bans := mocks.NewMockBanUC(t)
+		bans.On("IsBanned", mock.Anything, "Alice").Return(true, nil)
+
+		s := New(mocks.NewMockCommentUC(t), pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, bans)
+		got, err := s.AddComment(ctx, models.AddCommentInput{
+			PostID: globalid.Encode(globalid.Post, postID),
+			Author: "Alice",
+			Text:   "Hello",
+		})
+
+		assert.EqualError(t, err, "author is banned")
+		assert.Nil(t, got)
+	})
+
+	t.Run("other_author_is_added", func(t *testing.T) {
+		t.Parallel()
+
+		bans := mocks.NewMockBanUC(t)
+		bans.On("IsBanned", mock.Anything, "Bob").Return(false, nil)
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("GetCommentPolicy", mock.Anything, postID).Return(&models.PostCommentPolicy{Policy: models.CommentPolicyOpen}, nil)
+		repo.On("Add", mock.Anything, mock.Anything).Return(&models.Comment{ID: 1, PostID: postID, Status: models.CommentStatusPublished}, nil)
+		broker := pubsubMocks.NewMockBroker(t)
+		broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+		s := New(repo, broker, ReplyDepth{}, nil, nil, bans)
+		got, err := s.AddComment(ctx, models.AddCommentInput{
+			PostID: globalid.Encode(globalid.Post, postID),
+			Author: "Bob",
+			Text:   "Hello",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), got.ID)
+	})
+}
+
+func TestService_EditComment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name        string
+		commentID   string
+		text        string
+		setupMock   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker)
+		want        *models.Comment
+		expectedErr string
+	}{
+		{
+			name:      "successful_edit",
+			commentID: globalid.Encode(globalid.Comment, 5),
+			text:      "Fixed",
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Edit", mock.Anything, int64(5), "Fixed", mock.AnythingOfType("string")).Return(&models.Comment{
+					ID:        5,
+					PostID:    1,
+					Author:    "Alice",
+					Text:      "Fixed",
+					CreatedAt: now,
+					EditedAt:  &now,
+				}, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentUpdated && e.PostID == 1 && e.Comment.Text == "Fixed"
+				})).Return(nil)
+			},
+			want: &models.Comment{
+				ID:        5,
+				PostID:    1,
+				Author:    "Alice",
+				Text:      "Fixed",
+				CreatedAt: now,
+				EditedAt:  &now,
+			},
+		},
+		{
+			name:        "invalid_commentID",
+			commentID:   "abc",
+			text:        "Fixed",
+			expectedErr: "invalid commentID format",
+		},
+		{
+			name:        "non_positive_commentID",
+			commentID:   globalid.Encode(globalid.Comment, 0),
+			text:        "Fixed",
+			expectedErr: "commentID must be greater 0",
+		},
+		{
+			name:        "empty_text",
+			commentID:   globalid.Encode(globalid.Comment, 5),
+			text:        "",
+			expectedErr: "text cannot be empty",
+		},
+		{
+			name:        "text_too_long",
+			commentID:   globalid.Encode(globalid.Comment, 5),
+			text:        strings.Repeat("a", MaxCommentLenght+1),
+			expectedErr: "max comment length is 2000 char",
+		},
+		{
+			name:      "comment_not_found",
+			commentID: globalid.Encode(globalid.Comment, 999),
+			text:      "Fixed",
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Edit", mock.Anything, int64(999), "Fixed", mock.AnythingOfType("string")).
+					Return(nil, errors.New("comment not found"))
+			},
+			expectedErr: "comment not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockBroker)
+			}
+
+			s := New(mockRepo, mockBroker, ReplyDepth{}, nil, nil, nil)
+			got, err := s.EditComment(ctx, tt.commentID, tt.text)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_Revisions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	rev1 := &models.CommentRevision{ID: 3, CommentID: 5, Text: "v3", CreatedAt: "2026-02-13T12:00:00Z"}
+	rev2 := &models.CommentRevision{ID: 2, CommentID: 5, Text: "v2", CreatedAt: "2026-02-13T11:00:00Z"}
+	rev3 := &models.CommentRevision{ID: 1, CommentID: 5, Text: "v1", CreatedAt: "2026-02-13T10:00:00Z"}
+
+	tests := []struct {
+		name        string
+		first       *int32
+		after       *string
+		setupMock   func(repo *mocks.MockCommentUC)
+		want        *models.CommentRevisionConnection
+		expectedErr string
+	}{
+		{
+			name:  "with_next_page",
+			first: int32Ptr(2),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetRevisions", mock.Anything, int64(5), (*string)(nil), int64(0), int32(3)).
+					Return([]*models.CommentRevision{rev1, rev2, rev3}, nil)
+				repo.On("RevisionCount", mock.Anything, int64(5)).Return(int64(3), nil)
+			},
+			want: &models.CommentRevisionConnection{
+				Edges: []*models.CommentRevisionEdge{
+					{Cursor: cursor.Encode(rev1.CreatedAt, rev1.ID), Node: rev1},
+					{Cursor: cursor.Encode(rev2.CreatedAt, rev2.ID), Node: rev2},
+				},
+				PageInfo: &models.PageInfo{
+					StartCursor: strPtr(cursor.Encode(rev1.CreatedAt, rev1.ID)),
+					EndCursor:   strPtr(cursor.Encode(rev2.CreatedAt, rev2.ID)),
+					HasNextPage: true,
+				},
+				TotalCount: 3,
+			},
+		},
+		{
+			name:  "with_cursor",
+			first: int32Ptr(2),
+			after: strPtr(cursor.Encode(rev2.CreatedAt, rev2.ID)),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				afterCreatedAt := rev2.CreatedAt
+				repo.On("GetRevisions", mock.Anything, int64(5), &afterCreatedAt, rev2.ID, int32(3)).
+					Return([]*models.CommentRevision{rev3}, nil)
+				repo.On("RevisionCount", mock.Anything, int64(5)).Return(int64(3), nil)
+			},
+			want: &models.CommentRevisionConnection{
+				Edges: []*models.CommentRevisionEdge{
+					{Cursor: cursor.Encode(rev3.CreatedAt, rev3.ID), Node: rev3},
+				},
+				PageInfo: &models.PageInfo{
+					StartCursor: strPtr(cursor.Encode(rev3.CreatedAt, rev3.ID)),
+					EndCursor:   strPtr(cursor.Encode(rev3.CreatedAt, rev3.ID)),
+					HasNextPage: false,
+				},
+				TotalCount: 3,
+			},
+		},
+		{
+			name:        "invalid_cursor",
+			after:       strPtr("bad"),
+			expectedErr: "invalid cursor format",
+		},
+		{
+			name: "repo_error",
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetRevisions", mock.Anything, int64(5), (*string)(nil), int64(0), int32(21)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+			got, err := s.Revisions(ctx, 5, tt.first, tt.after)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_DeleteComment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tombstone := &models.Comment{ID: 5, PostID: 1, CreatedAt: "2026-02-12T19:00:00Z", IsDeleted: true}
+
+	tests := []struct {
+		name        string
+		commentID   string
+		setupMock   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker)
+		want        *models.Comment
+		expectedErr string
+	}{
+		{
+			name:      "successful_delete",
+			commentID: globalid.Encode(globalid.Comment, 5),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Delete", mock.Anything, int64(5)).Return(tombstone, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentDeleted && e.PostID == 1 && e.Comment.ID == 5
+				})).Return(nil)
+			},
+			want: tombstone,
+		},
+		{
+			name:        "invalid_commentID",
+			commentID:   "abc",
+			expectedErr: "invalid commentID format",
+		},
+		{
+			name:      "comment_not_found",
+			commentID: globalid.Encode(globalid.Comment, 5),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Delete", mock.Anything, int64(5)).Return(nil, errors.New("comment not found"))
+			},
+			expectedErr: "comment not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockBroker)
+			}
+
+			s := New(mockRepo, mockBroker, ReplyDepth{}, nil, nil, nil)
+			got, err := s.DeleteComment(ctx, tt.commentID)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_PurgeComment(t *testing.T) {
+	t.Parallel()
+
+	moderatorCtx := auth.WithAuthorization(context.Background(), "Bearer secret", "secret")
+	purged := []*models.Comment{
+		{ID: 5, PostID: 1, Status: models.CommentStatusPublished},
+		{ID: 6, PostID: 1, Status: models.CommentStatusPublished},
+	}
+	pending := []*models.Comment{{ID: 7, PostID: 1, Status: models.CommentStatusPending}}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		commentID   string
+		setupMock   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker)
+		want        []*models.Comment
+		expectedErr string
+	}{
+		{
+			name:      "successful_purge",
+			ctx:       moderatorCtx,
+			commentID: globalid.Encode(globalid.Comment, 5),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Purge", mock.Anything, int64(5)).Return(purged, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentDeleted && e.PostID == 1
+				})).Return(nil).Times(2)
+			},
+			want: purged,
+		},
+		{
+			name:      "pending_comment_not_announced",
+			ctx:       moderatorCtx,
+			commentID: globalid.Encode(globalid.Comment, 7),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Purge", mock.Anything, int64(7)).Return(pending, nil)
+			},
+			want: pending,
+		},
+		{
+			name:        "not_a_moderator",
+			ctx:         context.Background(),
+			commentID:   globalid.Encode(globalid.Comment, 5),
+			expectedErr: "moderator access required",
+		},
+		{
+			name:        "invalid_commentID",
+			ctx:         moderatorCtx,
+			commentID:   globalid.Encode(globalid.Comment, 0),
+			expectedErr: "commentID must be greater 0",
+		},
+		{
+			name:      "comment_not_found",
+			ctx:       moderatorCtx,
+			commentID: globalid.Encode(globalid.Comment, 5),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Purge", mock.Anything, int64(5)).Return(nil, errors.New("comment not found"))
+			},
+			expectedErr: "comment not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockBroker)
+			}
+
+			s := New(mockRepo, mockBroker, ReplyDepth{}, nil, nil, nil)
+			got, err := s.PurgeComment(tt.ctx, tt.commentID)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_ModerationQueue(t *testing.T) {
+	t.Parallel()
+
+	moderatorCtx := auth.WithAuthorization(context.Background(), "Bearer secret", "secret")
+	postID := int64(1)
+	first := &models.Comment{ID: 5, PostID: postID, CreatedAt: "2026-02-13T10:00:00Z", Status: models.CommentStatusPending}
+	second := &models.Comment{ID: 6, PostID: postID, CreatedAt: "2026-02-13T11:00:00Z", Status: models.CommentStatusPending}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		postID      *string
+		first       *int32
+		after       *string
+		setupMock   func(repo *mocks.MockCommentUC)
+		want        *models.CommentConnection
+		expectedErr string
+	}{
+		{
+			name:  "with_next_page",
+			ctx:   moderatorCtx,
+			first: int32Ptr(1),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetPending", mock.Anything, (*int64)(nil), (*string)(nil), int64(0), int32(2)).
+					Return([]*models.Comment{first, second}, nil)
+				repo.On("PendingCount", mock.Anything, (*int64)(nil)).Return(int64(2), nil)
+			},
+			want: &models.CommentConnection{
+				Edges: []*models.CommentEdge{
+					{Cursor: cursor.Encode(first.CreatedAt, first.ID), Node: first},
+				},
+				PageInfo: &models.PageInfo{
+					StartCursor: strPtr(cursor.Encode(first.CreatedAt, first.ID)),
+					EndCursor:   strPtr(cursor.Encode(first.CreatedAt, first.ID)),
+					HasNextPage: true,
+				},
+				TotalCount: 2,
+			},
+		},
+		{
+			name:   "one_post_with_cursor",
+			ctx:    moderatorCtx,
+			postID: strPtr(globalid.Encode(globalid.Post, postID)),
+			first:  int32Ptr(1),
+			after:  strPtr(cursor.Encode(first.CreatedAt, first.ID)),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				afterCreatedAt := first.CreatedAt
+				repo.On("GetPending", mock.Anything, &postID, &afterCreatedAt, first.ID, int32(2)).
+					Return([]*models.Comment{second}, nil)
+				repo.On("PendingCount", mock.Anything, &postID).Return(int64(2), nil)
+			},
+			want: &models.CommentConnection{
+				Edges: []*models.CommentEdge{
+					{Cursor: cursor.Encode(second.CreatedAt, second.ID), Node: second},
+				},
+				PageInfo: &models.PageInfo{
+					StartCursor: strPtr(cursor.Encode(second.CreatedAt, second.ID)),
+					EndCursor:   strPtr(cursor.Encode(second.CreatedAt, second.ID)),
+				},
+				TotalCount: 2,
+			},
+		},
+		{
+			name:        "not_a_moderator",
+			ctx:         context.Background(),
+			expectedErr: "moderator access required",
+		},
+		{
+			name:        "invalid_postID",
+			ctx:         moderatorCtx,
+			postID:      strPtr("bad"),
+			expectedErr: "invalid postID format",
+		},
+		{
+			name: "repo_error",
+			ctx:  moderatorCtx,
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("GetPending", mock.Anything, (*int64)(nil), (*string)(nil), int64(0), int32(21)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+			got, err := s.ModerationQueue(tt.ctx, tt.postID, tt.first, tt.after)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_ApproveComment(t *testing.T) {
+	t.Parallel()
+
+	moderatorCtx := auth.WithAuthorization(context.Background(), "Bearer secret", "secret")
+	approved := &models.Comment{ID: 5, PostID: 1, Status: models.CommentStatusPublished}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		commentID   string
+		setupMock   func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker)
+		want        *models.Comment
+		expectedErr string
+	}{
+		{
+			name:      "successful_approve",
+			ctx:       moderatorCtx,
+			commentID: globalid.Encode(globalid.Comment, 5),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Approve", mock.Anything, int64(5)).Return(approved, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentAdded && e.PostID == 1 && e.Comment.ID == 5
+				})).Return(nil)
+			},
+			want: approved,
+		},
+		{
+			name:        "not_a_moderator",
+			ctx:         context.Background(),
+			commentID:   globalid.Encode(globalid.Comment, 5),
+			expectedErr: "moderator access required",
+		},
+		{
+			name:        "invalid_commentID",
+			ctx:         moderatorCtx,
+			commentID:   globalid.Encode(globalid.Comment, 0),
+			expectedErr: "commentID must be greater 0",
+		},
+		{
+			name:      "not_pending",
+			ctx:       moderatorCtx,
+			commentID: globalid.Encode(globalid.Comment, 5),
+			setupMock: func(repo *mocks.MockCommentUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Approve", mock.Anything, int64(5)).Return(nil, errors.New("comment is not awaiting moderation"))
+			},
+			expectedErr: "comment is not awaiting moderation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockBroker)
+			}
+
+			s := New(mockRepo, mockBroker, ReplyDepth{}, nil, nil, nil)
+			got, err := s.ApproveComment(tt.ctx, tt.commentID)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_ApproveComment_ReachesResumedSubscriber(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	moderatorCtx := auth.WithAuthorization(ctx, "Bearer secret", "secret")
+
+	postID := int64(1)
+	now := time.Now().UTC()
+	repo := inmemoryComment.New(inmemoryPost.New())
+
+	held, err := repo.Add(ctx, models.Comment{
+		PostID:    postID,
+		Author:    "Alice",
+		Text:      "Held",
+		CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339),
+		Status:    models.CommentStatusPending,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	received, err := repo.Add(ctx, models.Comment{
+		PostID:    postID,
+		Author:    "Bob",
+		Text:      "Received",
+		CreatedAt: now.Add(-time.Hour).Format(time.RFC3339),
+		Status:    models.CommentStatusPublished,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s := New(repo, inmemoryPubsub.New(), ReplyDepth{}, nil, nil, nil)
+	after := cursor.Encode(received.CreatedAt, received.ID)
+	ch, err := s.CommentAdded(ctx, globalid.Encode(globalid.Post, postID), &after)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = s.ApproveComment(moderatorCtx, globalid.Encode(globalid.Comment, held.ID))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	select {
+	case got := <-ch:
+		assert.Equal(t, held.ID, got.ID)
+	case <-time.After(time.Second):
+		t.Fatal("approved comment older than the cursor was not delivered")
+	}
+}
+
+func TestService_RejectComment(t *testing.T) {
+	t.Parallel()
+
+	moderatorCtx := auth.WithAuthorization(context.Background(), "Bearer secret", "secret")
+	reason := "spam"
+	rejected := &models.Comment{ID: 5, PostID: 1, Status: models.CommentStatusRejected, RejectionReason: &reason}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		reason      *string
+		setupMock   func(repo *mocks.MockCommentUC)
+		want        *models.Comment
+		expectedErr string
+	}{
+		{
+			name:   "reason_is_trimmed",
+			ctx:    moderatorCtx,
+			reason: strPtr("  spam "),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("Reject", mock.Anything, int64(5), &reason).Return(rejected, nil)
+			},
+			want: rejected,
+		},
+		{
+			name:   "blank_reason_is_dropped",
+			ctx:    moderatorCtx,
+			reason: strPtr("   "),
+			setupMock: func(repo *mocks.MockCommentUC) {
+				repo.On("Reject", mock.Anything, int64(5), (*string)(nil)).Return(rejected, nil)
+			},
+			want: rejected,
+		},
+		{
+			name:        "not_a_moderator",
+			ctx:         context.Background(),
+			expectedErr: "moderator access required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCommentUC(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, nil, nil)
+			got, err := s.RejectComment(tt.ctx, globalid.Encode(globalid.Comment, 5), tt.reason)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_SpamClassifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	moderatorCtx := auth.WithAuthorization(ctx, "Bearer secret", "secret")
+	postID := int64(1)
+	openPolicy := &models.PostCommentPolicy{Policy: models.CommentPolicyOpen}
+
+	classifier := func(t *testing.T) (*spam.Classifier, *mocks.MockSpamUC) {
+		t.Helper()
+
+		spamRepo := mocks.NewMockSpamUC(t)
+		spamRepo.On("LoadStats", mock.Anything).Return(&models.SpamStats{
+			SpamDocs: 5,
+			HamDocs:  5,
+			Tokens: map[string]models.SpamTokenCount{
+				"casino": {Spam: 5},
+				"bonus":  {Spam: 5},
+				"thanks": {Ham: 5},
+			},
+		}, nil)
+
+		c := spam.New(spamRepo, 0.9)
+		assert.NoError(t, c.Load(ctx))
+
+		return c, spamRepo
+	}
+
+	t.Run("spam_is_held", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := classifier(t)
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+		repo.On("Add", mock.Anything, mock.MatchedBy(func(c models.Comment) bool {
+			return c.Status == models.CommentStatusPending && c.SpamScore != nil && *c.SpamScore > 0.9
+		})).Return(&models.Comment{ID: 1, PostID: postID, Status: models.CommentStatusPending}, nil)
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, c, nil)
+		got, err := s.AddComment(ctx, models.AddCommentInput{
+			PostID: globalid.Encode(globalid.Post, postID),
+			Author: "Alice",
+			Text:   "Casino bonus!",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.CommentStatusPending, got.Status)
+	})
+
+	t.Run("ham_is_published", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := classifier(t)
+		repo := mocks.NewMockCommentUC(t)
+		broker := pubsubMocks.NewMockBroker(t)
+		repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+		repo.On("Add", mock.Anything, mock.MatchedBy(func(c models.Comment) bool {
+			return c.Status == models.CommentStatusPublished && c.SpamScore != nil && *c.SpamScore < 0.5
+		})).Return(&models.Comment{ID: 1, PostID: postID, Status: models.CommentStatusPublished}, nil)
+		broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+		s := New(repo, broker, ReplyDepth{}, nil, c, nil)
+		got, err := s.AddComment(ctx, models.AddCommentInput{
+			PostID: globalid.Encode(globalid.Post, postID),
+			Author: "Alice",
+			Text:   "Thanks!",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.CommentStatusPublished, got.Status)
+	})
+
+	t.Run("approve_trains_ham", func(t *testing.T) {
+		t.Parallel()
+
+		c, spamRepo := classifier(t)
+		spamRepo.On("Train", mock.Anything, []string{"thanks", "again"}, false).Return(nil)
+
+		repo := mocks.NewMockCommentUC(t)
+		broker := pubsubMocks.NewMockBroker(t)
+		repo.On("Approve", mock.Anything, int64(5)).
+			Return(&models.Comment{ID: 5, PostID: postID, Text: "Thanks again", Status: models.CommentStatusPublished}, nil)
+		broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+		s := New(repo, broker, ReplyDepth{}, nil, c, nil)
+		_, err := s.ApproveComment(moderatorCtx, globalid.Encode(globalid.Comment, 5))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("reject_trains_spam_and_ignores_training_errors", func(t *testing.T) {
+		t.Parallel()
+
+		c, spamRepo := classifier(t)
+		spamRepo.On("Train", mock.Anything, []string{"casino"}, true).Return(errors.New("db error"))
+
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("Reject", mock.Anything, int64(5), (*string)(nil)).
+			Return(&models.Comment{ID: 5, PostID: postID, Text: "Casino", Status: models.CommentStatusRejected}, nil)
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, c, nil)
+		got, err := s.RejectComment(moderatorCtx, globalid.Encode(globalid.Comment, 5), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.CommentStatusRejected, got.Status)
+	})
+
+	t.Run("score", func(t *testing.T) {
+		t.Parallel()
+
+		stored := 0.42
+		repo := mocks.NewMockCommentUC(t)
+		repo.On("GetSpamScore", mock.Anything, int64(5)).Return(&stored, nil)
+
+		c, _ := classifier(t)
+		s := New(repo, pubsubMocks.NewMockBroker(t), ReplyDepth{}, nil, c, nil)
+
+		score, err := s.SpamScore(moderatorCtx, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, &stored, score)
+
+		score, err = s.SpamScore(ctx, 5)
+		assert.EqualError(t, err, "moderator access required")
+		assert.Nil(t, score)
+	})
+
+	t.Run("unscored_without_model", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockCommentUC(t)
+		broker := pubsubMocks.NewMockBroker(t)
+		repo.On("GetCommentPolicy", mock.Anything, postID).Return(openPolicy, nil)
+		repo.On("Add", mock.Anything, mock.MatchedBy(func(c models.Comment) bool {
+			return c.Status == models.CommentStatusPublished && c.SpamScore == nil
+		})).Return(&models.Comment{ID: 1, PostID: postID, Status: models.CommentStatusPublished}, nil)
+		broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+		s := New(repo, broker, ReplyDepth{}, nil, nil, nil)
+		_, err := s.AddComment(ctx, models.AddCommentInput{
+			PostID: globalid.Encode(globalid.Post, postID),
+			Author: "Alice",
+			Text:   "Casino bonus!",
+		})
+
+		assert.NoError(t, err)
+	})
+}
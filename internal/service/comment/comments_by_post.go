@@ -2,26 +2,17 @@ package comment
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/pkg/errors"
 
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/pagination"
 )
 
-const (
-	defaultPageLimit = 20
-)
-
-type cursorPosition struct {
-	afterCreatedAt *string
-	afterID        int64
-	err            error
-}
-
-func (s *Service) GetRootComments(ctx context.Context, postID string, first *int32, after *string) (*models.CommentConnection, error) {
-	pID, err := strconv.ParseInt(postID, 10, 64)
+func (s *Service) GetRootComments(ctx context.Context, postID string, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error) {
+	pID, err := globalid.DecodeAs(globalid.Post, postID)
 	if err != nil {
 		return nil, errors.New("invalid postID format")
 	}
@@ -29,97 +20,57 @@ func (s *Service) GetRootComments(ctx context.Context, postID string, first *int
 		return nil, errors.New("postID must be greater 0")
 	}
 
-	limit := s.getLimit(first)
-
-	cursorPos := s.parseCursor(after)
-	if cursorPos.err != nil {
-		return nil, cursorPos.err
-	}
-
-	comments, err := s.repo.GetRootByPost(ctx, pID, cursorPos.afterCreatedAt, cursorPos.afterID, limit+1)
+	order, err := parseCommentOrder(orderBy)
 	if err != nil {
 		return nil, err
 	}
 
-	hasNextPage, pageComments := s.extractPage(comments, limit)
-
-	edges := s.buildEdges(pageComments)
-
-	totalCount, err := s.repo.TotalCount(ctx, pID)
+	window, err := pagination.ParseWindow(first, after, last, before)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.buildConnection(edges, hasNextPage, totalCount), nil
-}
-
-func (s *Service) GetChildComments(ctx context.Context, parentID string, first *int32, after *string) (*models.CommentConnection, error) {
-	pID, err := strconv.ParseInt(parentID, 10, 64)
-	if err != nil || pID <= 0 {
-		return nil, errors.New("invalid parentID")
-	}
-
-	limit := s.getLimit(first)
-
-	cursorPos := s.parseCursor(after)
-	if cursorPos.err != nil {
-		return nil, cursorPos.err
-	}
-
-	comments, err := s.repo.GetChild(ctx, pID, cursorPos.afterCreatedAt, cursorPos.afterID, limit+1)
+	pos, err := cursor.ParseComment(order, window.Cursor)
 	if err != nil {
 		return nil, err
 	}
 
-	hasNextPage, pageComments := s.extractPage(comments, limit)
-
-	edges := s.buildEdges(pageComments)
-
-	totalCount := int64(len(pageComments))
-
-	return s.buildConnection(edges, hasNextPage, totalCount), nil
-}
-
-func (s *Service) getLimit(first *int32) int32 {
-	if first != nil && *first > 0 {
-		return *first
+	comments, err := s.repo.GetRootByPost(ctx, pID, order, pos, window.Backward, window.Limit+1)
+	if err != nil {
+		return nil, err
 	}
 
-	return defaultPageLimit
-}
+	hasMore, pageComments := pagination.ExtractPage(comments, window)
 
-func (s *Service) parseCursor(after *string) cursorPosition {
-	if after == nil || *after == "" {
-		return cursorPosition{}
-	}
+	edges := s.buildEdges(order, pageComments)
 
-	createdAt, id, err := cursor.Decode(*after)
+	totalCount, err := s.repo.TotalCount(ctx, pID)
 	if err != nil {
-		return cursorPosition{
-			err: errors.New("invalid cursor format"),
-		}
+		return nil, err
 	}
 
-	return cursorPosition{
-		afterCreatedAt: &createdAt,
-		afterID:        id,
-	}
+	return s.buildConnection(edges, window, hasMore, totalCount), nil
 }
 
-func (s *Service) extractPage(comments []*models.Comment, limit int32) (hasNextPage bool, page []*models.Comment) {
-	if len(comments) > int(limit) {
-		return true, comments[:limit]
+func parseCommentOrder(orderBy *models.CommentOrder) (models.CommentOrder, error) {
+	if orderBy == nil {
+		return models.CommentOrderNewest, nil
 	}
 
-	return false, comments
+	switch *orderBy {
+	case models.CommentOrderNewest, models.CommentOrderOldest, models.CommentOrderTop, models.CommentOrderControversial:
+		return *orderBy, nil
+	default:
+		return "", errors.New("invalid comment order")
+	}
 }
 
-func (s *Service) buildEdges(comments []*models.Comment) []*models.CommentEdge {
+func (s *Service) buildEdges(order models.CommentOrder, comments []*models.Comment) []*models.CommentEdge {
 	edges := make([]*models.CommentEdge, 0, len(comments))
 
 	for _, c := range comments {
 		edge := &models.CommentEdge{
-			Cursor: cursor.Encode(c.CreatedAt, c.ID),
+			Cursor: cursor.EncodeComment(order, c),
 			Node:   c,
 		}
 		edges = append(edges, edge)
@@ -128,21 +79,14 @@ func (s *Service) buildEdges(comments []*models.Comment) []*models.CommentEdge {
 	return edges
 }
 
-func (s *Service) getEndCursor(edges []*models.CommentEdge) *string {
-	if len(edges) == 0 {
-		return nil
-	}
-
-	return &edges[len(edges)-1].Cursor
+func commentCursor(edge *models.CommentEdge) string {
+	return edge.Cursor
 }
 
-func (s *Service) buildConnection(edges []*models.CommentEdge, hasNextPage bool, totalCount int64) *models.CommentConnection {
+func (s *Service) buildConnection(edges []*models.CommentEdge, window pagination.Window, hasMore bool, totalCount int64) *models.CommentConnection {
 	return &models.CommentConnection{
-		Edges: edges,
-		PageInfo: &models.PageInfo{
-			EndCursor:   s.getEndCursor(edges),
-			HasNextPage: hasNextPage,
-		},
+		Edges:      edges,
+		PageInfo:   pagination.BuildPageInfo(edges, commentCursor, window, hasMore),
 		TotalCount: int32(totalCount),
 	}
 }
@@ -0,0 +1,52 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// DeleteComment replaces the comment with a tombstone so its replies stay
+// reachable.
+func (s *Service) DeleteComment(ctx context.Context, commentID string) (*models.Comment, error) {
+	cID, err := parseCommentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.Delete(ctx, cID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, models.EventCommentDeleted, comment)
+
+	return comment, nil
+}
+
+// PurgeComment removes the comment and its whole reply subtree for good and
+// returns the removed comments. Only moderators may purge.
+func (s *Service) PurgeComment(ctx context.Context, commentID string) ([]*models.Comment, error) {
+	if err := auth.RequireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	cID, err := parseCommentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	purged, err := s.repo.Purge(ctx, cID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, comment := range purged {
+		if comment.Status == models.CommentStatusPublished {
+			s.publish(ctx, models.EventCommentDeleted, comment)
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,55 @@
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (s *Service) EditComment(ctx context.Context, commentID string, text string) (*models.Comment, error) {
+	cID, err := parseCommentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if text == "" {
+		return nil, errors.New("text cannot be empty")
+	}
+
+	if err := checkCommentLength(text); err != nil {
+		return nil, err
+	}
+
+	// A published comment cannot go back to the moderation queue, so an edit
+	// the filter would hold is turned away instead.
+	if decision := s.content.Check(ctx, filter.Content{Text: text}); decision.Action != filter.Allow {
+		return nil, &filter.RejectedError{Decision: decision}
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	comment, err := s.repo.Edit(ctx, cID, text, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, models.EventCommentUpdated, comment)
+
+	return comment, nil
+}
+
+func parseCommentID(commentID string) (int64, error) {
+	cID, err := globalid.DecodeAs(globalid.Comment, commentID)
+	if err != nil {
+		return 0, errors.New("invalid commentID format")
+	}
+	if cID <= 0 {
+		return 0, errors.New("commentID must be greater 0")
+	}
+
+	return cID, nil
+}
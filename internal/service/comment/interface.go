@@ -8,7 +8,22 @@ import (
 
 type UseCase interface {
 	AddComment(ctx context.Context, in models.AddCommentInput) (*models.Comment, error)
-	GetRootComments(ctx context.Context, postID string, first *int32, after *string) (*models.CommentConnection, error)
-	GetChildComments(ctx context.Context, parentID string, first *int32, after *string) (*models.CommentConnection, error)
-	Children(ctx context.Context, parentID int64, first *int32, after *string) (*models.CommentConnection, error)
+	EditComment(ctx context.Context, commentID string, text string) (*models.Comment, error)
+	DeleteComment(ctx context.Context, commentID string) (*models.Comment, error)
+	PurgeComment(ctx context.Context, commentID string) ([]*models.Comment, error)
+	ModerationQueue(ctx context.Context, postID *string, first *int32, after *string) (*models.CommentConnection, error)
+	ApproveComment(ctx context.Context, commentID string) (*models.Comment, error)
+	RejectComment(ctx context.Context, commentID string, reason *string) (*models.Comment, error)
+	SpamScore(ctx context.Context, commentID int64) (*float64, error)
+	GetRootComments(ctx context.Context, postID string, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error)
+	GetComment(ctx context.Context, commentID string) (*models.Comment, error)
+	Ancestors(ctx context.Context, commentID int64) ([]*models.Comment, error)
+	GetThread(ctx context.Context, postID string, rootID *string, maxDepth, maxPerLevel *int32) ([]*models.ThreadComment, error)
+	PostComments(ctx context.Context, postID int64, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error)
+	CommentCount(ctx context.Context, postID int64) (int64, error)
+	Children(ctx context.Context, parentID int64, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error)
+	ReplyCount(ctx context.Context, parentID int64) (int64, error)
+	Revisions(ctx context.Context, commentID int64, first *int32, after *string) (*models.CommentRevisionConnection, error)
+	CommentAdded(ctx context.Context, postID string, after *string) (<-chan *models.Comment, error)
+	CommentEvents(ctx context.Context, postID string) (<-chan *models.Event, error)
 }
@@ -81,9 +81,127 @@ func (_c *MockUseCase_AddComment_Call) RunAndReturn(run func(context.Context, mo
 	return _c
 }
 
-// Children provides a mock function with given fields: ctx, parentID, first, after
-func (_m *MockUseCase) Children(ctx context.Context, parentID int64, first *int32, after *string) (*models.CommentConnection, error) {
-	ret := _m.Called(ctx, parentID, first, after)
+// Ancestors provides a mock function with given fields: ctx, commentID
+func (_m *MockUseCase) Ancestors(ctx context.Context, commentID int64) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ancestors")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_Ancestors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ancestors'
+type MockUseCase_Ancestors_Call struct {
+	*mock.Call
+}
+
+// Ancestors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockUseCase_Expecter) Ancestors(ctx interface{}, commentID interface{}) *MockUseCase_Ancestors_Call {
+	return &MockUseCase_Ancestors_Call{Call: _e.mock.On("Ancestors", ctx, commentID)}
+}
+
+func (_c *MockUseCase_Ancestors_Call) Run(run func(ctx context.Context, commentID int64)) *MockUseCase_Ancestors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Ancestors_Call) Return(_a0 []*models.Comment, _a1 error) *MockUseCase_Ancestors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_Ancestors_Call) RunAndReturn(run func(context.Context, int64) ([]*models.Comment, error)) *MockUseCase_Ancestors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveComment provides a mock function with given fields: ctx, commentID
+func (_m *MockUseCase) ApproveComment(ctx context.Context, commentID string) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveComment")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_ApproveComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveComment'
+type MockUseCase_ApproveComment_Call struct {
+	*mock.Call
+}
+
+// ApproveComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID string
+func (_e *MockUseCase_Expecter) ApproveComment(ctx interface{}, commentID interface{}) *MockUseCase_ApproveComment_Call {
+	return &MockUseCase_ApproveComment_Call{Call: _e.mock.On("ApproveComment", ctx, commentID)}
+}
+
+func (_c *MockUseCase_ApproveComment_Call) Run(run func(ctx context.Context, commentID string)) *MockUseCase_ApproveComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_ApproveComment_Call) Return(_a0 *models.Comment, _a1 error) *MockUseCase_ApproveComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_ApproveComment_Call) RunAndReturn(run func(context.Context, string) (*models.Comment, error)) *MockUseCase_ApproveComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Children provides a mock function with given fields: ctx, parentID, first, after, last, before, orderBy
+func (_m *MockUseCase) Children(ctx context.Context, parentID int64, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error) {
+	ret := _m.Called(ctx, parentID, first, after, last, before, orderBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Children")
@@ -91,19 +209,437 @@ func (_m *MockUseCase) Children(ctx context.Context, parentID int64, first *int3
 
 	var r0 *models.CommentConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *int32, *string) (*models.CommentConnection, error)); ok {
-		return rf(ctx, parentID, first, after)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int32, *string, *int32, *string, *models.CommentOrder) (*models.CommentConnection, error)); ok {
+		return rf(ctx, parentID, first, after, last, before, orderBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int32, *string, *int32, *string, *models.CommentOrder) *models.CommentConnection); ok {
+		r0 = rf(ctx, parentID, first, after, last, before, orderBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CommentConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *int32, *string, *int32, *string, *models.CommentOrder) error); ok {
+		r1 = rf(ctx, parentID, first, after, last, before, orderBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_Children_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Children'
+type MockUseCase_Children_Call struct {
+	*mock.Call
+}
+
+// Children is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID int64
+//   - first *int32
+//   - after *string
+//   - last *int32
+//   - before *string
+//   - orderBy *models.CommentOrder
+func (_e *MockUseCase_Expecter) Children(ctx interface{}, parentID interface{}, first interface{}, after interface{}, last interface{}, before interface{}, orderBy interface{}) *MockUseCase_Children_Call {
+	return &MockUseCase_Children_Call{Call: _e.mock.On("Children", ctx, parentID, first, after, last, before, orderBy)}
+}
+
+func (_c *MockUseCase_Children_Call) Run(run func(ctx context.Context, parentID int64, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder)) *MockUseCase_Children_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*int32), args[3].(*string), args[4].(*int32), args[5].(*string), args[6].(*models.CommentOrder))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Children_Call) Return(_a0 *models.CommentConnection, _a1 error) *MockUseCase_Children_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_Children_Call) RunAndReturn(run func(context.Context, int64, *int32, *string, *int32, *string, *models.CommentOrder) (*models.CommentConnection, error)) *MockUseCase_Children_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommentAdded provides a mock function with given fields: ctx, postID, after
+func (_m *MockUseCase) CommentAdded(ctx context.Context, postID string, after *string) (<-chan *models.Comment, error) {
+	ret := _m.Called(ctx, postID, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommentAdded")
+	}
+
+	var r0 <-chan *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string) (<-chan *models.Comment, error)); ok {
+		return rf(ctx, postID, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string) <-chan *models.Comment); ok {
+		r0 = rf(ctx, postID, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string) error); ok {
+		r1 = rf(ctx, postID, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_CommentAdded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommentAdded'
+type MockUseCase_CommentAdded_Call struct {
+	*mock.Call
+}
+
+// CommentAdded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - after *string
+func (_e *MockUseCase_Expecter) CommentAdded(ctx interface{}, postID interface{}, after interface{}) *MockUseCase_CommentAdded_Call {
+	return &MockUseCase_CommentAdded_Call{Call: _e.mock.On("CommentAdded", ctx, postID, after)}
+}
+
+func (_c *MockUseCase_CommentAdded_Call) Run(run func(ctx context.Context, postID string, after *string)) *MockUseCase_CommentAdded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_CommentAdded_Call) Return(_a0 <-chan *models.Comment, _a1 error) *MockUseCase_CommentAdded_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_CommentAdded_Call) RunAndReturn(run func(context.Context, string, *string) (<-chan *models.Comment, error)) *MockUseCase_CommentAdded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommentCount provides a mock function with given fields: ctx, postID
+func (_m *MockUseCase) CommentCount(ctx context.Context, postID int64) (int64, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommentCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_CommentCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommentCount'
+type MockUseCase_CommentCount_Call struct {
+	*mock.Call
+}
+
+// CommentCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+func (_e *MockUseCase_Expecter) CommentCount(ctx interface{}, postID interface{}) *MockUseCase_CommentCount_Call {
+	return &MockUseCase_CommentCount_Call{Call: _e.mock.On("CommentCount", ctx, postID)}
+}
+
+func (_c *MockUseCase_CommentCount_Call) Run(run func(ctx context.Context, postID int64)) *MockUseCase_CommentCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_CommentCount_Call) Return(_a0 int64, _a1 error) *MockUseCase_CommentCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_CommentCount_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockUseCase_CommentCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommentEvents provides a mock function with given fields: ctx, postID
+func (_m *MockUseCase) CommentEvents(ctx context.Context, postID string) (<-chan *models.Event, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommentEvents")
+	}
+
+	var r0 <-chan *models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (<-chan *models.Event, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) <-chan *models.Event); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_CommentEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommentEvents'
+type MockUseCase_CommentEvents_Call struct {
+	*mock.Call
+}
+
+// CommentEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockUseCase_Expecter) CommentEvents(ctx interface{}, postID interface{}) *MockUseCase_CommentEvents_Call {
+	return &MockUseCase_CommentEvents_Call{Call: _e.mock.On("CommentEvents", ctx, postID)}
+}
+
+func (_c *MockUseCase_CommentEvents_Call) Run(run func(ctx context.Context, postID string)) *MockUseCase_CommentEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_CommentEvents_Call) Return(_a0 <-chan *models.Event, _a1 error) *MockUseCase_CommentEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_CommentEvents_Call) RunAndReturn(run func(context.Context, string) (<-chan *models.Event, error)) *MockUseCase_CommentEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, commentID
+func (_m *MockUseCase) DeleteComment(ctx context.Context, commentID string) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockUseCase_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID string
+func (_e *MockUseCase_Expecter) DeleteComment(ctx interface{}, commentID interface{}) *MockUseCase_DeleteComment_Call {
+	return &MockUseCase_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, commentID)}
+}
+
+func (_c *MockUseCase_DeleteComment_Call) Run(run func(ctx context.Context, commentID string)) *MockUseCase_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DeleteComment_Call) Return(_a0 *models.Comment, _a1 error) *MockUseCase_DeleteComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_DeleteComment_Call) RunAndReturn(run func(context.Context, string) (*models.Comment, error)) *MockUseCase_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditComment provides a mock function with given fields: ctx, commentID, text
+func (_m *MockUseCase) EditComment(ctx context.Context, commentID string, text string) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditComment")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Comment, error)); ok {
+		return rf(ctx, commentID, text)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Comment); ok {
+		r0 = rf(ctx, commentID, text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, commentID, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_EditComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditComment'
+type MockUseCase_EditComment_Call struct {
+	*mock.Call
+}
+
+// EditComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID string
+//   - text string
+func (_e *MockUseCase_Expecter) EditComment(ctx interface{}, commentID interface{}, text interface{}) *MockUseCase_EditComment_Call {
+	return &MockUseCase_EditComment_Call{Call: _e.mock.On("EditComment", ctx, commentID, text)}
+}
+
+func (_c *MockUseCase_EditComment_Call) Run(run func(ctx context.Context, commentID string, text string)) *MockUseCase_EditComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_EditComment_Call) Return(_a0 *models.Comment, _a1 error) *MockUseCase_EditComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_EditComment_Call) RunAndReturn(run func(context.Context, string, string) (*models.Comment, error)) *MockUseCase_EditComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComment provides a mock function with given fields: ctx, commentID
+func (_m *MockUseCase) GetComment(ctx context.Context, commentID string) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComment")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComment'
+type MockUseCase_GetComment_Call struct {
+	*mock.Call
+}
+
+// GetComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID string
+func (_e *MockUseCase_Expecter) GetComment(ctx interface{}, commentID interface{}) *MockUseCase_GetComment_Call {
+	return &MockUseCase_GetComment_Call{Call: _e.mock.On("GetComment", ctx, commentID)}
+}
+
+func (_c *MockUseCase_GetComment_Call) Run(run func(ctx context.Context, commentID string)) *MockUseCase_GetComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetComment_Call) Return(_a0 *models.Comment, _a1 error) *MockUseCase_GetComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetComment_Call) RunAndReturn(run func(context.Context, string) (*models.Comment, error)) *MockUseCase_GetComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRootComments provides a mock function with given fields: ctx, postID, first, after, last, before, orderBy
+func (_m *MockUseCase) GetRootComments(ctx context.Context, postID string, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error) {
+	ret := _m.Called(ctx, postID, first, after, last, before, orderBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRootComments")
+	}
+
+	var r0 *models.CommentConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int32, *string, *int32, *string, *models.CommentOrder) (*models.CommentConnection, error)); ok {
+		return rf(ctx, postID, first, after, last, before, orderBy)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *int32, *string) *models.CommentConnection); ok {
-		r0 = rf(ctx, parentID, first, after)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int32, *string, *int32, *string, *models.CommentOrder) *models.CommentConnection); ok {
+		r0 = rf(ctx, postID, first, after, last, before, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.CommentConnection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, *int32, *string) error); ok {
-		r1 = rf(ctx, parentID, first, after)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *int32, *string, *int32, *string, *models.CommentOrder) error); ok {
+		r1 = rf(ctx, postID, first, after, last, before, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,60 +647,63 @@ func (_m *MockUseCase) Children(ctx context.Context, parentID int64, first *int3
 	return r0, r1
 }
 
-// MockUseCase_Children_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Children'
-type MockUseCase_Children_Call struct {
+// MockUseCase_GetRootComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRootComments'
+type MockUseCase_GetRootComments_Call struct {
 	*mock.Call
 }
 
-// Children is a helper method to define mock.On call
+// GetRootComments is a helper method to define mock.On call
 //   - ctx context.Context
-//   - parentID int64
+//   - postID string
 //   - first *int32
 //   - after *string
-func (_e *MockUseCase_Expecter) Children(ctx interface{}, parentID interface{}, first interface{}, after interface{}) *MockUseCase_Children_Call {
-	return &MockUseCase_Children_Call{Call: _e.mock.On("Children", ctx, parentID, first, after)}
+//   - last *int32
+//   - before *string
+//   - orderBy *models.CommentOrder
+func (_e *MockUseCase_Expecter) GetRootComments(ctx interface{}, postID interface{}, first interface{}, after interface{}, last interface{}, before interface{}, orderBy interface{}) *MockUseCase_GetRootComments_Call {
+	return &MockUseCase_GetRootComments_Call{Call: _e.mock.On("GetRootComments", ctx, postID, first, after, last, before, orderBy)}
 }
 
-func (_c *MockUseCase_Children_Call) Run(run func(ctx context.Context, parentID int64, first *int32, after *string)) *MockUseCase_Children_Call {
+func (_c *MockUseCase_GetRootComments_Call) Run(run func(ctx context.Context, postID string, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder)) *MockUseCase_GetRootComments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(*int32), args[3].(*string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*int32), args[3].(*string), args[4].(*int32), args[5].(*string), args[6].(*models.CommentOrder))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_Children_Call) Return(_a0 *models.CommentConnection, _a1 error) *MockUseCase_Children_Call {
+func (_c *MockUseCase_GetRootComments_Call) Return(_a0 *models.CommentConnection, _a1 error) *MockUseCase_GetRootComments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_Children_Call) RunAndReturn(run func(context.Context, int64, *int32, *string) (*models.CommentConnection, error)) *MockUseCase_Children_Call {
+func (_c *MockUseCase_GetRootComments_Call) RunAndReturn(run func(context.Context, string, *int32, *string, *int32, *string, *models.CommentOrder) (*models.CommentConnection, error)) *MockUseCase_GetRootComments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetChildComments provides a mock function with given fields: ctx, parentID, first, after
-func (_m *MockUseCase) GetChildComments(ctx context.Context, parentID string, first *int32, after *string) (*models.CommentConnection, error) {
-	ret := _m.Called(ctx, parentID, first, after)
+// GetThread provides a mock function with given fields: ctx, postID, rootID, maxDepth, maxPerLevel
+func (_m *MockUseCase) GetThread(ctx context.Context, postID string, rootID *string, maxDepth *int32, maxPerLevel *int32) ([]*models.ThreadComment, error) {
+	ret := _m.Called(ctx, postID, rootID, maxDepth, maxPerLevel)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetChildComments")
+		panic("no return value specified for GetThread")
 	}
 
-	var r0 *models.CommentConnection
+	var r0 []*models.ThreadComment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *int32, *string) (*models.CommentConnection, error)); ok {
-		return rf(ctx, parentID, first, after)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, *int32, *int32) ([]*models.ThreadComment, error)); ok {
+		return rf(ctx, postID, rootID, maxDepth, maxPerLevel)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, *int32, *string) *models.CommentConnection); ok {
-		r0 = rf(ctx, parentID, first, after)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, *int32, *int32) []*models.ThreadComment); ok {
+		r0 = rf(ctx, postID, rootID, maxDepth, maxPerLevel)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.CommentConnection)
+			r0 = ret.Get(0).([]*models.ThreadComment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, *int32, *string) error); ok {
-		r1 = rf(ctx, parentID, first, after)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, *int32, *int32) error); ok {
+		r1 = rf(ctx, postID, rootID, maxDepth, maxPerLevel)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -172,51 +711,52 @@ func (_m *MockUseCase) GetChildComments(ctx context.Context, parentID string, fi
 	return r0, r1
 }
 
-// MockUseCase_GetChildComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChildComments'
-type MockUseCase_GetChildComments_Call struct {
+// MockUseCase_GetThread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetThread'
+type MockUseCase_GetThread_Call struct {
 	*mock.Call
 }
 
-// GetChildComments is a helper method to define mock.On call
+// GetThread is a helper method to define mock.On call
 //   - ctx context.Context
-//   - parentID string
-//   - first *int32
-//   - after *string
-func (_e *MockUseCase_Expecter) GetChildComments(ctx interface{}, parentID interface{}, first interface{}, after interface{}) *MockUseCase_GetChildComments_Call {
-	return &MockUseCase_GetChildComments_Call{Call: _e.mock.On("GetChildComments", ctx, parentID, first, after)}
+//   - postID string
+//   - rootID *string
+//   - maxDepth *int32
+//   - maxPerLevel *int32
+func (_e *MockUseCase_Expecter) GetThread(ctx interface{}, postID interface{}, rootID interface{}, maxDepth interface{}, maxPerLevel interface{}) *MockUseCase_GetThread_Call {
+	return &MockUseCase_GetThread_Call{Call: _e.mock.On("GetThread", ctx, postID, rootID, maxDepth, maxPerLevel)}
 }
 
-func (_c *MockUseCase_GetChildComments_Call) Run(run func(ctx context.Context, parentID string, first *int32, after *string)) *MockUseCase_GetChildComments_Call {
+func (_c *MockUseCase_GetThread_Call) Run(run func(ctx context.Context, postID string, rootID *string, maxDepth *int32, maxPerLevel *int32)) *MockUseCase_GetThread_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*int32), args[3].(*string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*string), args[3].(*int32), args[4].(*int32))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_GetChildComments_Call) Return(_a0 *models.CommentConnection, _a1 error) *MockUseCase_GetChildComments_Call {
+func (_c *MockUseCase_GetThread_Call) Return(_a0 []*models.ThreadComment, _a1 error) *MockUseCase_GetThread_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_GetChildComments_Call) RunAndReturn(run func(context.Context, string, *int32, *string) (*models.CommentConnection, error)) *MockUseCase_GetChildComments_Call {
+func (_c *MockUseCase_GetThread_Call) RunAndReturn(run func(context.Context, string, *string, *int32, *int32) ([]*models.ThreadComment, error)) *MockUseCase_GetThread_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRootComments provides a mock function with given fields: ctx, postID, first, after
-func (_m *MockUseCase) GetRootComments(ctx context.Context, postID string, first *int32, after *string) (*models.CommentConnection, error) {
+// ModerationQueue provides a mock function with given fields: ctx, postID, first, after
+func (_m *MockUseCase) ModerationQueue(ctx context.Context, postID *string, first *int32, after *string) (*models.CommentConnection, error) {
 	ret := _m.Called(ctx, postID, first, after)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRootComments")
+		panic("no return value specified for ModerationQueue")
 	}
 
 	var r0 *models.CommentConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *int32, *string) (*models.CommentConnection, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int32, *string) (*models.CommentConnection, error)); ok {
 		return rf(ctx, postID, first, after)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, *int32, *string) *models.CommentConnection); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int32, *string) *models.CommentConnection); ok {
 		r0 = rf(ctx, postID, first, after)
 	} else {
 		if ret.Get(0) != nil {
@@ -224,7 +764,7 @@ func (_m *MockUseCase) GetRootComments(ctx context.Context, postID string, first
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, *int32, *string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *int32, *string) error); ok {
 		r1 = rf(ctx, postID, first, after)
 	} else {
 		r1 = ret.Error(1)
@@ -233,33 +773,393 @@ func (_m *MockUseCase) GetRootComments(ctx context.Context, postID string, first
 	return r0, r1
 }
 
-// MockUseCase_GetRootComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRootComments'
-type MockUseCase_GetRootComments_Call struct {
+// MockUseCase_ModerationQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModerationQueue'
+type MockUseCase_ModerationQueue_Call struct {
 	*mock.Call
 }
 
-// GetRootComments is a helper method to define mock.On call
+// ModerationQueue is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
+//   - postID *string
 //   - first *int32
 //   - after *string
-func (_e *MockUseCase_Expecter) GetRootComments(ctx interface{}, postID interface{}, first interface{}, after interface{}) *MockUseCase_GetRootComments_Call {
-	return &MockUseCase_GetRootComments_Call{Call: _e.mock.On("GetRootComments", ctx, postID, first, after)}
+func (_e *MockUseCase_Expecter) ModerationQueue(ctx interface{}, postID interface{}, first interface{}, after interface{}) *MockUseCase_ModerationQueue_Call {
+	return &MockUseCase_ModerationQueue_Call{Call: _e.mock.On("ModerationQueue", ctx, postID, first, after)}
 }
 
-func (_c *MockUseCase_GetRootComments_Call) Run(run func(ctx context.Context, postID string, first *int32, after *string)) *MockUseCase_GetRootComments_Call {
+func (_c *MockUseCase_ModerationQueue_Call) Run(run func(ctx context.Context, postID *string, first *int32, after *string)) *MockUseCase_ModerationQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*int32), args[3].(*string))
+		run(args[0].(context.Context), args[1].(*string), args[2].(*int32), args[3].(*string))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_GetRootComments_Call) Return(_a0 *models.CommentConnection, _a1 error) *MockUseCase_GetRootComments_Call {
+func (_c *MockUseCase_ModerationQueue_Call) Return(_a0 *models.CommentConnection, _a1 error) *MockUseCase_ModerationQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_ModerationQueue_Call) RunAndReturn(run func(context.Context, *string, *int32, *string) (*models.CommentConnection, error)) *MockUseCase_ModerationQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostComments provides a mock function with given fields: ctx, postID, first, after, last, before, orderBy
+func (_m *MockUseCase) PostComments(ctx context.Context, postID int64, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error) {
+	ret := _m.Called(ctx, postID, first, after, last, before, orderBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostComments")
+	}
+
+	var r0 *models.CommentConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int32, *string, *int32, *string, *models.CommentOrder) (*models.CommentConnection, error)); ok {
+		return rf(ctx, postID, first, after, last, before, orderBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int32, *string, *int32, *string, *models.CommentOrder) *models.CommentConnection); ok {
+		r0 = rf(ctx, postID, first, after, last, before, orderBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CommentConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *int32, *string, *int32, *string, *models.CommentOrder) error); ok {
+		r1 = rf(ctx, postID, first, after, last, before, orderBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_PostComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostComments'
+type MockUseCase_PostComments_Call struct {
+	*mock.Call
+}
+
+// PostComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+//   - first *int32
+//   - after *string
+//   - last *int32
+//   - before *string
+//   - orderBy *models.CommentOrder
+func (_e *MockUseCase_Expecter) PostComments(ctx interface{}, postID interface{}, first interface{}, after interface{}, last interface{}, before interface{}, orderBy interface{}) *MockUseCase_PostComments_Call {
+	return &MockUseCase_PostComments_Call{Call: _e.mock.On("PostComments", ctx, postID, first, after, last, before, orderBy)}
+}
+
+func (_c *MockUseCase_PostComments_Call) Run(run func(ctx context.Context, postID int64, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder)) *MockUseCase_PostComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*int32), args[3].(*string), args[4].(*int32), args[5].(*string), args[6].(*models.CommentOrder))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_PostComments_Call) Return(_a0 *models.CommentConnection, _a1 error) *MockUseCase_PostComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_PostComments_Call) RunAndReturn(run func(context.Context, int64, *int32, *string, *int32, *string, *models.CommentOrder) (*models.CommentConnection, error)) *MockUseCase_PostComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeComment provides a mock function with given fields: ctx, commentID
+func (_m *MockUseCase) PurgeComment(ctx context.Context, commentID string) ([]*models.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeComment")
+	}
+
+	var r0 []*models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_PurgeComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeComment'
+type MockUseCase_PurgeComment_Call struct {
+	*mock.Call
+}
+
+// PurgeComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID string
+func (_e *MockUseCase_Expecter) PurgeComment(ctx interface{}, commentID interface{}) *MockUseCase_PurgeComment_Call {
+	return &MockUseCase_PurgeComment_Call{Call: _e.mock.On("PurgeComment", ctx, commentID)}
+}
+
+func (_c *MockUseCase_PurgeComment_Call) Run(run func(ctx context.Context, commentID string)) *MockUseCase_PurgeComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_PurgeComment_Call) Return(_a0 []*models.Comment, _a1 error) *MockUseCase_PurgeComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_PurgeComment_Call) RunAndReturn(run func(context.Context, string) ([]*models.Comment, error)) *MockUseCase_PurgeComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectComment provides a mock function with given fields: ctx, commentID, reason
+func (_m *MockUseCase) RejectComment(ctx context.Context, commentID string, reason *string) (*models.Comment, error) {
+	ret := _m.Called(ctx, commentID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectComment")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string) (*models.Comment, error)); ok {
+		return rf(ctx, commentID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string) *models.Comment); ok {
+		r0 = rf(ctx, commentID, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string) error); ok {
+		r1 = rf(ctx, commentID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_RejectComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectComment'
+type MockUseCase_RejectComment_Call struct {
+	*mock.Call
+}
+
+// RejectComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID string
+//   - reason *string
+func (_e *MockUseCase_Expecter) RejectComment(ctx interface{}, commentID interface{}, reason interface{}) *MockUseCase_RejectComment_Call {
+	return &MockUseCase_RejectComment_Call{Call: _e.mock.On("RejectComment", ctx, commentID, reason)}
+}
+
+func (_c *MockUseCase_RejectComment_Call) Run(run func(ctx context.Context, commentID string, reason *string)) *MockUseCase_RejectComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_RejectComment_Call) Return(_a0 *models.Comment, _a1 error) *MockUseCase_RejectComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_RejectComment_Call) RunAndReturn(run func(context.Context, string, *string) (*models.Comment, error)) *MockUseCase_RejectComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplyCount provides a mock function with given fields: ctx, parentID
+func (_m *MockUseCase) ReplyCount(ctx context.Context, parentID int64) (int64, error) {
+	ret := _m.Called(ctx, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplyCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, parentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, parentID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_ReplyCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplyCount'
+type MockUseCase_ReplyCount_Call struct {
+	*mock.Call
+}
+
+// ReplyCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID int64
+func (_e *MockUseCase_Expecter) ReplyCount(ctx interface{}, parentID interface{}) *MockUseCase_ReplyCount_Call {
+	return &MockUseCase_ReplyCount_Call{Call: _e.mock.On("ReplyCount", ctx, parentID)}
+}
+
+func (_c *MockUseCase_ReplyCount_Call) Run(run func(ctx context.Context, parentID int64)) *MockUseCase_ReplyCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_ReplyCount_Call) Return(_a0 int64, _a1 error) *MockUseCase_ReplyCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_ReplyCount_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockUseCase_ReplyCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revisions provides a mock function with given fields: ctx, commentID, first, after
+func (_m *MockUseCase) Revisions(ctx context.Context, commentID int64, first *int32, after *string) (*models.CommentRevisionConnection, error) {
+	ret := _m.Called(ctx, commentID, first, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revisions")
+	}
+
+	var r0 *models.CommentRevisionConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int32, *string) (*models.CommentRevisionConnection, error)); ok {
+		return rf(ctx, commentID, first, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int32, *string) *models.CommentRevisionConnection); ok {
+		r0 = rf(ctx, commentID, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CommentRevisionConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *int32, *string) error); ok {
+		r1 = rf(ctx, commentID, first, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_Revisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revisions'
+type MockUseCase_Revisions_Call struct {
+	*mock.Call
+}
+
+// Revisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+//   - first *int32
+//   - after *string
+func (_e *MockUseCase_Expecter) Revisions(ctx interface{}, commentID interface{}, first interface{}, after interface{}) *MockUseCase_Revisions_Call {
+	return &MockUseCase_Revisions_Call{Call: _e.mock.On("Revisions", ctx, commentID, first, after)}
+}
+
+func (_c *MockUseCase_Revisions_Call) Run(run func(ctx context.Context, commentID int64, first *int32, after *string)) *MockUseCase_Revisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*int32), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Revisions_Call) Return(_a0 *models.CommentRevisionConnection, _a1 error) *MockUseCase_Revisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_Revisions_Call) RunAndReturn(run func(context.Context, int64, *int32, *string) (*models.CommentRevisionConnection, error)) *MockUseCase_Revisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpamScore provides a mock function with given fields: ctx, commentID
+func (_m *MockUseCase) SpamScore(ctx context.Context, commentID int64) (*float64, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpamScore")
+	}
+
+	var r0 *float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*float64, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *float64); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*float64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_SpamScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpamScore'
+type MockUseCase_SpamScore_Call struct {
+	*mock.Call
+}
+
+// SpamScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int64
+func (_e *MockUseCase_Expecter) SpamScore(ctx interface{}, commentID interface{}) *MockUseCase_SpamScore_Call {
+	return &MockUseCase_SpamScore_Call{Call: _e.mock.On("SpamScore", ctx, commentID)}
+}
+
+func (_c *MockUseCase_SpamScore_Call) Run(run func(ctx context.Context, commentID int64)) *MockUseCase_SpamScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_SpamScore_Call) Return(_a0 *float64, _a1 error) *MockUseCase_SpamScore_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_GetRootComments_Call) RunAndReturn(run func(context.Context, string, *int32, *string) (*models.CommentConnection, error)) *MockUseCase_GetRootComments_Call {
+func (_c *MockUseCase_SpamScore_Call) RunAndReturn(run func(context.Context, int64) (*float64, error)) *MockUseCase_SpamScore_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,153 @@
+package comment
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/pagination"
+)
+
+// ModerationQueue pages through the comments waiting for a moderator, oldest
+// first, on one post or on all posts when postID is nil.
+func (s *Service) ModerationQueue(ctx context.Context, postID *string, first *int32, after *string) (*models.CommentConnection, error) {
+	if err := auth.RequireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	var pID *int64
+	if postID != nil {
+		id, err := globalid.DecodeAs(globalid.Post, *postID)
+		if err != nil {
+			return nil, errors.New("invalid postID format")
+		}
+		pID = &id
+	}
+
+	limit := pagination.Limit(first)
+
+	pos, err := cursor.Parse(after)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.repo.GetPending(ctx, pID, pos.CreatedAt, pos.ID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage := len(comments) > int(limit)
+	if hasNextPage {
+		comments = comments[:limit]
+	}
+
+	totalCount, err := s.repo.PendingCount(ctx, pID)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*models.CommentEdge, 0, len(comments))
+	for _, c := range comments {
+		edges = append(edges, &models.CommentEdge{
+			Cursor: cursor.Encode(c.CreatedAt, c.ID),
+			Node:   c,
+		})
+	}
+
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &models.CommentConnection{
+		Edges: edges,
+		PageInfo: &models.PageInfo{
+			StartCursor: startCursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		},
+		TotalCount: int32(totalCount),
+	}, nil
+}
+
+// ApproveComment publishes a pending comment and announces it to subscribers,
+// which did not hear about it when it was added. The event carries the publish
+// sequence the comment got on approval, so subscribers that resumed from a
+// newer comment still receive it. The spam classifier learns the comment as
+// not spam.
+func (s *Service) ApproveComment(ctx context.Context, commentID string) (*models.Comment, error) {
+	if err := auth.RequireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	cID, err := parseCommentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.Approve(ctx, cID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.learn(ctx, comment, false)
+	s.publish(ctx, models.EventCommentAdded, comment)
+
+	return comment, nil
+}
+
+// RejectComment keeps a pending comment hidden for good and teaches the spam
+// classifier it was spam. The reason is stored with the comment; a blank
+// reason is stored as none.
+func (s *Service) RejectComment(ctx context.Context, commentID string, reason *string) (*models.Comment, error) {
+	if err := auth.RequireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	cID, err := parseCommentID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var stored *string
+	if reason != nil {
+		if trimmed := strings.TrimSpace(*reason); trimmed != "" {
+			stored = &trimmed
+		}
+	}
+
+	comment, err := s.repo.Reject(ctx, cID, stored)
+	if err != nil {
+		return nil, err
+	}
+
+	s.learn(ctx, comment, true)
+
+	return comment, nil
+}
+
+// SpamScore returns the score the spam classifier gave a comment when it was
+// added. It is nil when the classifier had not seen enough moderation
+// outcomes to score it then.
+func (s *Service) SpamScore(ctx context.Context, commentID int64) (*float64, error) {
+	if err := auth.RequireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetSpamScore(ctx, commentID)
+}
+
+// learn feeds a moderation outcome to the spam classifier. A failure only
+// costs one training example, so it is logged rather than returned.
+func (s *Service) learn(ctx context.Context, comment *models.Comment, isSpam bool) {
+	if err := s.spam.Train(ctx, comment.Text, isSpam); err != nil {
+		log.Printf("failed to train the spam classifier on comment %d: %v", comment.ID, err)
+	}
+}
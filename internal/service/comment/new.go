@@ -1,15 +1,37 @@
 package comment
 
 import (
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/pubsub"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+	"github.com/Saracomethstein/ozon-test-task/internal/spam"
 )
 
+// ReplyDepth is the global nesting limit for replies. Max applies to posts
+// without their own limit, zero disables the check.
+type ReplyDepth struct {
+	Max    int32
+	Policy models.ReplyDepthPolicy
+}
+
 type Service struct {
-	repo repository.CommentUC
+	repo       repository.CommentUC
+	broker     pubsub.Broker
+	replyDepth ReplyDepth
+	content    *filter.Pipeline
+	spam       *spam.Classifier
+	bans       repository.BanUC
 }
 
-func New(repo repository.CommentUC) *Service {
+// New builds the comment service. A nil bans lets every author comment.
+func New(repo repository.CommentUC, broker pubsub.Broker, replyDepth ReplyDepth, content *filter.Pipeline, classifier *spam.Classifier, bans repository.BanUC) *Service {
 	return &Service{
-		repo: repo,
+		repo:       repo,
+		broker:     broker,
+		replyDepth: replyDepth,
+		content:    content,
+		spam:       classifier,
+		bans:       bans,
 	}
 }
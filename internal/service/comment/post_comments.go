@@ -0,0 +1,85 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/pkg/errors"
+
+	myLoader "github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/pagination"
+)
+
+// PostComments returns a page of a post's root comments through the post
+// comments dataloader, so a page of posts fetches its comments together.
+func (s *Service) PostComments(ctx context.Context, postID int64, first *int32, after *string, last *int32, before *string, orderBy *models.CommentOrder) (*models.CommentConnection, error) {
+	order, err := parseCommentOrder(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := pagination.ParseWindow(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := cursor.ParseComment(order, window.Cursor); err != nil {
+		return nil, err
+	}
+
+	loader, ok := ctx.Value(myLoader.PostCommentsKey).(dataloader.Interface)
+	if !ok {
+		return nil, errors.New("dataloader not found in context")
+	}
+
+	var pageCursor string
+	if window.Cursor != nil {
+		pageCursor = *window.Cursor
+	}
+
+	thunk := loader.Load(ctx, myLoader.PostCommentsKeyFor(order, window.Limit+1, pageCursor, window.Backward, postID))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	comments, ok := result.([]*models.Comment)
+	if !ok {
+		return nil, errors.New("unexpected data type from dataloader")
+	}
+
+	hasMore, pageComments := pagination.ExtractPage(comments, window)
+
+	edges := s.buildEdges(order, pageComments)
+
+	totalCount, err := s.CommentCount(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildConnection(edges, window, hasMore, totalCount), nil
+}
+
+// CommentCount returns the number of comments on a post through the comment
+// count dataloader.
+func (s *Service) CommentCount(ctx context.Context, postID int64) (int64, error) {
+	loader, ok := ctx.Value(myLoader.CommentCountKey).(dataloader.Interface)
+	if !ok {
+		return 0, errors.New("dataloader not found in context")
+	}
+
+	thunk := loader.Load(ctx, myLoader.PostKeyFor(postID))
+	result, err := thunk()
+	if err != nil {
+		return 0, err
+	}
+
+	count, ok := result.(int64)
+	if !ok {
+		return 0, errors.New("unexpected data type from dataloader")
+	}
+
+	return count, nil
+}
@@ -0,0 +1,58 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/pagination"
+)
+
+// Revisions pages through the previous versions of a comment, newest first.
+func (s *Service) Revisions(ctx context.Context, commentID int64, first *int32, after *string) (*models.CommentRevisionConnection, error) {
+	limit := pagination.Limit(first)
+
+	pos, err := cursor.Parse(after)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.repo.GetRevisions(ctx, commentID, pos.CreatedAt, pos.ID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage := len(revisions) > int(limit)
+	if hasNextPage {
+		revisions = revisions[:limit]
+	}
+
+	totalCount, err := s.repo.RevisionCount(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*models.CommentRevisionEdge, 0, len(revisions))
+	for _, rev := range revisions {
+		edges = append(edges, &models.CommentRevisionEdge{
+			Cursor: cursor.Encode(rev.CreatedAt, rev.ID),
+			Node:   rev,
+		})
+	}
+
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &models.CommentRevisionConnection{
+		Edges: edges,
+		PageInfo: &models.PageInfo{
+			StartCursor: startCursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		},
+		TotalCount: int32(totalCount),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+const (
+	defaultThreadDepth    = 5
+	maxThreadDepth        = 20
+	defaultThreadPerLevel = 20
+	maxThreadPerLevel     = 100
+)
+
+// GetThread returns a whole comment subtree flattened depth first, either
+// below rootID or below every root comment of the post.
+func (s *Service) GetThread(ctx context.Context, postID string, rootID *string, maxDepth, maxPerLevel *int32) ([]*models.ThreadComment, error) {
+	pID, err := globalid.DecodeAs(globalid.Post, postID)
+	if err != nil {
+		return nil, errors.New("invalid postID format")
+	}
+
+	if pID <= 0 {
+		return nil, errors.New("postID must be greater 0")
+	}
+
+	var rID *int64
+	if rootID != nil {
+		id, err := globalid.DecodeAs(globalid.Comment, *rootID)
+		if err != nil || id <= 0 {
+			return nil, errors.New("invalid rootID")
+		}
+		rID = &id
+	}
+
+	depth := int32(defaultThreadDepth)
+	if maxDepth != nil {
+		depth = *maxDepth
+	}
+	if depth < 0 || depth > maxThreadDepth {
+		return nil, errors.New("maxDepth must be between 0 and 20")
+	}
+
+	perLevel := int32(defaultThreadPerLevel)
+	if maxPerLevel != nil {
+		perLevel = *maxPerLevel
+	}
+	if perLevel < 1 || perLevel > maxThreadPerLevel {
+		return nil, errors.New("maxPerLevel must be between 1 and 100")
+	}
+
+	return s.repo.GetThread(ctx, pID, rID, depth, perLevel)
+}
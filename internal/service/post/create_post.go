@@ -2,24 +2,113 @@ package post
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 )
 
+const (
+	maxReplyDepth = 100
+)
+
 func (s *Post) CreatePost(ctx context.Context, in models.CreatePostInput) (*models.Post, error) {
+	tags, err := normalizeTags(in.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) > maxTagsPerPost {
+		return nil, errors.New("max 10 tags per post")
+	}
+
+	if err := checkMaxReplyDepth(in.MaxReplyDepth); err != nil {
+		return nil, err
+	}
+
+	policy, err := commentPolicyOf(in)
+	if err != nil {
+		return nil, err
+	}
+
+	closeAt, err := normalizeCloseAt(in.CommentsCloseAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkBanned(ctx, in.Author); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkContent(ctx, in.Author, in.Title, in.Body); err != nil {
+		return nil, err
+	}
+
 	createAt := time.Now().UTC().Format(time.RFC3339)
 
 	post, err := s.repo.Save(ctx, models.Post{
-		Title:         in.Title,
-		Author:        in.Author,
-		Body:          in.Body,
-		AllowComments: *in.AllowComments,
-		CreatedAt:     createAt,
+		Title:           in.Title,
+		Author:          in.Author,
+		Body:            in.Body,
+		CommentPolicy:   policy,
+		CommentsCloseAt: closeAt,
+		CreatedAt:       createAt,
+		Tags:            tags,
+		MaxReplyDepth:   in.MaxReplyDepth,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.content.Accept(ctx, postContent(in.Author, in.Title, in.Body))
+
 	return &post, nil
 }
+
+// checkMaxReplyDepth validates a post's own reply depth limit, nil keeps the
+// configured default.
+func checkMaxReplyDepth(depth *int32) error {
+	if depth != nil && (*depth < 1 || *depth > maxReplyDepth) {
+		return errors.New("maxReplyDepth must be between 1 and 100")
+	}
+
+	return nil
+}
+
+// checkBanned turns away posts by an author a moderator banned.
+func (s *Post) checkBanned(ctx context.Context, author string) error {
+	if s.bans == nil {
+		return nil
+	}
+
+	banned, err := s.bans.IsBanned(ctx, author)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return errors.New("author is banned")
+	}
+
+	return nil
+}
+
+// checkContent runs the content filter over the given parts of a post. Posts
+// have no moderation queue, so a hold turns the post away like a reject.
+func (s *Post) checkContent(ctx context.Context, author string, parts ...string) error {
+	decision := s.content.Check(ctx, postContent(author, parts...))
+	if decision.Action != filter.Allow {
+		return &filter.RejectedError{Decision: decision}
+	}
+
+	return nil
+}
+
+// postContent is what the content filter sees of a post.
+func postContent(author string, parts ...string) filter.Content {
+	return filter.Content{
+		Author: author,
+		Text:   strings.Join(parts, "\n"),
+	}
+}
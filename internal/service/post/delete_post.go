@@ -0,0 +1,49 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// DeletePost hides the post from reads but keeps the row and its comments,
+// so RestorePost brings it back intact.
+func (s *Post) DeletePost(ctx context.Context, postID string) (*models.Post, error) {
+	id, err := parsePostID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedAt := time.Now().UTC().Format(time.RFC3339)
+
+	out, err := s.repo.Delete(ctx, id, deletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, out)
+
+	return out, nil
+}
+
+// RestorePost brings back a deleted post. Only moderators can restore a post
+// that reports or a moderator hid.
+func (s *Post) RestorePost(ctx context.Context, postID string) (*models.Post, error) {
+	id, err := parsePostID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	moderator := auth.RequireModerator(ctx) == nil
+
+	out, err := s.repo.Restore(ctx, id, moderator)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, out)
+
+	return out, nil
+}
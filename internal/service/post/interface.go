@@ -9,6 +9,13 @@ import (
 type UseCase interface {
 	CreatePost(ctx context.Context, in models.CreatePostInput) (*models.Post, error)
 	SetPostCommentsAllowed(ctx context.Context, postID string, allow bool) (*models.Post, error)
+	SetPostCommentPolicy(ctx context.Context, postID string, policy models.CommentPolicy, closeAt *string) (*models.Post, error)
+	UpdatePost(ctx context.Context, postID string, in models.UpdatePostInput) (*models.Post, error)
+	DeletePost(ctx context.Context, postID string) (*models.Post, error)
+	RestorePost(ctx context.Context, postID string) (*models.Post, error)
 	GetPostById(ctx context.Context, postID string) (*models.Post, error)
-	GetPosts(ctx context.Context, first *int32, after *string) (*models.PostConnection, error)
+	LoadPost(ctx context.Context, postID int64) (*models.Post, error)
+	GetPosts(ctx context.Context, first *int32, after *string, last *int32, before *string, tags []string, match *models.TagMatch) (*models.PostConnection, error)
+	GetTags(ctx context.Context, prefix *string, first *int32) ([]string, error)
+	PostUpdated(ctx context.Context, postID string) (<-chan *models.Post, error)
 }
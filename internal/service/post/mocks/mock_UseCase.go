@@ -81,6 +81,65 @@ func (_c *MockUseCase_CreatePost_Call) RunAndReturn(run func(context.Context, mo
 	return _c
 }
 
+// DeletePost provides a mock function with given fields: ctx, postID
+func (_m *MockUseCase) DeletePost(ctx context.Context, postID string) (*models.Post, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePost")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Post, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Post); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
+type MockUseCase_DeletePost_Call struct {
+	*mock.Call
+}
+
+// DeletePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockUseCase_Expecter) DeletePost(ctx interface{}, postID interface{}) *MockUseCase_DeletePost_Call {
+	return &MockUseCase_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, postID)}
+}
+
+func (_c *MockUseCase_DeletePost_Call) Run(run func(ctx context.Context, postID string)) *MockUseCase_DeletePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DeletePost_Call) Return(_a0 *models.Post, _a1 error) *MockUseCase_DeletePost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_DeletePost_Call) RunAndReturn(run func(context.Context, string) (*models.Post, error)) *MockUseCase_DeletePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPostById provides a mock function with given fields: ctx, postID
 func (_m *MockUseCase) GetPostById(ctx context.Context, postID string) (*models.Post, error) {
 	ret := _m.Called(ctx, postID)
@@ -140,9 +199,9 @@ func (_c *MockUseCase_GetPostById_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
-// GetPosts provides a mock function with given fields: ctx, first, after
-func (_m *MockUseCase) GetPosts(ctx context.Context, first *int32, after *string) (*models.PostConnection, error) {
-	ret := _m.Called(ctx, first, after)
+// GetPosts provides a mock function with given fields: ctx, first, after, last, before, tags, match
+func (_m *MockUseCase) GetPosts(ctx context.Context, first *int32, after *string, last *int32, before *string, tags []string, match *models.TagMatch) (*models.PostConnection, error) {
+	ret := _m.Called(ctx, first, after, last, before, tags, match)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPosts")
@@ -150,19 +209,19 @@ func (_m *MockUseCase) GetPosts(ctx context.Context, first *int32, after *string
 
 	var r0 *models.PostConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *int32, *string) (*models.PostConnection, error)); ok {
-		return rf(ctx, first, after)
+	if rf, ok := ret.Get(0).(func(context.Context, *int32, *string, *int32, *string, []string, *models.TagMatch) (*models.PostConnection, error)); ok {
+		return rf(ctx, first, after, last, before, tags, match)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *int32, *string) *models.PostConnection); ok {
-		r0 = rf(ctx, first, after)
+	if rf, ok := ret.Get(0).(func(context.Context, *int32, *string, *int32, *string, []string, *models.TagMatch) *models.PostConnection); ok {
+		r0 = rf(ctx, first, after, last, before, tags, match)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.PostConnection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *int32, *string) error); ok {
-		r1 = rf(ctx, first, after)
+	if rf, ok := ret.Get(1).(func(context.Context, *int32, *string, *int32, *string, []string, *models.TagMatch) error); ok {
+		r1 = rf(ctx, first, after, last, before, tags, match)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -179,13 +238,17 @@ type MockUseCase_GetPosts_Call struct {
 //   - ctx context.Context
 //   - first *int32
 //   - after *string
-func (_e *MockUseCase_Expecter) GetPosts(ctx interface{}, first interface{}, after interface{}) *MockUseCase_GetPosts_Call {
-	return &MockUseCase_GetPosts_Call{Call: _e.mock.On("GetPosts", ctx, first, after)}
+//   - last *int32
+//   - before *string
+//   - tags []string
+//   - match *models.TagMatch
+func (_e *MockUseCase_Expecter) GetPosts(ctx interface{}, first interface{}, after interface{}, last interface{}, before interface{}, tags interface{}, match interface{}) *MockUseCase_GetPosts_Call {
+	return &MockUseCase_GetPosts_Call{Call: _e.mock.On("GetPosts", ctx, first, after, last, before, tags, match)}
 }
 
-func (_c *MockUseCase_GetPosts_Call) Run(run func(ctx context.Context, first *int32, after *string)) *MockUseCase_GetPosts_Call {
+func (_c *MockUseCase_GetPosts_Call) Run(run func(ctx context.Context, first *int32, after *string, last *int32, before *string, tags []string, match *models.TagMatch)) *MockUseCase_GetPosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*int32), args[2].(*string))
+		run(args[0].(context.Context), args[1].(*int32), args[2].(*string), args[3].(*int32), args[4].(*string), args[5].([]string), args[6].(*models.TagMatch))
 	})
 	return _c
 }
@@ -195,7 +258,305 @@ func (_c *MockUseCase_GetPosts_Call) Return(_a0 *models.PostConnection, _a1 erro
 	return _c
 }
 
-func (_c *MockUseCase_GetPosts_Call) RunAndReturn(run func(context.Context, *int32, *string) (*models.PostConnection, error)) *MockUseCase_GetPosts_Call {
+func (_c *MockUseCase_GetPosts_Call) RunAndReturn(run func(context.Context, *int32, *string, *int32, *string, []string, *models.TagMatch) (*models.PostConnection, error)) *MockUseCase_GetPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: ctx, prefix, first
+func (_m *MockUseCase) GetTags(ctx context.Context, prefix *string, first *int32) ([]string, error) {
+	ret := _m.Called(ctx, prefix, first)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int32) ([]string, error)); ok {
+		return rf(ctx, prefix, first)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int32) []string); ok {
+		r0 = rf(ctx, prefix, first)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *int32) error); ok {
+		r1 = rf(ctx, prefix, first)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockUseCase_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix *string
+//   - first *int32
+func (_e *MockUseCase_Expecter) GetTags(ctx interface{}, prefix interface{}, first interface{}) *MockUseCase_GetTags_Call {
+	return &MockUseCase_GetTags_Call{Call: _e.mock.On("GetTags", ctx, prefix, first)}
+}
+
+func (_c *MockUseCase_GetTags_Call) Run(run func(ctx context.Context, prefix *string, first *int32)) *MockUseCase_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string), args[2].(*int32))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetTags_Call) Return(_a0 []string, _a1 error) *MockUseCase_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetTags_Call) RunAndReturn(run func(context.Context, *string, *int32) ([]string, error)) *MockUseCase_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadPost provides a mock function with given fields: ctx, postID
+func (_m *MockUseCase) LoadPost(ctx context.Context, postID int64) (*models.Post, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadPost")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*models.Post, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *models.Post); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_LoadPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPost'
+type MockUseCase_LoadPost_Call struct {
+	*mock.Call
+}
+
+// LoadPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int64
+func (_e *MockUseCase_Expecter) LoadPost(ctx interface{}, postID interface{}) *MockUseCase_LoadPost_Call {
+	return &MockUseCase_LoadPost_Call{Call: _e.mock.On("LoadPost", ctx, postID)}
+}
+
+func (_c *MockUseCase_LoadPost_Call) Run(run func(ctx context.Context, postID int64)) *MockUseCase_LoadPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_LoadPost_Call) Return(_a0 *models.Post, _a1 error) *MockUseCase_LoadPost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_LoadPost_Call) RunAndReturn(run func(context.Context, int64) (*models.Post, error)) *MockUseCase_LoadPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostUpdated provides a mock function with given fields: ctx, postID
+func (_m *MockUseCase) PostUpdated(ctx context.Context, postID string) (<-chan *models.Post, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostUpdated")
+	}
+
+	var r0 <-chan *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (<-chan *models.Post, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) <-chan *models.Post); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_PostUpdated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUpdated'
+type MockUseCase_PostUpdated_Call struct {
+	*mock.Call
+}
+
+// PostUpdated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockUseCase_Expecter) PostUpdated(ctx interface{}, postID interface{}) *MockUseCase_PostUpdated_Call {
+	return &MockUseCase_PostUpdated_Call{Call: _e.mock.On("PostUpdated", ctx, postID)}
+}
+
+func (_c *MockUseCase_PostUpdated_Call) Run(run func(ctx context.Context, postID string)) *MockUseCase_PostUpdated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_PostUpdated_Call) Return(_a0 <-chan *models.Post, _a1 error) *MockUseCase_PostUpdated_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_PostUpdated_Call) RunAndReturn(run func(context.Context, string) (<-chan *models.Post, error)) *MockUseCase_PostUpdated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestorePost provides a mock function with given fields: ctx, postID
+func (_m *MockUseCase) RestorePost(ctx context.Context, postID string) (*models.Post, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestorePost")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Post, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Post); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_RestorePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestorePost'
+type MockUseCase_RestorePost_Call struct {
+	*mock.Call
+}
+
+// RestorePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockUseCase_Expecter) RestorePost(ctx interface{}, postID interface{}) *MockUseCase_RestorePost_Call {
+	return &MockUseCase_RestorePost_Call{Call: _e.mock.On("RestorePost", ctx, postID)}
+}
+
+func (_c *MockUseCase_RestorePost_Call) Run(run func(ctx context.Context, postID string)) *MockUseCase_RestorePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_RestorePost_Call) Return(_a0 *models.Post, _a1 error) *MockUseCase_RestorePost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_RestorePost_Call) RunAndReturn(run func(context.Context, string) (*models.Post, error)) *MockUseCase_RestorePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPostCommentPolicy provides a mock function with given fields: ctx, postID, policy, closeAt
+func (_m *MockUseCase) SetPostCommentPolicy(ctx context.Context, postID string, policy models.CommentPolicy, closeAt *string) (*models.Post, error) {
+	ret := _m.Called(ctx, postID, policy, closeAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPostCommentPolicy")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.CommentPolicy, *string) (*models.Post, error)); ok {
+		return rf(ctx, postID, policy, closeAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.CommentPolicy, *string) *models.Post); ok {
+		r0 = rf(ctx, postID, policy, closeAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.CommentPolicy, *string) error); ok {
+		r1 = rf(ctx, postID, policy, closeAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_SetPostCommentPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPostCommentPolicy'
+type MockUseCase_SetPostCommentPolicy_Call struct {
+	*mock.Call
+}
+
+// SetPostCommentPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - policy models.CommentPolicy
+//   - closeAt *string
+func (_e *MockUseCase_Expecter) SetPostCommentPolicy(ctx interface{}, postID interface{}, policy interface{}, closeAt interface{}) *MockUseCase_SetPostCommentPolicy_Call {
+	return &MockUseCase_SetPostCommentPolicy_Call{Call: _e.mock.On("SetPostCommentPolicy", ctx, postID, policy, closeAt)}
+}
+
+func (_c *MockUseCase_SetPostCommentPolicy_Call) Run(run func(ctx context.Context, postID string, policy models.CommentPolicy, closeAt *string)) *MockUseCase_SetPostCommentPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.CommentPolicy), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_SetPostCommentPolicy_Call) Return(_a0 *models.Post, _a1 error) *MockUseCase_SetPostCommentPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_SetPostCommentPolicy_Call) RunAndReturn(run func(context.Context, string, models.CommentPolicy, *string) (*models.Post, error)) *MockUseCase_SetPostCommentPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -260,6 +621,66 @@ func (_c *MockUseCase_SetPostCommentsAllowed_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UpdatePost provides a mock function with given fields: ctx, postID, in
+func (_m *MockUseCase) UpdatePost(ctx context.Context, postID string, in models.UpdatePostInput) (*models.Post, error) {
+	ret := _m.Called(ctx, postID, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePost")
+	}
+
+	var r0 *models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.UpdatePostInput) (*models.Post, error)); ok {
+		return rf(ctx, postID, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.UpdatePostInput) *models.Post); ok {
+		r0 = rf(ctx, postID, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.UpdatePostInput) error); ok {
+		r1 = rf(ctx, postID, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
+type MockUseCase_UpdatePost_Call struct {
+	*mock.Call
+}
+
+// UpdatePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - in models.UpdatePostInput
+func (_e *MockUseCase_Expecter) UpdatePost(ctx interface{}, postID interface{}, in interface{}) *MockUseCase_UpdatePost_Call {
+	return &MockUseCase_UpdatePost_Call{Call: _e.mock.On("UpdatePost", ctx, postID, in)}
+}
+
+func (_c *MockUseCase_UpdatePost_Call) Run(run func(ctx context.Context, postID string, in models.UpdatePostInput)) *MockUseCase_UpdatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.UpdatePostInput))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_UpdatePost_Call) Return(_a0 *models.Post, _a1 error) *MockUseCase_UpdatePost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_UpdatePost_Call) RunAndReturn(run func(context.Context, string, models.UpdatePostInput) (*models.Post, error)) *MockUseCase_UpdatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUseCase creates a new instance of MockUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUseCase(t interface {
@@ -1,15 +1,24 @@
 package post
 
 import (
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
+	"github.com/Saracomethstein/ozon-test-task/internal/pubsub"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository"
 )
 
 type Post struct {
-	repo repository.PostUC
+	repo    repository.PostUC
+	broker  pubsub.Broker
+	content *filter.Pipeline
+	bans    repository.BanUC
 }
 
-func New(repo repository.PostUC) *Post {
+// New builds the post service. A nil bans lets every author post.
+func New(repo repository.PostUC, broker pubsub.Broker, content *filter.Pipeline, bans repository.BanUC) *Post {
 	return &Post{
-		repo: repo,
+		repo:    repo,
+		broker:  broker,
+		content: content,
+		bans:    bans,
 	}
 }
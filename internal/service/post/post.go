@@ -2,31 +2,64 @@ package post
 
 import (
 	"context"
-	"strconv"
 
+	"github.com/graph-gophers/dataloader"
 	"github.com/pkg/errors"
 
+	myLoader "github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func (s *Post) GetPostById(ctx context.Context, postID string) (*models.Post, error) {
-	if postID == "" {
-		return nil, errors.New("post ID cannot be empty")
+	id, err := parsePostID(postID)
+	if err != nil {
+		return nil, err
 	}
 
-	id, err := strconv.ParseInt(postID, 10, 64)
+	post, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		return nil, errors.New("invalid post ID format")
+		return nil, err
 	}
 
-	if id <= 0 {
-		return nil, errors.New("post ID must be a positive integer")
+	return post, nil
+}
+
+// LoadPost resolves a post through the request's post dataloader, so the
+// posts of many comments are fetched in one batch. A deleted post loads as nil.
+func (s *Post) LoadPost(ctx context.Context, postID int64) (*models.Post, error) {
+	loader, ok := ctx.Value(myLoader.PostKey).(dataloader.Interface)
+	if !ok {
+		return nil, errors.New("dataloader not found in context")
 	}
 
-	post, err := s.repo.GetByID(ctx, id)
+	thunk := loader.Load(ctx, myLoader.PostKeyFor(postID))
+	result, err := thunk()
 	if err != nil {
 		return nil, err
 	}
 
+	post, ok := result.(*models.Post)
+	if !ok {
+		return nil, errors.New("unexpected data type from dataloader")
+	}
+
 	return post, nil
 }
+
+func parsePostID(postID string) (int64, error) {
+	if postID == "" {
+		return 0, errors.New("post ID cannot be empty")
+	}
+
+	id, err := globalid.DecodeAs(globalid.Post, postID)
+	if err != nil {
+		return 0, errors.New("invalid post ID format")
+	}
+
+	if id <= 0 {
+		return 0, errors.New("post ID must be a positive integer")
+	}
+
+	return id, nil
+}
@@ -2,16 +2,24 @@ package post
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/graph-gophers/dataloader"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/filter"
+	myLoader "github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	pubsubMocks "github.com/Saracomethstein/ozon-test-task/internal/pubsub/mocks"
 	"github.com/Saracomethstein/ozon-test-task/internal/repository/mocks"
 	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
 )
 
 func TestPostService_CreatePost(t *testing.T) {
@@ -19,6 +27,11 @@ func TestPostService_CreatePost(t *testing.T) {
 	ctx := context.Background()
 
 	allowComments := true
+	disallowComments := false
+	authorOnly := models.CommentPolicyAuthorOnly
+	badPolicy := models.CommentPolicy("NOBODY")
+	replyDepth := int32(4)
+	badReplyDepth := int32(0)
 
 	tests := []struct {
 		name        string
@@ -35,21 +48,24 @@ func TestPostService_CreatePost(t *testing.T) {
 				Author:        "Test Author",
 				Body:          "Test Body",
 				AllowComments: &allowComments,
+				Tags:          []string{"Go", " graphql ", "go"},
 			},
 			setupMock: func(repo *mocks.MockPostUC) {
 				repo.On("Save", mock.Anything, mock.MatchedBy(func(p models.Post) bool {
 					return p.Title == "Test Title" &&
 						p.Author == "Test Author" &&
 						p.Body == "Test Body" &&
-						p.AllowComments == true &&
-						p.CreatedAt != ""
+						p.CommentPolicy == models.CommentPolicyOpen &&
+						p.CreatedAt != "" &&
+						assert.ObjectsAreEqual([]string{"go", "graphql"}, p.Tags)
 				})).Return(models.Post{
 					ID:            1,
 					Title:         "Test Title",
 					Author:        "Test Author",
 					Body:          "Test Body",
-					AllowComments: true,
+					CommentPolicy: models.CommentPolicyOpen,
 					CreatedAt:     "2023-01-01T00:00:00Z",
+					Tags:          []string{"go", "graphql"},
 				}, nil)
 			},
 			want: &models.Post{
@@ -57,11 +73,158 @@ func TestPostService_CreatePost(t *testing.T) {
 				Title:         "Test Title",
 				Author:        "Test Author",
 				Body:          "Test Body",
-				AllowComments: true,
+				CommentPolicy: models.CommentPolicyOpen,
 				CreatedAt:     "2023-01-01T00:00:00Z",
+				Tags:          []string{"go", "graphql"},
 			},
 			wantErr: false,
 		},
+		{
+			name: "tag_too_long",
+			input: models.CreatePostInput{
+				Title:         "Test Title",
+				Author:        "Test Author",
+				Body:          "Test Body",
+				AllowComments: &allowComments,
+				Tags:          []string{strings.Repeat("a", 33)},
+			},
+			setupMock:   func(repo *mocks.MockPostUC) {},
+			wantErr:     true,
+			expectedErr: errors.New("max tag length is 32 char"),
+		},
+		{
+			name: "too_many_tags",
+			input: models.CreatePostInput{
+				Title:         "Test Title",
+				Author:        "Test Author",
+				Body:          "Test Body",
+				AllowComments: &allowComments,
+				Tags:          []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+			},
+			setupMock:   func(repo *mocks.MockPostUC) {},
+			wantErr:     true,
+			expectedErr: errors.New("max 10 tags per post"),
+		},
+		{
+			name: "with_max_reply_depth",
+			input: models.CreatePostInput{
+				Title:         "Test Title",
+				Author:        "Test Author",
+				Body:          "Test Body",
+				AllowComments: &allowComments,
+				MaxReplyDepth: &replyDepth,
+			},
+			setupMock: func(repo *mocks.MockPostUC) {
+				repo.On("Save", mock.Anything, mock.MatchedBy(func(p models.Post) bool {
+					return p.MaxReplyDepth != nil && *p.MaxReplyDepth == replyDepth
+				})).Return(models.Post{ID: 2, MaxReplyDepth: &replyDepth}, nil)
+			},
+			want: &models.Post{ID: 2, MaxReplyDepth: &replyDepth},
+		},
+		{
+			name: "invalid_max_reply_depth",
+			input: models.CreatePostInput{
+				Title:         "Test Title",
+				Author:        "Test Author",
+				Body:          "Test Body",
+				AllowComments: &allowComments,
+				MaxReplyDepth: &badReplyDepth,
+			},
+			setupMock:   func(repo *mocks.MockPostUC) {},
+			wantErr:     true,
+			expectedErr: errors.New("maxReplyDepth must be between 1 and 100"),
+		},
+		{
+			name: "allow_comments_false_closes_post",
+			input: models.CreatePostInput{
+				Title:         "Test Title",
+				Author:        "Test Author",
+				Body:          "Test Body",
+				AllowComments: &disallowComments,
+			},
+			setupMock: func(repo *mocks.MockPostUC) {
+				repo.On("Save", mock.Anything, mock.MatchedBy(func(p models.Post) bool {
+					return p.CommentPolicy == models.CommentPolicyClosed
+				})).Return(models.Post{ID: 3, CommentPolicy: models.CommentPolicyClosed}, nil)
+			},
+			want: &models.Post{ID: 3, CommentPolicy: models.CommentPolicyClosed},
+		},
+		{
+			name: "default_policy_is_open",
+			input: models.CreatePostInput{
+				Title:  "Test Title",
+				Author: "Test Author",
+				Body:   "Test Body",
+			},
+			setupMock: func(repo *mocks.MockPostUC) {
+				repo.On("Save", mock.Anything, mock.MatchedBy(func(p models.Post) bool {
+					return p.CommentPolicy == models.CommentPolicyOpen && p.CommentsCloseAt == nil
+				})).Return(models.Post{ID: 4, CommentPolicy: models.CommentPolicyOpen}, nil)
+			},
+			want: &models.Post{ID: 4, CommentPolicy: models.CommentPolicyOpen},
+		},
+		{
+			name: "policy_with_close_time",
+			input: models.CreatePostInput{
+				Title:           "Test Title",
+				Author:          "Test Author",
+				Body:            "Test Body",
+				CommentPolicy:   &authorOnly,
+				CommentsCloseAt: strPtr("2026-03-01T03:00:00+03:00"),
+			},
+			setupMock: func(repo *mocks.MockPostUC) {
+				repo.On("Save", mock.Anything, mock.MatchedBy(func(p models.Post) bool {
+					return p.CommentPolicy == models.CommentPolicyAuthorOnly &&
+						p.CommentsCloseAt != nil && *p.CommentsCloseAt == "2026-03-01T00:00:00Z"
+				})).Return(models.Post{
+					ID:              5,
+					CommentPolicy:   models.CommentPolicyAuthorOnly,
+					CommentsCloseAt: strPtr("2026-03-01T00:00:00Z"),
+				}, nil)
+			},
+			want: &models.Post{
+				ID:              5,
+				CommentPolicy:   models.CommentPolicyAuthorOnly,
+				CommentsCloseAt: strPtr("2026-03-01T00:00:00Z"),
+			},
+		},
+		{
+			name: "allow_comments_with_policy",
+			input: models.CreatePostInput{
+				Title:         "Test Title",
+				Author:        "Test Author",
+				Body:          "Test Body",
+				AllowComments: &allowComments,
+				CommentPolicy: &authorOnly,
+			},
+			setupMock:   func(repo *mocks.MockPostUC) {},
+			wantErr:     true,
+			expectedErr: errors.New("allowComments cannot be combined with commentPolicy"),
+		},
+		{
+			name: "invalid_policy",
+			input: models.CreatePostInput{
+				Title:         "Test Title",
+				Author:        "Test Author",
+				Body:          "Test Body",
+				CommentPolicy: &badPolicy,
+			},
+			setupMock:   func(repo *mocks.MockPostUC) {},
+			wantErr:     true,
+			expectedErr: errors.New("invalid comment policy"),
+		},
+		{
+			name: "invalid_close_time",
+			input: models.CreatePostInput{
+				Title:           "Test Title",
+				Author:          "Test Author",
+				Body:            "Test Body",
+				CommentsCloseAt: strPtr("tomorrow"),
+			},
+			setupMock:   func(repo *mocks.MockPostUC) {},
+			wantErr:     true,
+			expectedErr: errors.New("comments close time must be an RFC 3339 timestamp"),
+		},
 		{
 			name: "repository_error",
 			input: models.CreatePostInput{
@@ -84,7 +247,7 @@ func TestPostService_CreatePost(t *testing.T) {
 			mockRepo := mocks.NewMockPostUC(t)
 			tt.setupMock(mockRepo)
 
-			s := New(mockRepo)
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), nil, nil)
 			got, err := s.CreatePost(ctx, tt.input)
 
 			if tt.wantErr {
@@ -102,6 +265,100 @@ func TestPostService_CreatePost(t *testing.T) {
 	}
 }
 
+func TestPostService_ContentFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	content := filter.New(filter.NewAllCaps(filter.Hold, 5, 0.8))
+
+	t.Run("create_held_post_is_refused", func(t *testing.T) {
+		t.Parallel()
+
+		s := New(mocks.NewMockPostUC(t), pubsubMocks.NewMockBroker(t), content, nil)
+		got, err := s.CreatePost(ctx, models.CreatePostInput{
+			Title:  "READ THIS",
+			Body:   "NOW OR NEVER",
+			Author: "Alice",
+		})
+
+		var filterErr *filter.RejectedError
+		assert.ErrorAs(t, err, &filterErr)
+		assert.Equal(t, filter.Decision{Action: filter.Hold, Rule: "all_caps", Reason: "text is written in capital letters"}, filterErr.Decision)
+		assert.Nil(t, got)
+	})
+
+	t.Run("update_checks_changed_fields", func(t *testing.T) {
+		t.Parallel()
+
+		body := "SHOUTING BODY"
+		s := New(mocks.NewMockPostUC(t), pubsubMocks.NewMockBroker(t), content, nil)
+		got, err := s.UpdatePost(ctx, globalid.Encode(globalid.Post, 1), models.UpdatePostInput{Body: &body})
+
+		var filterErr *filter.RejectedError
+		assert.ErrorAs(t, err, &filterErr)
+		assert.Nil(t, got)
+	})
+
+	t.Run("allowed_post_is_saved", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockPostUC(t)
+		repo.On("Save", mock.Anything, mock.Anything).Return(models.Post{ID: 1, Title: "Hello"}, nil)
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), content, nil)
+		got, err := s.CreatePost(ctx, models.CreatePostInput{Title: "Hello", Body: "A calm body", Author: "Alice"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), got.ID)
+	})
+}
+
+func TestPostService_BannedAuthor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("banned_author_is_refused", func(t *testing.T) {
+		t.Parallel()
+
+		bans := mocks.NewMockBanUC(t)
+		bans.On("IsBanned", mock.Anything, "Alice").Return(true, nil)
+
+		s := New(mocks.NewMockPostUC(t), pubsubMocks.NewMockBroker(t), nil, bans)
+		got, err := s.CreatePost(ctx, models.CreatePostInput{Title: "Hello", Body: "Body", Author: "Alice"})
+
+		assert.EqualError(t, err, "author is banned")
+		assert.Nil(t, got)
+	})
+
+	t.Run("ban_check_error", func(t *testing.T) {
+		t.Parallel()
+
+		bans := mocks.NewMockBanUC(t)
+		bans.On("IsBanned", mock.Anything, "Alice").Return(false, errors.New("db error"))
+
+		s := New(mocks.NewMockPostUC(t), pubsubMocks.NewMockBroker(t), nil, bans)
+		got, err := s.CreatePost(ctx, models.CreatePostInput{Title: "Hello", Body: "Body", Author: "Alice"})
+
+		assert.EqualError(t, err, "db error")
+		assert.Nil(t, got)
+	})
+
+	t.Run("other_author_is_saved", func(t *testing.T) {
+		t.Parallel()
+
+		bans := mocks.NewMockBanUC(t)
+		bans.On("IsBanned", mock.Anything, "Bob").Return(false, nil)
+		repo := mocks.NewMockPostUC(t)
+		repo.On("Save", mock.Anything, mock.Anything).Return(models.Post{ID: 1, Author: "Bob"}, nil)
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), nil, bans)
+		got, err := s.CreatePost(ctx, models.CreatePostInput{Title: "Hello", Body: "Body", Author: "Bob"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), got.ID)
+	})
+}
+
 func TestPostService_GetPostById(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -116,14 +373,14 @@ func TestPostService_GetPostById(t *testing.T) {
 	}{
 		{
 			name:   "successful_get",
-			postID: "42",
+			postID: globalid.Encode(globalid.Post, 42),
 			setupMock: func(repo *mocks.MockPostUC) {
 				repo.On("GetByID", mock.Anything, int64(42)).Return(&models.Post{
 					ID:            42,
 					Title:         "Title",
 					Author:        "Author",
 					Body:          "Body",
-					AllowComments: true,
+					CommentPolicy: models.CommentPolicyOpen,
 					CreatedAt:     "2023-01-01T00:00:00Z",
 				}, nil)
 			},
@@ -132,7 +389,7 @@ func TestPostService_GetPostById(t *testing.T) {
 				Title:         "Title",
 				Author:        "Author",
 				Body:          "Body",
-				AllowComments: true,
+				CommentPolicy: models.CommentPolicyOpen,
 				CreatedAt:     "2023-01-01T00:00:00Z",
 			},
 			wantErr: false,
@@ -151,16 +408,23 @@ func TestPostService_GetPostById(t *testing.T) {
 			wantErr:     true,
 			expectedErr: errors.New("invalid post ID format"),
 		},
+		{
+			name:        "comment_id",
+			postID:      globalid.Encode(globalid.Comment, 42),
+			setupMock:   func(repo *mocks.MockPostUC) {},
+			wantErr:     true,
+			expectedErr: errors.New("invalid post ID format"),
+		},
 		{
 			name:        "negative_id",
-			postID:      "-5",
+			postID:      globalid.Encode(globalid.Post, -5),
 			setupMock:   func(repo *mocks.MockPostUC) {},
 			wantErr:     true,
 			expectedErr: errors.New("post ID must be a positive integer"),
 		},
 		{
 			name:   "post_not_found",
-			postID: "999",
+			postID: globalid.Encode(globalid.Post, 999),
 			setupMock: func(repo *mocks.MockPostUC) {
 				repo.On("GetByID", mock.Anything, int64(999)).Return(nil, errors.New("post not found"))
 			},
@@ -176,7 +440,7 @@ func TestPostService_GetPostById(t *testing.T) {
 			mockRepo := mocks.NewMockPostUC(t)
 			tt.setupMock(mockRepo)
 
-			s := New(mockRepo)
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), nil, nil)
 			got, err := s.GetPostById(ctx, tt.postID)
 
 			if tt.wantErr {
@@ -194,6 +458,65 @@ func TestPostService_GetPostById(t *testing.T) {
 	}
 }
 
+func TestPostService_LoadPost(t *testing.T) {
+	t.Parallel()
+
+	t.Run("batches_posts_through_dataloader", func(t *testing.T) {
+		repo := mocks.NewMockPostUC(t)
+		repo.On("GetByIDs", mock.Anything, mock.MatchedBy(func(ids []int64) bool {
+			return assert.ElementsMatch(t, []int64{1, 2}, ids)
+		})).Return([]*models.Post{
+			{ID: 1, Title: "First"},
+		}, nil).Once()
+
+		loader := dataloader.NewBatchedLoader(
+			myLoader.NewPostLoader(repo).BatchGetPosts,
+			dataloader.WithWait(10*time.Millisecond),
+		)
+		ctx := context.WithValue(context.Background(), myLoader.PostKey, loader)
+
+		s := New(repo, pubsubMocks.NewMockBroker(t), nil, nil)
+
+		type result struct {
+			post *models.Post
+			err  error
+		}
+		first := make(chan result, 1)
+		go func() {
+			p, err := s.LoadPost(ctx, 1)
+			first <- result{p, err}
+		}()
+
+		second, err := s.LoadPost(ctx, 2)
+		require.NoError(t, err)
+		assert.Nil(t, second)
+
+		r := <-first
+		require.NoError(t, r.err)
+		require.NotNil(t, r.post)
+		assert.Equal(t, "First", r.post.Title)
+	})
+
+	t.Run("repo_error", func(t *testing.T) {
+		repo := mocks.NewMockPostUC(t)
+		repo.On("GetByIDs", mock.Anything, []int64{3}).Return(nil, errors.New("db error"))
+
+		loader := dataloader.NewBatchedLoader(myLoader.NewPostLoader(repo).BatchGetPosts)
+		ctx := context.WithValue(context.Background(), myLoader.PostKey, loader)
+
+		_, err := New(repo, pubsubMocks.NewMockBroker(t), nil, nil).LoadPost(ctx, 3)
+
+		assert.EqualError(t, err, "db error")
+	})
+
+	t.Run("dataloader_missing", func(t *testing.T) {
+		s := New(mocks.NewMockPostUC(t), pubsubMocks.NewMockBroker(t), nil, nil)
+		_, err := s.LoadPost(context.Background(), 1)
+
+		assert.EqualError(t, err, "dataloader not found in context")
+	})
+}
+
 func TestPostService_SetPostCommentsAllowed(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -202,31 +525,34 @@ func TestPostService_SetPostCommentsAllowed(t *testing.T) {
 		name        string
 		postID      string
 		allow       bool
-		setupMock   func(repo *mocks.MockPostUC)
+		setupMock   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker)
 		want        *models.Post
 		wantErr     bool
 		expectedErr error
 	}{
 		{
 			name:   "successful_update",
-			postID: "42",
+			postID: globalid.Encode(globalid.Post, 42),
 			allow:  true,
-			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("SetCommentsAllowed", mock.Anything, int64(42), true).Return(&models.Post{
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("SetCommentPolicy", mock.Anything, int64(42), models.CommentPolicyOpen, (*string)(nil)).Return(&models.Post{
 					ID:            42,
 					Title:         "Title",
 					Author:        "Author",
 					Body:          "Body",
-					AllowComments: true,
+					CommentPolicy: models.CommentPolicyOpen,
 					CreatedAt:     "2023-01-01T00:00:00Z",
 				}, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventPostUpdated && e.PostID == 42 && e.Post.CommentPolicy == models.CommentPolicyOpen
+				})).Return(nil)
 			},
 			want: &models.Post{
 				ID:            42,
 				Title:         "Title",
 				Author:        "Author",
 				Body:          "Body",
-				AllowComments: true,
+				CommentPolicy: models.CommentPolicyOpen,
 				CreatedAt:     "2023-01-01T00:00:00Z",
 			},
 			wantErr: false,
@@ -235,7 +561,7 @@ func TestPostService_SetPostCommentsAllowed(t *testing.T) {
 			name:        "empty_id",
 			postID:      "",
 			allow:       true,
-			setupMock:   func(repo *mocks.MockPostUC) {},
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
 			wantErr:     true,
 			expectedErr: errors.New("post ID cannot be empty"),
 		},
@@ -243,24 +569,24 @@ func TestPostService_SetPostCommentsAllowed(t *testing.T) {
 			name:        "invalid_id_format",
 			postID:      "abc",
 			allow:       true,
-			setupMock:   func(repo *mocks.MockPostUC) {},
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
 			wantErr:     true,
 			expectedErr: errors.New("invalid post ID format"),
 		},
 		{
 			name:        "negative_id",
-			postID:      "-5",
+			postID:      globalid.Encode(globalid.Post, -5),
 			allow:       true,
-			setupMock:   func(repo *mocks.MockPostUC) {},
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
 			wantErr:     true,
 			expectedErr: errors.New("post ID must be a positive integer"),
 		},
 		{
 			name:   "post_not_found",
-			postID: "999",
+			postID: globalid.Encode(globalid.Post, 999),
 			allow:  true,
-			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("SetCommentsAllowed", mock.Anything, int64(999), true).Return(nil, errors.New("post not found"))
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("SetCommentPolicy", mock.Anything, int64(999), models.CommentPolicyOpen, (*string)(nil)).Return(nil, errors.New("post not found"))
 			},
 			wantErr:     true,
 			expectedErr: errors.New("post not found"),
@@ -272,9 +598,10 @@ func TestPostService_SetPostCommentsAllowed(t *testing.T) {
 			t.Parallel()
 
 			mockRepo := mocks.NewMockPostUC(t)
-			tt.setupMock(mockRepo)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			tt.setupMock(mockRepo, mockBroker)
 
-			s := New(mockRepo)
+			s := New(mockRepo, mockBroker, nil, nil)
 			got, err := s.SetPostCommentsAllowed(ctx, tt.postID, tt.allow)
 
 			if tt.wantErr {
@@ -288,6 +615,110 @@ func TestPostService_SetPostCommentsAllowed(t *testing.T) {
 				assert.Equal(t, tt.want, got)
 			}
 			mockRepo.AssertExpectations(t)
+			mockBroker.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostService_SetPostCommentPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		postID      string
+		policy      models.CommentPolicy
+		closeAt     *string
+		setupMock   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker)
+		want        *models.Post
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:    "successful_update",
+			postID:  globalid.Encode(globalid.Post, 42),
+			policy:  models.CommentPolicyRepliesOnly,
+			closeAt: strPtr("2026-03-01T03:00:00+03:00"),
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("SetCommentPolicy", mock.Anything, int64(42), models.CommentPolicyRepliesOnly, strPtr("2026-03-01T00:00:00Z")).
+					Return(&models.Post{
+						ID:              42,
+						CommentPolicy:   models.CommentPolicyRepliesOnly,
+						CommentsCloseAt: strPtr("2026-03-01T00:00:00Z"),
+					}, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventPostUpdated && e.PostID == 42 &&
+						e.Post.CommentPolicy == models.CommentPolicyRepliesOnly
+				})).Return(nil)
+			},
+			want: &models.Post{
+				ID:              42,
+				CommentPolicy:   models.CommentPolicyRepliesOnly,
+				CommentsCloseAt: strPtr("2026-03-01T00:00:00Z"),
+			},
+		},
+		{
+			name:    "empty_close_time_clears_it",
+			postID:  globalid.Encode(globalid.Post, 42),
+			policy:  models.CommentPolicyOpen,
+			closeAt: strPtr(""),
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("SetCommentPolicy", mock.Anything, int64(42), models.CommentPolicyOpen, (*string)(nil)).
+					Return(&models.Post{ID: 42, CommentPolicy: models.CommentPolicyOpen}, nil)
+				broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			},
+			want: &models.Post{ID: 42, CommentPolicy: models.CommentPolicyOpen},
+		},
+		{
+			name:        "invalid_policy",
+			postID:      globalid.Encode(globalid.Post, 42),
+			policy:      models.CommentPolicy("NOBODY"),
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			wantErr:     true,
+			expectedErr: errors.New("invalid comment policy"),
+		},
+		{
+			name:        "invalid_close_time",
+			postID:      globalid.Encode(globalid.Post, 42),
+			policy:      models.CommentPolicyOpen,
+			closeAt:     strPtr("2026-03-01"),
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			wantErr:     true,
+			expectedErr: errors.New("comments close time must be an RFC 3339 timestamp"),
+		},
+		{
+			name:        "invalid_id_format",
+			postID:      "abc",
+			policy:      models.CommentPolicyOpen,
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			wantErr:     true,
+			expectedErr: errors.New("invalid post ID format"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockPostUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			tt.setupMock(mockRepo, mockBroker)
+
+			s := New(mockRepo, mockBroker, nil, nil)
+			got, err := s.SetPostCommentPolicy(ctx, tt.postID, tt.policy, tt.closeAt)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				if tt.expectedErr != nil {
+					assert.EqualError(t, err, tt.expectedErr.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			mockRepo.AssertExpectations(t)
+			mockBroker.AssertExpectations(t)
 		})
 	}
 }
@@ -301,7 +732,7 @@ func TestPostService_GetPosts(t *testing.T) {
 		Title:         "Post 1",
 		Author:        "Author 1",
 		Body:          "Body 1",
-		AllowComments: true,
+		CommentPolicy: models.CommentPolicyOpen,
 		CreatedAt:     "2023-01-01T12:00:00Z",
 	}
 	post2 := &models.Post{
@@ -309,7 +740,7 @@ func TestPostService_GetPosts(t *testing.T) {
 		Title:         "Post 2",
 		Author:        "Author 2",
 		Body:          "Body 2",
-		AllowComments: true,
+		CommentPolicy: models.CommentPolicyOpen,
 		CreatedAt:     "2023-01-01T11:00:00Z",
 	}
 	post3 := &models.Post{
@@ -317,10 +748,14 @@ func TestPostService_GetPosts(t *testing.T) {
 		Title:         "Post 3",
 		Author:        "Author 3",
 		Body:          "Body 3",
-		AllowComments: true,
+		CommentPolicy: models.CommentPolicyOpen,
 		CreatedAt:     "2023-01-01T10:00:00Z",
 	}
 
+	noFilter := models.PostFilter{Tags: []string{}, Match: models.TagMatchAny}
+	matchAll := models.TagMatchAll
+	invalidMatch := models.TagMatch("SOME")
+
 	cursorFor := func(post *models.Post) string {
 		return cursor.Encode(post.CreatedAt, post.ID)
 	}
@@ -329,6 +764,10 @@ func TestPostService_GetPosts(t *testing.T) {
 		name          string
 		first         *int32
 		after         *string
+		last          *int32
+		before        *string
+		tags          []string
+		match         *models.TagMatch
 		setupMock     func(repo *mocks.MockPostUC)
 		expected      *models.PostConnection
 		expectedError string
@@ -338,9 +777,9 @@ func TestPostService_GetPosts(t *testing.T) {
 			first: nil,
 			after: nil,
 			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("Get", mock.Anything, (*string)(nil), int64(0), int32(21)).
+				repo.On("Get", mock.Anything, noFilter, (*string)(nil), int64(0), false, int32(21)).
 					Return([]*models.Post{post1, post2, post3}, nil)
-				repo.On("TotalCount", mock.Anything).Return(int64(5), nil)
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(5), nil)
 			},
 			expected: &models.PostConnection{
 				Edges: []*models.PostEdge{
@@ -366,9 +805,9 @@ func TestPostService_GetPosts(t *testing.T) {
 					p.ID = int64(i + 1)
 					posts[i] = &p
 				}
-				repo.On("Get", mock.Anything, (*string)(nil), int64(0), int32(21)).
+				repo.On("Get", mock.Anything, noFilter, (*string)(nil), int64(0), false, int32(21)).
 					Return(posts, nil)
-				repo.On("TotalCount", mock.Anything).Return(int64(30), nil)
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(30), nil)
 			},
 			expected: &models.PostConnection{
 				Edges: func() []*models.PostEdge {
@@ -395,9 +834,9 @@ func TestPostService_GetPosts(t *testing.T) {
 			first: int32Ptr(2),
 			after: nil,
 			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("Get", mock.Anything, (*string)(nil), int64(0), int32(3)).
+				repo.On("Get", mock.Anything, noFilter, (*string)(nil), int64(0), false, int32(3)).
 					Return([]*models.Post{post1, post2}, nil)
-				repo.On("TotalCount", mock.Anything).Return(int64(10), nil)
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(10), nil)
 			},
 			expected: &models.PostConnection{
 				Edges: []*models.PostEdge{
@@ -416,9 +855,9 @@ func TestPostService_GetPosts(t *testing.T) {
 			first: int32Ptr(2),
 			after: nil,
 			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("Get", mock.Anything, (*string)(nil), int64(0), int32(3)).
+				repo.On("Get", mock.Anything, noFilter, (*string)(nil), int64(0), false, int32(3)).
 					Return([]*models.Post{post1, post2, post3}, nil)
-				repo.On("TotalCount", mock.Anything).Return(int64(10), nil)
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(10), nil)
 			},
 			expected: &models.PostConnection{
 				Edges: []*models.PostEdge{
@@ -439,9 +878,9 @@ func TestPostService_GetPosts(t *testing.T) {
 			setupMock: func(repo *mocks.MockPostUC) {
 				afterCreatedAt := "2023-01-01T11:00:00Z"
 				afterID := int64(2)
-				repo.On("Get", mock.Anything, &afterCreatedAt, afterID, int32(3)).
+				repo.On("Get", mock.Anything, noFilter, &afterCreatedAt, afterID, false, int32(3)).
 					Return([]*models.Post{post3}, nil)
-				repo.On("TotalCount", mock.Anything).Return(int64(10), nil)
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(10), nil)
 			},
 			expected: &models.PostConnection{
 				Edges: []*models.PostEdge{
@@ -454,6 +893,35 @@ func TestPostService_GetPosts(t *testing.T) {
 				TotalCount: 10,
 			},
 		},
+		{
+			name:   "backward_before_cursor",
+			last:   int32Ptr(1),
+			before: strPtr(cursorFor(post3)),
+			setupMock: func(repo *mocks.MockPostUC) {
+				beforeCreatedAt := post3.CreatedAt
+				repo.On("Get", mock.Anything, noFilter, &beforeCreatedAt, post3.ID, true, int32(2)).
+					Return([]*models.Post{post1, post2}, nil)
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(10), nil)
+			},
+			expected: &models.PostConnection{
+				Edges: []*models.PostEdge{
+					{Cursor: cursorFor(post2), Node: post2},
+				},
+				PageInfo: &models.PageInfo{
+					StartCursor:     strPtr(cursorFor(post2)),
+					EndCursor:       strPtr(cursorFor(post2)),
+					HasPreviousPage: true,
+				},
+				TotalCount: 10,
+			},
+		},
+		{
+			name:          "after_mixed_with_before",
+			after:         strPtr(cursorFor(post1)),
+			before:        strPtr(cursorFor(post3)),
+			setupMock:     func(repo *mocks.MockPostUC) {},
+			expectedError: "first and after cannot be combined with last and before",
+		},
 		{
 			name:          "invalid_cursor_format",
 			first:         int32Ptr(2),
@@ -466,9 +934,9 @@ func TestPostService_GetPosts(t *testing.T) {
 			first: int32Ptr(2),
 			after: strPtr(""),
 			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("Get", mock.Anything, (*string)(nil), int64(0), int32(3)).
+				repo.On("Get", mock.Anything, noFilter, (*string)(nil), int64(0), false, int32(3)).
 					Return([]*models.Post{post1, post2}, nil)
-				repo.On("TotalCount", mock.Anything).Return(int64(10), nil)
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(10), nil)
 			},
 			expected: &models.PostConnection{
 				Edges: []*models.PostEdge{
@@ -487,7 +955,7 @@ func TestPostService_GetPosts(t *testing.T) {
 			first: int32Ptr(2),
 			after: nil,
 			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("Get", mock.Anything, (*string)(nil), int64(0), int32(3)).
+				repo.On("Get", mock.Anything, noFilter, (*string)(nil), int64(0), false, int32(3)).
 					Return(nil, errors.New("db error"))
 			},
 			expectedError: "db error",
@@ -497,9 +965,9 @@ func TestPostService_GetPosts(t *testing.T) {
 			first: int32Ptr(2),
 			after: nil,
 			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("Get", mock.Anything, (*string)(nil), int64(0), int32(3)).
+				repo.On("Get", mock.Anything, noFilter, (*string)(nil), int64(0), false, int32(3)).
 					Return([]*models.Post{post1, post2}, nil)
-				repo.On("TotalCount", mock.Anything).Return(int64(0), errors.New("count error"))
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(0), errors.New("count error"))
 			},
 			expectedError: "count error",
 		},
@@ -508,9 +976,9 @@ func TestPostService_GetPosts(t *testing.T) {
 			first: int32Ptr(2),
 			after: nil,
 			setupMock: func(repo *mocks.MockPostUC) {
-				repo.On("Get", mock.Anything, (*string)(nil), int64(0), int32(3)).
+				repo.On("Get", mock.Anything, noFilter, (*string)(nil), int64(0), false, int32(3)).
 					Return([]*models.Post{}, nil)
-				repo.On("TotalCount", mock.Anything).Return(int64(0), nil)
+				repo.On("TotalCount", mock.Anything, noFilter).Return(int64(0), nil)
 			},
 			expected: &models.PostConnection{
 				Edges:      []*models.PostEdge{},
@@ -518,6 +986,44 @@ func TestPostService_GetPosts(t *testing.T) {
 				TotalCount: 0,
 			},
 		},
+		{
+			name:  "normalized_tag_filter",
+			first: int32Ptr(3),
+			tags:  []string{" Go ", "go", "GraphQL"},
+			match: &matchAll,
+			setupMock: func(repo *mocks.MockPostUC) {
+				filter := models.PostFilter{Tags: []string{"go", "graphql"}, Match: models.TagMatchAll}
+				repo.On("Get", mock.Anything, filter, (*string)(nil), int64(0), false, int32(4)).
+					Return([]*models.Post{post1}, nil)
+				repo.On("TotalCount", mock.Anything, filter).Return(int64(1), nil)
+			},
+			expected: &models.PostConnection{
+				Edges: []*models.PostEdge{
+					{Cursor: cursorFor(post1), Node: post1},
+				},
+				PageInfo: &models.PageInfo{
+					EndCursor:   strPtr(cursorFor(post1)),
+					HasNextPage: false,
+				},
+				TotalCount: 1,
+			},
+		},
+		{
+			name:          "empty_tag",
+			tags:          []string{"go", " "},
+			expectedError: "tag cannot be empty",
+		},
+		{
+			name:          "too_many_tags",
+			tags:          []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+			expectedError: "max 10 tags per filter",
+		},
+		{
+			name:          "invalid_tag_match",
+			tags:          []string{"go"},
+			match:         &invalidMatch,
+			expectedError: "invalid tag match mode",
+		},
 	}
 
 	for _, tt := range tests {
@@ -529,8 +1035,8 @@ func TestPostService_GetPosts(t *testing.T) {
 				tt.setupMock(mockRepo)
 			}
 
-			s := New(mockRepo)
-			got, err := s.GetPosts(ctx, tt.first, tt.after)
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), nil, nil)
+			got, err := s.GetPosts(ctx, tt.first, tt.after, tt.last, tt.before, tt.tags, tt.match)
 
 			if tt.expectedError != "" {
 				assert.Error(t, err)
@@ -542,6 +1048,7 @@ func TestPostService_GetPosts(t *testing.T) {
 
 				assert.Equal(t, tt.expected.TotalCount, got.TotalCount)
 				assert.Equal(t, tt.expected.PageInfo.HasNextPage, got.PageInfo.HasNextPage)
+				assert.Equal(t, tt.expected.PageInfo.HasPreviousPage, got.PageInfo.HasPreviousPage)
 				assert.Equal(t, tt.expected.PageInfo.EndCursor, got.PageInfo.EndCursor)
 
 				require.Len(t, got.Edges, len(tt.expected.Edges))
@@ -555,5 +1062,385 @@ func TestPostService_GetPosts(t *testing.T) {
 	}
 }
 
+func TestPostService_PostUpdated(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	post := &models.Post{ID: 42, Title: "Title", CommentPolicy: models.CommentPolicyClosed}
+
+	tests := []struct {
+		name        string
+		postID      string
+		setupMock   func(broker *pubsubMocks.MockBroker)
+		want        []*models.Post
+		expectedErr string
+	}{
+		{
+			name:   "filters_post_events",
+			postID: globalid.Encode(globalid.Post, 42),
+			setupMock: func(broker *pubsubMocks.MockBroker) {
+				ch := make(chan *models.Event, 2)
+				ch <- &models.Event{Type: models.EventCommentAdded, PostID: 42, Comment: &models.Comment{ID: 1, PostID: 42}}
+				ch <- &models.Event{Type: models.EventPostUpdated, PostID: 42, Post: post}
+				close(ch)
+
+				broker.On("Subscribe", mock.Anything, int64(42)).Return((<-chan *models.Event)(ch), nil)
+			},
+			want: []*models.Post{post},
+		},
+		{
+			name:        "empty_id",
+			postID:      "",
+			expectedErr: "post ID cannot be empty",
+		},
+		{
+			name:        "invalid_id_format",
+			postID:      "abc",
+			expectedErr: "invalid post ID format",
+		},
+		{
+			name:        "negative_id",
+			postID:      globalid.Encode(globalid.Post, -5),
+			expectedErr: "post ID must be a positive integer",
+		},
+		{
+			name:   "subscribe_error",
+			postID: globalid.Encode(globalid.Post, 42),
+			setupMock: func(broker *pubsubMocks.MockBroker) {
+				broker.On("Subscribe", mock.Anything, int64(42)).Return(nil, errors.New("broker closed"))
+			},
+			expectedErr: "broker closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			if tt.setupMock != nil {
+				tt.setupMock(mockBroker)
+			}
+
+			s := New(mocks.NewMockPostUC(t), mockBroker, nil, nil)
+			got, err := s.PostUpdated(ctx, tt.postID)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+
+				var received []*models.Post
+				for p := range got {
+					received = append(received, p)
+				}
+				assert.Equal(t, tt.want, received)
+			}
+			mockBroker.AssertExpectations(t)
+		})
+	}
+}
+
 func int32Ptr(v int32) *int32 { return &v }
 func strPtr(v string) *string { return &v }
+
+func TestPostService_UpdatePost(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	title := "New title"
+	empty := ""
+
+	tests := []struct {
+		name        string
+		postID      string
+		in          models.UpdatePostInput
+		setupMock   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker)
+		want        *models.Post
+		expectedErr string
+	}{
+		{
+			name:   "successful_update",
+			postID: globalid.Encode(globalid.Post, 42),
+			in:     models.UpdatePostInput{Title: &title},
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Update", mock.Anything, int64(42), &title, (*string)(nil)).Return(&models.Post{
+					ID:    42,
+					Title: title,
+					Body:  "Body",
+				}, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventPostUpdated && e.PostID == 42 && e.Post.Title == title
+				})).Return(nil)
+			},
+			want: &models.Post{ID: 42, Title: title, Body: "Body"},
+		},
+		{
+			name:        "invalid_id_format",
+			postID:      "abc",
+			in:          models.UpdatePostInput{Title: &title},
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			expectedErr: "invalid post ID format",
+		},
+		{
+			name:        "nothing_to_update",
+			postID:      globalid.Encode(globalid.Post, 42),
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			expectedErr: "nothing to update",
+		},
+		{
+			name:        "empty_title",
+			postID:      globalid.Encode(globalid.Post, 42),
+			in:          models.UpdatePostInput{Title: &empty},
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			expectedErr: "title cannot be empty",
+		},
+		{
+			name:        "empty_body",
+			postID:      globalid.Encode(globalid.Post, 42),
+			in:          models.UpdatePostInput{Body: &empty},
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			expectedErr: "body cannot be empty",
+		},
+		{
+			name:   "post_not_found",
+			postID: globalid.Encode(globalid.Post, 999),
+			in:     models.UpdatePostInput{Title: &title},
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Update", mock.Anything, int64(999), &title, (*string)(nil)).Return(nil, errors.New("post not found"))
+			},
+			expectedErr: "post not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockPostUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			tt.setupMock(mockRepo, mockBroker)
+
+			s := New(mockRepo, mockBroker, nil, nil)
+			got, err := s.UpdatePost(ctx, tt.postID, tt.in)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPostService_DeletePost(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	deletedAt := "2023-01-02T00:00:00Z"
+
+	tests := []struct {
+		name        string
+		postID      string
+		setupMock   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker)
+		want        *models.Post
+		expectedErr string
+	}{
+		{
+			name:   "successful_delete",
+			postID: globalid.Encode(globalid.Post, 42),
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Delete", mock.Anything, int64(42), mock.AnythingOfType("string")).Return(&models.Post{
+					ID:        42,
+					DeletedAt: &deletedAt,
+				}, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventPostUpdated && e.PostID == 42 && e.Post.DeletedAt != nil
+				})).Return(nil)
+			},
+			want: &models.Post{ID: 42, DeletedAt: &deletedAt},
+		},
+		{
+			name:        "empty_id",
+			postID:      "",
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			expectedErr: "post ID cannot be empty",
+		},
+		{
+			name:   "already_deleted",
+			postID: globalid.Encode(globalid.Post, 42),
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Delete", mock.Anything, int64(42), mock.AnythingOfType("string")).Return(nil, errors.New("post not found"))
+			},
+			expectedErr: "post not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockPostUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			tt.setupMock(mockRepo, mockBroker)
+
+			s := New(mockRepo, mockBroker, nil, nil)
+			got, err := s.DeletePost(ctx, tt.postID)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPostService_RestorePost(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	moderatorCtx := auth.WithAuthorization(ctx, "Bearer secret", "secret")
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		postID      string
+		setupMock   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker)
+		want        *models.Post
+		expectedErr string
+	}{
+		{
+			name:   "successful_restore",
+			postID: globalid.Encode(globalid.Post, 42),
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Restore", mock.Anything, int64(42), false).Return(&models.Post{ID: 42}, nil)
+				broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventPostUpdated && e.PostID == 42 && e.Post.DeletedAt == nil
+				})).Return(nil)
+			},
+			want: &models.Post{ID: 42},
+		},
+		{
+			name:        "negative_id",
+			postID:      globalid.Encode(globalid.Post, -1),
+			setupMock:   func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {},
+			expectedErr: "post ID must be a positive integer",
+		},
+		{
+			name:   "post_not_found",
+			postID: globalid.Encode(globalid.Post, 999),
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Restore", mock.Anything, int64(999), false).Return(nil, errors.New("post not found"))
+			},
+			expectedErr: "post not found",
+		},
+		{
+			name:   "hidden_by_moderator",
+			postID: globalid.Encode(globalid.Post, 42),
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Restore", mock.Anything, int64(42), false).Return(nil, errors.New("post was hidden by a moderator"))
+			},
+			expectedErr: "post was hidden by a moderator",
+		},
+		{
+			name:   "moderator_unhides",
+			ctx:    moderatorCtx,
+			postID: globalid.Encode(globalid.Post, 42),
+			setupMock: func(repo *mocks.MockPostUC, broker *pubsubMocks.MockBroker) {
+				repo.On("Restore", mock.Anything, int64(42), true).Return(&models.Post{ID: 42}, nil)
+				broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			},
+			want: &models.Post{ID: 42},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockPostUC(t)
+			mockBroker := pubsubMocks.NewMockBroker(t)
+			tt.setupMock(mockRepo, mockBroker)
+
+			callCtx := ctx
+			if tt.ctx != nil {
+				callCtx = tt.ctx
+			}
+
+			s := New(mockRepo, mockBroker, nil, nil)
+			got, err := s.RestorePost(callCtx, tt.postID)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPostService_GetTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		prefix      *string
+		first       *int32
+		setupMock   func(repo *mocks.MockPostUC)
+		want        []string
+		expectedErr string
+	}{
+		{
+			name:   "normalized_prefix_default_limit",
+			prefix: strPtr(" Gr "),
+			setupMock: func(repo *mocks.MockPostUC) {
+				repo.On("Tags", mock.Anything, "gr", int32(10)).Return([]string{"graphql"}, nil)
+			},
+			want: []string{"graphql"},
+		},
+		{
+			name:  "no_prefix_limit_capped",
+			first: int32Ptr(1000),
+			setupMock: func(repo *mocks.MockPostUC) {
+				repo.On("Tags", mock.Anything, "", int32(50)).Return([]string{"go", "graphql"}, nil)
+			},
+			want: []string{"go", "graphql"},
+		},
+		{
+			name: "repo_error",
+			setupMock: func(repo *mocks.MockPostUC) {
+				repo.On("Tags", mock.Anything, "", int32(10)).Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockPostUC(t)
+			tt.setupMock(mockRepo)
+
+			s := New(mockRepo, pubsubMocks.NewMockBroker(t), nil, nil)
+			got, err := s.GetTags(ctx, tt.prefix, tt.first)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
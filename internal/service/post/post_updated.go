@@ -0,0 +1,53 @@
+package post
+
+import (
+	"context"
+	"log"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (s *Post) PostUpdated(ctx context.Context, postID string) (<-chan *models.Post, error) {
+	id, err := parsePostID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := s.broker.Subscribe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *models.Post, 1)
+
+	go func() {
+		defer close(out)
+
+		for event := range live {
+			if event.Type != models.EventPostUpdated {
+				continue
+			}
+
+			select {
+			case out <- event.Post:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// publish notifies subscribers about a change that is already persisted, so a
+// broker failure is only logged and never fails the mutation itself.
+func (s *Post) publish(ctx context.Context, post *models.Post) {
+	err := s.broker.Publish(ctx, models.Event{
+		Type:   models.EventPostUpdated,
+		PostID: post.ID,
+		Post:   post,
+	})
+	if err != nil {
+		log.Printf("failed to publish %s event for post %d: %v", models.EventPostUpdated, post.ID, err)
+	}
+}
@@ -7,75 +7,60 @@ import (
 
 	"github.com/Saracomethstein/ozon-test-task/internal/models"
 	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/pagination"
 )
 
-const (
-	defaultPageLimit = 20
-)
-
-type cursorPosition struct {
-	afterCreatedAt *string
-	afterID        int64
-	err            error
-}
+func (s *Post) GetPosts(ctx context.Context, first *int32, after *string, last *int32, before *string, tags []string, match *models.TagMatch) (*models.PostConnection, error) {
+	window, err := pagination.ParseWindow(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
 
-func (s *Post) GetPosts(ctx context.Context, first *int32, after *string) (*models.PostConnection, error) {
-	limit := s.getLimit(first)
+	filter, err := s.buildFilter(tags, match)
+	if err != nil {
+		return nil, err
+	}
 
-	cursorPos := s.parseCursor(after)
-	if cursorPos.err != nil {
-		return nil, cursorPos.err
+	pos, err := cursor.Parse(window.Cursor)
+	if err != nil {
+		return nil, err
 	}
 
-	posts, err := s.repo.Get(ctx, cursorPos.afterCreatedAt, cursorPos.afterID, limit+1)
+	posts, err := s.repo.Get(ctx, filter, pos.CreatedAt, pos.ID, window.Backward, window.Limit+1)
 	if err != nil {
 		return nil, err
 	}
 
-	hasNextPage, pagePosts := s.extractPage(posts, limit)
+	hasMore, pagePosts := pagination.ExtractPage(posts, window)
 
 	edges := s.buildEdges(pagePosts)
 
-	totalCount, err := s.repo.TotalCount(ctx)
+	totalCount, err := s.repo.TotalCount(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.buildConnection(edges, hasNextPage, totalCount), nil
+	return s.buildConnection(edges, window, hasMore, totalCount), nil
 }
 
-func (s *Post) getLimit(first *int32) int32 {
-	if first != nil && *first > 0 {
-		return *first
-	}
-
-	return defaultPageLimit
-}
-
-func (s *Post) parseCursor(after *string) cursorPosition {
-	if after == nil || *after == "" {
-		return cursorPosition{}
-	}
-
-	createdAt, id, err := cursor.Decode(*after)
+func (s *Post) buildFilter(tags []string, match *models.TagMatch) (models.PostFilter, error) {
+	normalized, err := normalizeTags(tags)
 	if err != nil {
-		return cursorPosition{
-			err: errors.New("invalid cursor format"),
-		}
+		return models.PostFilter{}, err
 	}
-
-	return cursorPosition{
-		afterCreatedAt: &createdAt,
-		afterID:        id,
+	if len(normalized) > maxTagsPerFilter {
+		return models.PostFilter{}, errors.New("max 10 tags per filter")
 	}
-}
 
-func (s *Post) extractPage(posts []*models.Post, limit int32) (hasNextPage bool, page []*models.Post) {
-	if len(posts) > int(limit) {
-		return true, posts[:limit]
+	m, err := parseTagMatch(match)
+	if err != nil {
+		return models.PostFilter{}, err
 	}
 
-	return false, posts
+	return models.PostFilter{
+		Tags:  normalized,
+		Match: m,
+	}, nil
 }
 
 func (s *Post) buildEdges(posts []*models.Post) []*models.PostEdge {
@@ -92,21 +77,14 @@ func (s *Post) buildEdges(posts []*models.Post) []*models.PostEdge {
 	return edges
 }
 
-func (s *Post) getEndCursor(edges []*models.PostEdge) *string {
-	if len(edges) == 0 {
-		return nil
-	}
-
-	return &edges[len(edges)-1].Cursor
+func postCursor(edge *models.PostEdge) string {
+	return edge.Cursor
 }
 
-func (s *Post) buildConnection(edges []*models.PostEdge, hasNextPage bool, totalCount int64) *models.PostConnection {
+func (s *Post) buildConnection(edges []*models.PostEdge, window pagination.Window, hasMore bool, totalCount int64) *models.PostConnection {
 	return &models.PostConnection{
-		Edges: edges,
-		PageInfo: &models.PageInfo{
-			EndCursor:   s.getEndCursor(edges),
-			HasNextPage: hasNextPage,
-		},
+		Edges:      edges,
+		PageInfo:   pagination.BuildPageInfo(edges, postCursor, window, hasMore),
 		TotalCount: int32(totalCount),
 	}
 }
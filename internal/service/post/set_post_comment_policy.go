@@ -0,0 +1,90 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (s *Post) SetPostCommentPolicy(ctx context.Context, postID string, policy models.CommentPolicy, closeAt *string) (*models.Post, error) {
+	id, err := parsePostID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCommentPolicy(policy); err != nil {
+		return nil, err
+	}
+
+	closeAt, err = normalizeCloseAt(closeAt)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.repo.SetCommentPolicy(ctx, id, policy, closeAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, out)
+
+	return out, nil
+}
+
+// SetPostCommentsAllowed is the boolean form of SetPostCommentPolicy kept for
+// the deprecated mutation. Allowing comments reopens the post and drops its
+// auto-close time.
+func (s *Post) SetPostCommentsAllowed(ctx context.Context, postID string, allow bool) (*models.Post, error) {
+	policy := models.CommentPolicyClosed
+	if allow {
+		policy = models.CommentPolicyOpen
+	}
+
+	return s.SetPostCommentPolicy(ctx, postID, policy, nil)
+}
+
+// commentPolicyOf resolves the policy of a new post. allowComments predates
+// policies: an explicit false means CLOSED, anything else OPEN.
+func commentPolicyOf(in models.CreatePostInput) (models.CommentPolicy, error) {
+	if in.CommentPolicy != nil {
+		if in.AllowComments != nil {
+			return "", errors.New("allowComments cannot be combined with commentPolicy")
+		}
+		return *in.CommentPolicy, checkCommentPolicy(*in.CommentPolicy)
+	}
+
+	if in.AllowComments != nil && !*in.AllowComments {
+		return models.CommentPolicyClosed, nil
+	}
+
+	return models.CommentPolicyOpen, nil
+}
+
+func checkCommentPolicy(policy models.CommentPolicy) error {
+	switch policy {
+	case models.CommentPolicyOpen, models.CommentPolicyClosed, models.CommentPolicyAuthorOnly,
+		models.CommentPolicyRepliesOnly, models.CommentPolicyModerated:
+		return nil
+	default:
+		return errors.New("invalid comment policy")
+	}
+}
+
+// normalizeCloseAt validates an auto-close time and converts it to the UTC
+// RFC 3339 form of every other timestamp, so that it compares as a string.
+func normalizeCloseAt(closeAt *string) (*string, error) {
+	if closeAt == nil || *closeAt == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *closeAt)
+	if err != nil {
+		return nil, errors.New("comments close time must be an RFC 3339 timestamp")
+	}
+
+	out := t.UTC().Format(time.RFC3339)
+	return &out, nil
+}
@@ -0,0 +1,72 @@
+package post
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	maxTagsPerPost   = 10
+	maxTagsPerFilter = 10
+	maxTagLength     = 32
+	defaultTagsLimit = 10
+	maxTagsLimit     = 50
+)
+
+func (s *Post) GetTags(ctx context.Context, prefix *string, first *int32) ([]string, error) {
+	limit := int32(defaultTagsLimit)
+	if first != nil && *first > 0 {
+		limit = min(*first, maxTagsLimit)
+	}
+
+	var p string
+	if prefix != nil {
+		p = strings.ToLower(strings.TrimSpace(*prefix))
+	}
+
+	return s.repo.Tags(ctx, p, limit)
+}
+
+// normalizeTags trims and lowercases tags and drops duplicates, keeping the
+// order in which they were first given.
+func normalizeTags(tags []string) ([]string, error) {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, errors.New("tag cannot be empty")
+		}
+
+		if len([]rune(tag)) > maxTagLength {
+			return nil, errors.New("max tag length is 32 char")
+		}
+
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+
+	return out, nil
+}
+
+func parseTagMatch(match *models.TagMatch) (models.TagMatch, error) {
+	if match == nil {
+		return models.TagMatchAny, nil
+	}
+
+	switch *match {
+	case models.TagMatchAny, models.TagMatchAll:
+		return *match, nil
+	default:
+		return "", errors.New("invalid tag match mode")
+	}
+}
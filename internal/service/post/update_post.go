@@ -0,0 +1,47 @@
+package post
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (s *Post) UpdatePost(ctx context.Context, postID string, in models.UpdatePostInput) (*models.Post, error) {
+	id, err := parsePostID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Title == nil && in.Body == nil {
+		return nil, errors.New("nothing to update")
+	}
+
+	if in.Title != nil && *in.Title == "" {
+		return nil, errors.New("title cannot be empty")
+	}
+
+	if in.Body != nil && *in.Body == "" {
+		return nil, errors.New("body cannot be empty")
+	}
+
+	var parts []string
+	for _, part := range []*string{in.Title, in.Body} {
+		if part != nil {
+			parts = append(parts, *part)
+		}
+	}
+	if err := s.checkContent(ctx, "", parts...); err != nil {
+		return nil, err
+	}
+
+	out, err := s.repo.Update(ctx, id, in.Title, in.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, out)
+
+	return out, nil
+}
@@ -0,0 +1,14 @@
+package reaction
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+type UseCase interface {
+	React(ctx context.Context, target models.ReactionTarget, targetID, author, kind string) (*models.ReactionSummary, error)
+	Unreact(ctx context.Context, target models.ReactionTarget, targetID, author string) (*models.ReactionSummary, error)
+	Reactions(ctx context.Context, target models.ReactionTarget, targetID int64) (*models.ReactionSummary, error)
+	Kinds() []string
+}
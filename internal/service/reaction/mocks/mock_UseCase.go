@@ -0,0 +1,267 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/Saracomethstein/ozon-test-task/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUseCase is an autogenerated mock type for the UseCase type
+type MockUseCase struct {
+	mock.Mock
+}
+
+type MockUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUseCase) EXPECT() *MockUseCase_Expecter {
+	return &MockUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Kinds provides a mock function with no fields
+func (_m *MockUseCase) Kinds() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Kinds")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// MockUseCase_Kinds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Kinds'
+type MockUseCase_Kinds_Call struct {
+	*mock.Call
+}
+
+// Kinds is a helper method to define mock.On call
+func (_e *MockUseCase_Expecter) Kinds() *MockUseCase_Kinds_Call {
+	return &MockUseCase_Kinds_Call{Call: _e.mock.On("Kinds")}
+}
+
+func (_c *MockUseCase_Kinds_Call) Run(run func()) *MockUseCase_Kinds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Kinds_Call) Return(_a0 []string) *MockUseCase_Kinds_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_Kinds_Call) RunAndReturn(run func() []string) *MockUseCase_Kinds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// React provides a mock function with given fields: ctx, target, targetID, author, kind
+func (_m *MockUseCase) React(ctx context.Context, target models.ReactionTarget, targetID string, author string, kind string) (*models.ReactionSummary, error) {
+	ret := _m.Called(ctx, target, targetID, author, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for React")
+	}
+
+	var r0 *models.ReactionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, string, string, string) (*models.ReactionSummary, error)); ok {
+		return rf(ctx, target, targetID, author, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, string, string, string) *models.ReactionSummary); ok {
+		r0 = rf(ctx, target, targetID, author, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReactionSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ReactionTarget, string, string, string) error); ok {
+		r1 = rf(ctx, target, targetID, author, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_React_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'React'
+type MockUseCase_React_Call struct {
+	*mock.Call
+}
+
+// React is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ReactionTarget
+//   - targetID string
+//   - author string
+//   - kind string
+func (_e *MockUseCase_Expecter) React(ctx interface{}, target interface{}, targetID interface{}, author interface{}, kind interface{}) *MockUseCase_React_Call {
+	return &MockUseCase_React_Call{Call: _e.mock.On("React", ctx, target, targetID, author, kind)}
+}
+
+func (_c *MockUseCase_React_Call) Run(run func(ctx context.Context, target models.ReactionTarget, targetID string, author string, kind string)) *MockUseCase_React_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReactionTarget), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_React_Call) Return(_a0 *models.ReactionSummary, _a1 error) *MockUseCase_React_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_React_Call) RunAndReturn(run func(context.Context, models.ReactionTarget, string, string, string) (*models.ReactionSummary, error)) *MockUseCase_React_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reactions provides a mock function with given fields: ctx, target, targetID
+func (_m *MockUseCase) Reactions(ctx context.Context, target models.ReactionTarget, targetID int64) (*models.ReactionSummary, error) {
+	ret := _m.Called(ctx, target, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reactions")
+	}
+
+	var r0 *models.ReactionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, int64) (*models.ReactionSummary, error)); ok {
+		return rf(ctx, target, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, int64) *models.ReactionSummary); ok {
+		r0 = rf(ctx, target, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReactionSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ReactionTarget, int64) error); ok {
+		r1 = rf(ctx, target, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_Reactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reactions'
+type MockUseCase_Reactions_Call struct {
+	*mock.Call
+}
+
+// Reactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ReactionTarget
+//   - targetID int64
+func (_e *MockUseCase_Expecter) Reactions(ctx interface{}, target interface{}, targetID interface{}) *MockUseCase_Reactions_Call {
+	return &MockUseCase_Reactions_Call{Call: _e.mock.On("Reactions", ctx, target, targetID)}
+}
+
+func (_c *MockUseCase_Reactions_Call) Run(run func(ctx context.Context, target models.ReactionTarget, targetID int64)) *MockUseCase_Reactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReactionTarget), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Reactions_Call) Return(_a0 *models.ReactionSummary, _a1 error) *MockUseCase_Reactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_Reactions_Call) RunAndReturn(run func(context.Context, models.ReactionTarget, int64) (*models.ReactionSummary, error)) *MockUseCase_Reactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unreact provides a mock function with given fields: ctx, target, targetID, author
+func (_m *MockUseCase) Unreact(ctx context.Context, target models.ReactionTarget, targetID string, author string) (*models.ReactionSummary, error) {
+	ret := _m.Called(ctx, target, targetID, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unreact")
+	}
+
+	var r0 *models.ReactionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, string, string) (*models.ReactionSummary, error)); ok {
+		return rf(ctx, target, targetID, author)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReactionTarget, string, string) *models.ReactionSummary); ok {
+		r0 = rf(ctx, target, targetID, author)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReactionSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ReactionTarget, string, string) error); ok {
+		r1 = rf(ctx, target, targetID, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_Unreact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unreact'
+type MockUseCase_Unreact_Call struct {
+	*mock.Call
+}
+
+// Unreact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ReactionTarget
+//   - targetID string
+//   - author string
+func (_e *MockUseCase_Expecter) Unreact(ctx interface{}, target interface{}, targetID interface{}, author interface{}) *MockUseCase_Unreact_Call {
+	return &MockUseCase_Unreact_Call{Call: _e.mock.On("Unreact", ctx, target, targetID, author)}
+}
+
+func (_c *MockUseCase_Unreact_Call) Run(run func(ctx context.Context, target models.ReactionTarget, targetID string, author string)) *MockUseCase_Unreact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReactionTarget), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Unreact_Call) Return(_a0 *models.ReactionSummary, _a1 error) *MockUseCase_Unreact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_Unreact_Call) RunAndReturn(run func(context.Context, models.ReactionTarget, string, string) (*models.ReactionSummary, error)) *MockUseCase_Unreact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUseCase creates a new instance of MockUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUseCase {
+	mock := &MockUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
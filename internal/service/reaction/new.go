@@ -0,0 +1,28 @@
+package reaction
+
+import (
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+type Service struct {
+	repo  repository.ReactionUC
+	kinds map[string]struct{}
+}
+
+// New builds the service with the configured reaction kinds; up and down
+// votes are always accepted on top of them.
+func New(repo repository.ReactionUC, kinds []string) *Service {
+	allowed := map[string]struct{}{
+		models.ReactionUpvote:   {},
+		models.ReactionDownvote: {},
+	}
+	for _, kind := range kinds {
+		allowed[kind] = struct{}{}
+	}
+
+	return &Service{
+		repo:  repo,
+		kinds: allowed,
+	}
+}
@@ -0,0 +1,120 @@
+package reaction
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func (s *Service) React(ctx context.Context, target models.ReactionTarget, targetID, author, kind string) (*models.ReactionSummary, error) {
+	id, err := parseTargetID(target, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if author == "" {
+		return nil, errors.New("author cannot be empty")
+	}
+
+	if _, ok := s.kinds[kind]; !ok {
+		return nil, errors.New("unknown reaction kind")
+	}
+
+	err = s.repo.Set(ctx, models.Reaction{
+		Target:    target,
+		TargetID:  id,
+		Author:    author,
+		Kind:      kind,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.summary(ctx, target, id)
+}
+
+func (s *Service) Unreact(ctx context.Context, target models.ReactionTarget, targetID, author string) (*models.ReactionSummary, error) {
+	id, err := parseTargetID(target, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if author == "" {
+		return nil, errors.New("author cannot be empty")
+	}
+
+	if err := s.repo.Remove(ctx, target, id, author); err != nil {
+		return nil, err
+	}
+
+	return s.summary(ctx, target, id)
+}
+
+func (s *Service) Kinds() []string {
+	kinds := make([]string, 0, len(s.kinds))
+	for kind := range s.kinds {
+		kinds = append(kinds, kind)
+	}
+
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// summary reads the counts straight from the repository: after a mutation the
+// request scoped dataloader may already hold stale counts for the target.
+func (s *Service) summary(ctx context.Context, target models.ReactionTarget, targetID int64) (*models.ReactionSummary, error) {
+	counts, err := s.repo.CountBatch(ctx, target, []int64{targetID})
+	if err != nil {
+		return nil, err
+	}
+
+	return buildSummary(counts), nil
+}
+
+func buildSummary(counts []*models.ReactionCount) *models.ReactionSummary {
+	summary := &models.ReactionSummary{
+		Counts: make([]*models.ReactionCount, 0, len(counts)),
+	}
+
+	for _, c := range counts {
+		switch c.Kind {
+		case models.ReactionUpvote:
+			summary.Upvotes = c.Count
+		case models.ReactionDownvote:
+			summary.Downvotes = c.Count
+		default:
+			summary.Counts = append(summary.Counts, c)
+		}
+	}
+
+	summary.Score = summary.Upvotes - summary.Downvotes
+
+	return summary
+}
+
+// parseTargetID decodes the global ID of the reacted post or comment. An ID of
+// the other type is rejected rather than read as a row of target.
+func parseTargetID(target models.ReactionTarget, targetID string) (int64, error) {
+	typ := globalid.Post
+	if target == models.ReactionTargetComment {
+		typ = globalid.Comment
+	}
+
+	id, err := globalid.DecodeAs(typ, targetID)
+	if err != nil {
+		return 0, errors.New("invalid targetID format")
+	}
+
+	if id <= 0 {
+		return 0, errors.New("targetID must be greater 0")
+	}
+
+	return id, nil
+}
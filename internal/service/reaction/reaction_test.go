@@ -0,0 +1,224 @@
+package reaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	myLoader "github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+func TestService_React(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		targetID    string
+		author      string
+		kind        string
+		setupMock   func(repo *mocks.MockReactionUC)
+		want        *models.ReactionSummary
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:     "successful_upvote",
+			targetID: globalid.Encode(globalid.Post, 1),
+			author:   "Alice",
+			kind:     models.ReactionUpvote,
+			setupMock: func(repo *mocks.MockReactionUC) {
+				repo.On("Set", mock.Anything, mock.MatchedBy(func(r models.Reaction) bool {
+					return r.Target == models.ReactionTargetPost && r.TargetID == 1 &&
+						r.Author == "Alice" && r.Kind == models.ReactionUpvote && r.CreatedAt != ""
+				})).Return(nil)
+				repo.On("CountBatch", mock.Anything, models.ReactionTargetPost, []int64{1}).Return([]*models.ReactionCount{
+					{TargetID: 1, Kind: "like", Count: 2},
+					{TargetID: 1, Kind: models.ReactionUpvote, Count: 3},
+					{TargetID: 1, Kind: models.ReactionDownvote, Count: 1},
+				}, nil)
+			},
+			want: &models.ReactionSummary{
+				Upvotes:   3,
+				Downvotes: 1,
+				Score:     2,
+				Counts:    []*models.ReactionCount{{TargetID: 1, Kind: "like", Count: 2}},
+			},
+		},
+		{
+			name:        "invalid_target_id",
+			targetID:    "abc",
+			author:      "Alice",
+			kind:        models.ReactionUpvote,
+			setupMock:   func(repo *mocks.MockReactionUC) {},
+			wantErr:     true,
+			expectedErr: "invalid targetID format",
+		},
+		{
+			name:        "comment_id_for_post_target",
+			targetID:    globalid.Encode(globalid.Comment, 1),
+			author:      "Alice",
+			kind:        models.ReactionUpvote,
+			setupMock:   func(repo *mocks.MockReactionUC) {},
+			wantErr:     true,
+			expectedErr: "invalid targetID format",
+		},
+		{
+			name:        "non_positive_target_id",
+			targetID:    globalid.Encode(globalid.Post, 0),
+			author:      "Alice",
+			kind:        models.ReactionUpvote,
+			setupMock:   func(repo *mocks.MockReactionUC) {},
+			wantErr:     true,
+			expectedErr: "targetID must be greater 0",
+		},
+		{
+			name:        "empty_author",
+			targetID:    globalid.Encode(globalid.Post, 1),
+			author:      "",
+			kind:        models.ReactionUpvote,
+			setupMock:   func(repo *mocks.MockReactionUC) {},
+			wantErr:     true,
+			expectedErr: "author cannot be empty",
+		},
+		{
+			name:        "unknown_kind",
+			targetID:    globalid.Encode(globalid.Post, 1),
+			author:      "Alice",
+			kind:        "shrug",
+			setupMock:   func(repo *mocks.MockReactionUC) {},
+			wantErr:     true,
+			expectedErr: "unknown reaction kind",
+		},
+		{
+			name:     "repository_error",
+			targetID: globalid.Encode(globalid.Post, 1),
+			author:   "Alice",
+			kind:     "like",
+			setupMock: func(repo *mocks.MockReactionUC) {
+				repo.On("Set", mock.Anything, mock.Anything).Return(errors.New("post not found"))
+			},
+			wantErr:     true,
+			expectedErr: "post not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewMockReactionUC(t)
+			tt.setupMock(repo)
+
+			svc := New(repo, []string{"like"})
+			got, err := svc.React(ctx, models.ReactionTargetPost, tt.targetID, tt.author, tt.kind)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_Unreact(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("successful_unreact", func(t *testing.T) {
+		repo := mocks.NewMockReactionUC(t)
+		repo.On("Remove", mock.Anything, models.ReactionTargetComment, int64(5), "Alice").Return(nil)
+		repo.On("CountBatch", mock.Anything, models.ReactionTargetComment, []int64{5}).Return(nil, nil)
+
+		svc := New(repo, nil)
+		got, err := svc.Unreact(ctx, models.ReactionTargetComment, globalid.Encode(globalid.Comment, 5), "Alice")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &models.ReactionSummary{Counts: []*models.ReactionCount{}}, got)
+	})
+
+	t.Run("reaction_not_found", func(t *testing.T) {
+		repo := mocks.NewMockReactionUC(t)
+		repo.On("Remove", mock.Anything, models.ReactionTargetComment, int64(5), "Alice").Return(errors.New("reaction not found"))
+
+		svc := New(repo, nil)
+		got, err := svc.Unreact(ctx, models.ReactionTargetComment, globalid.Encode(globalid.Comment, 5), "Alice")
+
+		assert.EqualError(t, err, "reaction not found")
+		assert.Nil(t, got)
+	})
+
+	t.Run("empty_author", func(t *testing.T) {
+		svc := New(mocks.NewMockReactionUC(t), nil)
+		_, err := svc.Unreact(ctx, models.ReactionTargetComment, globalid.Encode(globalid.Comment, 5), "")
+
+		assert.EqualError(t, err, "author cannot be empty")
+	})
+}
+
+func TestService_Reactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("batches_targets_through_dataloader", func(t *testing.T) {
+		repo := mocks.NewMockReactionUC(t)
+		repo.On("CountBatch", mock.Anything, models.ReactionTargetComment, mock.MatchedBy(func(ids []int64) bool {
+			return assert.ElementsMatch(t, []int64{1, 2}, ids)
+		})).Return([]*models.ReactionCount{
+			{TargetID: 1, Kind: models.ReactionUpvote, Count: 4},
+		}, nil).Once()
+
+		loader := dataloader.NewBatchedLoader(
+			myLoader.NewReactionLoader(repo).BatchGetReactions,
+			dataloader.WithWait(10*time.Millisecond),
+		)
+		ctx := context.WithValue(context.Background(), myLoader.ReactionKey, loader)
+
+		svc := New(repo, nil)
+
+		type result struct {
+			summary *models.ReactionSummary
+			err     error
+		}
+		first := make(chan result, 1)
+		go func() {
+			s, err := svc.Reactions(ctx, models.ReactionTargetComment, 1)
+			first <- result{s, err}
+		}()
+
+		second, err := svc.Reactions(ctx, models.ReactionTargetComment, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), second.Score)
+
+		r := <-first
+		require.NoError(t, r.err)
+		assert.Equal(t, int32(4), r.summary.Upvotes)
+		assert.Equal(t, int32(4), r.summary.Score)
+	})
+
+	t.Run("dataloader_missing", func(t *testing.T) {
+		svc := New(mocks.NewMockReactionUC(t), nil)
+		_, err := svc.Reactions(context.Background(), models.ReactionTargetPost, 1)
+
+		assert.EqualError(t, err, "dataloader not found in context")
+	})
+}
+
+func TestService_Kinds(t *testing.T) {
+	t.Parallel()
+
+	svc := New(mocks.NewMockReactionUC(t), []string{"wow", "like", models.ReactionUpvote})
+
+	assert.Equal(t, []string{"down", "like", "up", "wow"}, svc.Kinds())
+}
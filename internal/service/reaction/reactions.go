@@ -0,0 +1,31 @@
+package reaction
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/pkg/errors"
+
+	myLoader "github.com/Saracomethstein/ozon-test-task/internal/graphql/dataloader"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+func (s *Service) Reactions(ctx context.Context, target models.ReactionTarget, targetID int64) (*models.ReactionSummary, error) {
+	loader, ok := ctx.Value(myLoader.ReactionKey).(dataloader.Interface)
+	if !ok {
+		return nil, errors.New("dataloader not found in context")
+	}
+
+	thunk := loader.Load(ctx, myLoader.ReactionKeyFor(target, targetID))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	counts, ok := result.([]*models.ReactionCount)
+	if !ok {
+		return nil, errors.New("unexpected data type from dataloader")
+	}
+
+	return buildSummary(counts), nil
+}
@@ -0,0 +1,14 @@
+package report
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+type UseCase interface {
+	Report(ctx context.Context, targetID, reporter string, reason models.ReportReason, details *string) (*models.Report, error)
+	Reports(ctx context.Context, status *models.ReportStatus, first *int32, after *string) (*models.ReportConnection, error)
+	ResolveReport(ctx context.Context, reportID string, action models.ReportAction) (*models.Report, error)
+	AuditLog(ctx context.Context, first *int32, after *string) (*models.AuditEntryConnection, error)
+}
@@ -0,0 +1,280 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/Saracomethstein/ozon-test-task/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUseCase is an autogenerated mock type for the UseCase type
+type MockUseCase struct {
+	mock.Mock
+}
+
+type MockUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUseCase) EXPECT() *MockUseCase_Expecter {
+	return &MockUseCase_Expecter{mock: &_m.Mock}
+}
+
+// AuditLog provides a mock function with given fields: ctx, first, after
+func (_m *MockUseCase) AuditLog(ctx context.Context, first *int32, after *string) (*models.AuditEntryConnection, error) {
+	ret := _m.Called(ctx, first, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditLog")
+	}
+
+	var r0 *models.AuditEntryConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int32, *string) (*models.AuditEntryConnection, error)); ok {
+		return rf(ctx, first, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int32, *string) *models.AuditEntryConnection); ok {
+		r0 = rf(ctx, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AuditEntryConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int32, *string) error); ok {
+		r1 = rf(ctx, first, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_AuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditLog'
+type MockUseCase_AuditLog_Call struct {
+	*mock.Call
+}
+
+// AuditLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - first *int32
+//   - after *string
+func (_e *MockUseCase_Expecter) AuditLog(ctx interface{}, first interface{}, after interface{}) *MockUseCase_AuditLog_Call {
+	return &MockUseCase_AuditLog_Call{Call: _e.mock.On("AuditLog", ctx, first, after)}
+}
+
+func (_c *MockUseCase_AuditLog_Call) Run(run func(ctx context.Context, first *int32, after *string)) *MockUseCase_AuditLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*int32), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_AuditLog_Call) Return(_a0 *models.AuditEntryConnection, _a1 error) *MockUseCase_AuditLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_AuditLog_Call) RunAndReturn(run func(context.Context, *int32, *string) (*models.AuditEntryConnection, error)) *MockUseCase_AuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Report provides a mock function with given fields: ctx, targetID, reporter, reason, details
+func (_m *MockUseCase) Report(ctx context.Context, targetID string, reporter string, reason models.ReportReason, details *string) (*models.Report, error) {
+	ret := _m.Called(ctx, targetID, reporter, reason, details)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 *models.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.ReportReason, *string) (*models.Report, error)); ok {
+		return rf(ctx, targetID, reporter, reason, details)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.ReportReason, *string) *models.Report); ok {
+		r0 = rf(ctx, targetID, reporter, reason, details)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, models.ReportReason, *string) error); ok {
+		r1 = rf(ctx, targetID, reporter, reason, details)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type MockUseCase_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetID string
+//   - reporter string
+//   - reason models.ReportReason
+//   - details *string
+func (_e *MockUseCase_Expecter) Report(ctx interface{}, targetID interface{}, reporter interface{}, reason interface{}, details interface{}) *MockUseCase_Report_Call {
+	return &MockUseCase_Report_Call{Call: _e.mock.On("Report", ctx, targetID, reporter, reason, details)}
+}
+
+func (_c *MockUseCase_Report_Call) Run(run func(ctx context.Context, targetID string, reporter string, reason models.ReportReason, details *string)) *MockUseCase_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(models.ReportReason), args[4].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Report_Call) Return(_a0 *models.Report, _a1 error) *MockUseCase_Report_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_Report_Call) RunAndReturn(run func(context.Context, string, string, models.ReportReason, *string) (*models.Report, error)) *MockUseCase_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reports provides a mock function with given fields: ctx, status, first, after
+func (_m *MockUseCase) Reports(ctx context.Context, status *models.ReportStatus, first *int32, after *string) (*models.ReportConnection, error) {
+	ret := _m.Called(ctx, status, first, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reports")
+	}
+
+	var r0 *models.ReportConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ReportStatus, *int32, *string) (*models.ReportConnection, error)); ok {
+		return rf(ctx, status, first, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ReportStatus, *int32, *string) *models.ReportConnection); ok {
+		r0 = rf(ctx, status, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ReportConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ReportStatus, *int32, *string) error); ok {
+		r1 = rf(ctx, status, first, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_Reports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reports'
+type MockUseCase_Reports_Call struct {
+	*mock.Call
+}
+
+// Reports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status *models.ReportStatus
+//   - first *int32
+//   - after *string
+func (_e *MockUseCase_Expecter) Reports(ctx interface{}, status interface{}, first interface{}, after interface{}) *MockUseCase_Reports_Call {
+	return &MockUseCase_Reports_Call{Call: _e.mock.On("Reports", ctx, status, first, after)}
+}
+
+func (_c *MockUseCase_Reports_Call) Run(run func(ctx context.Context, status *models.ReportStatus, first *int32, after *string)) *MockUseCase_Reports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ReportStatus), args[2].(*int32), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Reports_Call) Return(_a0 *models.ReportConnection, _a1 error) *MockUseCase_Reports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_Reports_Call) RunAndReturn(run func(context.Context, *models.ReportStatus, *int32, *string) (*models.ReportConnection, error)) *MockUseCase_Reports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveReport provides a mock function with given fields: ctx, reportID, action
+func (_m *MockUseCase) ResolveReport(ctx context.Context, reportID string, action models.ReportAction) (*models.Report, error) {
+	ret := _m.Called(ctx, reportID, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveReport")
+	}
+
+	var r0 *models.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.ReportAction) (*models.Report, error)); ok {
+		return rf(ctx, reportID, action)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.ReportAction) *models.Report); ok {
+		r0 = rf(ctx, reportID, action)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.ReportAction) error); ok {
+		r1 = rf(ctx, reportID, action)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_ResolveReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveReport'
+type MockUseCase_ResolveReport_Call struct {
+	*mock.Call
+}
+
+// ResolveReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportID string
+//   - action models.ReportAction
+func (_e *MockUseCase_Expecter) ResolveReport(ctx interface{}, reportID interface{}, action interface{}) *MockUseCase_ResolveReport_Call {
+	return &MockUseCase_ResolveReport_Call{Call: _e.mock.On("ResolveReport", ctx, reportID, action)}
+}
+
+func (_c *MockUseCase_ResolveReport_Call) Run(run func(ctx context.Context, reportID string, action models.ReportAction)) *MockUseCase_ResolveReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.ReportAction))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_ResolveReport_Call) Return(_a0 *models.Report, _a1 error) *MockUseCase_ResolveReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_ResolveReport_Call) RunAndReturn(run func(context.Context, string, models.ReportAction) (*models.Report, error)) *MockUseCase_ResolveReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUseCase creates a new instance of MockUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUseCase {
+	mock := &MockUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
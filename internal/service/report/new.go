@@ -0,0 +1,48 @@
+package report
+
+import (
+	"github.com/Saracomethstein/ozon-test-task/internal/pubsub"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+// Limits bound how much one reporter can do with reports. Reporters are told
+// apart by the name they report under, the identity bans apply to. The host a
+// report came from is not used: clients behind one proxy or NAT share it.
+type Limits struct {
+	// HideThreshold is how many distinct reporters hide a target until a
+	// moderator looks at it; zero never hides.
+	HideThreshold int
+	// HourlyReports is how many reports one reporter may file per hour; zero
+	// is unlimited.
+	HourlyReports int
+}
+
+type Service struct {
+	repo     repository.ReportUC
+	bans     repository.BanUC
+	posts    repository.PostUC
+	comments repository.CommentUC
+	tx       repository.Transactor
+	broker   pubsub.Broker
+	limits   Limits
+}
+
+func New(
+	repo repository.ReportUC,
+	bans repository.BanUC,
+	posts repository.PostUC,
+	comments repository.CommentUC,
+	tx repository.Transactor,
+	broker pubsub.Broker,
+	limits Limits,
+) *Service {
+	return &Service{
+		repo:     repo,
+		bans:     bans,
+		posts:    posts,
+		comments: comments,
+		tx:       tx,
+		broker:   broker,
+		limits:   limits,
+	}
+}
@@ -0,0 +1,253 @@
+package report
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+)
+
+const maxDetailsLength = 1000
+
+// Report files a report on a post or a comment. A reporter has one open report
+// per target: reporting it again returns that report unchanged. When reports
+// from HideThreshold distinct reporters are open on the target it is hidden
+// until a moderator resolves them. The report, its audit entry and the hiding
+// it triggers are written in one transaction.
+func (s *Service) Report(ctx context.Context, targetID, reporter string, reason models.ReportReason, details *string) (*models.Report, error) {
+	reporter = strings.TrimSpace(reporter)
+	if reporter == "" {
+		return nil, errors.New("reporter cannot be empty")
+	}
+
+	if !validReason(reason) {
+		return nil, errors.New("unknown report reason")
+	}
+
+	if details != nil {
+		trimmed := strings.TrimSpace(*details)
+		if utf8.RuneCountInString(trimmed) > maxDetailsLength {
+			return nil, errors.Errorf("details cannot be longer than %d characters", maxDetailsLength)
+		}
+
+		details = nil
+		if trimmed != "" {
+			details = &trimmed
+		}
+	}
+
+	target, id, err := parseTargetID(targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	banned, err := s.bans.IsBanned(ctx, reporter)
+	if err != nil {
+		return nil, err
+	}
+	if banned {
+		return nil, errors.New("reporter is banned")
+	}
+
+	author, err := s.targetAuthor(ctx, target, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	if err := s.checkRate(ctx, reporter, now); err != nil {
+		return nil, err
+	}
+
+	var (
+		report *models.Report
+		hidden *models.Event
+	)
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		filed, created, err := s.repo.Add(ctx, models.Report{
+			Target:       target,
+			TargetID:     id,
+			TargetAuthor: author,
+			Reporter:     reporter,
+			Caller:       auth.Caller(ctx),
+			Reason:       reason,
+			Details:      details,
+			CreatedAt:    now.Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+
+		report = filed
+		if !created {
+			return nil
+		}
+
+		reasonText := string(reason)
+		err = s.record(ctx, models.AuditEntry{
+			Actor:     reporter,
+			Action:    models.AuditActionReport,
+			Target:    target,
+			TargetID:  id,
+			ReportID:  &report.ID,
+			Details:   &reasonText,
+			CreatedAt: report.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		hidden, err = s.autoHide(ctx, report)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if hidden != nil {
+		report.TargetHidden = true
+		s.publish(ctx, *hidden)
+	}
+
+	return report, nil
+}
+
+// checkRate turns away a reporter who filed HourlyReports reports in the last
+// hour.
+func (s *Service) checkRate(ctx context.Context, reporter string, now time.Time) error {
+	if s.limits.HourlyReports <= 0 {
+		return nil
+	}
+
+	since := now.Add(-time.Hour).Format(time.RFC3339)
+	count, err := s.repo.CountByReporter(ctx, reporter, since)
+	if err != nil {
+		return err
+	}
+	if count >= int64(s.limits.HourlyReports) {
+		return errors.New("too many reports, try again later")
+	}
+
+	return nil
+}
+
+// autoHide hides the target of a new report once enough reporters have
+// reported it, and returns the event to publish when it did. It runs in the
+// transaction filing the report, so a failure here files nothing.
+func (s *Service) autoHide(ctx context.Context, report *models.Report) (*models.Event, error) {
+	if s.limits.HideThreshold <= 0 {
+		return nil, nil
+	}
+
+	count, err := s.repo.ReporterCount(ctx, report.Target, report.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if count < int64(s.limits.HideThreshold) {
+		return nil, nil
+	}
+
+	event, err := s.hideTarget(ctx, report.Target, report.TargetID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "hide %s %d", report.Target, report.TargetID)
+	}
+
+	if err := s.repo.MarkHidden(ctx, report.Target, report.TargetID); err != nil {
+		return nil, err
+	}
+
+	details := "hidden after " + strconv.FormatInt(count, 10) + " reports"
+	err = s.record(ctx, models.AuditEntry{
+		Actor:     models.AuditActorSystem,
+		Action:    models.AuditActionAutoHide,
+		Target:    report.Target,
+		TargetID:  report.TargetID,
+		ReportID:  &report.ID,
+		Details:   &details,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// targetAuthor returns the author of a visible post or comment; hidden and
+// deleted content cannot be reported.
+func (s *Service) targetAuthor(ctx context.Context, target models.ReportTarget, id int64) (string, error) {
+	if target == models.ReportTargetPost {
+		post, err := s.posts.GetByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return post.Author, nil
+	}
+
+	comment, err := s.comments.GetByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if comment.IsDeleted {
+		return "", errors.New("comment not found")
+	}
+
+	return comment.Author, nil
+}
+
+func (s *Service) record(ctx context.Context, entry models.AuditEntry) error {
+	return errors.Wrap(s.repo.Log(ctx, entry), "record audit log")
+}
+
+func validReason(reason models.ReportReason) bool {
+	switch reason {
+	case models.ReportReasonSpam, models.ReportReasonAbuse, models.ReportReasonOffTopic, models.ReportReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseTargetID decodes the global ID of a reported post or comment.
+func parseTargetID(targetID string) (models.ReportTarget, int64, error) {
+	typ, id, err := globalid.Decode(targetID)
+	if err != nil {
+		return "", 0, errors.New("invalid targetId format")
+	}
+
+	var target models.ReportTarget
+	switch typ {
+	case globalid.Post:
+		target = models.ReportTargetPost
+	case globalid.Comment:
+		target = models.ReportTargetComment
+	default:
+		return "", 0, errors.New("only posts and comments can be reported")
+	}
+
+	if id <= 0 {
+		return "", 0, errors.New("targetId must be greater 0")
+	}
+
+	return target, id, nil
+}
+
+func parseReportID(reportID string) (int64, error) {
+	id, err := globalid.DecodeAs(globalid.Report, reportID)
+	if err != nil {
+		return 0, errors.New("invalid report id format")
+	}
+	if id <= 0 {
+		return 0, errors.New("report id must be greater 0")
+	}
+
+	return id, nil
+}
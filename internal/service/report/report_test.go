@@ -0,0 +1,687 @@
+package report
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	pubsubMocks "github.com/Saracomethstein/ozon-test-task/internal/pubsub/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/globalid"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/pagination"
+)
+
+type deps struct {
+	repo     *mocks.MockReportUC
+	bans     *mocks.MockBanUC
+	posts    *mocks.MockPostUC
+	comments *mocks.MockCommentUC
+	tx       *mocks.MockTransactor
+	broker   *pubsubMocks.MockBroker
+}
+
+func newDeps(t *testing.T) *deps {
+	d := &deps{
+		repo:     mocks.NewMockReportUC(t),
+		bans:     mocks.NewMockBanUC(t),
+		posts:    mocks.NewMockPostUC(t),
+		comments: mocks.NewMockCommentUC(t),
+		tx:       mocks.NewMockTransactor(t),
+		broker:   pubsubMocks.NewMockBroker(t),
+	}
+	d.tx.EXPECT().InTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+
+	return d
+}
+
+func (d *deps) service(limits Limits) *Service {
+	return New(d.repo, d.bans, d.posts, d.comments, d.tx, d.broker, limits)
+}
+
+func TestService_Report(t *testing.T) {
+	t.Parallel()
+	ctx := auth.WithCaller(context.Background(), "203.0.113.7:51234")
+
+	commentID := globalid.Encode(globalid.Comment, 7)
+	filed := &models.Report{ID: 3, Target: models.ReportTargetComment, TargetID: 7, TargetAuthor: "Bob", Reporter: "Alice", Caller: "203.0.113.7", Reason: models.ReportReasonSpam, Status: models.ReportStatusOpen}
+	anonFiled := &models.Report{ID: 4, Target: models.ReportTargetComment, TargetID: 7, TargetAuthor: "Bob", Reporter: "Alice", Reason: models.ReportReasonSpam, Status: models.ReportStatusOpen}
+
+	tests := []struct {
+		name        string
+		targetID    string
+		reporter    string
+		reason      models.ReportReason
+		details     *string
+		anonymous   bool
+		limits      Limits
+		setupMock   func(d *deps)
+		want        *models.Report
+		expectedErr string
+	}{
+		{
+			name:     "successful_report",
+			targetID: commentID,
+			reporter: " Alice ",
+			reason:   models.ReportReasonSpam,
+			details:  strPtr("  casino links "),
+			limits:   Limits{HideThreshold: 3},
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.comments.On("GetByID", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, Author: "Bob"}, nil)
+				d.repo.On("Add", mock.Anything, mock.MatchedBy(func(r models.Report) bool {
+					return r.Target == models.ReportTargetComment && r.TargetID == 7 && r.TargetAuthor == "Bob" &&
+						r.Reporter == "Alice" && r.Caller == "203.0.113.7" && r.Details != nil && *r.Details == "casino links" && r.CreatedAt != ""
+				})).Return(filed, true, nil)
+				d.repo.On("Log", mock.Anything, mock.MatchedBy(func(e models.AuditEntry) bool {
+					return e.Actor == "Alice" && e.Action == models.AuditActionReport && *e.ReportID == 3 && *e.Details == "SPAM"
+				})).Return(nil)
+				d.repo.On("ReporterCount", mock.Anything, models.ReportTargetComment, int64(7)).Return(int64(1), nil)
+			},
+			want: filed,
+		},
+		{
+			name:     "duplicate_report",
+			targetID: globalid.Encode(globalid.Post, 1),
+			reporter: "Alice",
+			reason:   models.ReportReasonAbuse,
+			limits:   Limits{HideThreshold: 3},
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.posts.On("GetByID", mock.Anything, int64(1)).Return(&models.Post{ID: 1, Author: "Bob"}, nil)
+				d.repo.On("Add", mock.Anything, mock.Anything).Return(filed, false, nil)
+			},
+			want: filed,
+		},
+		{
+			name:     "threshold_hides_comment",
+			targetID: commentID,
+			reporter: "Alice",
+			reason:   models.ReportReasonSpam,
+			limits:   Limits{HideThreshold: 2},
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.comments.On("GetByID", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, Author: "Bob"}, nil)
+				d.repo.On("Add", mock.Anything, mock.Anything).Return(&models.Report{ID: 3, Target: models.ReportTargetComment, TargetID: 7, Caller: "203.0.113.7"}, true, nil)
+				d.repo.On("Log", mock.Anything, mock.MatchedBy(func(e models.AuditEntry) bool {
+					return e.Action == models.AuditActionReport
+				})).Return(nil)
+				d.repo.On("ReporterCount", mock.Anything, models.ReportTargetComment, int64(7)).Return(int64(2), nil)
+				d.comments.On("Hold", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1, Status: models.CommentStatusPending}, nil)
+				d.broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentDeleted && e.Comment.ID == 7
+				})).Return(nil)
+				d.repo.On("MarkHidden", mock.Anything, models.ReportTargetComment, int64(7)).Return(nil)
+				d.repo.On("Log", mock.Anything, mock.MatchedBy(func(e models.AuditEntry) bool {
+					return e.Actor == models.AuditActorSystem && e.Action == models.AuditActionAutoHide && *e.Details == "hidden after 2 reports"
+				})).Return(nil)
+			},
+			want: &models.Report{ID: 3, Target: models.ReportTargetComment, TargetID: 7, Caller: "203.0.113.7", TargetHidden: true},
+		},
+		{
+			name:     "failed_hide_files_nothing",
+			targetID: globalid.Encode(globalid.Post, 1),
+			reporter: "Alice",
+			reason:   models.ReportReasonSpam,
+			limits:   Limits{HideThreshold: 1},
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.posts.On("GetByID", mock.Anything, int64(1)).Return(&models.Post{ID: 1, Author: "Bob"}, nil)
+				d.repo.On("Add", mock.Anything, mock.Anything).Return(&models.Report{ID: 3, Target: models.ReportTargetPost, TargetID: 1, Caller: "203.0.113.7"}, true, nil)
+				d.repo.On("Log", mock.Anything, mock.Anything).Return(nil)
+				d.repo.On("ReporterCount", mock.Anything, models.ReportTargetPost, int64(1)).Return(int64(1), nil)
+				d.posts.On("Hide", mock.Anything, int64(1), mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: "hide POST 1: db error",
+		},
+		{
+			name:     "failed_mark_hidden_publishes_nothing",
+			targetID: commentID,
+			reporter: "Alice",
+			reason:   models.ReportReasonSpam,
+			limits:   Limits{HideThreshold: 1},
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.comments.On("GetByID", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, Author: "Bob"}, nil)
+				d.repo.On("Add", mock.Anything, mock.Anything).Return(&models.Report{ID: 3, Target: models.ReportTargetComment, TargetID: 7}, true, nil)
+				d.repo.On("Log", mock.Anything, mock.Anything).Return(nil)
+				d.repo.On("ReporterCount", mock.Anything, models.ReportTargetComment, int64(7)).Return(int64(1), nil)
+				d.comments.On("Hold", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1, Status: models.CommentStatusPending}, nil)
+				d.repo.On("MarkHidden", mock.Anything, models.ReportTargetComment, int64(7)).Return(errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			name:      "unknown_caller_counts_by_reporter",
+			targetID:  commentID,
+			reporter:  "Alice",
+			reason:    models.ReportReasonSpam,
+			anonymous: true,
+			limits:    Limits{HideThreshold: 2, HourlyReports: 1},
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.comments.On("GetByID", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, Author: "Bob"}, nil)
+				d.repo.On("CountByReporter", mock.Anything, "Alice", mock.Anything).Return(int64(0), nil)
+				d.repo.On("Add", mock.Anything, mock.MatchedBy(func(r models.Report) bool {
+					return r.Caller == ""
+				})).Return(anonFiled, true, nil)
+				d.repo.On("Log", mock.Anything, mock.Anything).Return(nil)
+				d.repo.On("ReporterCount", mock.Anything, models.ReportTargetComment, int64(7)).Return(int64(1), nil)
+			},
+			want: anonFiled,
+		},
+		{
+			name:     "under_hourly_limit",
+			targetID: commentID,
+			reporter: "Alice",
+			reason:   models.ReportReasonSpam,
+			limits:   Limits{HourlyReports: 3},
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.comments.On("GetByID", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, Author: "Bob"}, nil)
+				d.repo.On("CountByReporter", mock.Anything, "Alice", mock.Anything).Return(int64(2), nil)
+				d.repo.On("Add", mock.Anything, mock.Anything).Return(filed, true, nil)
+				d.repo.On("Log", mock.Anything, mock.Anything).Return(nil)
+			},
+			want: filed,
+		},
+		{
+			name:     "hourly_limit_reached",
+			targetID: commentID,
+			reporter: "Alice",
+			reason:   models.ReportReasonSpam,
+			limits:   Limits{HourlyReports: 3},
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.comments.On("GetByID", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, Author: "Bob"}, nil)
+				d.repo.On("CountByReporter", mock.Anything, "Alice", mock.Anything).Return(int64(3), nil)
+			},
+			expectedErr: "too many reports, try again later",
+		},
+		{
+			name:        "empty_reporter",
+			targetID:    commentID,
+			reporter:    "  ",
+			reason:      models.ReportReasonSpam,
+			expectedErr: "reporter cannot be empty",
+		},
+		{
+			name:        "unknown_reason",
+			targetID:    commentID,
+			reporter:    "Alice",
+			reason:      "BORING",
+			expectedErr: "unknown report reason",
+		},
+		{
+			name:        "details_too_long",
+			targetID:    commentID,
+			reporter:    "Alice",
+			reason:      models.ReportReasonOther,
+			details:     strPtr(strings.Repeat("a", maxDetailsLength+1)),
+			expectedErr: "details cannot be longer than 1000 characters",
+		},
+		{
+			name:        "invalid_target_id",
+			targetID:    "abc",
+			reporter:    "Alice",
+			reason:      models.ReportReasonSpam,
+			expectedErr: "invalid targetId format",
+		},
+		{
+			name:        "report_target_not_reportable",
+			targetID:    globalid.Encode(globalid.Report, 1),
+			reporter:    "Alice",
+			reason:      models.ReportReasonSpam,
+			expectedErr: "only posts and comments can be reported",
+		},
+		{
+			name:     "banned_reporter",
+			targetID: commentID,
+			reporter: "Alice",
+			reason:   models.ReportReasonSpam,
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(true, nil)
+			},
+			expectedErr: "reporter is banned",
+		},
+		{
+			name:     "deleted_comment",
+			targetID: commentID,
+			reporter: "Alice",
+			reason:   models.ReportReasonSpam,
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.comments.On("GetByID", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, IsDeleted: true}, nil)
+			},
+			expectedErr: "comment not found",
+		},
+		{
+			name:     "audit_log_error",
+			targetID: commentID,
+			reporter: "Alice",
+			reason:   models.ReportReasonSpam,
+			setupMock: func(d *deps) {
+				d.bans.On("IsBanned", mock.Anything, "Alice").Return(false, nil)
+				d.comments.On("GetByID", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, Author: "Bob"}, nil)
+				d.repo.On("Add", mock.Anything, mock.Anything).Return(filed, true, nil)
+				d.repo.On("Log", mock.Anything, mock.Anything).Return(errors.New("db error"))
+			},
+			expectedErr: "record audit log: db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			if tt.setupMock != nil {
+				tt.setupMock(d)
+			}
+
+			callCtx := ctx
+			if tt.anonymous {
+				callCtx = context.Background()
+			}
+
+			got, err := d.service(tt.limits).Report(callCtx, tt.targetID, tt.reporter, tt.reason, tt.details)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_ResolveReport(t *testing.T) {
+	t.Parallel()
+
+	moderatorCtx := auth.WithAuthorization(context.Background(), "Bearer secret", "secret")
+	reportID := globalid.Encode(globalid.Report, 3)
+
+	openComment := func(hidden bool) *models.Report {
+		return &models.Report{ID: 3, Target: models.ReportTargetComment, TargetID: 7, TargetAuthor: "Bob", Reason: models.ReportReasonSpam, Status: models.ReportStatusOpen, TargetHidden: hidden}
+	}
+	openPost := &models.Report{ID: 3, Target: models.ReportTargetPost, TargetID: 1, TargetAuthor: "Bob", Reason: models.ReportReasonAbuse, Status: models.ReportStatusOpen}
+	resolved := &models.Report{ID: 3, Status: models.ReportStatusResolved}
+	reason := "Reported as SPAM"
+
+	expectStatus := func(d *deps, status models.CommentStatus) {
+		d.comments.On("GetByIDAnyStatus", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1, Status: status}, nil)
+	}
+
+	expectResolved := func(d *deps, action models.ReportAction, details *string) {
+		d.repo.On("Resolve", mock.Anything, int64(3), action, mock.Anything).Return(resolved, nil)
+		d.repo.On("Log", mock.Anything, mock.MatchedBy(func(e models.AuditEntry) bool {
+			return e.Actor == models.AuditActorModerator && e.Action == models.AuditAction(action) &&
+				*e.ReportID == 3 && assert.ObjectsAreEqual(details, e.Details)
+		})).Return(nil)
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		reportID    string
+		action      models.ReportAction
+		setupMock   func(d *deps)
+		expectedErr string
+	}{
+		{
+			name:     "dismiss_restores_hidden_comment",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionDismiss,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				expectStatus(d, models.CommentStatusPending)
+				d.comments.On("Approve", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1}, nil)
+				d.broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentAdded
+				})).Return(nil)
+				expectResolved(d, models.ReportActionDismiss, nil)
+			},
+		},
+		{
+			name:     "dismiss_comment_rejected_from_queue",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionDismiss,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				expectStatus(d, models.CommentStatusRejected)
+				expectResolved(d, models.ReportActionDismiss, nil)
+			},
+		},
+		{
+			name:     "dismiss_visible_target",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionDismiss,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(false), nil)
+				expectResolved(d, models.ReportActionDismiss, nil)
+			},
+		},
+		{
+			name:     "hide_visible_comment",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionHide,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(false), nil)
+				expectStatus(d, models.CommentStatusPublished)
+				d.comments.On("Hold", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1}, nil)
+				d.broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+				d.comments.On("Reject", mock.Anything, int64(7), &reason).Return(&models.Comment{ID: 7}, nil)
+				expectResolved(d, models.ReportActionHide, nil)
+			},
+		},
+		{
+			name:     "hide_hidden_comment",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionHide,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				expectStatus(d, models.CommentStatusPending)
+				d.comments.On("Reject", mock.Anything, int64(7), &reason).Return(&models.Comment{ID: 7}, nil)
+				expectResolved(d, models.ReportActionHide, nil)
+			},
+		},
+		{
+			name:     "hide_comment_approved_from_queue",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionHide,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				expectStatus(d, models.CommentStatusPublished)
+				d.comments.On("Hold", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1}, nil)
+				d.broker.On("Publish", mock.Anything, mock.Anything).Return(nil)
+				d.comments.On("Reject", mock.Anything, int64(7), &reason).Return(&models.Comment{ID: 7}, nil)
+				expectResolved(d, models.ReportActionHide, nil)
+			},
+		},
+		{
+			name:     "hide_comment_rejected_from_queue",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionHide,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				expectStatus(d, models.CommentStatusRejected)
+				expectResolved(d, models.ReportActionHide, nil)
+			},
+		},
+		{
+			name:     "delete_hidden_comment",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionDelete,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				expectStatus(d, models.CommentStatusPending)
+				d.comments.On("Approve", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1}, nil)
+				d.comments.On("Delete", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1, IsDeleted: true}, nil)
+				d.broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentDeleted
+				})).Return(nil)
+				expectResolved(d, models.ReportActionDelete, nil)
+			},
+		},
+		{
+			name:     "delete_comment_approved_from_queue",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionDelete,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				expectStatus(d, models.CommentStatusPublished)
+				d.comments.On("Delete", mock.Anything, int64(7)).Return(&models.Comment{ID: 7, PostID: 1, IsDeleted: true}, nil)
+				d.broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventCommentDeleted
+				})).Return(nil)
+				expectResolved(d, models.ReportActionDelete, nil)
+			},
+		},
+		{
+			name:     "delete_comment_rejected_from_queue",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionDelete,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				expectStatus(d, models.CommentStatusRejected)
+				expectResolved(d, models.ReportActionDelete, nil)
+			},
+		},
+		{
+			name:     "delete_post",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionDelete,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openPost, nil)
+				d.posts.On("Hide", mock.Anything, int64(1), mock.Anything).Return(&models.Post{ID: 1}, nil)
+				d.broker.On("Publish", mock.Anything, mock.MatchedBy(func(e models.Event) bool {
+					return e.Type == models.EventPostUpdated
+				})).Return(nil)
+				expectResolved(d, models.ReportActionDelete, nil)
+			},
+		},
+		{
+			name:     "ban_author",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionBanAuthor,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openComment(true), nil)
+				d.bans.On("Ban", mock.Anything, "Bob", mock.Anything).Return(nil)
+				expectStatus(d, models.CommentStatusPending)
+				d.comments.On("Reject", mock.Anything, int64(7), &reason).Return(&models.Comment{ID: 7}, nil)
+				expectResolved(d, models.ReportActionBanAuthor, strPtr("Bob"))
+			},
+		},
+		{
+			name:        "not_a_moderator",
+			ctx:         context.Background(),
+			reportID:    reportID,
+			action:      models.ReportActionHide,
+			expectedErr: "moderator access required",
+		},
+		{
+			name:        "invalid_report_id",
+			ctx:         moderatorCtx,
+			reportID:    globalid.Encode(globalid.Comment, 3),
+			action:      models.ReportActionHide,
+			expectedErr: "invalid report id format",
+		},
+		{
+			name:     "already_resolved",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionHide,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(resolved, nil)
+			},
+			expectedErr: "report is already resolved",
+		},
+		{
+			name:     "unknown_action",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   "PUNISH",
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openPost, nil)
+			},
+			expectedErr: "unknown report action",
+		},
+		{
+			name:     "action_error",
+			ctx:      moderatorCtx,
+			reportID: reportID,
+			action:   models.ReportActionBanAuthor,
+			setupMock: func(d *deps) {
+				d.repo.On("GetByID", mock.Anything, int64(3)).Return(openPost, nil)
+				d.bans.On("Ban", mock.Anything, "Bob", mock.Anything).Return(errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			if tt.setupMock != nil {
+				tt.setupMock(d)
+			}
+
+			got, err := d.service(Limits{HideThreshold: 5}).ResolveReport(tt.ctx, tt.reportID, tt.action)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, resolved, got)
+			}
+		})
+	}
+}
+
+func TestService_Reports(t *testing.T) {
+	t.Parallel()
+
+	moderatorCtx := auth.WithAuthorization(context.Background(), "Bearer secret", "secret")
+	reports := []*models.Report{
+		{ID: 1, CreatedAt: "2026-02-13T10:00:00Z"},
+		{ID: 2, CreatedAt: "2026-02-13T11:00:00Z"},
+	}
+
+	t.Run("first_page", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.repo.On("Get", mock.Anything, models.ReportStatusOpen, (*string)(nil), int64(0), int32(2)).Return(reports, nil)
+		d.repo.On("Count", mock.Anything, models.ReportStatusOpen).Return(int64(2), nil)
+
+		got, err := d.service(Limits{HideThreshold: 5}).Reports(moderatorCtx, nil, int32Ptr(1), nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, got.Edges, 1)
+		assert.Equal(t, reports[0], got.Edges[0].Node)
+		assert.True(t, got.PageInfo.HasNextPage)
+		assert.Equal(t, cursor.Encode(reports[0].CreatedAt, reports[0].ID), *got.PageInfo.EndCursor)
+		assert.Equal(t, int32(2), got.TotalCount)
+	})
+
+	t.Run("after_cursor", func(t *testing.T) {
+		t.Parallel()
+
+		after := cursor.Encode(reports[0].CreatedAt, reports[0].ID)
+		status := models.ReportStatusResolved
+
+		d := newDeps(t)
+		d.repo.On("Get", mock.Anything, models.ReportStatusResolved, &reports[0].CreatedAt, int64(1), int32(pagination.DefaultLimit+1)).Return(reports[1:], nil)
+		d.repo.On("Count", mock.Anything, models.ReportStatusResolved).Return(int64(2), nil)
+
+		got, err := d.service(Limits{HideThreshold: 5}).Reports(moderatorCtx, &status, nil, &after)
+
+		assert.NoError(t, err)
+		assert.Len(t, got.Edges, 1)
+		assert.False(t, got.PageInfo.HasNextPage)
+	})
+
+	t.Run("not_a_moderator", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := newDeps(t).service(Limits{HideThreshold: 5}).Reports(context.Background(), nil, nil, nil)
+
+		assert.EqualError(t, err, "moderator access required")
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := newDeps(t).service(Limits{HideThreshold: 5}).Reports(moderatorCtx, nil, nil, strPtr("bad"))
+
+		assert.EqualError(t, err, "invalid cursor format")
+		assert.Nil(t, got)
+	})
+}
+
+func TestService_AuditLog(t *testing.T) {
+	t.Parallel()
+
+	moderatorCtx := auth.WithAuthorization(context.Background(), "Bearer secret", "secret")
+	entries := []*models.AuditEntry{
+		{ID: 2, CreatedAt: "2026-02-13T11:00:00Z"},
+		{ID: 1, CreatedAt: "2026-02-13T10:00:00Z"},
+	}
+
+	t.Run("newest_first", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.repo.On("GetLog", mock.Anything, (*string)(nil), int64(0), int32(pagination.DefaultLimit+1)).Return(entries, nil)
+		d.repo.On("LogCount", mock.Anything).Return(int64(2), nil)
+
+		got, err := d.service(Limits{HideThreshold: 5}).AuditLog(moderatorCtx, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, got.Edges, 2)
+		assert.Equal(t, entries[0], got.Edges[0].Node)
+		assert.False(t, got.PageInfo.HasNextPage)
+		assert.Equal(t, int32(2), got.TotalCount)
+	})
+
+	t.Run("before_cursor", func(t *testing.T) {
+		t.Parallel()
+
+		after := cursor.Encode(entries[0].CreatedAt, entries[0].ID)
+
+		d := newDeps(t)
+		d.repo.On("GetLog", mock.Anything, &entries[0].CreatedAt, int64(2), int32(2)).Return(entries[1:], nil)
+		d.repo.On("LogCount", mock.Anything).Return(int64(2), nil)
+
+		got, err := d.service(Limits{HideThreshold: 5}).AuditLog(moderatorCtx, int32Ptr(1), &after)
+
+		assert.NoError(t, err)
+		assert.Len(t, got.Edges, 1)
+		assert.Equal(t, entries[1], got.Edges[0].Node)
+	})
+
+	t.Run("not_a_moderator", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := newDeps(t).service(Limits{HideThreshold: 5}).AuditLog(context.Background(), nil, nil)
+
+		assert.EqualError(t, err, "moderator access required")
+		assert.Nil(t, got)
+	})
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,109 @@
+package report
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/pagination"
+)
+
+// Reports pages through the reports with the given status, OPEN by default,
+// oldest first.
+func (s *Service) Reports(ctx context.Context, status *models.ReportStatus, first *int32, after *string) (*models.ReportConnection, error) {
+	if err := auth.RequireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	st := models.ReportStatusOpen
+	if status != nil {
+		st = *status
+	}
+	if st != models.ReportStatusOpen && st != models.ReportStatusResolved {
+		return nil, errors.New("unknown report status")
+	}
+
+	window := pagination.Window{Limit: pagination.Limit(first), Cursor: after}
+
+	pos, err := cursor.Parse(window.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := s.repo.Get(ctx, st, pos.CreatedAt, pos.ID, window.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage, reports := pagination.ExtractPage(reports, window)
+
+	totalCount, err := s.repo.Count(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*models.ReportEdge, 0, len(reports))
+	for _, r := range reports {
+		edges = append(edges, &models.ReportEdge{
+			Cursor: cursor.Encode(r.CreatedAt, r.ID),
+			Node:   r,
+		})
+	}
+
+	return &models.ReportConnection{
+		Edges:      edges,
+		PageInfo:   pagination.BuildPageInfo(edges, reportCursor, window, hasNextPage),
+		TotalCount: int32(totalCount),
+	}, nil
+}
+
+// AuditLog pages through the moderation audit log newest first.
+func (s *Service) AuditLog(ctx context.Context, first *int32, after *string) (*models.AuditEntryConnection, error) {
+	if err := auth.RequireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	window := pagination.Window{Limit: pagination.Limit(first), Cursor: after}
+
+	pos, err := cursor.Parse(window.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repo.GetLog(ctx, pos.CreatedAt, pos.ID, window.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage, entries := pagination.ExtractPage(entries, window)
+
+	totalCount, err := s.repo.LogCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*models.AuditEntryEdge, 0, len(entries))
+	for _, e := range entries {
+		edges = append(edges, &models.AuditEntryEdge{
+			Cursor: cursor.Encode(e.CreatedAt, e.ID),
+			Node:   e,
+		})
+	}
+
+	return &models.AuditEntryConnection{
+		Edges:      edges,
+		PageInfo:   pagination.BuildPageInfo(edges, auditEntryCursor, window, hasNextPage),
+		TotalCount: int32(totalCount),
+	}, nil
+}
+
+func reportCursor(edge *models.ReportEdge) string {
+	return edge.Cursor
+}
+
+func auditEntryCursor(edge *models.AuditEntryEdge) string {
+	return edge.Cursor
+}
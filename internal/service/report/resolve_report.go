@@ -0,0 +1,81 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/auth"
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// ResolveReport applies a moderator's decision to the target of a report and
+// resolves every open report on that target with it:
+//
+//   - DISMISS keeps the target and brings it back if reports hid it.
+//   - HIDE hides the target for good.
+//   - DELETE deletes the target.
+//   - BAN_AUTHOR bans the author of the target and hides it.
+func (s *Service) ResolveReport(ctx context.Context, reportID string, action models.ReportAction) (*models.Report, error) {
+	if err := auth.RequireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := parseReportID(reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != models.ReportStatusOpen {
+		return nil, errors.New("report is already resolved")
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	var details *string
+	switch action {
+	case models.ReportActionDismiss:
+		if report.TargetHidden {
+			err = s.restoreTarget(ctx, report.Target, report.TargetID)
+		}
+	case models.ReportActionHide:
+		err = s.removeTarget(ctx, report)
+	case models.ReportActionDelete:
+		err = s.deleteTarget(ctx, report)
+	case models.ReportActionBanAuthor:
+		details = &report.TargetAuthor
+		if err = s.bans.Ban(ctx, report.TargetAuthor, now); err == nil {
+			err = s.removeTarget(ctx, report)
+		}
+	default:
+		return nil, errors.New("unknown report action")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := s.repo.Resolve(ctx, id, action, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.record(ctx, models.AuditEntry{
+		Actor:     models.AuditActorModerator,
+		Action:    models.AuditAction(action),
+		Target:    report.Target,
+		TargetID:  report.TargetID,
+		ReportID:  &report.ID,
+		Details:   details,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,161 @@
+package report
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// hideTarget takes a visible post or comment out of every listing until a
+// moderator looks at it. Comments go back to the moderation queue; posts have
+// no queue and are soft-deleted in a way only a moderator can restore. The
+// caller publishes the returned event once the change is committed.
+func (s *Service) hideTarget(ctx context.Context, target models.ReportTarget, id int64) (models.Event, error) {
+	if target == models.ReportTargetPost {
+		post, err := s.posts.Hide(ctx, id, time.Now().UTC().Format(time.RFC3339))
+		if err != nil {
+			return models.Event{}, err
+		}
+
+		return postEvent(post), nil
+	}
+
+	comment, err := s.comments.Hold(ctx, id)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	return commentEvent(models.EventCommentDeleted, comment), nil
+}
+
+// restoreTarget brings back a target hideTarget hid. A moderator may have
+// approved or rejected the comment from the moderation queue since, which
+// settles it already.
+func (s *Service) restoreTarget(ctx context.Context, target models.ReportTarget, id int64) error {
+	if target == models.ReportTargetPost {
+		post, err := s.posts.Restore(ctx, id, true)
+		if err != nil {
+			return err
+		}
+
+		s.publish(ctx, postEvent(post))
+		return nil
+	}
+
+	current, err := s.comments.GetByIDAnyStatus(ctx, id)
+	if err != nil || current.Status != models.CommentStatusPending {
+		return err
+	}
+
+	comment, err := s.comments.Approve(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.publish(ctx, commentEvent(models.EventCommentAdded, comment))
+	return nil
+}
+
+// removeTarget hides the target of a report for good. A comment is rejected
+// with the report reason; a post stays soft-deleted. Like restoreTarget, it
+// goes by the status the comment has now rather than by report.TargetHidden:
+// a moderator may have approved the comment from the queue, which makes it
+// visible again, or rejected it, which leaves nothing to do. A tombstone has
+// nothing left to hide either.
+func (s *Service) removeTarget(ctx context.Context, report *models.Report) error {
+	if report.Target == models.ReportTargetPost {
+		if report.TargetHidden {
+			return nil
+		}
+		return s.hide(ctx, report.Target, report.TargetID)
+	}
+
+	current, err := s.comments.GetByIDAnyStatus(ctx, report.TargetID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case current.IsDeleted || current.Status == models.CommentStatusRejected:
+		return nil
+	case current.Status == models.CommentStatusPublished:
+		if err := s.hide(ctx, report.Target, report.TargetID); err != nil {
+			return err
+		}
+	}
+
+	reason := "Reported as " + string(report.Reason)
+	_, err = s.comments.Reject(ctx, report.TargetID, &reason)
+
+	return err
+}
+
+// deleteTarget deletes the target of a report the way its author would: a
+// comment becomes a tombstone that keeps its replies attached. Only published
+// comments can become tombstones, so a held one is published first and a
+// rejected one is gone already. Posts only have a soft delete, which is what
+// hiding them does already.
+func (s *Service) deleteTarget(ctx context.Context, report *models.Report) error {
+	if report.Target == models.ReportTargetPost {
+		return s.removeTarget(ctx, report)
+	}
+
+	current, err := s.comments.GetByIDAnyStatus(ctx, report.TargetID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case current.IsDeleted || current.Status == models.CommentStatusRejected:
+		return nil
+	case current.Status == models.CommentStatusPending:
+		if _, err := s.comments.Approve(ctx, report.TargetID); err != nil {
+			return err
+		}
+	}
+
+	comment, err := s.comments.Delete(ctx, report.TargetID)
+	if err != nil {
+		return err
+	}
+
+	s.publish(ctx, commentEvent(models.EventCommentDeleted, comment))
+	return nil
+}
+
+// hide hides a target outside of a transaction and publishes the change.
+func (s *Service) hide(ctx context.Context, target models.ReportTarget, id int64) error {
+	event, err := s.hideTarget(ctx, target, id)
+	if err != nil {
+		return err
+	}
+
+	s.publish(ctx, event)
+	return nil
+}
+
+// publish tells subscribers about a change that is already persisted, so a
+// broker failure is only logged.
+func (s *Service) publish(ctx context.Context, event models.Event) {
+	if err := s.broker.Publish(ctx, event); err != nil {
+		log.Printf("failed to publish %s event for post %d: %v", event.Type, event.PostID, err)
+	}
+}
+
+func commentEvent(eventType models.EventType, comment *models.Comment) models.Event {
+	return models.Event{
+		Type:    eventType,
+		PostID:  comment.PostID,
+		Comment: comment,
+	}
+}
+
+func postEvent(post *models.Post) models.Event {
+	return models.Event{
+		Type:   models.EventPostUpdated,
+		PostID: post.ID,
+		Post:   post,
+	}
+}
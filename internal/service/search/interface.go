@@ -0,0 +1,11 @@
+package search
+
+import (
+	"context"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+type UseCase interface {
+	Search(ctx context.Context, query string, first *int32, after *string) (*models.SearchConnection, error)
+}
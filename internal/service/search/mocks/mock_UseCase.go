@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/Saracomethstein/ozon-test-task/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUseCase is an autogenerated mock type for the UseCase type
+type MockUseCase struct {
+	mock.Mock
+}
+
+type MockUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUseCase) EXPECT() *MockUseCase_Expecter {
+	return &MockUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function with given fields: ctx, query, first, after
+func (_m *MockUseCase) Search(ctx context.Context, query string, first *int32, after *string) (*models.SearchConnection, error) {
+	ret := _m.Called(ctx, query, first, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 *models.SearchConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int32, *string) (*models.SearchConnection, error)); ok {
+		return rf(ctx, query, first, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int32, *string) *models.SearchConnection); ok {
+		r0 = rf(ctx, query, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SearchConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *int32, *string) error); ok {
+		r1 = rf(ctx, query, first, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockUseCase_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - first *int32
+//   - after *string
+func (_e *MockUseCase_Expecter) Search(ctx interface{}, query interface{}, first interface{}, after interface{}) *MockUseCase_Search_Call {
+	return &MockUseCase_Search_Call{Call: _e.mock.On("Search", ctx, query, first, after)}
+}
+
+func (_c *MockUseCase_Search_Call) Run(run func(ctx context.Context, query string, first *int32, after *string)) *MockUseCase_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*int32), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_Search_Call) Return(_a0 *models.SearchConnection, _a1 error) *MockUseCase_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_Search_Call) RunAndReturn(run func(context.Context, string, *int32, *string) (*models.SearchConnection, error)) *MockUseCase_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUseCase creates a new instance of MockUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUseCase {
+	mock := &MockUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,17 @@
+package search
+
+import (
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+type Service struct {
+	postRepo    repository.PostUC
+	commentRepo repository.CommentUC
+}
+
+func New(postRepo repository.PostUC, commentRepo repository.CommentUC) *Service {
+	return &Service{
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+	}
+}
@@ -0,0 +1,150 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+	maxQueryLength   = 256
+)
+
+func (s *Service) Search(ctx context.Context, query string, first *int32, after *string) (*models.SearchConnection, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New("query cannot be empty")
+	}
+
+	if len([]rune(query)) > maxQueryLength {
+		return nil, errors.New("max query length is 256 char")
+	}
+
+	limit := int32(defaultPageLimit)
+	if first != nil && *first > 0 {
+		limit = min(*first, maxPageLimit)
+	}
+
+	pos, err := cursor.ParseHit(after)
+	if err != nil {
+		return nil, err
+	}
+
+	postRank, postAfterID := resumeAfter(pos, cursor.HitPost)
+	posts, err := s.postRepo.Search(ctx, query, postRank, postAfterID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	commentRank, commentAfterID := resumeAfter(pos, cursor.HitComment)
+	comments, err := s.commentRepo.Search(ctx, query, commentRank, commentAfterID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := merge(posts, comments)
+
+	hasNextPage := len(hits) > int(limit)
+	if hasNextPage {
+		hits = hits[:limit]
+	}
+
+	postCount, err := s.postRepo.SearchCount(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	commentCount, err := s.commentRepo.SearchCount(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*models.SearchEdge, len(hits))
+	for i, hit := range hits {
+		edges[i] = &models.SearchEdge{
+			Cursor: encodeCursor(hit),
+			Node:   hit,
+		}
+	}
+
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &models.SearchConnection{
+		Edges: edges,
+		PageInfo: &models.PageInfo{
+			StartCursor: startCursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		},
+		TotalCount: int32(postCount + commentCount),
+	}, nil
+}
+
+// resumeAfter translates the merged position into the (rank, id) keyset of a
+// single repository. Posts sort before comments on equal rank, so after a
+// comment no post of that rank is left, while after a post every comment of it
+// still is.
+func resumeAfter(pos *cursor.Hit, kind string) (*float64, int64) {
+	if pos == nil {
+		return nil, 0
+	}
+
+	rank := pos.Rank
+
+	switch {
+	case pos.Kind == kind:
+		return &rank, pos.ID
+	case kind == cursor.HitPost:
+		return &rank, 0
+	default:
+		return &rank, math.MaxInt64
+	}
+}
+
+func merge(posts, comments []*models.SearchHit) []*models.SearchHit {
+	hits := make([]*models.SearchHit, 0, len(posts)+len(comments))
+	hits = append(hits, posts...)
+	hits = append(hits, comments...)
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Rank != hits[j].Rank {
+			return hits[i].Rank > hits[j].Rank
+		}
+
+		if (hits[i].Post != nil) != (hits[j].Post != nil) {
+			return hits[i].Post != nil
+		}
+
+		return hitID(hits[i]) > hitID(hits[j])
+	})
+
+	return hits
+}
+
+func hitID(hit *models.SearchHit) int64 {
+	if hit.Post != nil {
+		return hit.Post.ID
+	}
+	return hit.Comment.ID
+}
+
+func encodeCursor(hit *models.SearchHit) string {
+	kind := cursor.HitComment
+	if hit.Post != nil {
+		kind = cursor.HitPost
+	}
+
+	return cursor.EncodeHit(hit.Rank, kind, hitID(hit))
+}
@@ -0,0 +1,144 @@
+package search
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/mocks"
+	"github.com/Saracomethstein/ozon-test-task/internal/utils/cursor"
+)
+
+func TestService_Search(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	post1 := &models.SearchHit{Rank: 0.5, Post: &models.Post{ID: 1}}
+	comment3 := &models.SearchHit{Rank: 0.7, Comment: &models.Comment{ID: 3}}
+	comment9 := &models.SearchHit{Rank: 0.5, Comment: &models.Comment{ID: 9}}
+
+	afterPost := cursor.EncodeRank(0.5, "post:1")
+	afterComment := cursor.EncodeRank(0.5, "comment:9")
+	badKind := cursor.EncodeRank(0.5, "user:1")
+	rank := 0.5
+	two := int32(2)
+
+	tests := []struct {
+		name        string
+		query       string
+		first       *int32
+		after       *string
+		setupMock   func(posts *mocks.MockPostUC, comments *mocks.MockCommentUC)
+		wantIDs     []string
+		wantNext    bool
+		wantTotal   int32
+		expectedErr string
+	}{
+		{
+			name:  "merged_by_rank_posts_first_on_ties",
+			query: " hello ",
+			first: &two,
+			setupMock: func(posts *mocks.MockPostUC, comments *mocks.MockCommentUC) {
+				posts.On("Search", mock.Anything, "hello", (*float64)(nil), int64(0), int32(3)).
+					Return([]*models.SearchHit{post1}, nil)
+				comments.On("Search", mock.Anything, "hello", (*float64)(nil), int64(0), int32(3)).
+					Return([]*models.SearchHit{comment3, comment9}, nil)
+				posts.On("SearchCount", mock.Anything, "hello").Return(int64(1), nil)
+				comments.On("SearchCount", mock.Anything, "hello").Return(int64(2), nil)
+			},
+			wantIDs:   []string{"comment:3", "post:1"},
+			wantNext:  true,
+			wantTotal: 3,
+		},
+		{
+			name:  "after_post_keeps_comments_of_same_rank",
+			query: "hello",
+			after: &afterPost,
+			setupMock: func(posts *mocks.MockPostUC, comments *mocks.MockCommentUC) {
+				posts.On("Search", mock.Anything, "hello", &rank, int64(1), int32(21)).
+					Return([]*models.SearchHit{}, nil)
+				comments.On("Search", mock.Anything, "hello", &rank, int64(math.MaxInt64), int32(21)).
+					Return([]*models.SearchHit{comment9}, nil)
+				posts.On("SearchCount", mock.Anything, "hello").Return(int64(1), nil)
+				comments.On("SearchCount", mock.Anything, "hello").Return(int64(2), nil)
+			},
+			wantIDs:   []string{"comment:9"},
+			wantTotal: 3,
+		},
+		{
+			name:  "after_comment_skips_posts_of_same_rank",
+			query: "hello",
+			after: &afterComment,
+			setupMock: func(posts *mocks.MockPostUC, comments *mocks.MockCommentUC) {
+				posts.On("Search", mock.Anything, "hello", &rank, int64(0), int32(21)).
+					Return([]*models.SearchHit{}, nil)
+				comments.On("Search", mock.Anything, "hello", &rank, int64(9), int32(21)).
+					Return([]*models.SearchHit{}, nil)
+				posts.On("SearchCount", mock.Anything, "hello").Return(int64(1), nil)
+				comments.On("SearchCount", mock.Anything, "hello").Return(int64(2), nil)
+			},
+			wantIDs:   []string{},
+			wantTotal: 3,
+		},
+		{
+			name:        "empty_query",
+			query:       "  ",
+			expectedErr: "query cannot be empty",
+		},
+		{
+			name:        "invalid_cursor",
+			query:       "hello",
+			after:       &badKind,
+			expectedErr: "invalid cursor format",
+		},
+		{
+			name:  "repo_error",
+			query: "hello",
+			setupMock: func(posts *mocks.MockPostUC, comments *mocks.MockCommentUC) {
+				posts.On("Search", mock.Anything, "hello", (*float64)(nil), int64(0), int32(21)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			postRepo := mocks.NewMockPostUC(t)
+			commentRepo := mocks.NewMockCommentUC(t)
+			if tt.setupMock != nil {
+				tt.setupMock(postRepo, commentRepo)
+			}
+
+			s := New(postRepo, commentRepo)
+			got, err := s.Search(ctx, tt.query, tt.first, tt.after)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+
+			ids := make([]string, len(got.Edges))
+			for i, edge := range got.Edges {
+				_, id, err := cursor.DecodeRank(edge.Cursor)
+				require.NoError(t, err)
+				ids[i] = id
+			}
+
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantNext, got.PageInfo.HasNextPage)
+			assert.Equal(t, tt.wantTotal, got.TotalCount)
+		})
+	}
+}
@@ -3,19 +3,31 @@ package service
 import (
 	"github.com/Saracomethstein/ozon-test-task/internal/service/comment"
 	"github.com/Saracomethstein/ozon-test-task/internal/service/post"
+	"github.com/Saracomethstein/ozon-test-task/internal/service/reaction"
+	"github.com/Saracomethstein/ozon-test-task/internal/service/report"
+	"github.com/Saracomethstein/ozon-test-task/internal/service/search"
 )
 
 type Container struct {
-	PostService    post.UseCase
-	CommentService comment.UseCase
+	PostService     post.UseCase
+	CommentService  comment.UseCase
+	SearchService   search.UseCase
+	ReactionService reaction.UseCase
+	ReportService   report.UseCase
 }
 
 func New(
 	post post.UseCase,
 	comment comment.UseCase,
+	search search.UseCase,
+	reaction reaction.UseCase,
+	report report.UseCase,
 ) *Container {
 	return &Container{
-		PostService:    post,
-		CommentService: comment,
+		PostService:     post,
+		CommentService:  comment,
+		SearchService:   search,
+		ReactionService: reaction,
+		ReportService:   report,
 	}
 }
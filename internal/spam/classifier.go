@@ -0,0 +1,182 @@
+package spam
+
+import (
+	"context"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository"
+)
+
+// minDocs is how many comments of each class the classifier must have seen
+// before it scores anything; a handful of examples says little.
+const minDocs = 5
+
+// Classifier is a naive-Bayes spam classifier over the words of a comment.
+// It learns from moderation outcomes, keeps its statistics in memory and
+// saves every training step through the repository. Replicas sharing a
+// database only see each other's training when they reload, see Reload. A nil
+// Classifier scores nothing and learns nothing.
+type Classifier struct {
+	repo      repository.SpamUC
+	threshold float64
+
+	mu    sync.RWMutex
+	stats models.SpamStats
+}
+
+// New returns a classifier that holds comments scoring at or above threshold.
+// A threshold of zero or less never holds.
+func New(repo repository.SpamUC, threshold float64) *Classifier {
+	return &Classifier{
+		repo:      repo,
+		threshold: threshold,
+		stats:     models.SpamStats{Tokens: make(map[string]models.SpamTokenCount)},
+	}
+}
+
+// Load replaces the in-memory statistics with the saved ones.
+func (c *Classifier) Load(ctx context.Context) error {
+	stats, err := c.repo.LoadStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.stats = *stats
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Reload loads the saved statistics every interval until ctx is done, so a
+// replica picks up what the others learned. Between reloads a replica may
+// score with statistics up to one interval old. An interval of zero or less
+// never reloads.
+func (c *Classifier) Reload(ctx context.Context, interval time.Duration) {
+	if c == nil || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Load(ctx); err != nil {
+				log.Printf("failed to reload the spam model: %v", err)
+			}
+		}
+	}
+}
+
+// Score returns the probability that text is spam. ok is false while the
+// classifier has seen fewer than minDocs comments of either class.
+func (c *Classifier) Score(text string) (score float64, ok bool) {
+	if c == nil {
+		return 0, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	spamDocs, hamDocs := float64(c.stats.SpamDocs), float64(c.stats.HamDocs)
+	if spamDocs < minDocs || hamDocs < minDocs {
+		return 0, false
+	}
+
+	logSpam := math.Log(spamDocs / (spamDocs + hamDocs))
+	logHam := math.Log(hamDocs / (spamDocs + hamDocs))
+
+	// Laplace smoothing keeps a token seen in one class only from deciding
+	// the score on its own.
+	for _, token := range tokenize(text) {
+		count, seen := c.stats.Tokens[token]
+		if !seen {
+			continue
+		}
+
+		logSpam += math.Log((float64(count.Spam) + 1) / (spamDocs + 2))
+		logHam += math.Log((float64(count.Ham) + 1) / (hamDocs + 2))
+	}
+
+	return 1 / (1 + math.Exp(logHam-logSpam)), true
+}
+
+// Hold reports whether a comment with the given score waits for a moderator.
+func (c *Classifier) Hold(score float64) bool {
+	if c == nil || c.threshold <= 0 {
+		return false
+	}
+
+	return score >= c.threshold
+}
+
+// Train learns from a moderation outcome: spam for rejected comments, not
+// spam for approved ones.
+func (c *Classifier) Train(ctx context.Context, text string, isSpam bool) error {
+	if c == nil {
+		return nil
+	}
+
+	tokens := tokenize(text)
+
+	// Save first so scoring is not blocked on the database; the in-memory
+	// statistics only change once the step is saved.
+	if err := c.repo.Train(ctx, tokens, isSpam); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if isSpam {
+		c.stats.SpamDocs++
+	} else {
+		c.stats.HamDocs++
+	}
+
+	for _, token := range tokens {
+		count := c.stats.Tokens[token]
+		if isSpam {
+			count.Spam++
+		} else {
+			count.Ham++
+		}
+		c.stats.Tokens[token] = count
+	}
+
+	return nil
+}
+
+// tokenize returns the distinct lowercase words of text, skipping one-letter
+// words.
+func tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]struct{}, len(fields))
+	tokens := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if len([]rune(f)) < 2 {
+			continue
+		}
+		if _, ok := seen[f]; ok {
+			continue
+		}
+
+		seen[f] = struct{}{}
+		tokens = append(tokens, f)
+	}
+
+	return tokens
+}
@@ -0,0 +1,157 @@
+package spam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+	"github.com/Saracomethstein/ozon-test-task/internal/repository/mocks"
+)
+
+func trained(t *testing.T, threshold float64) *Classifier {
+	t.Helper()
+
+	repo := mocks.NewMockSpamUC(t)
+	repo.On("Train", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	c := New(repo, threshold)
+	for _, text := range []string{
+		"cheap pills buy now",
+		"buy cheap watches now",
+		"win money now click here",
+		"cheap casino bonus click",
+		"free money click here now",
+	} {
+		require.NoError(t, c.Train(context.Background(), text, true))
+	}
+	for _, text := range []string{
+		"great post thanks for sharing",
+		"I disagree with the second point",
+		"thanks, this helped me a lot",
+		"could you share the source code",
+		"nice write up on graphql",
+	} {
+		require.NoError(t, c.Train(context.Background(), text, false))
+	}
+
+	return c
+}
+
+func TestClassifier_Score(t *testing.T) {
+	t.Parallel()
+
+	c := trained(t, 0.9)
+
+	spamScore, ok := c.Score("Buy cheap pills now, click here!")
+	require.True(t, ok)
+	assert.Greater(t, spamScore, 0.9)
+	assert.True(t, c.Hold(spamScore))
+
+	hamScore, ok := c.Score("Thanks for sharing the source")
+	require.True(t, ok)
+	assert.Less(t, hamScore, 0.1)
+	assert.False(t, c.Hold(hamScore))
+
+	unknown, ok := c.Score("zzz")
+	require.True(t, ok)
+	assert.InDelta(t, 0.5, unknown, 1e-9)
+}
+
+func TestClassifier_Untrained(t *testing.T) {
+	t.Parallel()
+
+	c := New(mocks.NewMockSpamUC(t), 0.9)
+
+	_, ok := c.Score("buy cheap pills now")
+	assert.False(t, ok)
+
+	var disabled *Classifier
+	_, ok = disabled.Score("buy cheap pills now")
+	assert.False(t, ok)
+	assert.False(t, disabled.Hold(1))
+	assert.NoError(t, disabled.Train(context.Background(), "buy", true))
+}
+
+func TestClassifier_HoldDisabled(t *testing.T) {
+	t.Parallel()
+
+	c := trained(t, 0)
+
+	assert.False(t, c.Hold(1))
+}
+
+func TestClassifier_Load(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewMockSpamUC(t)
+	repo.On("LoadStats", mock.Anything).Return(&models.SpamStats{
+		SpamDocs: 5,
+		HamDocs:  5,
+		Tokens:   map[string]models.SpamTokenCount{"casino": {Spam: 5}},
+	}, nil)
+
+	c := New(repo, 0.9)
+	require.NoError(t, c.Load(context.Background()))
+
+	score, ok := c.Score("casino")
+	require.True(t, ok)
+	assert.InDelta(t, 6.0/7.0, score, 1e-9)
+}
+
+func TestClassifier_TrainError(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewMockSpamUC(t)
+	repo.On("Train", mock.Anything, []string{"buy", "pills"}, true).Return(errors.New("db error"))
+
+	c := New(repo, 0.9)
+	err := c.Train(context.Background(), "Buy a pills, buy!", true)
+
+	assert.EqualError(t, err, "db error")
+	assert.Zero(t, c.stats.SpamDocs)
+	assert.Empty(t, c.stats.Tokens)
+}
+
+func TestClassifier_ScoresWhileTraining(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewMockSpamUC(t)
+	c := New(repo, 0.9)
+
+	// Score runs while the training step is being saved; holding the lock
+	// across the save would deadlock here.
+	repo.On("Train", mock.Anything, []string{"casino"}, true).
+		Run(func(mock.Arguments) { c.Score("casino") }).
+		Return(nil)
+
+	require.NoError(t, c.Train(context.Background(), "casino", true))
+	assert.Equal(t, int64(1), c.stats.SpamDocs)
+}
+
+func TestClassifier_Reload(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The first reload stops the loop, so Reload returns after it.
+	repo := mocks.NewMockSpamUC(t)
+	repo.On("LoadStats", mock.Anything).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(&models.SpamStats{SpamDocs: 5, HamDocs: 5}, nil).
+		Once()
+
+	c := New(repo, 0.9)
+	c.Reload(ctx, time.Millisecond)
+
+	_, ok := c.Score("casino")
+	assert.True(t, ok)
+
+	// A disabled reload returns at once.
+	c.Reload(context.Background(), 0)
+}
@@ -0,0 +1,115 @@
+package cursor
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+// Score is the vote based sort key of a comment: the balance of votes for
+// TOP and the votes on the losing side for CONTROVERSIAL. Postgres computes
+// the same values as upvotes - downvotes and least(upvotes, downvotes).
+func Score(order models.CommentOrder, c *models.Comment) int64 {
+	if order == models.CommentOrderControversial {
+		return int64(min(c.Upvotes, c.Downvotes))
+	}
+
+	return int64(c.Upvotes - c.Downvotes)
+}
+
+// Position returns the keyset position of a comment inside the ordering.
+func Position(order models.CommentOrder, c *models.Comment) models.CommentCursor {
+	pos := models.CommentCursor{ID: c.ID}
+
+	switch order {
+	case models.CommentOrderTop, models.CommentOrderControversial:
+		pos.Score = Score(order, c)
+	default:
+		pos.CreatedAt = c.CreatedAt
+	}
+
+	return pos
+}
+
+// Less reports whether position a comes before position b: NEWEST sorts by
+// (createdAt, id) descending, OLDEST ascending, TOP and CONTROVERSIAL by
+// (score, id) descending.
+func Less(order models.CommentOrder, a, b models.CommentCursor) bool {
+	switch order {
+	case models.CommentOrderOldest:
+		if a.CreatedAt != b.CreatedAt {
+			return a.CreatedAt < b.CreatedAt
+		}
+		return a.ID < b.ID
+	case models.CommentOrderTop, models.CommentOrderControversial:
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.ID > b.ID
+	default:
+		if a.CreatedAt != b.CreatedAt {
+			return a.CreatedAt > b.CreatedAt
+		}
+		return a.ID > b.ID
+	}
+}
+
+// EncodeComment builds the cursor of a comment inside the ordering. NEWEST
+// keeps the plain createdAt cursor so cursors issued before orderings existed
+// stay valid.
+func EncodeComment(order models.CommentOrder, c *models.Comment) string {
+	switch order {
+	case models.CommentOrderTop, models.CommentOrderControversial:
+		return EncodeKey(string(order), strconv.FormatInt(Score(order, c), 10), c.ID)
+	case models.CommentOrderOldest:
+		return EncodeKey(string(order), c.CreatedAt, c.ID)
+	default:
+		return Encode(c.CreatedAt, c.ID)
+	}
+}
+
+func DecodeComment(order models.CommentOrder, cursor string) (*models.CommentCursor, error) {
+	if order == models.CommentOrderNewest {
+		createdAt, id, err := Decode(cursor)
+		if err != nil {
+			return nil, err
+		}
+		return &models.CommentCursor{CreatedAt: createdAt, ID: id}, nil
+	}
+
+	key, id, err := DecodeKey(string(order), cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	pos := &models.CommentCursor{ID: id}
+	switch order {
+	case models.CommentOrderTop, models.CommentOrderControversial:
+		pos.Score, err = strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid cursor score")
+		}
+	default:
+		pos.CreatedAt = key
+	}
+
+	return pos, nil
+}
+
+// ParseComment decodes the optional after or before argument of a comment
+// connection. Cursors issued for another ordering are rejected as invalid; a
+// missing or empty cursor is the start of the listing and yields nil.
+func ParseComment(order models.CommentOrder, cursor *string) (*models.CommentCursor, error) {
+	if cursor == nil || *cursor == "" {
+		return nil, nil
+	}
+
+	pos, err := DecodeComment(order, *cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor format")
+	}
+
+	return pos, nil
+}
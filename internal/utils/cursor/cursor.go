@@ -35,3 +35,84 @@ func Decode(cursor string) (createdAt string, id int64, err error) {
 
 	return parts[0], id, nil
 }
+
+// Keyset is where a cursor made by Encode points in a listing ordered by
+// creation time and id. The zero Keyset, with no CreatedAt, is the start of
+// the listing.
+type Keyset struct {
+	CreatedAt *string
+	ID        int64
+}
+
+// Parse decodes the optional after or before argument of a connection; a
+// missing or empty cursor is the start of the listing.
+func Parse(cursor *string) (Keyset, error) {
+	if cursor == nil || *cursor == "" {
+		return Keyset{}, nil
+	}
+
+	createdAt, id, err := Decode(*cursor)
+	if err != nil {
+		return Keyset{}, errors.New("invalid cursor format")
+	}
+
+	return Keyset{CreatedAt: &createdAt, ID: id}, nil
+}
+
+// EncodeRank builds a cursor for relevance ordered results. The rank is
+// formatted with the shortest exact representation so that decoding returns
+// the very same float and keyset comparisons stay stable between pages.
+func EncodeRank(rank float64, id string) string {
+	encoded := strconv.FormatFloat(rank, 'g', -1, 64) + cursorSeparator + id
+	return base64.RawURLEncoding.EncodeToString([]byte(encoded))
+}
+
+func DecodeRank(cursor string) (rank float64, id string, err error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, "", errors.New("invalid cursor encoding")
+	}
+
+	parts := strings.SplitN(string(decoded), cursorSeparator, 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", errors.New("invalid cursor format")
+	}
+
+	rank, err = strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, "", errors.New("invalid cursor rank")
+	}
+
+	return rank, parts[1], nil
+}
+
+// EncodeKey builds a cursor for a keyset position inside a named ordering.
+// The ordering is part of the cursor, so a cursor issued for one ordering is
+// rejected by another instead of silently skipping rows.
+func EncodeKey(order, sortKey string, id int64) string {
+	encoded := order + cursorSeparator + sortKey + cursorSeparator + strconv.FormatInt(id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(encoded))
+}
+
+func DecodeKey(order, cursor string) (sortKey string, id int64, err error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", 0, errors.New("invalid cursor encoding")
+	}
+
+	parts := strings.SplitN(string(decoded), cursorSeparator, 3)
+	if len(parts) != 3 {
+		return "", 0, errors.New("invalid cursor format")
+	}
+
+	if parts[0] != order {
+		return "", 0, errors.New("cursor does not match ordering")
+	}
+
+	id, err = strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", 0, errors.New("invalid cursor id")
+	}
+
+	return parts[1], id, nil
+}
@@ -0,0 +1,53 @@
+package cursor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Kinds of result a search cursor points at.
+const (
+	HitPost    = "post"
+	HitComment = "comment"
+)
+
+// Hit is where a cursor made by EncodeHit points in the merged search
+// results, which are ordered by rank desc, then posts before comments, then
+// id desc.
+type Hit struct {
+	Rank float64
+	Kind string
+	ID   int64
+}
+
+// EncodeHit builds the cursor of a search result.
+func EncodeHit(rank float64, kind string, id int64) string {
+	return EncodeRank(rank, kind+":"+strconv.FormatInt(id, 10))
+}
+
+// ParseHit decodes the optional after argument of a search connection; a
+// missing or empty cursor is the start of the results and yields nil.
+func ParseHit(cursor *string) (*Hit, error) {
+	if cursor == nil || *cursor == "" {
+		return nil, nil
+	}
+
+	rank, key, err := DecodeRank(*cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor format")
+	}
+
+	kind, rawID, ok := strings.Cut(key, ":")
+	if !ok || (kind != HitPost && kind != HitComment) {
+		return nil, errors.New("invalid cursor format")
+	}
+
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid cursor format")
+	}
+
+	return &Hit{Rank: rank, Kind: kind, ID: id}, nil
+}
@@ -0,0 +1,74 @@
+package globalid
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Type names the GraphQL type a global ID belongs to. It is part of the
+// encoded ID, so the row IDs of different types never collide.
+type Type string
+
+const (
+	Post       Type = "Post"
+	Comment    Type = "Comment"
+	Report     Type = "Report"
+	Revision   Type = "CommentRevision"
+	AuditEntry Type = "AuditEntry"
+)
+
+const (
+	idSeparator = ":"
+)
+
+// Encode builds the opaque ID a row of the given type is exposed with.
+func Encode(typ Type, id int64) string {
+	encoded := string(typ) + idSeparator + strconv.FormatInt(id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(encoded))
+}
+
+// Decode returns the type and row ID of a global ID. Node resolution uses it
+// to dispatch on the type; everything expecting one type uses DecodeAs.
+func Decode(globalID string) (typ Type, id int64, err error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(globalID)
+	if err != nil {
+		return "", 0, errors.New("invalid id encoding")
+	}
+
+	parts := strings.SplitN(string(decoded), idSeparator, 2)
+	if len(parts) != 2 {
+		return "", 0, errors.New("invalid id format")
+	}
+
+	typ = Type(parts[0])
+	switch typ {
+	case Post, Comment, Report, Revision, AuditEntry:
+	default:
+		return "", 0, errors.New("unknown id type")
+	}
+
+	id, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, errors.New("invalid id format")
+	}
+
+	return typ, id, nil
+}
+
+// DecodeAs returns the row ID of a global ID issued for typ; IDs of any other
+// type are rejected instead of being read as a row of typ.
+func DecodeAs(typ Type, globalID string) (int64, error) {
+	got, id, err := Decode(globalID)
+	if err != nil {
+		return 0, err
+	}
+
+	if got != typ {
+		return 0, errors.New("id does not belong to a " + string(typ))
+	}
+
+	return id, nil
+}
@@ -0,0 +1,30 @@
+// Package highlight turns search snippets into HTML-safe text. Snippets are
+// built with the StartSel and StopSel control characters around matches;
+// HTML escapes the user text and only then swaps them for <b> and </b>.
+package highlight
+
+import (
+	"html"
+	"strings"
+)
+
+const (
+	StartSel = "\x02"
+	StopSel  = "\x03"
+)
+
+var (
+	stripper = strings.NewReplacer(StartSel, "", StopSel, "")
+	markup   = strings.NewReplacer(StartSel, "<b>", StopSel, "</b>")
+)
+
+// Strip removes the selector characters from text, so user text cannot
+// forge a highlight.
+func Strip(text string) string {
+	return stripper.Replace(text)
+}
+
+// HTML escapes snippet and turns its selectors into <b> and </b>.
+func HTML(snippet string) string {
+	return markup.Replace(html.EscapeString(snippet))
+}
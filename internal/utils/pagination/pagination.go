@@ -0,0 +1,77 @@
+package pagination
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/Saracomethstein/ozon-test-task/internal/models"
+)
+
+const (
+	DefaultLimit = 20
+)
+
+// Window is the keyset read a connection page resolves to: first and after
+// read forward from the cursor, last and before read backward from it.
+type Window struct {
+	Limit    int32
+	Cursor   *string
+	Backward bool
+}
+
+// Limit returns the requested page size, or DefaultLimit when none was given.
+func Limit(n *int32) int32 {
+	if n != nil && *n > 0 {
+		return *n
+	}
+
+	return DefaultLimit
+}
+
+// ParseWindow resolves the Relay arguments of a connection. A page is read
+// in one direction only, so first and after cannot be mixed with last and
+// before.
+func ParseWindow(first *int32, after *string, last *int32, before *string) (Window, error) {
+	if last == nil && before == nil {
+		return Window{Limit: Limit(first), Cursor: after}, nil
+	}
+
+	if first != nil || after != nil {
+		return Window{}, errors.New("first and after cannot be combined with last and before")
+	}
+
+	return Window{Limit: Limit(last), Cursor: before, Backward: true}, nil
+}
+
+// ExtractPage drops the extra row read to learn whether another page exists
+// in the read direction; a backward read keeps the rows nearest the cursor,
+// which come last.
+func ExtractPage[T any](rows []T, window Window) (hasMore bool, page []T) {
+	if len(rows) <= int(window.Limit) {
+		return false, rows
+	}
+
+	if window.Backward {
+		return true, rows[len(rows)-int(window.Limit):]
+	}
+
+	return true, rows[:window.Limit]
+}
+
+// BuildPageInfo reports hasMore on the side the page was read toward. The
+// other side is left false, which Relay allows when it is not known cheaply.
+func BuildPageInfo[E any](edges []E, cursorOf func(E) string, window Window, hasMore bool) *models.PageInfo {
+	pageInfo := &models.PageInfo{}
+	if len(edges) > 0 {
+		start, end := cursorOf(edges[0]), cursorOf(edges[len(edges)-1])
+		pageInfo.StartCursor = &start
+		pageInfo.EndCursor = &end
+	}
+
+	if window.Backward {
+		pageInfo.HasPreviousPage = hasMore
+	} else {
+		pageInfo.HasNextPage = hasMore
+	}
+
+	return pageInfo
+}
@@ -0,0 +1,3 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS deleted_at TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_posts_alive_created_at_id ON posts (created_at DESC, id DESC) WHERE deleted_at IS NULL;
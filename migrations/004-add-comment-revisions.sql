@@ -0,0 +1,10 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS edited_at TEXT;
+
+CREATE TABLE IF NOT EXISTS comment_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    comment_id BIGINT NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_comment_revisions_comment_created_id ON comment_revisions (comment_id, created_at DESC, id DESC);
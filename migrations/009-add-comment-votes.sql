@@ -0,0 +1,31 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS upvotes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS downvotes INTEGER NOT NULL DEFAULT 0;
+
+CREATE OR REPLACE FUNCTION sync_comment_votes() RETURNS TRIGGER AS $$
+DECLARE
+    target BIGINT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        target := OLD.comment_id;
+    ELSE
+        target := NEW.comment_id;
+    END IF;
+
+    UPDATE comments SET
+        upvotes = (SELECT count(*) FROM comment_reactions WHERE comment_id = target AND kind = 'up'),
+        downvotes = (SELECT count(*) FROM comment_reactions WHERE comment_id = target AND kind = 'down')
+    WHERE id = target;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_comment_reactions_votes ON comment_reactions;
+CREATE TRIGGER trg_comment_reactions_votes
+    AFTER INSERT OR UPDATE OR DELETE ON comment_reactions
+    FOR EACH ROW EXECUTE FUNCTION sync_comment_votes();
+
+CREATE INDEX IF NOT EXISTS idx_comments_post_oldest ON comments (post_id, created_at, id) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_parent_oldest ON comments (parent_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_comments_post_top ON comments (post_id, (upvotes - downvotes) DESC, id DESC) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_post_controversial ON comments (post_id, least(upvotes, downvotes) DESC, id DESC) WHERE parent_id IS NULL;
@@ -0,0 +1,11 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS depth INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS max_reply_depth INTEGER;
+
+WITH RECURSIVE tree AS (
+    SELECT id, 0 AS depth FROM comments WHERE parent_id IS NULL
+    UNION ALL
+    SELECT c.id, tree.depth + 1 FROM comments c JOIN tree ON c.parent_id = tree.id
+)
+UPDATE comments SET depth = tree.depth
+FROM tree
+WHERE comments.id = tree.id AND comments.depth <> tree.depth;
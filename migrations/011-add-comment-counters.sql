@@ -0,0 +1,22 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS comment_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS root_comment_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS reply_count BIGINT NOT NULL DEFAULT 0;
+
+UPDATE posts SET
+    comment_count = counts.total,
+    root_comment_count = counts.roots
+FROM (
+    SELECT post_id, count(*) AS total, count(*) FILTER (WHERE parent_id IS NULL) AS roots
+    FROM comments
+    GROUP BY post_id
+) counts
+WHERE posts.id = counts.post_id;
+
+UPDATE comments SET reply_count = counts.replies
+FROM (
+    SELECT parent_id, count(*) AS replies
+    FROM comments
+    WHERE parent_id IS NOT NULL
+    GROUP BY parent_id
+) counts
+WHERE comments.id = counts.parent_id;
@@ -0,0 +1,14 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS comment_policy TEXT NOT NULL DEFAULT 'OPEN'
+    CHECK (comment_policy IN ('OPEN', 'CLOSED', 'AUTHOR_ONLY', 'REPLIES_ONLY', 'MODERATED'));
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS comments_close_at TEXT;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'posts' AND column_name = 'allow_comments'
+    ) THEN
+        UPDATE posts SET comment_policy = 'CLOSED' WHERE NOT allow_comments;
+        ALTER TABLE posts DROP COLUMN allow_comments;
+    END IF;
+END $$;
@@ -0,0 +1,5 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'PUBLISHED'
+    CHECK (status IN ('PUBLISHED', 'PENDING', 'REJECTED'));
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS rejection_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_comments_pending ON comments (created_at, id) WHERE status = 'PENDING';
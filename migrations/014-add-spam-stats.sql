@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS spam_totals (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    spam_docs BIGINT NOT NULL DEFAULT 0,
+    ham_docs BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO spam_totals (id) VALUES (TRUE) ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS spam_tokens (
+    token TEXT PRIMARY KEY,
+    spam_count BIGINT NOT NULL DEFAULT 0,
+    ham_count BIGINT NOT NULL DEFAULT 0
+);
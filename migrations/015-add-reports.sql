@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS reports (
+    id BIGSERIAL PRIMARY KEY,
+    target_type TEXT NOT NULL CHECK (target_type IN ('POST', 'COMMENT')),
+    target_id BIGINT NOT NULL,
+    target_author TEXT NOT NULL,
+    reporter TEXT NOT NULL,
+    caller TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL,
+    details TEXT,
+    status TEXT NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'RESOLVED')),
+    action TEXT,
+    target_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TEXT NOT NULL,
+    resolved_at TEXT
+);
+
+-- A reporter has one open report per target, and may report it again once
+-- that report is resolved.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_open_reporter ON reports (target_type, target_id, reporter) WHERE status = 'OPEN';
+CREATE INDEX IF NOT EXISTS idx_reports_caller ON reports (caller, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports (status, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_reports_open_target ON reports (target_type, target_id) WHERE status = 'OPEN';
+
+CREATE TABLE IF NOT EXISTS moderation_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id BIGINT NOT NULL,
+    report_id BIGINT REFERENCES reports(id) ON DELETE SET NULL,
+    details TEXT,
+    created_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_log_created ON moderation_log (created_at DESC, id DESC);
+
+CREATE TABLE IF NOT EXISTS banned_authors (
+    author TEXT PRIMARY KEY,
+    created_at TEXT NOT NULL
+);
+
+-- Posts hidden by reports or moderators are soft-deleted like any other post,
+-- but their authors cannot restore them.
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS moderator_hidden BOOLEAN NOT NULL DEFAULT FALSE;
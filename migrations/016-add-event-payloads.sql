@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS event_payloads (
+    id BIGSERIAL PRIMARY KEY,
+    payload TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_payloads_created_at ON event_payloads (created_at);
@@ -0,0 +1,14 @@
+CREATE SEQUENCE IF NOT EXISTS comments_published_seq;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS published_seq BIGINT;
+
+UPDATE comments SET published_seq = ordered.seq
+FROM (
+    SELECT id, row_number() OVER (ORDER BY created_at, id) AS seq
+    FROM comments
+    WHERE status = 'PUBLISHED'
+) ordered
+WHERE comments.id = ordered.id AND comments.published_seq IS NULL;
+
+SELECT setval('comments_published_seq', coalesce(max(published_seq), 0) + 1, false) FROM comments;
+
+CREATE INDEX IF NOT EXISTS idx_comments_post_published_seq ON comments (post_id, published_seq) WHERE status = 'PUBLISHED';
@@ -0,0 +1,3 @@
+-- Report limits count reporters instead of the hosts reports came from.
+DROP INDEX IF EXISTS idx_reports_caller;
+CREATE INDEX IF NOT EXISTS idx_reports_reporter ON reports (reporter, created_at);
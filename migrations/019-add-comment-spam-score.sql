@@ -0,0 +1,3 @@
+-- The spam score a comment got from the classifier when it was added. Null
+-- when the classifier could not score it yet.
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS spam_score DOUBLE PRECISION;